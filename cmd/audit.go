@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/cmd/flags"
+	auditpg "github.com/longfan78/quorum-key-manager/src/audit/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/audit/service/auditor"
+	authpg "github.com/longfan78/quorum-key-manager/src/auth/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/roles"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/zap"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newAuditCommand() *cobra.Command {
+	var logger *zap.Logger
+
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log management tool",
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the integrity of the audit log",
+		Long:  "Verifies that no event of the audit log was modified, removed or reordered by checking its hash chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var err error
+			if logger, err = getLogger(); err != nil {
+				return err
+			}
+
+			postgresClient, err := client.New(flags.NewPostgresConfig(viper.GetViper()))
+			if err != nil {
+				return err
+			}
+
+			rolesService := roles.New(authpg.NewRoles(postgresClient), logger)
+			auditorService := auditor.New(auditpg.NewEvents(postgresClient, logger), rolesService, logger)
+
+			result, err := auditorService.Verify(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if !result.Valid {
+				return fmt.Errorf("audit log is corrupted at event %d after %d valid events: %s", result.BrokenAt, result.Count, result.Reason)
+			}
+
+			cmd.Printf("audit log is valid: %d events verified, last hash %s\n", result.Count, result.LastHash)
+			return nil
+		},
+		PostRun: func(cmd *cobra.Command, args []string) {
+			syncZapLogger(logger)
+		},
+	}
+	auditCmd.AddCommand(verifyCmd)
+	flags.LoggerFlags(verifyCmd.Flags())
+	flags.PGFlags(verifyCmd.Flags())
+
+	return auditCmd
+}
@@ -2,7 +2,9 @@ package flags
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/infra/api-key/csv"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -10,6 +12,10 @@ import (
 
 func init() {
 	_ = viper.BindEnv(authAPIKeyFileViperKey, authAPIKeyFileEnv)
+	viper.SetDefault(authAPIKeysDBViperKey, authAPIKeysDBDefault)
+	_ = viper.BindEnv(authAPIKeysDBViperKey, authAPIKeysDBEnv)
+	viper.SetDefault(authAPIKeysLastUsedIntervalViperKey, authAPIKeysLastUsedIntervalDefault)
+	_ = viper.BindEnv(authAPIKeysLastUsedIntervalViperKey, authAPIKeysLastUsedIntervalEnv)
 }
 
 const (
@@ -19,8 +25,24 @@ const (
 	authAPIKeyFileEnv         = "AUTH_API_KEY_FILE"
 )
 
+const (
+	authAPIKeysDBFlag     = "auth-api-keys-db"
+	authAPIKeysDBViperKey = "auth.api.keys.db"
+	authAPIKeysDBDefault  = false
+	authAPIKeysDBEnv      = "AUTH_API_KEYS_DB"
+)
+
+const (
+	authAPIKeysLastUsedIntervalFlag     = "auth-api-keys-last-used-interval"
+	authAPIKeysLastUsedIntervalViperKey = "auth.api.keys.last.used.interval"
+	authAPIKeysLastUsedIntervalDefault  = time.Minute
+	authAPIKeysLastUsedIntervalEnv      = "AUTH_API_KEYS_LAST_USED_INTERVAL"
+)
+
 func APIKeyFlags(f *pflag.FlagSet) {
 	authAPIKeyFile(f)
+	authAPIKeysDB(f)
+	authAPIKeysLastUsedInterval(f)
 }
 
 func authAPIKeyFile(f *pflag.FlagSet) {
@@ -30,6 +52,20 @@ Environment variable: %q`, authAPIKeyFileEnv)
 	_ = viper.BindPFlag(authAPIKeyFileViperKey, f.Lookup(authAPIKeyFileFlag))
 }
 
+func authAPIKeysDB(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Enables the API keys issued by the key manager and stored in the database
+Environment variable: %q`, authAPIKeysDBEnv)
+	f.Bool(authAPIKeysDBFlag, authAPIKeysDBDefault, desc)
+	_ = viper.BindPFlag(authAPIKeysDBViperKey, f.Lookup(authAPIKeysDBFlag))
+}
+
+func authAPIKeysLastUsedInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Minimum interval between two updates of the last usage of an API key issued by the key manager
+Environment variable: %q`, authAPIKeysLastUsedIntervalEnv)
+	f.Duration(authAPIKeysLastUsedIntervalFlag, authAPIKeysLastUsedIntervalDefault, desc)
+	_ = viper.BindPFlag(authAPIKeysLastUsedIntervalViperKey, f.Lookup(authAPIKeysLastUsedIntervalFlag))
+}
+
 func NewAPIKeyConfig(vipr *viper.Viper) *csv.Config {
 	path := vipr.GetString(authAPIKeyFileViperKey)
 
@@ -39,3 +75,13 @@ func NewAPIKeyConfig(vipr *viper.Viper) *csv.Config {
 
 	return nil
 }
+
+func NewAPIKeysConfig(vipr *viper.Viper) *entities.APIKeysConfig {
+	if !vipr.GetBool(authAPIKeysDBViperKey) {
+		return nil
+	}
+
+	return &entities.APIKeysConfig{
+		LastUsedInterval: vipr.GetDuration(authAPIKeysLastUsedIntervalViperKey),
+	}
+}
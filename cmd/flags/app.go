@@ -11,13 +11,36 @@ func NewAppConfig(vipr *viper.Viper) (*app.Config, error) {
 		return nil, err
 	}
 
+	vaultsCfg, err := NewVaultsConfig(vipr)
+	if err != nil {
+		return nil, err
+	}
+
+	approvalsCfg, err := NewApprovalsConfig(vipr)
+	if err != nil {
+		return nil, err
+	}
+
 	return &app.Config{
-		Logger:   NewLoggerConfig(vipr),
-		HTTP:     httpCfg,
-		Manifest: NewManifestConfig(vipr),
-		OIDC:     NewOIDCConfig(vipr),
-		APIKey:   NewAPIKeyConfig(vipr),
-		TLS:      NewTLSConfig(vipr),
-		Postgres: NewPostgresConfig(vipr),
+		Logger:       NewLoggerConfig(vipr),
+		HTTP:         httpCfg,
+		Manifest:     NewManifestConfig(vipr),
+		OIDC:         NewOIDCConfig(vipr),
+		APIKey:       NewAPIKeyConfig(vipr),
+		APIKeys:      NewAPIKeysConfig(vipr),
+		TLS:          NewTLSConfig(vipr),
+		Postgres:     NewPostgresConfig(vipr),
+		Vaults:       vaultsCfg,
+		Approvals:    approvalsCfg,
+		Nonces:       NewNoncesConfig(vipr),
+		Transactions: NewTransactionsConfig(vipr),
+		Rotation:     NewRotationConfig(vipr),
+		Expiry:       NewExpiryConfig(vipr),
+		Sync:         NewSyncConfig(vipr),
+		Quotas:       NewQuotaConfig(vipr),
+		RateLimit:    NewRateLimitConfig(vipr),
+		Reload:       NewReloadConfig(vipr),
+		Tracing:      NewTracingConfig(vipr),
+		Cluster:      NewClusterConfig(vipr),
 	}, nil
 }
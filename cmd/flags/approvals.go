@@ -0,0 +1,87 @@
+package flags
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(approvalsRequiredViperKey, approvalsRequiredDefault)
+	_ = viper.BindEnv(approvalsRequiredViperKey, approvalsRequiredEnv)
+	viper.SetDefault(approvalsTTLViperKey, approvalsTTLDefault)
+	_ = viper.BindEnv(approvalsTTLViperKey, approvalsTTLEnv)
+	viper.SetDefault(approvalsValueThresholdViperKey, approvalsValueThresholdDefault)
+	_ = viper.BindEnv(approvalsValueThresholdViperKey, approvalsValueThresholdEnv)
+}
+
+const (
+	approvalsRequiredFlag     = "approvals-required"
+	approvalsRequiredViperKey = "approvals.required"
+	approvalsRequiredDefault  = 0
+	approvalsRequiredEnv      = "APPROVALS_REQUIRED"
+)
+
+const (
+	approvalsTTLFlag     = "approvals-ttl"
+	approvalsTTLViperKey = "approvals.ttl"
+	approvalsTTLDefault  = 24 * time.Hour
+	approvalsTTLEnv      = "APPROVALS_TTL"
+)
+
+const (
+	approvalsValueThresholdFlag     = "approvals-value-threshold"
+	approvalsValueThresholdViperKey = "approvals.value.threshold"
+	approvalsValueThresholdDefault  = ""
+	approvalsValueThresholdEnv      = "APPROVALS_VALUE_THRESHOLD"
+)
+
+// ApprovalsFlags register flags for the approval of sensitive operations
+func ApprovalsFlags(f *pflag.FlagSet) {
+	approvalsRequired(f)
+	approvalsTTL(f)
+	approvalsValueThreshold(f)
+}
+
+func approvalsRequired(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of approvals required to destroy resources or sign high-value transactions, approvals are disabled when 0
+Environment variable: %q`, approvalsRequiredEnv)
+	f.Int(approvalsRequiredFlag, approvalsRequiredDefault, desc)
+	_ = viper.BindPFlag(approvalsRequiredViperKey, f.Lookup(approvalsRequiredFlag))
+}
+
+func approvalsTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration after which operations submitted for approval expire
+Environment variable: %q`, approvalsTTLEnv)
+	f.Duration(approvalsTTLFlag, approvalsTTLDefault, desc)
+	_ = viper.BindPFlag(approvalsTTLViperKey, f.Lookup(approvalsTTLFlag))
+}
+
+func approvalsValueThreshold(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Transaction value, in wei, from which signatures require approvals. Signatures never require approvals when empty
+Environment variable: %q`, approvalsValueThresholdEnv)
+	f.String(approvalsValueThresholdFlag, approvalsValueThresholdDefault, desc)
+	_ = viper.BindPFlag(approvalsValueThresholdViperKey, f.Lookup(approvalsValueThresholdFlag))
+}
+
+func NewApprovalsConfig(vipr *viper.Viper) (*entities.Config, error) {
+	cfg := &entities.Config{
+		RequiredApprovals: vipr.GetInt(approvalsRequiredViperKey),
+		TTL:               vipr.GetDuration(approvalsTTLViperKey),
+	}
+
+	if threshold := vipr.GetString(approvalsValueThresholdViperKey); threshold != "" {
+		value, ok := new(big.Int).SetString(threshold, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid approvals value threshold %s", threshold)
+		}
+
+		cfg.ValueThreshold = value
+	}
+
+	return cfg, nil
+}
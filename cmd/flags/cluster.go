@@ -0,0 +1,101 @@
+package flags
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	cluster "github.com/longfan78/quorum-key-manager/src/infra/cluster/postgres"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(clusterEnabledViperKey, clusterEnabledDefault)
+	_ = viper.BindEnv(clusterEnabledViperKey, clusterEnabledEnv)
+	viper.SetDefault(clusterReplicaIDViperKey, clusterReplicaIDDefault)
+	_ = viper.BindEnv(clusterReplicaIDViperKey, clusterReplicaIDEnv)
+	viper.SetDefault(clusterElectionIntervalViperKey, clusterElectionIntervalDefault)
+	_ = viper.BindEnv(clusterElectionIntervalViperKey, clusterElectionIntervalEnv)
+	viper.SetDefault(clusterResyncIntervalViperKey, clusterResyncIntervalDefault)
+	_ = viper.BindEnv(clusterResyncIntervalViperKey, clusterResyncIntervalEnv)
+}
+
+const (
+	clusterEnabledFlag     = "cluster-enabled"
+	clusterEnabledViperKey = "cluster.enabled"
+	clusterEnabledDefault  = false
+	clusterEnabledEnv      = "CLUSTER_ENABLED"
+)
+
+const (
+	clusterReplicaIDFlag     = "cluster-replica-id"
+	clusterReplicaIDViperKey = "cluster.replica.id"
+	clusterReplicaIDDefault  = ""
+	clusterReplicaIDEnv      = "CLUSTER_REPLICA_ID"
+)
+
+const (
+	clusterElectionIntervalFlag     = "cluster-election-interval"
+	clusterElectionIntervalViperKey = "cluster.election.interval"
+	clusterElectionIntervalDefault  = 5 * time.Second
+	clusterElectionIntervalEnv      = "CLUSTER_ELECTION_INTERVAL"
+)
+
+const (
+	clusterResyncIntervalFlag     = "cluster-resync-interval"
+	clusterResyncIntervalViperKey = "cluster.resync.interval"
+	clusterResyncIntervalDefault  = time.Minute
+	clusterResyncIntervalEnv      = "CLUSTER_RESYNC_INTERVAL"
+)
+
+// ClusterFlags register flags for the coordination of the replicas sharing the Postgres database
+func ClusterFlags(f *pflag.FlagSet) {
+	clusterEnabled(f)
+	clusterReplicaID(f)
+	clusterElectionInterval(f)
+	clusterResyncInterval(f)
+}
+
+func clusterEnabled(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Propagate stores, vaults and nodes changes to the other replicas and elect a leader to run background jobs
+Environment variable: %q`, clusterEnabledEnv)
+	f.Bool(clusterEnabledFlag, clusterEnabledDefault, desc)
+	_ = viper.BindPFlag(clusterEnabledViperKey, f.Lookup(clusterEnabledFlag))
+}
+
+func clusterReplicaID(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Identifier of the replica in the cluster. Defaults to the hostname followed by a random suffix
+Environment variable: %q`, clusterReplicaIDEnv)
+	f.String(clusterReplicaIDFlag, clusterReplicaIDDefault, desc)
+	_ = viper.BindPFlag(clusterReplicaIDViperKey, f.Lookup(clusterReplicaIDFlag))
+}
+
+func clusterElectionInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which replicas try to become the leader and the leader checks it still is
+Environment variable: %q`, clusterElectionIntervalEnv)
+	f.Duration(clusterElectionIntervalFlag, clusterElectionIntervalDefault, desc)
+	_ = viper.BindPFlag(clusterElectionIntervalViperKey, f.Lookup(clusterElectionIntervalFlag))
+}
+
+func clusterResyncInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which replicas reload stores, vaults and nodes from the database to apply the changes they missed. 0 only reloads them after reconnecting
+Environment variable: %q`, clusterResyncIntervalEnv)
+	f.Duration(clusterResyncIntervalFlag, clusterResyncIntervalDefault, desc)
+	_ = viper.BindPFlag(clusterResyncIntervalViperKey, f.Lookup(clusterResyncIntervalFlag))
+}
+
+func NewClusterConfig(vipr *viper.Viper) *cluster.Config {
+	if !vipr.GetBool(clusterEnabledViperKey) {
+		return nil
+	}
+
+	replicaID := vipr.GetString(clusterReplicaIDViperKey)
+	if replicaID == "" {
+		hostname, _ := os.Hostname()
+		replicaID = fmt.Sprintf("%s-%s", hostname, common.RandString(8))
+	}
+
+	return cluster.NewConfig(replicaID, vipr.GetDuration(clusterElectionIntervalViperKey), vipr.GetDuration(clusterResyncIntervalViperKey))
+}
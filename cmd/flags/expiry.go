@@ -0,0 +1,58 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(expiryReaperIntervalViperKey, expiryReaperIntervalDefault)
+	_ = viper.BindEnv(expiryReaperIntervalViperKey, expiryReaperIntervalEnv)
+	viper.SetDefault(expiryRecoveryPeriodViperKey, expiryRecoveryPeriodDefault)
+	_ = viper.BindEnv(expiryRecoveryPeriodViperKey, expiryRecoveryPeriodEnv)
+}
+
+const (
+	expiryReaperIntervalFlag     = "expiry-reaper-interval"
+	expiryReaperIntervalViperKey = "expiry.reaper.interval"
+	expiryReaperIntervalDefault  = time.Minute
+	expiryReaperIntervalEnv      = "EXPIRY_REAPER_INTERVAL"
+)
+
+const (
+	expiryRecoveryPeriodFlag     = "expiry-recovery-period"
+	expiryRecoveryPeriodViperKey = "expiry.recovery.period"
+	expiryRecoveryPeriodDefault  = 24 * time.Hour
+	expiryRecoveryPeriodEnv      = "EXPIRY_RECOVERY_PERIOD"
+)
+
+// ExpiryFlags register flags for the expiry of keys, secrets and Ethereum accounts
+func ExpiryFlags(f *pflag.FlagSet) {
+	expiryReaperInterval(f)
+	expiryRecoveryPeriod(f)
+}
+
+func expiryReaperInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which expired items are deleted and destroyed after their recovery period (0 disables the reaper)
+Environment variable: %q`, expiryReaperIntervalEnv)
+	f.Duration(expiryReaperIntervalFlag, expiryReaperIntervalDefault, desc)
+	_ = viper.BindPFlag(expiryReaperIntervalViperKey, f.Lookup(expiryReaperIntervalFlag))
+}
+
+func expiryRecoveryPeriod(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Period during which an expired item created without recovery period can be restored before being destroyed
+Environment variable: %q`, expiryRecoveryPeriodEnv)
+	f.Duration(expiryRecoveryPeriodFlag, expiryRecoveryPeriodDefault, desc)
+	_ = viper.BindPFlag(expiryRecoveryPeriodViperKey, f.Lookup(expiryRecoveryPeriodFlag))
+}
+
+func NewExpiryConfig(vipr *viper.Viper) *entities.ExpiryConfig {
+	return &entities.ExpiryConfig{
+		ReaperInterval: vipr.GetDuration(expiryReaperIntervalViperKey),
+		RecoveryPeriod: vipr.GetDuration(expiryRecoveryPeriodViperKey),
+	}
+}
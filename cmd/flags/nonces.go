@@ -0,0 +1,39 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(noncesPersistedViperKey, noncesPersistedDefault)
+	_ = viper.BindEnv(noncesPersistedViperKey, noncesPersistedEnv)
+}
+
+const (
+	noncesPersistedFlag     = "nonces-persisted"
+	noncesPersistedViperKey = "nonces.persisted"
+	noncesPersistedDefault  = false
+	noncesPersistedEnv      = "NONCES_PERSISTED"
+)
+
+// NoncesFlags register flags for the nonce manager of the proxy nodes
+func NoncesFlags(f *pflag.FlagSet) {
+	noncesPersisted(f)
+}
+
+func noncesPersisted(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Persist the nonces allocated by the proxy nodes in Postgres so that they are shared between replicas
+Environment variable: %q`, noncesPersistedEnv)
+	f.Bool(noncesPersistedFlag, noncesPersistedDefault, desc)
+	_ = viper.BindPFlag(noncesPersistedViperKey, f.Lookup(noncesPersistedFlag))
+}
+
+func NewNoncesConfig(vipr *viper.Viper) *entities.NoncesConfig {
+	return &entities.NoncesConfig{
+		Persisted: vipr.GetBool(noncesPersistedViperKey),
+	}
+}
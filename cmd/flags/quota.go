@@ -0,0 +1,57 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(quotaMaxKeysViperKey, quotaMaxKeysDefault)
+	_ = viper.BindEnv(quotaMaxKeysViperKey, quotaMaxKeysEnv)
+	viper.SetDefault(quotaMaxAccountsViperKey, quotaMaxAccountsDefault)
+	_ = viper.BindEnv(quotaMaxAccountsViperKey, quotaMaxAccountsEnv)
+}
+
+const (
+	quotaMaxKeysFlag     = "quota-max-keys"
+	quotaMaxKeysViperKey = "quota.max.keys"
+	quotaMaxKeysDefault  = 0
+	quotaMaxKeysEnv      = "QUOTA_MAX_KEYS"
+)
+
+const (
+	quotaMaxAccountsFlag     = "quota-max-accounts"
+	quotaMaxAccountsViperKey = "quota.max.accounts"
+	quotaMaxAccountsDefault  = 0
+	quotaMaxAccountsEnv      = "QUOTA_MAX_ACCOUNTS"
+)
+
+// QuotaFlags register flags for the quotas of keys and Ethereum accounts
+func QuotaFlags(f *pflag.FlagSet) {
+	quotaMaxKeys(f)
+	quotaMaxAccounts(f)
+}
+
+func quotaMaxKeys(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of keys of a tenant in each key store, including the deleted ones (0 is unlimited)
+Environment variable: %q`, quotaMaxKeysEnv)
+	f.Int(quotaMaxKeysFlag, quotaMaxKeysDefault, desc)
+	_ = viper.BindPFlag(quotaMaxKeysViperKey, f.Lookup(quotaMaxKeysFlag))
+}
+
+func quotaMaxAccounts(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of accounts of a tenant in each Ethereum store, including the deleted ones (0 is unlimited)
+Environment variable: %q`, quotaMaxAccountsEnv)
+	f.Int(quotaMaxAccountsFlag, quotaMaxAccountsDefault, desc)
+	_ = viper.BindPFlag(quotaMaxAccountsViperKey, f.Lookup(quotaMaxAccountsFlag))
+}
+
+func NewQuotaConfig(vipr *viper.Viper) *entities.QuotaConfig {
+	return &entities.QuotaConfig{
+		MaxKeys:     vipr.GetInt(quotaMaxKeysViperKey),
+		MaxAccounts: vipr.GetInt(quotaMaxAccountsViperKey),
+	}
+}
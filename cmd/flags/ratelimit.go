@@ -0,0 +1,111 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(rateLimitKeyViperKey, rateLimitKeyDefault)
+	_ = viper.BindEnv(rateLimitKeyViperKey, rateLimitKeyEnv)
+	viper.SetDefault(rateLimitSignRateViperKey, rateLimitSignRateDefault)
+	_ = viper.BindEnv(rateLimitSignRateViperKey, rateLimitSignRateEnv)
+	viper.SetDefault(rateLimitSignBurstViperKey, rateLimitSignBurstDefault)
+	_ = viper.BindEnv(rateLimitSignBurstViperKey, rateLimitSignBurstEnv)
+	viper.SetDefault(rateLimitReadRateViperKey, rateLimitReadRateDefault)
+	_ = viper.BindEnv(rateLimitReadRateViperKey, rateLimitReadRateEnv)
+	viper.SetDefault(rateLimitReadBurstViperKey, rateLimitReadBurstDefault)
+	_ = viper.BindEnv(rateLimitReadBurstViperKey, rateLimitReadBurstEnv)
+}
+
+const (
+	rateLimitKeyFlag     = "rate-limit-key"
+	rateLimitKeyViperKey = "rate-limit.key"
+	rateLimitKeyDefault  = ratelimit.KeyTenant
+	rateLimitKeyEnv      = "RATE_LIMIT_KEY"
+)
+
+const (
+	rateLimitSignRateFlag     = "rate-limit-sign-rate"
+	rateLimitSignRateViperKey = "rate-limit.sign.rate"
+	rateLimitSignRateDefault  = float64(0)
+	rateLimitSignRateEnv      = "RATE_LIMIT_SIGN_RATE"
+)
+
+const (
+	rateLimitSignBurstFlag     = "rate-limit-sign-burst"
+	rateLimitSignBurstViperKey = "rate-limit.sign.burst"
+	rateLimitSignBurstDefault  = 0
+	rateLimitSignBurstEnv      = "RATE_LIMIT_SIGN_BURST"
+)
+
+const (
+	rateLimitReadRateFlag     = "rate-limit-read-rate"
+	rateLimitReadRateViperKey = "rate-limit.read.rate"
+	rateLimitReadRateDefault  = float64(0)
+	rateLimitReadRateEnv      = "RATE_LIMIT_READ_RATE"
+)
+
+const (
+	rateLimitReadBurstFlag     = "rate-limit-read-burst"
+	rateLimitReadBurstViperKey = "rate-limit.read.burst"
+	rateLimitReadBurstDefault  = 0
+	rateLimitReadBurstEnv      = "RATE_LIMIT_READ_BURST"
+)
+
+// RateLimitFlags register flags for the rate limiting of HTTP and JSON-RPC requests
+func RateLimitFlags(f *pflag.FlagSet) {
+	rateLimitKey(f)
+	rateLimitSignRate(f)
+	rateLimitSignBurst(f)
+	rateLimitReadRate(f)
+	rateLimitReadBurst(f)
+}
+
+func rateLimitKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Identity requests are limited by, one of %q, %q or %q. Users not authenticated with an API key are limited per user with %q
+Environment variable: %q`, ratelimit.KeyTenant, ratelimit.KeyUser, ratelimit.KeyAPIKey, ratelimit.KeyAPIKey, rateLimitKeyEnv)
+	f.String(rateLimitKeyFlag, rateLimitKeyDefault, desc)
+	_ = viper.BindPFlag(rateLimitKeyViperKey, f.Lookup(rateLimitKeyFlag))
+}
+
+func rateLimitSignRate(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Signing, encryption and decryption requests allowed per second (0 disables the limit)
+Environment variable: %q`, rateLimitSignRateEnv)
+	f.Float64(rateLimitSignRateFlag, rateLimitSignRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitSignRateViperKey, f.Lookup(rateLimitSignRateFlag))
+}
+
+func rateLimitSignBurst(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum burst of signing requests, defaults to the rate
+Environment variable: %q`, rateLimitSignBurstEnv)
+	f.Int(rateLimitSignBurstFlag, rateLimitSignBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitSignBurstViperKey, f.Lookup(rateLimitSignBurstFlag))
+}
+
+func rateLimitReadRate(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Other requests allowed per second (0 disables the limit)
+Environment variable: %q`, rateLimitReadRateEnv)
+	f.Float64(rateLimitReadRateFlag, rateLimitReadRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitReadRateViperKey, f.Lookup(rateLimitReadRateFlag))
+}
+
+func rateLimitReadBurst(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum burst of other requests, defaults to the rate
+Environment variable: %q`, rateLimitReadBurstEnv)
+	f.Int(rateLimitReadBurstFlag, rateLimitReadBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitReadBurstViperKey, f.Lookup(rateLimitReadBurstFlag))
+}
+
+func NewRateLimitConfig(vipr *viper.Viper) *ratelimit.Config {
+	sign := ratelimit.Budget{Rate: vipr.GetFloat64(rateLimitSignRateViperKey), Burst: vipr.GetInt(rateLimitSignBurstViperKey)}
+	read := ratelimit.Budget{Rate: vipr.GetFloat64(rateLimitReadRateViperKey), Burst: vipr.GetInt(rateLimitReadBurstViperKey)}
+	if sign.Rate == 0 && read.Rate == 0 {
+		return nil
+	}
+
+	return ratelimit.NewConfig(vipr.GetString(rateLimitKeyViperKey), sign, read)
+}
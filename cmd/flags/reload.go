@@ -0,0 +1,55 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/watcher"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(reloadWatchViperKey, reloadWatchDefault)
+	_ = viper.BindEnv(reloadWatchViperKey, reloadWatchEnv)
+	viper.SetDefault(reloadDebounceViperKey, reloadDebounceDefault)
+	_ = viper.BindEnv(reloadDebounceViperKey, reloadDebounceEnv)
+}
+
+const (
+	reloadWatchFlag     = "reload-watch"
+	reloadWatchViperKey = "reload.watch"
+	reloadWatchDefault  = false
+	reloadWatchEnv      = "RELOAD_WATCH"
+)
+
+const (
+	reloadDebounceFlag     = "reload-debounce"
+	reloadDebounceViperKey = "reload.debounce"
+	reloadDebounceDefault  = 2 * time.Second
+	reloadDebounceEnv      = "RELOAD_DEBOUNCE"
+)
+
+// ReloadFlags register flags for the hot reload of manifests, API keys and TLS CAs
+func ReloadFlags(f *pflag.FlagSet) {
+	reloadWatch(f)
+	reloadDebounce(f)
+}
+
+func reloadWatch(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Reload manifests, API keys and TLS CAs when their files change. They are always reloaded on SIGHUP
+Environment variable: %q`, reloadWatchEnv)
+	f.Bool(reloadWatchFlag, reloadWatchDefault, desc)
+	_ = viper.BindPFlag(reloadWatchViperKey, f.Lookup(reloadWatchFlag))
+}
+
+func reloadDebounce(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration without file changes to wait for before reloading
+Environment variable: %q`, reloadDebounceEnv)
+	f.Duration(reloadDebounceFlag, reloadDebounceDefault, desc)
+	_ = viper.BindPFlag(reloadDebounceViperKey, f.Lookup(reloadDebounceFlag))
+}
+
+func NewReloadConfig(vipr *viper.Viper) *watcher.Config {
+	return watcher.NewConfig(vipr.GetBool(reloadWatchViperKey), vipr.GetDuration(reloadDebounceViperKey))
+}
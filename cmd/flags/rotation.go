@@ -0,0 +1,40 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(rotationCheckIntervalViperKey, rotationCheckIntervalDefault)
+	_ = viper.BindEnv(rotationCheckIntervalViperKey, rotationCheckIntervalEnv)
+}
+
+const (
+	rotationCheckIntervalFlag     = "keys-rotation-check-interval"
+	rotationCheckIntervalViperKey = "keys.rotation.check.interval"
+	rotationCheckIntervalDefault  = time.Minute
+	rotationCheckIntervalEnv      = "KEYS_ROTATION_CHECK_INTERVAL"
+)
+
+// RotationFlags register flags for the scheduled rotation of keys
+func RotationFlags(f *pflag.FlagSet) {
+	rotationCheckInterval(f)
+}
+
+func rotationCheckInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the rotation policies of the keys are evaluated (0 disables scheduled rotation)
+Environment variable: %q`, rotationCheckIntervalEnv)
+	f.Duration(rotationCheckIntervalFlag, rotationCheckIntervalDefault, desc)
+	_ = viper.BindPFlag(rotationCheckIntervalViperKey, f.Lookup(rotationCheckIntervalFlag))
+}
+
+func NewRotationConfig(vipr *viper.Viper) *entities.RotationConfig {
+	return &entities.RotationConfig{
+		CheckInterval: vipr.GetDuration(rotationCheckIntervalViperKey),
+	}
+}
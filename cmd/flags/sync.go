@@ -2,7 +2,9 @@ package flags
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -10,6 +12,12 @@ import (
 func init() {
 	viper.SetDefault(storeNameViperKey, storeNameDefault)
 	_ = viper.BindEnv(storeNameViperKey, storeNameEnv)
+	viper.SetDefault(syncIntervalViperKey, syncIntervalDefault)
+	_ = viper.BindEnv(syncIntervalViperKey, syncIntervalEnv)
+	viper.SetDefault(syncDryRunViperKey, syncDryRunDefault)
+	_ = viper.BindEnv(syncDryRunViperKey, syncDryRunEnv)
+	viper.SetDefault(syncStoresViperKey, syncStoresDefault)
+	_ = viper.BindEnv(syncStoresViperKey, syncStoresEnv)
 }
 
 const (
@@ -19,6 +27,28 @@ const (
 	storeNameEnv      = "SYNC_STORE_NAME"
 )
 
+const (
+	syncIntervalFlag     = "sync-interval"
+	syncIntervalViperKey = "sync.interval"
+	syncIntervalDefault  = time.Duration(0)
+	syncIntervalEnv      = "SYNC_INTERVAL"
+)
+
+const (
+	syncDryRunFlag     = "sync-dry-run"
+	syncDryRunViperKey = "sync.dry-run"
+	syncDryRunDefault  = false
+	syncDryRunEnv      = "SYNC_DRY_RUN"
+)
+
+var syncStoresDefault []string
+
+const (
+	syncStoresFlag     = "sync-stores"
+	syncStoresViperKey = "sync.stores"
+	syncStoresEnv      = "SYNC_STORES"
+)
+
 func SyncFlags(f *pflag.FlagSet) {
 	storeName(f)
 }
@@ -33,3 +63,44 @@ Environment variable: %q`, storeNameEnv)
 func GetStoreName(vipr *viper.Viper) string {
 	return vipr.GetString(storeNameViperKey)
 }
+
+// ScheduledSyncFlags register flags for the scheduled synchronization of the stores with their vault
+func ScheduledSyncFlags(f *pflag.FlagSet) {
+	syncInterval(f)
+	syncDryRun(f)
+	syncStores(f)
+}
+
+func syncInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the stores are synchronized with their vault (0 disables scheduled synchronization)
+Environment variable: %q`, syncIntervalEnv)
+	f.Duration(syncIntervalFlag, syncIntervalDefault, desc)
+	_ = viper.BindPFlag(syncIntervalViperKey, f.Lookup(syncIntervalFlag))
+}
+
+func syncDryRun(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Only reports the drift between the stores and their vault, without updating the database
+Environment variable: %q`, syncDryRunEnv)
+	f.Bool(syncDryRunFlag, syncDryRunDefault, desc)
+	_ = viper.BindPFlag(syncDryRunViperKey, f.Lookup(syncDryRunFlag))
+}
+
+func syncStores(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Stores synchronized on schedule, all the stores being synchronized if empty
+Environment variable: %q`, syncStoresEnv)
+	f.StringSlice(syncStoresFlag, syncStoresDefault, desc)
+	_ = viper.BindPFlag(syncStoresViperKey, f.Lookup(syncStoresFlag))
+}
+
+func NewSyncConfig(vipr *viper.Viper) *entities.SyncConfig {
+	interval := vipr.GetDuration(syncIntervalViperKey)
+	if interval <= 0 {
+		return nil
+	}
+
+	return &entities.SyncConfig{
+		Interval: interval,
+		DryRun:   vipr.GetBool(syncDryRunViperKey),
+		Stores:   vipr.GetStringSlice(syncStoresViperKey),
+	}
+}
@@ -0,0 +1,76 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(tracingOTLPEndpointViperKey, tracingOTLPEndpointDefault)
+	_ = viper.BindEnv(tracingOTLPEndpointViperKey, tracingOTLPEndpointEnv)
+	viper.SetDefault(tracingInsecureViperKey, tracingInsecureDefault)
+	_ = viper.BindEnv(tracingInsecureViperKey, tracingInsecureEnv)
+	viper.SetDefault(tracingSampleRatioViperKey, tracingSampleRatioDefault)
+	_ = viper.BindEnv(tracingSampleRatioViperKey, tracingSampleRatioEnv)
+}
+
+const (
+	tracingOTLPEndpointFlag     = "tracing-otlp-endpoint"
+	tracingOTLPEndpointViperKey = "tracing.otlp.endpoint"
+	tracingOTLPEndpointDefault  = ""
+	tracingOTLPEndpointEnv      = "TRACING_OTLP_ENDPOINT"
+)
+
+const (
+	tracingInsecureFlag     = "tracing-insecure"
+	tracingInsecureViperKey = "tracing.insecure"
+	tracingInsecureDefault  = false
+	tracingInsecureEnv      = "TRACING_INSECURE"
+)
+
+const (
+	tracingSampleRatioFlag     = "tracing-sample-ratio"
+	tracingSampleRatioViperKey = "tracing.sample.ratio"
+	tracingSampleRatioDefault  = 1.0
+	tracingSampleRatioEnv      = "TRACING_SAMPLE_RATIO"
+)
+
+// TracingFlags register flags for the export of traces to an OpenTelemetry collector
+func TracingFlags(f *pflag.FlagSet) {
+	tracingOTLPEndpoint(f)
+	tracingInsecure(f)
+	tracingSampleRatio(f)
+}
+
+func tracingOTLPEndpoint(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Host and port of the OTLP/HTTP collector to export traces to (e.g. localhost:4318). Tracing is disabled if empty
+Environment variable: %q`, tracingOTLPEndpointEnv)
+	f.String(tracingOTLPEndpointFlag, tracingOTLPEndpointDefault, desc)
+	_ = viper.BindPFlag(tracingOTLPEndpointViperKey, f.Lookup(tracingOTLPEndpointFlag))
+}
+
+func tracingInsecure(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Export traces without TLS
+Environment variable: %q`, tracingInsecureEnv)
+	f.Bool(tracingInsecureFlag, tracingInsecureDefault, desc)
+	_ = viper.BindPFlag(tracingInsecureViperKey, f.Lookup(tracingInsecureFlag))
+}
+
+func tracingSampleRatio(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Ratio of the traces started by the key manager that are sampled, between 0 and 1. Traces of callers follow their sampling decision
+Environment variable: %q`, tracingSampleRatioEnv)
+	f.Float64(tracingSampleRatioFlag, tracingSampleRatioDefault, desc)
+	_ = viper.BindPFlag(tracingSampleRatioViperKey, f.Lookup(tracingSampleRatioFlag))
+}
+
+func NewTracingConfig(vipr *viper.Viper) *tracing.Config {
+	endpoint := vipr.GetString(tracingOTLPEndpointViperKey)
+	if endpoint == "" {
+		return nil
+	}
+
+	return tracing.NewConfig(endpoint, vipr.GetBool(tracingInsecureViperKey), vipr.GetFloat64(tracingSampleRatioViperKey))
+}
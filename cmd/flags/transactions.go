@@ -0,0 +1,81 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(txTrackingIntervalViperKey, txTrackingIntervalDefault)
+	_ = viper.BindEnv(txTrackingIntervalViperKey, txTrackingIntervalEnv)
+	viper.SetDefault(txConfirmationsViperKey, txConfirmationsDefault)
+	_ = viper.BindEnv(txConfirmationsViperKey, txConfirmationsEnv)
+	viper.SetDefault(txDropTimeoutViperKey, txDropTimeoutDefault)
+	_ = viper.BindEnv(txDropTimeoutViperKey, txDropTimeoutEnv)
+}
+
+const (
+	txTrackingIntervalFlag     = "transactions-tracking-interval"
+	txTrackingIntervalViperKey = "transactions.tracking.interval"
+	txTrackingIntervalDefault  = time.Duration(0)
+	txTrackingIntervalEnv      = "TRANSACTIONS_TRACKING_INTERVAL"
+)
+
+const (
+	txConfirmationsFlag     = "transactions-confirmations"
+	txConfirmationsViperKey = "transactions.confirmations"
+	txConfirmationsDefault  = uint64(6)
+	txConfirmationsEnv      = "TRANSACTIONS_CONFIRMATIONS"
+)
+
+const (
+	txDropTimeoutFlag     = "transactions-drop-timeout"
+	txDropTimeoutViperKey = "transactions.drop-timeout"
+	txDropTimeoutDefault  = 10 * time.Minute
+	txDropTimeoutEnv      = "TRANSACTIONS_DROP_TIMEOUT"
+)
+
+// TransactionsFlags register flags for the tracking of the transactions sent through the proxy nodes
+func TransactionsFlags(f *pflag.FlagSet) {
+	txTrackingInterval(f)
+	txConfirmations(f)
+	txDropTimeout(f)
+}
+
+func txTrackingInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the receipts of the sent transactions are polled (0 disables transaction tracking)
+Environment variable: %q`, txTrackingIntervalEnv)
+	f.Duration(txTrackingIntervalFlag, txTrackingIntervalDefault, desc)
+	_ = viper.BindPFlag(txTrackingIntervalViperKey, f.Lookup(txTrackingIntervalFlag))
+}
+
+func txConfirmations(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of blocks mined on top of a transaction for it to be confirmed and not watched anymore
+Environment variable: %q`, txConfirmationsEnv)
+	f.Uint64(txConfirmationsFlag, txConfirmationsDefault, desc)
+	_ = viper.BindPFlag(txConfirmationsViperKey, f.Lookup(txConfirmationsFlag))
+}
+
+func txDropTimeout(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration after which a pending transaction unknown to the node is dropped (0 only drops transactions replaced by another transaction with the same nonce)
+Environment variable: %q`, txDropTimeoutEnv)
+	f.Duration(txDropTimeoutFlag, txDropTimeoutDefault, desc)
+	_ = viper.BindPFlag(txDropTimeoutViperKey, f.Lookup(txDropTimeoutFlag))
+}
+
+func NewTransactionsConfig(vipr *viper.Viper) *entities.TransactionsConfig {
+	interval := vipr.GetDuration(txTrackingIntervalViperKey)
+	if interval <= 0 {
+		return nil
+	}
+
+	return &entities.TransactionsConfig{
+		WatchInterval: interval,
+		Confirmations: vipr.GetUint64(txConfirmationsViperKey),
+		DropTimeout:   vipr.GetDuration(txDropTimeoutViperKey),
+	}
+}
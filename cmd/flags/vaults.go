@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/aes"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(vaultsEncryptionKeyViperKey, vaultsEncryptionKeyDefault)
+	_ = viper.BindEnv(vaultsEncryptionKeyViperKey, vaultsEncryptionKeyEnv)
+}
+
+const (
+	vaultsEncryptionKeyFlag     = "vaults-encryption-key"
+	vaultsEncryptionKeyViperKey = "vaults.encryption.key"
+	vaultsEncryptionKeyDefault  = ""
+	vaultsEncryptionKeyEnv      = "VAULTS_ENCRYPTION_KEY"
+)
+
+// VaultsFlags register flags for the vaults managed at runtime
+func VaultsFlags(f *pflag.FlagSet) {
+	vaultsEncryptionKey(f)
+}
+
+func vaultsEncryptionKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Hex encoded AES-256 key encrypting the credentials of the vaults persisted in Postgres. Vaults cannot be created through the API when empty
+Environment variable: %q`, vaultsEncryptionKeyEnv)
+	f.String(vaultsEncryptionKeyFlag, vaultsEncryptionKeyDefault, desc)
+	_ = viper.BindPFlag(vaultsEncryptionKeyViperKey, f.Lookup(vaultsEncryptionKeyFlag))
+}
+
+func NewVaultsConfig(vipr *viper.Viper) (*entities.VaultsConfig, error) {
+	cfg := &entities.VaultsConfig{}
+
+	if encryptionKey := vipr.GetString(vaultsEncryptionKeyViperKey); encryptionKey != "" {
+		key, err := hex.DecodeString(encryptionKey)
+		if err != nil || len(key) != aes.KeySize {
+			return nil, fmt.Errorf("invalid vaults encryption key, expected %d hex encoded bytes", aes.KeySize)
+		}
+
+		cfg.EncryptionKey = key
+	}
+
+	return cfg, nil
+}
@@ -20,6 +20,7 @@ func NewCommand() *cobra.Command {
 	rootCmd.AddCommand(newRunCommand())
 	rootCmd.AddCommand(newMigrateCommand())
 	rootCmd.AddCommand(newSyncCommand())
+	rootCmd.AddCommand(newAuditCommand())
 
 	return rootCmd
 }
@@ -34,6 +34,18 @@ func newRunCommand() *cobra.Command {
 	flags.OIDCFlags(runCmd.Flags())
 	flags.APIKeyFlags(runCmd.Flags())
 	flags.TLSFlags(runCmd.Flags())
+	flags.VaultsFlags(runCmd.Flags())
+	flags.ApprovalsFlags(runCmd.Flags())
+	flags.NoncesFlags(runCmd.Flags())
+	flags.TransactionsFlags(runCmd.Flags())
+	flags.RotationFlags(runCmd.Flags())
+	flags.ExpiryFlags(runCmd.Flags())
+	flags.ScheduledSyncFlags(runCmd.Flags())
+	flags.QuotaFlags(runCmd.Flags())
+	flags.RateLimitFlags(runCmd.Flags())
+	flags.ReloadFlags(runCmd.Flags())
+	flags.TracingFlags(runCmd.Flags())
+	flags.ClusterFlags(runCmd.Flags())
 
 	return runCmd
 }
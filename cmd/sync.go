@@ -3,15 +3,25 @@ package cmd
 import (
 	"context"
 
+	approvalspg "github.com/longfan78/quorum-key-manager/src/approvals/database/postgres"
+	approvalsentities "github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	"github.com/longfan78/quorum-key-manager/src/approvals/service/approvals"
+	auditpg "github.com/longfan78/quorum-key-manager/src/audit/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/audit/service/auditor"
+	authpg "github.com/longfan78/quorum-key-manager/src/auth/database/postgres"
 	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/auth/service/roles"
 	"github.com/longfan78/quorum-key-manager/src/entities"
+	policiespg "github.com/longfan78/quorum-key-manager/src/policies/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/policies/service/policies"
 	storesservice "github.com/longfan78/quorum-key-manager/src/stores"
 	manifeststores "github.com/longfan78/quorum-key-manager/src/stores/api/manifest"
 	manifestvaults "github.com/longfan78/quorum-key-manager/src/vaults/api/manifest"
+	vaultspg "github.com/longfan78/quorum-key-manager/src/vaults/database/postgres"
 	"github.com/longfan78/quorum-key-manager/src/vaults/service/vaults"
 
 	"github.com/longfan78/quorum-key-manager/cmd/flags"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/zap"
 	manifestreader "github.com/longfan78/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
@@ -48,22 +58,38 @@ func newSyncCommand() *cobra.Command {
 				return err
 			}
 
+			vaultsCfg, err := flags.NewVaultsConfig(viper.GetViper())
+			if err != nil {
+				return err
+			}
+
 			if mnfs, err = getManifests(ctx); err != nil {
 				return err
 			}
 
 			// Instantiate register vaults
-			roles := roles.New(logger)
-			vaultService := vaults.New(roles, logger)
+			// Vaults and stores are only loaded by this command, so the other replicas do not need to be notified
+			roles := roles.New(authpg.NewRoles(postgresClient), logger)
+			vaultService := vaults.New(vaultspg.NewVaults(postgresClient, vaultsCfg.EncryptionKey), roles, cluster.Standalone{}, logger)
 			if err := manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
 				return err
 			}
+			if err := vaultService.Load(ctx); err != nil {
+				return err
+			}
 
 			// Instantiate register stores
-			storesService = stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+			auditorService := auditor.New(auditpg.NewEvents(postgresClient, logger), roles, logger)
+			policiesService := policies.New(policiespg.NewSpendings(postgresClient, logger), roles, logger)
+			// Resources are only imported, no operation requiring approvals is performed
+			approvalsService := approvals.New(approvalspg.NewOperations(postgresClient, logger), roles, &approvalsentities.Config{}, logger)
+			storesService = stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, auditorService, policiesService, approvalsService, nil, cluster.Standalone{}, logger)
 			if err := manifeststores.NewStoresHandler(storesService).Register(ctx, mnfs[entities.StoreKind]); err != nil {
 				return err
 			}
+			if err := storesService.Load(ctx); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -73,6 +99,7 @@ func newSyncCommand() *cobra.Command {
 	}
 
 	flags.PGFlags(syncCmd.Flags())
+	flags.VaultsFlags(syncCmd.Flags())
 	flags.SyncFlags(syncCmd.Flags())
 	flags.ManifestFlags(syncCmd.Flags())
 
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS nodes;
+DROP TABLE IF EXISTS stores;
+DROP TABLE IF EXISTS vaults;
+
+COMMIT;
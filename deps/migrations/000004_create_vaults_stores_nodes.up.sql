@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS vaults (
+    name TEXT PRIMARY KEY,
+    vault_type TEXT NOT NULL,
+    config JSONB NOT NULL,
+    allowed_tenants TEXT [],
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS stores (
+    name TEXT PRIMARY KEY,
+    store_type TEXT NOT NULL,
+    vault TEXT,
+    secret_store TEXT,
+    key_store TEXT,
+    allowed_tenants TEXT [],
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nodes (
+    name TEXT PRIMARY KEY,
+    config JSONB NOT NULL,
+    allowed_tenants TEXT [],
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+COMMIT;
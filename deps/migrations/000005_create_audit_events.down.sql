@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+DROP TRIGGER IF EXISTS audit_events_no_update_delete ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_append_only;
+DROP TABLE IF EXISTS audit_events;
+
+COMMIT;
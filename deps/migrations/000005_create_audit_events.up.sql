@@ -0,0 +1,38 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT,
+    tenant TEXT,
+    operation TEXT NOT NULL,
+    resource TEXT NOT NULL,
+    store_name TEXT,
+    resource_id TEXT,
+    payload_hash TEXT,
+    outcome TEXT NOT NULL,
+    error TEXT,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_username_idx ON audit_events (username);
+CREATE INDEX IF NOT EXISTS audit_events_tenant_idx ON audit_events (tenant);
+CREATE INDEX IF NOT EXISTS audit_events_store_name_resource_id_idx ON audit_events (store_name, resource_id);
+CREATE INDEX IF NOT EXISTS audit_events_created_at_idx ON audit_events (created_at);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_no_update_delete
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();
+
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE PROCEDURE audit_events_append_only();
+
+COMMIT;
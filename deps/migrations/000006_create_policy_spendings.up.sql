@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS policy_spendings (
+    policy TEXT NOT NULL,
+    address TEXT NOT NULL,
+    day DATE NOT NULL,
+    amount NUMERIC(78, 0) DEFAULT 0 NOT NULL,
+    PRIMARY KEY (policy, address, day)
+);
+
+COMMIT;
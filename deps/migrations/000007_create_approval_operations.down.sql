@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS approval_operations;
+
+COMMIT;
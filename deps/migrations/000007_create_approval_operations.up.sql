@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS approval_operations (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    resource TEXT NOT NULL,
+    store_name TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    payload_hash TEXT NOT NULL,
+    value TEXT,
+    requester TEXT NOT NULL,
+    tenant TEXT NOT NULL,
+    required_approvals INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    decisions JSONB,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS approval_operations_status_idx ON approval_operations (status, expires_at);
+CREATE INDEX IF NOT EXISTS approval_operations_requester_idx ON approval_operations (requester, tenant, store_name, resource_id);
+
+COMMIT;
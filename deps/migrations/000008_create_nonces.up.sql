@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS nonces (
+    node TEXT NOT NULL,
+    chain_id TEXT NOT NULL,
+    address TEXT NOT NULL,
+    nonce BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (node, chain_id, address)
+);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE IF EXISTS key_versions;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS rotation_policy,
+    DROP COLUMN IF EXISTS rotated_at;
+
+COMMIT;
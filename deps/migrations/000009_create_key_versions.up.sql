@@ -0,0 +1,22 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN version TEXT DEFAULT '' NOT NULL,
+    ADD COLUMN rotation_policy JSONB,
+    ADD COLUMN rotated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL;
+
+UPDATE keys SET rotated_at = created_at;
+
+CREATE TABLE IF NOT EXISTS key_versions (
+    id TEXT NOT NULL,
+    store_id TEXT NOT NULL,
+    version TEXT NOT NULL,
+    public_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (store_id, id, version)
+);
+
+INSERT INTO key_versions (id, store_id, version, public_key, created_at)
+SELECT id, store_id, version, public_key, created_at FROM keys;
+
+COMMIT;
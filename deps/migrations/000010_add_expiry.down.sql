@@ -0,0 +1,19 @@
+BEGIN;
+
+DROP INDEX IF EXISTS keys_expire_at_idx;
+DROP INDEX IF EXISTS secrets_expire_at_idx;
+DROP INDEX IF EXISTS eth_accounts_expire_at_idx;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS expire_at,
+    DROP COLUMN IF EXISTS recovery_period;
+
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS expire_at,
+    DROP COLUMN IF EXISTS recovery_period;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN IF EXISTS expire_at,
+    DROP COLUMN IF EXISTS recovery_period;
+
+COMMIT;
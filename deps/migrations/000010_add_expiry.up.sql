@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN expire_at TIMESTAMPTZ,
+    ADD COLUMN recovery_period BIGINT DEFAULT 0 NOT NULL;
+
+ALTER TABLE secrets
+    ADD COLUMN expire_at TIMESTAMPTZ,
+    ADD COLUMN recovery_period BIGINT DEFAULT 0 NOT NULL;
+
+ALTER TABLE eth_accounts
+    ADD COLUMN expire_at TIMESTAMPTZ,
+    ADD COLUMN recovery_period BIGINT DEFAULT 0 NOT NULL;
+
+CREATE INDEX keys_expire_at_idx ON keys (store_id, expire_at) WHERE expire_at IS NOT NULL;
+CREATE INDEX secrets_expire_at_idx ON secrets (store_id, expire_at) WHERE expire_at IS NOT NULL;
+CREATE INDEX eth_accounts_expire_at_idx ON eth_accounts (store_id, expire_at) WHERE expire_at IS NOT NULL;
+
+COMMIT;
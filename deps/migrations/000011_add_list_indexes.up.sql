@@ -0,0 +1,9 @@
+BEGIN;
+
+CREATE INDEX keys_created_at_idx ON keys (store_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX eth_accounts_created_at_idx ON eth_accounts (store_id, created_at, address) WHERE deleted_at IS NULL;
+CREATE INDEX keys_tags_idx ON keys USING GIN (tags jsonb_path_ops);
+CREATE INDEX secrets_tags_idx ON secrets USING GIN (tags jsonb_path_ops);
+CREATE INDEX eth_accounts_tags_idx ON eth_accounts USING GIN (tags jsonb_path_ops);
+
+COMMIT;
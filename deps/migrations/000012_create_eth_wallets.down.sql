@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS eth_accounts_derivation_path_idx;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN IF EXISTS wallet_id,
+    DROP COLUMN IF EXISTS derivation_path;
+
+DROP TABLE IF EXISTS eth_wallets;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS eth_wallets (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL,
+    store_id TEXT NOT NULL,
+    tags JSONB,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    deleted_at TIMESTAMPTZ,
+    UNIQUE(id, store_id)
+);
+
+ALTER TABLE eth_accounts
+    ADD COLUMN wallet_id TEXT,
+    ADD COLUMN derivation_path TEXT;
+
+CREATE UNIQUE INDEX eth_accounts_derivation_path_idx ON eth_accounts (store_id, wallet_id, derivation_path) WHERE wallet_id IS NOT NULL;
+
+COMMIT;
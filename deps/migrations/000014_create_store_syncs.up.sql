@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS store_syncs (
+    store_name TEXT PRIMARY KEY,
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    added TEXT[],
+    deleted TEXT[],
+    updated TEXT[],
+    failed TEXT[],
+    started_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL
+);
+
+COMMIT;
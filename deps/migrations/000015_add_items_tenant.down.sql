@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS eth_accounts_store_id_tenant_idx;
+DROP INDEX IF EXISTS keys_store_id_tenant_idx;
+
+ALTER TABLE eth_accounts DROP COLUMN IF EXISTS tenant;
+ALTER TABLE keys DROP COLUMN IF EXISTS tenant;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE keys ADD COLUMN IF NOT EXISTS tenant TEXT;
+ALTER TABLE eth_accounts ADD COLUMN IF NOT EXISTS tenant TEXT;
+
+CREATE INDEX IF NOT EXISTS keys_store_id_tenant_idx ON keys (store_id, tenant);
+CREATE INDEX IF NOT EXISTS eth_accounts_store_id_tenant_idx ON eth_accounts (store_id, tenant);
+
+COMMIT;
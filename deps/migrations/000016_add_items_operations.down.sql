@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE eth_accounts DROP COLUMN IF EXISTS operations;
+ALTER TABLE keys DROP COLUMN IF EXISTS operations;
+
+COMMIT;
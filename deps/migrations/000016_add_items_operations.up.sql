@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE keys ADD COLUMN IF NOT EXISTS operations TEXT[];
+ALTER TABLE eth_accounts ADD COLUMN IF NOT EXISTS operations TEXT[];
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id BIGSERIAL PRIMARY KEY,
+    hash TEXT NOT NULL,
+    node TEXT NOT NULL,
+    chain_id TEXT NOT NULL,
+    from_address TEXT NOT NULL,
+    nonce BIGINT NOT NULL,
+    raw BYTEA,
+    private BOOLEAN DEFAULT false NOT NULL,
+    tenant TEXT,
+    status TEXT NOT NULL,
+    block_number BIGINT,
+    block_hash TEXT,
+    gas_used BIGINT,
+    contract_address TEXT,
+    confirmed BOOLEAN DEFAULT false NOT NULL,
+    reorgs INTEGER DEFAULT 0 NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(node, hash)
+);
+
+CREATE INDEX IF NOT EXISTS transactions_node_from_address_idx ON transactions (node, from_address);
+CREATE INDEX IF NOT EXISTS transactions_unsettled_idx ON transactions (node) WHERE NOT confirmed AND status <> 'dropped';
+
+COMMIT;
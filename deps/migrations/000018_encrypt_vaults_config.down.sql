@@ -0,0 +1,7 @@
+BEGIN;
+
+DELETE FROM vaults WHERE config IS NULL;
+ALTER TABLE vaults ALTER COLUMN config SET NOT NULL;
+ALTER TABLE vaults DROP COLUMN IF EXISTS encrypted_config;
+
+COMMIT;
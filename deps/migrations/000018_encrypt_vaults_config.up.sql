@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE vaults ADD COLUMN IF NOT EXISTS encrypted_config BYTEA;
+ALTER TABLE vaults ALTER COLUMN config DROP NOT NULL;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS keys_next_rotation_at_idx;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS next_rotation_at;
+
+COMMIT;
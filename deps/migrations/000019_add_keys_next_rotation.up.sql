@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN next_rotation_at TIMESTAMPTZ;
+
+UPDATE keys SET next_rotation_at = rotated_at + (rotation_policy->>'Interval')::BIGINT / 1000 * INTERVAL '1 microsecond'
+WHERE (rotation_policy->>'Interval')::BIGINT > 0;
+
+CREATE INDEX keys_next_rotation_at_idx ON keys (store_id, next_rotation_at) WHERE next_rotation_at IS NOT NULL AND deleted_at IS NULL;
+
+COMMIT;
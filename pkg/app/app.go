@@ -9,6 +9,8 @@ import (
 	"sync"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 
 	"github.com/longfan78/quorum-key-manager/pkg/common"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
@@ -54,6 +56,7 @@ type App struct {
 func New(cfg *Config, logger log.Logger) *App {
 	// Create router and register APIs
 	router := gorillamux.NewRouter()
+	router.Use(metrics.HTTPMiddleware, tracing.RouteMiddleware)
 
 	// Enable CORS
         c := cors.New(cors.Options{
@@ -235,6 +238,9 @@ func (app *App) startServer() {
 		app.server.Handler = app.middleware(app.server.Handler)
 	}
 
+	// Trace requests from the start, authentication included
+	app.server.Handler = tracing.HTTPMiddleware(app.server.Handler)
+
 	go func() {
 		ln, err := net.Listen("tcp", app.server.Addr)
 		if err != nil {
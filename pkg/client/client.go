@@ -5,6 +5,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
 	aliastypes "github.com/longfan78/quorum-key-manager/src/aliases/api/types"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/api/types"
 	storestypes "github.com/longfan78/quorum-key-manager/src/stores/api/types"
 	utilstypes "github.com/longfan78/quorum-key-manager/src/utils/api/types"
 )
@@ -26,6 +27,8 @@ type KeysClient interface {
 	CreateKey(ctx context.Context, storeName, id string, request *storestypes.CreateKeyRequest) (*storestypes.KeyResponse, error)
 	ImportKey(ctx context.Context, storeName, id string, request *storestypes.ImportKeyRequest) (*storestypes.KeyResponse, error)
 	SignKey(ctx context.Context, storeName, id string, request *storestypes.SignBase64PayloadRequest) (string, error)
+	EncryptKey(ctx context.Context, storeName, id string, request *storestypes.EncryptBase64PayloadRequest) (string, error)
+	DecryptKey(ctx context.Context, storeName, id string, request *storestypes.DecryptBase64PayloadRequest) (string, error)
 	GetKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteKey(ctx context.Context, storeName, id string) error
@@ -44,12 +47,19 @@ type EthClient interface {
 	SignTransaction(ctx context.Context, storeName, address string, request *storestypes.SignETHTransactionRequest) (string, error)
 	SignQuorumPrivateTransaction(ctx context.Context, storeName, address string, request *storestypes.SignQuorumPrivateTransactionRequest) (string, error)
 	SignEEATransaction(ctx context.Context, storeName, address string, request *storestypes.SignEEATransactionRequest) (string, error)
+	EncryptEth(ctx context.Context, storeName, address string, request *storestypes.EncryptEthRequest) (string, error)
+	DecryptEth(ctx context.Context, storeName, address string, request *storestypes.DecryptEthRequest) (string, error)
 	GetEthAccount(ctx context.Context, storeName, address string) (*storestypes.EthAccountResponse, error)
 	ListEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteEthAccount(ctx context.Context, storeName, address string) error
 	DestroyEthAccount(ctx context.Context, storeName, address string) error
 	RestoreEthAccount(ctx context.Context, storeName, address string) error
+	CreateEthWallet(ctx context.Context, storeName string, request *storestypes.CreateEthWalletRequest) (*storestypes.EthWalletResponse, error)
+	ImportEthWallet(ctx context.Context, storeName string, request *storestypes.ImportEthWalletRequest) (*storestypes.EthWalletResponse, error)
+	GetEthWallet(ctx context.Context, storeName, id string) (*storestypes.EthWalletResponse, error)
+	ListEthWallets(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
+	DeriveEthAccount(ctx context.Context, storeName, walletID string, request *storestypes.DeriveEthAccountRequest) (*storestypes.EthAccountResponse, error)
 }
 
 type UtilsClient interface {
@@ -72,6 +82,14 @@ type AliasRegistryClient interface {
 	DeleteRegistry(ctx context.Context, registry string) error
 }
 
+type RolesClient interface {
+	CreateRole(ctx context.Context, roleName string, req *authtypes.CreateRoleRequest) (*authtypes.RoleResponse, error)
+	GetRole(ctx context.Context, roleName string) (*authtypes.RoleResponse, error)
+	ListRoles(ctx context.Context) ([]string, error)
+	UpdateRole(ctx context.Context, roleName string, req *authtypes.UpdateRoleRequest) (*authtypes.RoleResponse, error)
+	DeleteRole(ctx context.Context, roleName string) error
+}
+
 type JSONRPC interface {
 	Call(ctx context.Context, nodeID, method string, args ...interface{}) (*jsonrpc.ResponseMsg, error)
 }
@@ -83,5 +101,6 @@ type KeyManagerClient interface {
 	UtilsClient
 	AliasRegistryClient
 	AliasClient
+	RolesClient
 	JSONRPC
 }
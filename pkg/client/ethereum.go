@@ -71,6 +71,28 @@ func (c *HTTPClient) SignMessage(ctx context.Context, storeName, address string,
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) EncryptEth(ctx context.Context, storeName, address string, req *types.EncryptEthRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/encrypt", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) DecryptEth(ctx context.Context, storeName, address string, req *types.DecryptEthRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/decrypt", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
 func (c *HTTPClient) SignTypedData(ctx context.Context, storeName, address string, req *types.SignTypedDataRequest) (string, error) {
 	reqURL := fmt.Sprintf("%s/%s/%s/sign-typed-data", withURLStore(c.config.URL, storeName), ethPath, address)
 	response, err := postRequest(ctx, c.client, reqURL, req)
@@ -173,3 +195,75 @@ func (c *HTTPClient) RestoreEthAccount(ctx context.Context, storeName, address s
 	defer closeResponse(response)
 	return parseEmptyBodyResponse(response)
 }
+
+func (c *HTTPClient) CreateEthWallet(ctx context.Context, storeName string, req *types.CreateEthWalletRequest) (*types.EthWalletResponse, error) {
+	wallet := &types.EthWalletResponse{}
+	reqURL := fmt.Sprintf("%s/%s/wallets", withURLStore(c.config.URL, storeName), ethPath)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func (c *HTTPClient) ImportEthWallet(ctx context.Context, storeName string, req *types.ImportEthWalletRequest) (*types.EthWalletResponse, error) {
+	wallet := &types.EthWalletResponse{}
+	reqURL := fmt.Sprintf("%s/%s/wallets/import", withURLStore(c.config.URL, storeName), ethPath)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func (c *HTTPClient) GetEthWallet(ctx context.Context, storeName, id string) (*types.EthWalletResponse, error) {
+	wallet := &types.EthWalletResponse{}
+	reqURL := fmt.Sprintf("%s/%s/wallets/%s", withURLStore(c.config.URL, storeName), ethPath, id)
+	response, err := getRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func (c *HTTPClient) ListEthWallets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
+	return listRequest(ctx, c.client, fmt.Sprintf("%s/%s/wallets", withURLStore(c.config.URL, storeName), ethPath), false, limit, page)
+}
+
+func (c *HTTPClient) DeriveEthAccount(ctx context.Context, storeName, walletID string, req *types.DeriveEthAccountRequest) (*types.EthAccountResponse, error) {
+	ethAcc := &types.EthAccountResponse{}
+	reqURL := fmt.Sprintf("%s/%s/wallets/%s/derive", withURLStore(c.config.URL, storeName), ethPath, walletID)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, ethAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethAcc, nil
+}
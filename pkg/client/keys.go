@@ -54,6 +54,28 @@ func (c *HTTPClient) SignKey(ctx context.Context, storeName, id string, req *typ
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) EncryptKey(ctx context.Context, storeName, id string, req *types.EncryptBase64PayloadRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/encrypt", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) DecryptKey(ctx context.Context, storeName, id string, req *types.DecryptBase64PayloadRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/decrypt", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
 func (c *HTTPClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	key := &types.KeyResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), keysPath, id)
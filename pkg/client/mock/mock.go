@@ -10,6 +10,7 @@ import (
 	types "github.com/longfan78/quorum-key-manager/src/aliases/api/types"
 	types0 "github.com/longfan78/quorum-key-manager/src/stores/api/types"
 	types1 "github.com/longfan78/quorum-key-manager/src/utils/api/types"
+	types2 "github.com/longfan78/quorum-key-manager/src/auth/api/types"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -222,6 +223,36 @@ func (mr *MockKeysClientMockRecorder) SignKey(ctx, storeName, id, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeysClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// EncryptKey mocks base method
+func (m *MockKeysClient) EncryptKey(ctx context.Context, storeName, id string, request *types0.EncryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptKey indicates an expected call of EncryptKey
+func (mr *MockKeysClientMockRecorder) EncryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptKey", reflect.TypeOf((*MockKeysClient)(nil).EncryptKey), ctx, storeName, id, request)
+}
+
+// DecryptKey mocks base method
+func (m *MockKeysClient) DecryptKey(ctx context.Context, storeName, id string, request *types0.DecryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptKey indicates an expected call of DecryptKey
+func (mr *MockKeysClientMockRecorder) DecryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptKey", reflect.TypeOf((*MockKeysClient)(nil).DecryptKey), ctx, storeName, id, request)
+}
+
 // GetKey mocks base method
 func (m *MockKeysClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -467,6 +498,36 @@ func (mr *MockEthClientMockRecorder) SignEEATransaction(ctx, storeName, address,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockEthClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// EncryptEth mocks base method
+func (m *MockEthClient) EncryptEth(ctx context.Context, storeName, address string, request *types0.EncryptEthRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptEth indicates an expected call of EncryptEth
+func (mr *MockEthClientMockRecorder) EncryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptEth", reflect.TypeOf((*MockEthClient)(nil).EncryptEth), ctx, storeName, address, request)
+}
+
+// DecryptEth mocks base method
+func (m *MockEthClient) DecryptEth(ctx context.Context, storeName, address string, request *types0.DecryptEthRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptEth indicates an expected call of DecryptEth
+func (mr *MockEthClientMockRecorder) DecryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptEth", reflect.TypeOf((*MockEthClient)(nil).DecryptEth), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockEthClient) GetEthAccount(ctx context.Context, storeName, address string) (*types0.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +615,81 @@ func (mr *MockEthClientMockRecorder) RestoreEthAccount(ctx, storeName, address i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockEthClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// CreateEthWallet mocks base method
+func (m *MockEthClient) CreateEthWallet(ctx context.Context, storeName string, request *types0.CreateEthWalletRequest) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEthWallet", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEthWallet indicates an expected call of CreateEthWallet
+func (mr *MockEthClientMockRecorder) CreateEthWallet(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthWallet", reflect.TypeOf((*MockEthClient)(nil).CreateEthWallet), ctx, storeName, request)
+}
+
+// ImportEthWallet mocks base method
+func (m *MockEthClient) ImportEthWallet(ctx context.Context, storeName string, request *types0.ImportEthWalletRequest) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEthWallet", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEthWallet indicates an expected call of ImportEthWallet
+func (mr *MockEthClientMockRecorder) ImportEthWallet(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEthWallet", reflect.TypeOf((*MockEthClient)(nil).ImportEthWallet), ctx, storeName, request)
+}
+
+// GetEthWallet mocks base method
+func (m *MockEthClient) GetEthWallet(ctx context.Context, storeName, id string) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEthWallet", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEthWallet indicates an expected call of GetEthWallet
+func (mr *MockEthClientMockRecorder) GetEthWallet(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthWallet", reflect.TypeOf((*MockEthClient)(nil).GetEthWallet), ctx, storeName, id)
+}
+
+// ListEthWallets mocks base method
+func (m *MockEthClient) ListEthWallets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEthWallets", ctx, storeName, limit, page)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEthWallets indicates an expected call of ListEthWallets
+func (mr *MockEthClientMockRecorder) ListEthWallets(ctx, storeName, limit, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEthWallets", reflect.TypeOf((*MockEthClient)(nil).ListEthWallets), ctx, storeName, limit, page)
+}
+
+// DeriveEthAccount mocks base method
+func (m *MockEthClient) DeriveEthAccount(ctx context.Context, storeName, walletID string, request *types0.DeriveEthAccountRequest) (*types0.EthAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveEthAccount", ctx, storeName, walletID, request)
+	ret0, _ := ret[0].(*types0.EthAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveEthAccount indicates an expected call of DeriveEthAccount
+func (mr *MockEthClientMockRecorder) DeriveEthAccount(ctx, storeName, walletID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveEthAccount", reflect.TypeOf((*MockEthClient)(nil).DeriveEthAccount), ctx, storeName, walletID, request)
+}
+
 // MockUtilsClient is a mock of UtilsClient interface
 type MockUtilsClient struct {
 	ctrl     *gomock.Controller
@@ -783,6 +919,103 @@ func (mr *MockAliasRegistryClientMockRecorder) DeleteRegistry(ctx, registry inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistry", reflect.TypeOf((*MockAliasRegistryClient)(nil).DeleteRegistry), ctx, registry)
 }
 
+// MockRolesClient is a mock of RolesClient interface
+type MockRolesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolesClientMockRecorder
+}
+
+// MockRolesClientMockRecorder is the mock recorder for MockRolesClient
+type MockRolesClientMockRecorder struct {
+	mock *MockRolesClient
+}
+
+// NewMockRolesClient creates a new mock instance
+func NewMockRolesClient(ctrl *gomock.Controller) *MockRolesClient {
+	mock := &MockRolesClient{ctrl: ctrl}
+	mock.recorder = &MockRolesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRolesClient) EXPECT() *MockRolesClientMockRecorder {
+	return m.recorder
+}
+
+// CreateRole mocks base method
+func (m *MockRolesClient) CreateRole(ctx context.Context, roleName string, req *types2.CreateRoleRequest) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, roleName, req)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole
+func (mr *MockRolesClientMockRecorder) CreateRole(ctx, roleName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRolesClient)(nil).CreateRole), ctx, roleName, req)
+}
+
+// GetRole mocks base method
+func (m *MockRolesClient) GetRole(ctx context.Context, roleName string) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, roleName)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole
+func (mr *MockRolesClientMockRecorder) GetRole(ctx, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRolesClient)(nil).GetRole), ctx, roleName)
+}
+
+// ListRoles mocks base method
+func (m *MockRolesClient) ListRoles(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles
+func (mr *MockRolesClientMockRecorder) ListRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRolesClient)(nil).ListRoles), ctx)
+}
+
+// UpdateRole mocks base method
+func (m *MockRolesClient) UpdateRole(ctx context.Context, roleName string, req *types2.UpdateRoleRequest) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, roleName, req)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole
+func (mr *MockRolesClientMockRecorder) UpdateRole(ctx, roleName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRolesClient)(nil).UpdateRole), ctx, roleName, req)
+}
+
+// DeleteRole mocks base method
+func (m *MockRolesClient) DeleteRole(ctx context.Context, roleName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, roleName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole
+func (mr *MockRolesClientMockRecorder) DeleteRole(ctx, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRolesClient)(nil).DeleteRole), ctx, roleName)
+}
+
 // MockJSONRPC is a mock of JSONRPC interface
 type MockJSONRPC struct {
 	ctrl     *gomock.Controller
@@ -1011,6 +1244,36 @@ func (mr *MockKeyManagerClientMockRecorder) SignKey(ctx, storeName, id, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeyManagerClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// EncryptKey mocks base method
+func (m *MockKeyManagerClient) EncryptKey(ctx context.Context, storeName, id string, request *types0.EncryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptKey indicates an expected call of EncryptKey
+func (mr *MockKeyManagerClientMockRecorder) EncryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptKey", reflect.TypeOf((*MockKeyManagerClient)(nil).EncryptKey), ctx, storeName, id, request)
+}
+
+// DecryptKey mocks base method
+func (m *MockKeyManagerClient) DecryptKey(ctx context.Context, storeName, id string, request *types0.DecryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptKey indicates an expected call of DecryptKey
+func (mr *MockKeyManagerClientMockRecorder) DecryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptKey", reflect.TypeOf((*MockKeyManagerClient)(nil).DecryptKey), ctx, storeName, id, request)
+}
+
 // GetKey mocks base method
 func (m *MockKeyManagerClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1233,6 +1496,36 @@ func (mr *MockKeyManagerClientMockRecorder) SignEEATransaction(ctx, storeName, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockKeyManagerClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// EncryptEth mocks base method
+func (m *MockKeyManagerClient) EncryptEth(ctx context.Context, storeName, address string, request *types0.EncryptEthRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptEth indicates an expected call of EncryptEth
+func (mr *MockKeyManagerClientMockRecorder) EncryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptEth", reflect.TypeOf((*MockKeyManagerClient)(nil).EncryptEth), ctx, storeName, address, request)
+}
+
+// DecryptEth mocks base method
+func (m *MockKeyManagerClient) DecryptEth(ctx context.Context, storeName, address string, request *types0.DecryptEthRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptEth indicates an expected call of DecryptEth
+func (mr *MockKeyManagerClientMockRecorder) DecryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptEth", reflect.TypeOf((*MockKeyManagerClient)(nil).DecryptEth), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockKeyManagerClient) GetEthAccount(ctx context.Context, storeName, address string) (*types0.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -1320,6 +1613,81 @@ func (mr *MockKeyManagerClientMockRecorder) RestoreEthAccount(ctx, storeName, ad
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// CreateEthWallet mocks base method
+func (m *MockKeyManagerClient) CreateEthWallet(ctx context.Context, storeName string, request *types0.CreateEthWalletRequest) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEthWallet", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEthWallet indicates an expected call of CreateEthWallet
+func (mr *MockKeyManagerClientMockRecorder) CreateEthWallet(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthWallet", reflect.TypeOf((*MockKeyManagerClient)(nil).CreateEthWallet), ctx, storeName, request)
+}
+
+// ImportEthWallet mocks base method
+func (m *MockKeyManagerClient) ImportEthWallet(ctx context.Context, storeName string, request *types0.ImportEthWalletRequest) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEthWallet", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEthWallet indicates an expected call of ImportEthWallet
+func (mr *MockKeyManagerClientMockRecorder) ImportEthWallet(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEthWallet", reflect.TypeOf((*MockKeyManagerClient)(nil).ImportEthWallet), ctx, storeName, request)
+}
+
+// GetEthWallet mocks base method
+func (m *MockKeyManagerClient) GetEthWallet(ctx context.Context, storeName, id string) (*types0.EthWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEthWallet", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.EthWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEthWallet indicates an expected call of GetEthWallet
+func (mr *MockKeyManagerClientMockRecorder) GetEthWallet(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthWallet", reflect.TypeOf((*MockKeyManagerClient)(nil).GetEthWallet), ctx, storeName, id)
+}
+
+// ListEthWallets mocks base method
+func (m *MockKeyManagerClient) ListEthWallets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEthWallets", ctx, storeName, limit, page)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEthWallets indicates an expected call of ListEthWallets
+func (mr *MockKeyManagerClientMockRecorder) ListEthWallets(ctx, storeName, limit, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEthWallets", reflect.TypeOf((*MockKeyManagerClient)(nil).ListEthWallets), ctx, storeName, limit, page)
+}
+
+// DeriveEthAccount mocks base method
+func (m *MockKeyManagerClient) DeriveEthAccount(ctx context.Context, storeName, walletID string, request *types0.DeriveEthAccountRequest) (*types0.EthAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveEthAccount", ctx, storeName, walletID, request)
+	ret0, _ := ret[0].(*types0.EthAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveEthAccount indicates an expected call of DeriveEthAccount
+func (mr *MockKeyManagerClientMockRecorder) DeriveEthAccount(ctx, storeName, walletID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).DeriveEthAccount), ctx, storeName, walletID, request)
+}
+
 // VerifyKeySignature mocks base method
 func (m *MockKeyManagerClient) VerifyKeySignature(ctx context.Context, request *types1.VerifyKeySignatureRequest) error {
 	m.ctrl.T.Helper()
@@ -1480,6 +1848,80 @@ func (mr *MockKeyManagerClientMockRecorder) DeleteAlias(ctx, registry, aliasKey
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockKeyManagerClient)(nil).DeleteAlias), ctx, registry, aliasKey)
 }
 
+// CreateRole mocks base method
+func (m *MockKeyManagerClient) CreateRole(ctx context.Context, roleName string, req *types2.CreateRoleRequest) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, roleName, req)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole
+func (mr *MockKeyManagerClientMockRecorder) CreateRole(ctx, roleName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockKeyManagerClient)(nil).CreateRole), ctx, roleName, req)
+}
+
+// GetRole mocks base method
+func (m *MockKeyManagerClient) GetRole(ctx context.Context, roleName string) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, roleName)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole
+func (mr *MockKeyManagerClientMockRecorder) GetRole(ctx, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockKeyManagerClient)(nil).GetRole), ctx, roleName)
+}
+
+// ListRoles mocks base method
+func (m *MockKeyManagerClient) ListRoles(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles
+func (mr *MockKeyManagerClientMockRecorder) ListRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockKeyManagerClient)(nil).ListRoles), ctx)
+}
+
+// UpdateRole mocks base method
+func (m *MockKeyManagerClient) UpdateRole(ctx context.Context, roleName string, req *types2.UpdateRoleRequest) (*types2.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, roleName, req)
+	ret0, _ := ret[0].(*types2.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole
+func (mr *MockKeyManagerClientMockRecorder) UpdateRole(ctx, roleName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockKeyManagerClient)(nil).UpdateRole), ctx, roleName, req)
+}
+
+// DeleteRole mocks base method
+func (m *MockKeyManagerClient) DeleteRole(ctx context.Context, roleName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, roleName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole
+func (mr *MockKeyManagerClientMockRecorder) DeleteRole(ctx, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockKeyManagerClient)(nil).DeleteRole), ctx, roleName)
+}
+
 // Call mocks base method
 func (m *MockKeyManagerClient) Call(ctx context.Context, nodeID, method string, args ...interface{}) (*jsonrpc.ResponseMsg, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/api/types"
+)
+
+const rolesPath = "%s/roles"
+const rolePathf = "%s/roles/%s"
+
+// CreateRole creates a role with a set of permissions.
+func (c *HTTPClient) CreateRole(ctx context.Context, roleName string, req *types.CreateRoleRequest) (*types.RoleResponse, error) {
+	requestURL := fmt.Sprintf(rolePathf, c.config.URL, roleName)
+	resp, err := postRequest(ctx, c.client, requestURL, req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var role types.RoleResponse
+	err = parseResponse(resp, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// GetRole gets a role and its permissions.
+func (c *HTTPClient) GetRole(ctx context.Context, roleName string) (*types.RoleResponse, error) {
+	requestURL := fmt.Sprintf(rolePathf, c.config.URL, roleName)
+	resp, err := getRequest(ctx, c.client, requestURL)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var role types.RoleResponse
+	err = parseResponse(resp, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// ListRoles lists the names of all the roles.
+func (c *HTTPClient) ListRoles(ctx context.Context) ([]string, error) {
+	requestURL := fmt.Sprintf(rolesPath, c.config.URL)
+	resp, err := getRequest(ctx, c.client, requestURL)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var roles []string
+	err = parseResponse(resp, &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// UpdateRole replaces the permissions of a role.
+func (c *HTTPClient) UpdateRole(ctx context.Context, roleName string, req *types.UpdateRoleRequest) (*types.RoleResponse, error) {
+	requestURL := fmt.Sprintf(rolePathf, c.config.URL, roleName)
+	resp, err := patchRequest(ctx, c.client, requestURL, req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var role types.RoleResponse
+	err = parseResponse(resp, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// DeleteRole deletes a role.
+func (c *HTTPClient) DeleteRole(ctx context.Context, roleName string) error {
+	requestURL := fmt.Sprintf(rolePathf, c.config.URL, roleName)
+	resp, err := deleteRequest(ctx, c.client, requestURL)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+
+	return parseEmptyBodyResponse(resp)
+}
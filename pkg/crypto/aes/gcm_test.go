@@ -0,0 +1,37 @@
+package aes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+	plaintext := []byte("my-secret")
+
+	t.Run("should encrypt and decrypt successfully", func(t *testing.T) {
+		ciphertext, err := Encrypt(key, plaintext)
+		require.NoError(t, err)
+		assert.NotContains(t, string(ciphertext), string(plaintext))
+
+		decrypted, err := Decrypt(key, ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("should fail to decrypt with another key", func(t *testing.T) {
+		ciphertext, err := Encrypt(key, plaintext)
+		require.NoError(t, err)
+
+		_, err = Decrypt(bytes.Repeat([]byte{2}, KeySize), ciphertext)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with an invalid key size", func(t *testing.T) {
+		_, err := Encrypt([]byte("short"), plaintext)
+		assert.Error(t, err)
+	})
+}
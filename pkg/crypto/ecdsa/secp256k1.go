@@ -2,10 +2,12 @@ package ecdsa
 
 import (
 	"crypto/ecdsa"
+	"crypto/rand"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
 )
 
 func CreateSecp256k1(importedPrivKey []byte) (privKey, pubKey []byte, err error) {
@@ -60,3 +62,23 @@ func VerifySecp256k1Signature(publicKey, message, signature []byte) (bool, error
 
 	return ecdsa.Verify(pubKey, message, r, s), nil
 }
+
+// EncryptSecp256k1 encrypts data for the owner of publicKey using ECIES
+func EncryptSecp256k1(publicKey, data []byte) ([]byte, error) {
+	pubKey, err := crypto.UnmarshalPubkey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key. %s", err.Error())
+	}
+
+	return ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pubKey), data, nil, nil)
+}
+
+// DecryptSecp256k1 decrypts data encrypted by EncryptSecp256k1
+func DecryptSecp256k1(privKey, data []byte) ([]byte, error) {
+	ecdsaPrivKey, err := crypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key. %s", err.Error())
+	}
+
+	return ecies.ImportECDSA(ecdsaPrivKey).Decrypt(data, nil, nil)
+}
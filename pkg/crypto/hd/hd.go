@@ -0,0 +1,110 @@
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	mnemonicEntropySize = 256
+	hardenedOffset      = 0x80000000
+)
+
+var masterKeySalt = []byte("Bitcoin seed")
+
+// NewMnemonic generates a 24 words BIP-39 mnemonic
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// IsMnemonicValid checks the words and the checksum of a BIP-39 mnemonic
+func IsMnemonicValid(mnemonic string) bool {
+	return bip39.IsMnemonicValid(mnemonic)
+}
+
+// DeriveSecp256k1 derives the BIP-32 secp256k1 private key of a derivation path from a BIP-39 mnemonic
+func DeriveSecp256k1(mnemonic string, path accounts.DerivationPath) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveSecp256k1(seed, path)
+}
+
+func deriveSecp256k1(seed []byte, path accounts.DerivationPath) ([]byte, error) {
+	mac := hmac.New(sha512.New, masterKeySalt)
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key, chainCode := sum[:32], sum[32:]
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		var err error
+		key, chainCode, err = deriveChild(key, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func deriveChild(key, chainCode []byte, index uint32) (childKey, childChainCode []byte, err error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0x0}, key...)
+	} else {
+		privKey, err := crypto.ToECDSA(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = crypto.CompressPubkey(&privKey.PublicKey)
+	}
+	serIndex := make([]byte, 4)
+	binary.BigEndian.PutUint32(serIndex, index)
+	data = append(data, serIndex...)
+
+	mac := hmac.New(sha512.New, chainCode)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	if err = checkKey(sum[:32]); err != nil {
+		return nil, nil, err
+	}
+
+	n := crypto.S256().Params().N
+	k := new(big.Int).SetBytes(sum[:32])
+	k.Add(k, new(big.Int).SetBytes(key))
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+
+	childKey = make([]byte, 32)
+	return k.FillBytes(childKey), sum[32:], nil
+}
+
+func checkKey(key []byte) error {
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(crypto.S256().Params().N) >= 0 {
+		return fmt.Errorf("invalid derived key")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSecp256k1(t *testing.T) {
+	t.Run("should derive BIP-32 test vector keys", func(t *testing.T) {
+		seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+		testCases := map[string]string{
+			"m":         "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+			"m/0'":      "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+			"m/0'/1":    "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+			"m/0'/1/2'": "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		}
+
+		for path, expectedKey := range testCases {
+			derivationPath := accounts.DerivationPath{}
+			if path != "m" {
+				var err error
+				derivationPath, err = accounts.ParseDerivationPath(path)
+				require.NoError(t, err)
+			}
+
+			key, err := deriveSecp256k1(seed, derivationPath)
+			require.NoError(t, err)
+			assert.Equal(t, expectedKey, hex.EncodeToString(key), path)
+		}
+	})
+
+	t.Run("should derive the first BIP-44 Ethereum account of a mnemonic", func(t *testing.T) {
+		mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+		key, err := DeriveSecp256k1(mnemonic, accounts.DefaultBaseDerivationPath)
+		require.NoError(t, err)
+
+		privKey, err := crypto.ToECDSA(key)
+		require.NoError(t, err)
+		assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+	})
+
+	t.Run("should fail with an invalid mnemonic", func(t *testing.T) {
+		_, err := DeriveSecp256k1("abandon abandon", accounts.DefaultBaseDerivationPath)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	assert.True(t, IsMnemonicValid(mnemonic))
+}
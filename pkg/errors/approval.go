@@ -0,0 +1,16 @@
+package errors
+
+const (
+	Approval        = "AP000"
+	PendingApproval = "AP100"
+)
+
+// PendingApprovalError is raised when an operation is waiting for approvals before being executed
+func PendingApprovalError(format string, a ...interface{}) *Error {
+	return Errorf(PendingApproval, format, a...)
+}
+
+// IsPendingApprovalError indicate whether an error is a pending approval error
+func IsPendingApprovalError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), PendingApproval)
+}
@@ -16,6 +16,7 @@ const (
 	InvalidFormat    = "IR400"
 	InvalidParameter = "IR500"
 	Forbidden        = "IR600"
+	PolicyViolation  = "IR610"
 	TooManyRequest   = "IR700"
 )
 
@@ -100,6 +101,16 @@ func IsForbiddenError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), Forbidden)
 }
 
+// PolicyViolationError is raised when a signature is rejected by a signing policy
+func PolicyViolationError(format string, a ...interface{}) *Error {
+	return Errorf(PolicyViolation, format, a...)
+}
+
+// IsPolicyViolationError indicate whether an error is a signing policy violation
+func IsPolicyViolationError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), PolicyViolation)
+}
+
 // NotSupportedError is raised when operation is not supported
 func NotSupportedError(format string, a ...interface{}) *Error {
 	return Errorf(NotSupported, format, a...)
@@ -5,6 +5,8 @@ const (
 	NotFound       = "ST100"
 	AlreadyExists  = "ST200"
 	StatusConflict = "ST300"
+	Expired        = "ST400"
+	Disabled       = "ST500"
 )
 
 // NotFoundError is raised when accessing a missing Data
@@ -36,3 +38,23 @@ func StatusConflictError(format string, a ...interface{}) *Error {
 func IsStatusConflictError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), StatusConflict)
 }
+
+// ExpiredError is raised when using an item after its expiry date
+func ExpiredError(format string, a ...interface{}) *Error {
+	return Errorf(Expired, format, a...)
+}
+
+// IsExpiredError indicate whether an error is an expired error
+func IsExpiredError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), Expired)
+}
+
+// DisabledError is raised when using a disabled item
+func DisabledError(format string, a ...interface{}) *Error {
+	return Errorf(Disabled, format, a...)
+}
+
+// IsDisabledError indicate whether an error is a disabled error
+func IsDisabledError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), Disabled)
+}
@@ -19,6 +19,9 @@ const (
 	StateValidationPrivacyFlag             = iota | PartyProtectionPrivacyFlag // 3 which includes PrivacyFlagPartyProtection
 )
 
+// MandatoryRecipientsPrivacyFlag requires the parties of mandatoryFor to be recipients of all the transactions of a contract
+const MandatoryRecipientsPrivacyFlag PrivacyFlag = 2
+
 const (
 	PrivateTypeRestricted   PrivateType = "restricted"
 	PrivateTypeUnrestricted PrivateType = "unrestricted"
@@ -32,6 +35,7 @@ type PrivateArgs struct {
 	PrivateType    *PrivateType `json:"restriction,omitempty"`
 	PrivacyFlag    *PrivacyFlag `json:"privacyFlag,omitempty"`
 	PrivacyGroupID *string      `json:"privacyGroupId,omitempty"`
+	MandatoryFor   *[]string    `json:"mandatoryFor,omitempty"`
 }
 
 func (args *PrivateArgs) WithPrivateFrom(pubKey string) *PrivateArgs {
@@ -59,6 +63,11 @@ func (args *PrivateArgs) WithPrivacyGroupID(id string) *PrivateArgs {
 	return args
 }
 
+func (args *PrivateArgs) WithMandatoryFor(pubKeys []string) *PrivateArgs {
+	args.MandatoryFor = &pubKeys
+	return args
+}
+
 // TODO: Delete usage of unnecessary pointers: https://app.zenhub.com/workspaces/orchestrate-5ea70772b186e10067f57842/issues/consensys/quorum-key-manager/96
 type SendTxMsg struct {
 	From       ethcommon.Address
@@ -31,6 +31,9 @@ type ethService struct {
 	SendRawTransaction        func(jsonrpc.Client) func(context.Context, hexutil.Bytes) (ethcommon.Hash, error)                   `namespace:"eth"`
 	SendRawPrivateTransaction func(jsonrpc.Client) func(context.Context, hexutil.Bytes, *PrivateArgs) (ethcommon.Hash, error)     `namespace:"eth"`
 	GetBlockByNumber          func(jsonrpc.Client) func(context.Context, BlockNumber, bool) (*types.Header, error)                `method:"eth_getBlockByNumber"`
+	BlockNumber               func(jsonrpc.Client) func(context.Context) (hexutil.Uint64, error)                                  `namespace:"eth"`
+	GetTransactionByHash      func(jsonrpc.Client) func(context.Context, ethcommon.Hash) (*TxInfo, error)                         `namespace:"eth"`
+	GetTransactionReceipt     func(jsonrpc.Client) func(context.Context, ethcommon.Hash) (*Receipt, error)                        `namespace:"eth"`
 }
 
 //go:generate mockgen -source=caller_eth.go -destination=mock/caller_eth.go -package=mock
@@ -44,6 +47,11 @@ type EthCaller interface {
 	EstimateGas(context.Context, *CallMsg) (uint64, error)
 	SendRawTransaction(context.Context, []byte) (ethcommon.Hash, error)
 	SendRawPrivateTransaction(context.Context, []byte, *PrivateArgs) (ethcommon.Hash, error)
+	BlockNumber(context.Context) (uint64, error)
+	// GetTransactionByHash returns nil if the transaction is unknown to the node
+	GetTransactionByHash(context.Context, ethcommon.Hash) (*TxInfo, error)
+	// GetTransactionReceipt returns nil if the transaction is not mined
+	GetTransactionReceipt(context.Context, ethcommon.Hash) (*Receipt, error)
 }
 
 type ethCaller struct {
@@ -102,3 +110,20 @@ func (c *ethCaller) BaseFeePerGas(ctx context.Context, blockNumber BlockNumber)
 
 	return header.BaseFee, nil
 }
+
+func (c *ethCaller) BlockNumber(ctx context.Context) (uint64, error) {
+	n, err := ethSrv.BlockNumber(c.client)(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(n), nil
+}
+
+func (c *ethCaller) GetTransactionByHash(ctx context.Context, hash ethcommon.Hash) (*TxInfo, error) {
+	return ethSrv.GetTransactionByHash(c.client)(ctx, hash)
+}
+
+func (c *ethCaller) GetTransactionReceipt(ctx context.Context, hash ethcommon.Hash) (*Receipt, error) {
+	return ethSrv.GetTransactionReceipt(c.client)(ctx, hash)
+}
@@ -165,6 +165,81 @@ func TestCaller(t *testing.T) {
 		assert.Equal(t, "0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a", hash.String(), "Result should be valid")
 	})
 
+	t.Run("eth_blockNumber", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":"0x4b7"}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		blockNumber, err := cllr.Eth().BlockNumber(context.Background())
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(1207), blockNumber, "Result should be valid")
+	})
+
+	t.Run("eth_getTransactionByHash", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"hash":"0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a","from":"0xc94770007dda54cf92009bff0de90c06f603a09f","nonce":"0x2","blockNumber":null}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		tx, err := cllr.Eth().GetTransactionByHash(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(2), uint64(tx.Nonce), "Result should be valid")
+		assert.Nil(t, tx.BlockNumber, "Transaction should be pending")
+	})
+
+	t.Run("eth_getTransactionReceipt", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"transactionHash":"0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a","blockHash":"0x2a1ca9f16d4eca4e0ee3ffd0ff3fc67d1ec4f2da9b7e31ed6a1c92ac65e3c3d1","blockNumber":"0x4b7","status":"0x1","gasUsed":"0x5208","contractAddress":null}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(1207), uint64(receipt.BlockNumber), "Result should be valid")
+		assert.Equal(t, ReceiptStatusSuccessful, uint64(receipt.Status), "Result should be valid")
+		assert.Equal(t, uint64(21000), uint64(receipt.GasUsed), "Result should be valid")
+	})
+
+	t.Run("eth_getTransactionReceipt of a transaction not mined", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":null}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Nil(t, receipt, "Receipt should be nil")
+	})
+
 	t.Run("eea_sendRawTransaction", func(t *testing.T) {
 		m := testutils.RequestMatcher(
 			t,
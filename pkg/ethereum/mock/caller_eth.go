@@ -140,3 +140,48 @@ func (mr *MockEthCallerMockRecorder) SendRawPrivateTransaction(arg0, arg1, arg2
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawPrivateTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawPrivateTransaction), arg0, arg1, arg2)
 }
+
+// BlockNumber mocks base method
+func (m *MockEthCaller) BlockNumber(arg0 context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockEthCallerMockRecorder) BlockNumber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockEthCaller)(nil).BlockNumber), arg0)
+}
+
+// GetTransactionByHash mocks base method
+func (m *MockEthCaller) GetTransactionByHash(arg0 context.Context, arg1 common.Hash) (*ethereum.TxInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByHash", arg0, arg1)
+	ret0, _ := ret[0].(*ethereum.TxInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByHash indicates an expected call of GetTransactionByHash
+func (mr *MockEthCallerMockRecorder) GetTransactionByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionByHash), arg0, arg1)
+}
+
+// GetTransactionReceipt mocks base method
+func (m *MockEthCaller) GetTransactionReceipt(arg0 context.Context, arg1 common.Hash) (*ethereum.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*ethereum.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionReceipt indicates an expected call of GetTransactionReceipt
+func (mr *MockEthCallerMockRecorder) GetTransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipt", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionReceipt), arg0, arg1)
+}
@@ -0,0 +1,29 @@
+package ethereum
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	ReceiptStatusFailed     = uint64(0)
+	ReceiptStatusSuccessful = uint64(1)
+)
+
+// Receipt is the receipt of a mined transaction, limited to the fields needed to follow the transaction
+type Receipt struct {
+	TxHash          ethcommon.Hash     `json:"transactionHash"`
+	BlockHash       ethcommon.Hash     `json:"blockHash"`
+	BlockNumber     hexutil.Uint64     `json:"blockNumber"`
+	Status          hexutil.Uint64     `json:"status"`
+	GasUsed         hexutil.Uint64     `json:"gasUsed"`
+	ContractAddress *ethcommon.Address `json:"contractAddress"`
+}
+
+// TxInfo is a transaction known by a node, which is pending as long as it has no block number
+type TxInfo struct {
+	Hash        ethcommon.Hash    `json:"hash"`
+	From        ethcommon.Address `json:"from"`
+	Nonce       hexutil.Uint64    `json:"nonce"`
+	BlockNumber *hexutil.Uint64   `json:"blockNumber"`
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type HealthzHandler struct {
@@ -23,6 +25,7 @@ func NewHealthzHandler() *HealthzHandler {
 	}
 	h.Handle("/live", http.HandlerFunc(h.LiveEndpoint))
 	h.Handle("/ready", http.HandlerFunc(h.ReadyEndpoint))
+	h.Handle("/metrics", promhttp.Handler())
 	return h
 }
 
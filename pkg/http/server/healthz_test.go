@@ -108,3 +108,14 @@ func parseResponseBody(body io.ReadCloser, res interface{}) error {
 	dec.DisallowUnknownFields()
 	return dec.Decode(res)
 }
+
+func TestMetrics(t *testing.T) {
+	handler := NewHealthzHandler()
+
+	rw := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "http://test.com/metrics", nil)
+	handler.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusOK, rw.Result().StatusCode)
+	assert.Contains(t, rw.Body.String(), "go_goroutines")
+}
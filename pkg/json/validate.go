@@ -1,6 +1,8 @@
 package json
 
 import (
+	"time"
+
 	"github.com/longfan78/quorum-key-manager/src/entities"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
@@ -57,6 +59,15 @@ func isAliasKind(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isDuration(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		d, err := time.ParseDuration(fl.Field().String())
+		return err == nil && d > 0
+	}
+
+	return true
+}
+
 func init() {
 	if validate != nil {
 		return
@@ -67,6 +78,7 @@ func init() {
 	_ = validate.RegisterValidation("isCurve", isCurve)
 	_ = validate.RegisterValidation("isSigningAlgorithm", isSigningAlgorithm)
 	_ = validate.RegisterValidation("isAliasKind", isAliasKind)
+	_ = validate.RegisterValidation("isDuration", isDuration)
 }
 
 func getValidator() *validator.Validate {
@@ -0,0 +1,85 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// IsBatch indicates whether a JSON-RPC body holds a batch of requests
+func IsBatch(b []byte) bool {
+	b = bytes.TrimLeft(b, " \t\r\n")
+	return len(b) > 0 && b[0] == '['
+}
+
+// UnmarshalBatch decodes a batch of requests, failing if the batch is not a JSON array, is empty or holds more than
+// maxSize requests. Requests that cannot be decoded are kept in the batch to be answered with an invalid request error
+func UnmarshalBatch(b []byte, maxSize int) ([]*RequestMsg, error) {
+	var raws []json.RawMessage
+	err := json.Unmarshal(b, &raws)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	if len(raws) == 0 {
+		return nil, InvalidRequest(fmt.Errorf("empty batch"))
+	}
+
+	if maxSize > 0 && len(raws) > maxSize {
+		return nil, InvalidRequest(fmt.Errorf("batch of %d requests exceeds the maximum of %d", len(raws), maxSize))
+	}
+
+	msgs := make([]*RequestMsg, len(raws))
+	for i, raw := range raws {
+		msg := new(RequestMsg)
+		if err := json.Unmarshal(raw, msg); err != nil {
+			msg = &RequestMsg{err: InvalidRequest(err)}
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ServeBatch serves the requests of a batch one after the other and writes their responses in order.
+// Notifications, i.e. requests without ID, get no response. Requests that could not be decoded are answered with an
+// invalid request error without ID
+func ServeBatch(w io.Writer, h Handler, msgs []*RequestMsg) error {
+	resps := make([]*ResponseMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg.err != nil {
+			resps = append(resps, (&ResponseMsg{}).WithVersion(defaultVersion).WithError(msg.err))
+			continue
+		}
+
+		rec := new(responseRecorder)
+		h.ServeRPC(rec, msg)
+
+		if msg.ID != nil && rec.msg != nil {
+			resps = append(resps, rec.msg)
+		}
+	}
+
+	if len(resps) == 0 {
+		return nil
+	}
+
+	if httpRw, ok := w.(http.ResponseWriter); ok {
+		httpRw.Header().Set("Content-Type", "application/json")
+	}
+
+	return json.NewEncoder(w).Encode(resps)
+}
+
+// responseRecorder records the response of a request of a batch
+type responseRecorder struct {
+	msg *ResponseMsg
+}
+
+func (rec *responseRecorder) WriteMsg(msg *ResponseMsg) error {
+	rec.msg = msg
+	return nil
+}
@@ -0,0 +1,78 @@
+package jsonrpc
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBatch(t *testing.T) {
+	assert.True(t, IsBatch([]byte(` [{"jsonrpc":"2.0"}]`)), "Array should be a batch")
+	assert.False(t, IsBatch([]byte(`{"jsonrpc":"2.0"}`)), "Object should not be a batch")
+	assert.False(t, IsBatch([]byte(``)), "Empty body should not be a batch")
+}
+
+func TestUnmarshalBatch(t *testing.T) {
+	msgs, err := UnmarshalBatch([]byte(`[{"jsonrpc":"2.0","method":"m1","id":1},{"jsonrpc":"2.0","method":"m2"}]`), 2)
+	require.NoError(t, err, "UnmarshalBatch should not error")
+	require.Len(t, msgs, 2, "UnmarshalBatch should decode all requests")
+	assert.Equal(t, "m1", msgs[0].Method, "First request should be correct")
+	assert.Nil(t, msgs[1].ID, "Notification should have no ID")
+
+	_, err = UnmarshalBatch([]byte(`[]`), 2)
+	assert.Equal(t, -32600, err.(*ErrorMsg).Code, "Empty batch should be an invalid request")
+
+	_, err = UnmarshalBatch([]byte(`[{"method":"m1"},{"method":"m2"},{"method":"m3"}]`), 2)
+	assert.Equal(t, -32600, err.(*ErrorMsg).Code, "Batch exceeding max size should be an invalid request")
+
+	_, err = UnmarshalBatch([]byte(`[{"method":`), 2)
+	assert.Equal(t, -32700, err.(*ErrorMsg).Code, "Invalid JSON should be a parse error")
+
+	_, err = UnmarshalBatch([]byte(`{"jsonrpc":"2.0","method":"m1"}`), 2)
+	assert.Equal(t, -32700, err.(*ErrorMsg).Code, "Batch that is not an array should be a parse error")
+
+	msgs, err = UnmarshalBatch([]byte(`[1,{"jsonrpc":"2.0","method":"m1","id":1}]`), 2)
+	require.NoError(t, err, "UnmarshalBatch should not error on invalid requests")
+	require.Len(t, msgs, 2, "UnmarshalBatch should keep invalid requests")
+	assert.Equal(t, -32600, msgs[0].err.Code, "Invalid request should be kept with an invalid request error")
+	assert.Nil(t, msgs[1].err, "Valid request should have no error")
+	assert.Equal(t, "m1", msgs[1].Method, "Valid request should be decoded")
+}
+
+func TestServeBatch(t *testing.T) {
+	h := DefaultRWHandler(HandlerFunc(func(rw ResponseWriter, msg *RequestMsg) {
+		_ = WriteResult(rw, msg.Method)
+	}))
+
+	msgs, _ := UnmarshalBatch([]byte(`[{"jsonrpc":"2.0","method":"m1","id":1},{"jsonrpc":"2.0","method":"m2"},{"jsonrpc":"2.0","method":"m3","id":"3"}]`), 0)
+
+	rec := httptest.NewRecorder()
+	err := ServeBatch(rec, h, msgs)
+	require.NoError(t, err, "ServeBatch should not error")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"), "Content-Type should be set")
+	assert.Equal(
+		t,
+		`[{"jsonrpc":"2.0","result":"m1","error":null,"id":1},{"jsonrpc":"2.0","result":"m3","error":null,"id":"3"}]`+"\n",
+		rec.Body.String(),
+		"Responses should be in order without notifications",
+	)
+
+	rec = httptest.NewRecorder()
+	msgs, _ = UnmarshalBatch([]byte(`[{"jsonrpc":"2.0","method":"m1","id":1},"invalid",{"jsonrpc":"2.0","method":"m2"}]`), 0)
+	err = ServeBatch(rec, h, msgs)
+	require.NoError(t, err, "ServeBatch should not error")
+	assert.Equal(
+		t,
+		`[{"jsonrpc":"2.0","result":"m1","error":null,"id":1},{"jsonrpc":"2.0","result":null,"error":{"code":-32600,"message":"Invalid Request","data":{"message":"json: cannot unmarshal string into Go value of type jsonrpc.jsonReqMsg"}},"id":null}]`+"\n",
+		rec.Body.String(),
+		"Invalid requests of a mixed batch should get an invalid request response",
+	)
+
+	rec = httptest.NewRecorder()
+	msgs, _ = UnmarshalBatch([]byte(`[{"jsonrpc":"2.0","method":"m1"}]`), 0)
+	err = ServeBatch(rec, h, msgs)
+	require.NoError(t, err, "ServeBatch should not error")
+	assert.Empty(t, rec.Body.String(), "Batch of notifications should get no response")
+}
@@ -56,6 +56,13 @@ func NotImplementedMethodError(method string) *ErrorMsg {
 	}
 }
 
+func MethodNotAllowedError(method string) *ErrorMsg {
+	return &ErrorMsg{
+		Code:    -32601,
+		Message: fmt.Sprintf("Method %q not allowed", method),
+	}
+}
+
 func MethodNotFoundError() *ErrorMsg {
 	return &ErrorMsg{
 		Code:    -32601,
@@ -122,3 +129,14 @@ func InvalidDownstreamResponse(err error) *ErrorMsg {
 		},
 	}
 }
+
+func LimitExceededError(err error, retryAfter int) *ErrorMsg {
+	return &ErrorMsg{
+		Code:    -32005,
+		Message: "Limit exceeded",
+		Data: map[string]interface{}{
+			"message":    err.Error(),
+			"retryAfter": retryAfter,
+		},
+	}
+}
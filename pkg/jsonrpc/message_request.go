@@ -19,6 +19,8 @@ type RequestMsg struct {
 	ctx context.Context
 
 	raw *jsonReqMsg
+	// err is the error decoding the request from a batch, the request being answered with it
+	err *ErrorMsg
 }
 
 // jsonReqMsg is a struct allowing to encode/decode a JSON-RPC request body
@@ -105,6 +107,7 @@ func (msg *RequestMsg) Copy() *RequestMsg {
 	newMsg.Method = msg.Method
 	newMsg.Params = msg.Params
 	newMsg.ID = msg.ID
+	newMsg.err = msg.err
 
 	if msg.raw != nil {
 		newMsg.raw = new(jsonReqMsg)
@@ -14,6 +14,8 @@ type ResponseMsg struct {
 	Error   *ErrorMsg
 
 	raw *jsonRespMsg
+	// nullResult indicates a null result was received, which is a valid result on success
+	nullResult bool
 }
 
 // jsonRespMsg is a struct allowing to encode/decode a JSON-RPC response body
@@ -50,9 +52,21 @@ func (msg *ResponseMsg) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	msg.nullResult = raw.Result == nil && raw.Error == nil && hasField(b, "result")
+
 	return nil
 }
 
+func hasField(b []byte, field string) bool {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return false
+	}
+
+	_, ok := fields[field]
+	return ok
+}
+
 func (msg *ResponseMsg) Err() error {
 	if msg.Error == nil {
 		return nil
@@ -110,7 +124,7 @@ func (msg *ResponseMsg) Validate() error {
 	}
 
 	isSuccess := msg.Error == nil
-	hasResult := msg.Result != nil
+	hasResult := msg.Result != nil || msg.nullResult
 
 	if isSuccess && !hasResult {
 		return fmt.Errorf("missing result on success")
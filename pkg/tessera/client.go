@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 
 	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
 	"github.com/longfan78/quorum-key-manager/pkg/http/request"
@@ -15,7 +18,29 @@ import (
 
 // Client is a client to Tessera Private Transaction Manager
 type Client interface {
+	// StoreRaw stores a private payload and returns its key
 	StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error)
+
+	// Receive returns a private payload given its key, decrypted for the recipient to if set
+	Receive(ctx context.Context, key []byte, to string) (*ReceiveResponse, error)
+
+	// SendSignedTx distributes a payload stored with StoreRaw, given its key, to the recipients
+	SendSignedTx(ctx context.Context, key []byte, to []string, opts *SendSignedTxOptions) ([]byte, error)
+
+	// CreatePrivacyGroup creates a privacy group of the given members, from being one of the keys of the node
+	CreatePrivacyGroup(ctx context.Context, from string, addresses []string, name, description string) (*PrivacyGroup, error)
+
+	// FindPrivacyGroup returns the privacy groups made of exactly the given members
+	FindPrivacyGroup(ctx context.Context, addresses []string) ([]*PrivacyGroup, error)
+
+	// RetrievePrivacyGroup returns a privacy group given its ID
+	RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*PrivacyGroup, error)
+
+	// DeletePrivacyGroup deletes a privacy group, from being one of its members
+	DeletePrivacyGroup(ctx context.Context, from, privacyGroupID string) error
+
+	// PartyInfo returns the peers known by the node and their public keys
+	PartyInfo(ctx context.Context) (*PartyInfo, error)
 }
 
 // HTTPClient is a tessera.Client that uses http
@@ -39,34 +64,204 @@ type StoreRawResponse struct {
 	Key string `json:"key"`
 }
 
-func (c *HTTPClient) StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error) {
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/storeraw", nil)
+type ReceiveResponse struct {
+	Payload        []byte   `json:"payload"`
+	PrivacyFlag    int      `json:"privacyFlag"`
+	PrivacyGroupID string   `json:"privacyGroupId,omitempty"`
+	Sender         string   `json:"senderKey,omitempty"`
+	ManagedParties []string `json:"managedParties,omitempty"`
+}
+
+// SendSignedTxOptions are the options of enhanced privacy of a private transaction
+type SendSignedTxOptions struct {
+	PrivacyFlag         int
+	MandatoryRecipients []string
+	PrivacyGroupID      string
+}
+
+type SendSignedTxRequest struct {
+	Hash                string   `json:"hash"`
+	To                  []string `json:"to"`
+	PrivacyFlag         int      `json:"privacyFlag,omitempty"`
+	MandatoryRecipients []string `json:"mandatoryRecipients,omitempty"`
+	PrivacyGroupID      string   `json:"privacyGroupId,omitempty"`
+}
+
+type SendSignedTxResponse struct {
+	Key string `json:"key"`
+}
+
+// PrivacyGroup is a group of Tessera keys sharing private transactions
+type PrivacyGroup struct {
+	PrivacyGroupID string   `json:"privacyGroupId"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Type           string   `json:"type"`
+	Members        []string `json:"members"`
+}
+
+type CreatePrivacyGroupRequest struct {
+	From        string   `json:"from"`
+	Addresses   []string `json:"addresses"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+type FindPrivacyGroupRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+type RetrievePrivacyGroupRequest struct {
+	PrivacyGroupID string `json:"privacyGroupId"`
+}
+
+type DeletePrivacyGroupRequest struct {
+	From           string `json:"from"`
+	PrivacyGroupID string `json:"privacyGroupId"`
+}
 
-	err := request.WriteJSON(req, &StoreRawRequest{
+type PartyInfo struct {
+	URL   string      `json:"url"`
+	Peers []*PartyURL `json:"peers"`
+	Keys  []*PartyKey `json:"keys"`
+}
+
+type PartyURL struct {
+	URL         string `json:"url"`
+	LastContact string `json:"lastContact,omitempty"`
+}
+
+type PartyKey struct {
+	Key string `json:"key"`
+	URL string `json:"url,omitempty"`
+}
+
+func (c *HTTPClient) StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error) {
+	msg := new(StoreRawResponse)
+	err := c.postJSON(ctx, "/storeraw", &StoreRawRequest{
 		Payload:     base64.StdEncoding.EncodeToString(payload),
 		PrivateFrom: privateFrom,
-	})
+	}, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.client.Do(req)
+	return base64.StdEncoding.DecodeString(msg.Key)
+}
+
+func (c *HTTPClient) Receive(ctx context.Context, key []byte, to string) (*ReceiveResponse, error) {
+	path := "/transaction/" + url.PathEscape(base64.StdEncoding.EncodeToString(key))
+	if to != "" {
+		path += "?to=" + url.QueryEscape(to)
+	}
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+
+	msg := new(ReceiveResponse)
+	err := c.do(req, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	msg := new(StoreRawResponse)
-	err = response.ReadJSON(resp, msg)
+	return msg, nil
+}
+
+func (c *HTTPClient) SendSignedTx(ctx context.Context, key []byte, to []string, opts *SendSignedTxOptions) ([]byte, error) {
+	sendReq := &SendSignedTxRequest{
+		Hash: base64.StdEncoding.EncodeToString(key),
+		To:   to,
+	}
+	if opts != nil {
+		sendReq.PrivacyFlag = opts.PrivacyFlag
+		sendReq.MandatoryRecipients = opts.MandatoryRecipients
+		sendReq.PrivacyGroupID = opts.PrivacyGroupID
+	}
+
+	msg := new(SendSignedTxResponse)
+	err := c.postJSON(ctx, "/sendsignedtx", sendReq, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := base64.StdEncoding.DecodeString(msg.Key)
+	return base64.StdEncoding.DecodeString(msg.Key)
+}
+
+func (c *HTTPClient) CreatePrivacyGroup(ctx context.Context, from string, addresses []string, name, description string) (*PrivacyGroup, error) {
+	group := new(PrivacyGroup)
+	err := c.postJSON(ctx, "/createPrivacyGroup", &CreatePrivacyGroupRequest{
+		From:        from,
+		Addresses:   addresses,
+		Name:        name,
+		Description: description,
+	}, group)
 	if err != nil {
 		return nil, err
 	}
 
-	return b, nil
+	return group, nil
+}
+
+func (c *HTTPClient) FindPrivacyGroup(ctx context.Context, addresses []string) ([]*PrivacyGroup, error) {
+	var groups []*PrivacyGroup
+	err := c.postJSON(ctx, "/findPrivacyGroup", &FindPrivacyGroupRequest{Addresses: addresses}, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (c *HTTPClient) RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*PrivacyGroup, error) {
+	group := new(PrivacyGroup)
+	err := c.postJSON(ctx, "/retrievePrivacyGroup", &RetrievePrivacyGroupRequest{PrivacyGroupID: privacyGroupID}, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (c *HTTPClient) DeletePrivacyGroup(ctx context.Context, from, privacyGroupID string) error {
+	// Tessera returns the ID of the deleted group
+	var deletedID string
+	return c.postJSON(ctx, "/deletePrivacyGroup", &DeletePrivacyGroupRequest{From: from, PrivacyGroupID: privacyGroupID}, &deletedID)
+}
+
+func (c *HTTPClient) PartyInfo(ctx context.Context) (*PartyInfo, error) {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/partyinfo", nil)
+
+	msg := new(PartyInfo)
+	err := c.do(req, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (c *HTTPClient) postJSON(ctx context.Context, path string, body, msg interface{}) error {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
+
+	err := request.WriteJSON(req, body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, msg)
+}
+
+func (c *HTTPClient) do(req *http.Request, msg interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("tessera responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	return response.ReadJSON(resp, msg)
 }
 
 var ErrNotConfigured = fmt.Errorf("tessera not configured")
@@ -77,3 +272,31 @@ type NotConfiguredClient struct{}
 func (c *NotConfiguredClient) StoreRaw(context.Context, []byte, string) ([]byte, error) {
 	return nil, ErrNotConfigured
 }
+
+func (c *NotConfiguredClient) Receive(context.Context, []byte, string) (*ReceiveResponse, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) SendSignedTx(context.Context, []byte, []string, *SendSignedTxOptions) ([]byte, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) CreatePrivacyGroup(context.Context, string, []string, string, string) (*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) FindPrivacyGroup(context.Context, []string) ([]*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) RetrievePrivacyGroup(context.Context, string) (*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) DeletePrivacyGroup(context.Context, string, string) error {
+	return ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) PartyInfo(context.Context) (*PartyInfo, error) {
+	return nil, ErrNotConfigured
+}
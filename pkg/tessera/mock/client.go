@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	tessera "github.com/longfan78/quorum-key-manager/pkg/tessera"
 )
 
 // MockClient is a mock of Client interface.
@@ -48,3 +49,107 @@ func (mr *MockClientMockRecorder) StoreRaw(ctx, payload, privateFrom interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRaw", reflect.TypeOf((*MockClient)(nil).StoreRaw), ctx, payload, privateFrom)
 }
+
+// Receive mock base method.
+func (m *MockClient) Receive(ctx context.Context, key []byte, to string) (*tessera.ReceiveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, key, to)
+	ret0, _ := ret[0].(*tessera.ReceiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockClientMockRecorder) Receive(ctx, key, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockClient)(nil).Receive), ctx, key, to)
+}
+
+// SendSignedTx mock base method.
+func (m *MockClient) SendSignedTx(ctx context.Context, key []byte, to []string, opts *tessera.SendSignedTxOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSignedTx", ctx, key, to, opts)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendSignedTx indicates an expected call of SendSignedTx.
+func (mr *MockClientMockRecorder) SendSignedTx(ctx, key, to, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSignedTx", reflect.TypeOf((*MockClient)(nil).SendSignedTx), ctx, key, to, opts)
+}
+
+// CreatePrivacyGroup mock base method.
+func (m *MockClient) CreatePrivacyGroup(ctx context.Context, from string, addresses []string, name, description string) (*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivacyGroup", ctx, from, addresses, name, description)
+	ret0, _ := ret[0].(*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivacyGroup indicates an expected call of CreatePrivacyGroup.
+func (mr *MockClientMockRecorder) CreatePrivacyGroup(ctx, from, addresses, name, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivacyGroup", reflect.TypeOf((*MockClient)(nil).CreatePrivacyGroup), ctx, from, addresses, name, description)
+}
+
+// FindPrivacyGroup mock base method.
+func (m *MockClient) FindPrivacyGroup(ctx context.Context, addresses []string) ([]*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrivacyGroup", ctx, addresses)
+	ret0, _ := ret[0].([]*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrivacyGroup indicates an expected call of FindPrivacyGroup.
+func (mr *MockClientMockRecorder) FindPrivacyGroup(ctx, addresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivacyGroup", reflect.TypeOf((*MockClient)(nil).FindPrivacyGroup), ctx, addresses)
+}
+
+// RetrievePrivacyGroup mock base method.
+func (m *MockClient) RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePrivacyGroup", ctx, privacyGroupID)
+	ret0, _ := ret[0].(*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePrivacyGroup indicates an expected call of RetrievePrivacyGroup.
+func (mr *MockClientMockRecorder) RetrievePrivacyGroup(ctx, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePrivacyGroup", reflect.TypeOf((*MockClient)(nil).RetrievePrivacyGroup), ctx, privacyGroupID)
+}
+
+// DeletePrivacyGroup mock base method.
+func (m *MockClient) DeletePrivacyGroup(ctx context.Context, from, privacyGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivacyGroup", ctx, from, privacyGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivacyGroup indicates an expected call of DeletePrivacyGroup.
+func (mr *MockClientMockRecorder) DeletePrivacyGroup(ctx, from, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivacyGroup", reflect.TypeOf((*MockClient)(nil).DeletePrivacyGroup), ctx, from, privacyGroupID)
+}
+
+// PartyInfo mock base method.
+func (m *MockClient) PartyInfo(ctx context.Context) (*tessera.PartyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartyInfo", ctx)
+	ret0, _ := ret[0].(*tessera.PartyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartyInfo indicates an expected call of PartyInfo.
+func (mr *MockClientMockRecorder) PartyInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartyInfo", reflect.TypeOf((*MockClient)(nil).PartyInfo), ctx)
+}
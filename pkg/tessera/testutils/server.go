@@ -0,0 +1,358 @@
+package testutils
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
+	"github.com/longfan78/quorum-key-manager/pkg/http/request"
+	"github.com/longfan78/quorum-key-manager/pkg/tessera"
+)
+
+// mandatoryRecipientsPrivacyFlag is the privacy flag of transactions enforcing mandatory recipients
+const mandatoryRecipientsPrivacyFlag = 2
+
+// Server is an in-process Tessera serving the private transaction and privacy group APIs from memory, so that private
+// transactions can be exercised without a running Tessera
+type Server struct {
+	*httptest.Server
+
+	mux    sync.Mutex
+	keys   []string
+	nonce  uint64
+	txs    map[string]*storedTx
+	groups map[string]*tessera.PrivacyGroup
+}
+
+type storedTx struct {
+	payload             []byte
+	sender              string
+	recipients          []string
+	privacyFlag         int
+	mandatoryRecipients []string
+}
+
+// NewServer starts a fake Tessera owning the given public keys, which are the only ones transactions and privacy
+// groups can be created from
+func NewServer(keys ...string) *Server {
+	s := &Server{
+		keys:   keys,
+		txs:    make(map[string]*storedTx),
+		groups: make(map[string]*tessera.PrivacyGroup),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a Tessera client connected to the server
+func (s *Server) Client() *tessera.HTTPClient {
+	preparer, _ := request.Proxy(&request.ProxyConfig{Addr: s.URL})
+	return tessera.NewHTTPClient(httpclient.WithPreparer(preparer)(s.Server.Client()))
+}
+
+// Recipients returns the recipients a payload was sent to, or nil if the payload is unknown or was not sent
+func (s *Server) Recipients(key []byte) []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	tx, ok := s.txs[base64.StdEncoding.EncodeToString(key)]
+	if !ok {
+		return nil
+	}
+
+	return tx.recipients
+}
+
+// serveHTTP routes requests without cleaning their path, as payload keys are base64 encoded and may hold slashes
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	switch path := req.URL.EscapedPath(); {
+	case path == "/storeraw":
+		s.storeRaw(rw, req)
+	case strings.HasPrefix(path, "/transaction/"):
+		s.receive(rw, req)
+	case path == "/sendsignedtx":
+		s.sendSignedTx(rw, req)
+	case path == "/createPrivacyGroup":
+		s.createPrivacyGroup(rw, req)
+	case path == "/findPrivacyGroup":
+		s.findPrivacyGroup(rw, req)
+	case path == "/retrievePrivacyGroup":
+		s.retrievePrivacyGroup(rw, req)
+	case path == "/deletePrivacyGroup":
+		s.deletePrivacyGroup(rw, req)
+	case path == "/partyinfo":
+		s.partyInfo(rw, req)
+	default:
+		writeError(rw, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) storeRaw(rw http.ResponseWriter, req *http.Request) {
+	storeReq := new(tessera.StoreRawRequest)
+	if !decode(rw, req, http.MethodPost, storeReq) {
+		return
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(storeReq.Payload)
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, "invalid payload")
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if !s.isLocalKey(storeReq.PrivateFrom) {
+		writeError(rw, http.StatusNotFound, fmt.Sprintf("key %s not found", storeReq.PrivateFrom))
+		return
+	}
+
+	s.nonce++
+	hash := sha512.Sum512(append(payload, []byte(fmt.Sprint(s.nonce))...))
+	key := base64.StdEncoding.EncodeToString(hash[:])
+	s.txs[key] = &storedTx{payload: payload, sender: storeReq.PrivateFrom}
+
+	writeJSON(rw, &tessera.StoreRawResponse{Key: key})
+}
+
+func (s *Server) receive(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		writeError(rw, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	key, err := url.PathUnescape(strings.TrimPrefix(req.URL.EscapedPath(), "/transaction/"))
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, "invalid key")
+		return
+	}
+	to := req.URL.Query().Get("to")
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	tx, ok := s.txs[key]
+	if !ok || (to != "" && to != tx.sender && !contains(tx.recipients, to)) {
+		writeError(rw, http.StatusNotFound, "message not found")
+		return
+	}
+
+	writeJSON(rw, &tessera.ReceiveResponse{
+		Payload:     tx.payload,
+		PrivacyFlag: tx.privacyFlag,
+		Sender:      tx.sender,
+	})
+}
+
+func (s *Server) sendSignedTx(rw http.ResponseWriter, req *http.Request) {
+	sendReq := new(tessera.SendSignedTxRequest)
+	if !decode(rw, req, http.MethodPost, sendReq) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	tx, ok := s.txs[sendReq.Hash]
+	if !ok {
+		writeError(rw, http.StatusNotFound, "transaction not found")
+		return
+	}
+
+	recipients := sendReq.To
+	if sendReq.PrivacyGroupID != "" {
+		group, found := s.groups[sendReq.PrivacyGroupID]
+		if !found {
+			writeError(rw, http.StatusNotFound, "privacy group not found")
+			return
+		}
+		recipients = group.Members
+	}
+
+	if (sendReq.PrivacyFlag == mandatoryRecipientsPrivacyFlag) != (len(sendReq.MandatoryRecipients) > 0) {
+		writeError(rw, http.StatusBadRequest, "mandatory recipients must be set with, and only with, the mandatory recipients privacy flag")
+		return
+	}
+
+	for _, mandatory := range sendReq.MandatoryRecipients {
+		if !contains(recipients, mandatory) {
+			writeError(rw, http.StatusBadRequest, fmt.Sprintf("mandatory recipient %s is not a recipient", mandatory))
+			return
+		}
+	}
+
+	tx.recipients = recipients
+	tx.privacyFlag = sendReq.PrivacyFlag
+	tx.mandatoryRecipients = sendReq.MandatoryRecipients
+
+	writeJSON(rw, &tessera.SendSignedTxResponse{Key: sendReq.Hash})
+}
+
+func (s *Server) createPrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	createReq := new(tessera.CreatePrivacyGroupRequest)
+	if !decode(rw, req, http.MethodPost, createReq) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if !s.isLocalKey(createReq.From) {
+		writeError(rw, http.StatusNotFound, fmt.Sprintf("key %s not found", createReq.From))
+		return
+	}
+
+	members := createReq.Addresses
+	if !contains(members, createReq.From) {
+		members = append(members, createReq.From)
+	}
+
+	s.nonce++
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v%s%d", sortedCopy(members), createReq.Name, s.nonce)))
+	group := &tessera.PrivacyGroup{
+		PrivacyGroupID: base64.StdEncoding.EncodeToString(hash[:]),
+		Name:           createReq.Name,
+		Description:    createReq.Description,
+		Type:           "PANTHEON",
+		Members:        members,
+	}
+	s.groups[group.PrivacyGroupID] = group
+
+	writeJSON(rw, group)
+}
+
+func (s *Server) findPrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	findReq := new(tessera.FindPrivacyGroupRequest)
+	if !decode(rw, req, http.MethodPost, findReq) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	addresses := fmt.Sprint(sortedCopy(findReq.Addresses))
+	groups := []*tessera.PrivacyGroup{}
+	for _, group := range s.groups {
+		if fmt.Sprint(sortedCopy(group.Members)) == addresses {
+			groups = append(groups, group)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].PrivacyGroupID < groups[j].PrivacyGroupID
+	})
+
+	writeJSON(rw, groups)
+}
+
+func (s *Server) retrievePrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	retrieveReq := new(tessera.RetrievePrivacyGroupRequest)
+	if !decode(rw, req, http.MethodPost, retrieveReq) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	group, ok := s.groups[retrieveReq.PrivacyGroupID]
+	if !ok {
+		writeError(rw, http.StatusNotFound, "privacy group not found")
+		return
+	}
+
+	writeJSON(rw, group)
+}
+
+func (s *Server) deletePrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	deleteReq := new(tessera.DeletePrivacyGroupRequest)
+	if !decode(rw, req, http.MethodPost, deleteReq) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	group, ok := s.groups[deleteReq.PrivacyGroupID]
+	if !ok {
+		writeError(rw, http.StatusNotFound, "privacy group not found")
+		return
+	}
+
+	if !contains(group.Members, deleteReq.From) {
+		writeError(rw, http.StatusForbidden, "sender is not a member of the privacy group")
+		return
+	}
+
+	delete(s.groups, deleteReq.PrivacyGroupID)
+	writeJSON(rw, deleteReq.PrivacyGroupID)
+}
+
+func (s *Server) partyInfo(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		writeError(rw, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	info := &tessera.PartyInfo{URL: s.URL, Peers: []*tessera.PartyURL{{URL: s.URL}}}
+	for _, key := range s.keys {
+		info.Keys = append(info.Keys, &tessera.PartyKey{Key: key, URL: s.URL})
+	}
+
+	writeJSON(rw, info)
+}
+
+func (s *Server) isLocalKey(key string) bool {
+	return contains(s.keys, key)
+}
+
+func decode(rw http.ResponseWriter, req *http.Request, method string, msg interface{}) bool {
+	if req.Method != method {
+		writeError(rw, http.StatusMethodNotAllowed, "method not allowed")
+		return false
+	}
+
+	err := json.NewDecoder(req.Body).Decode(msg)
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(rw http.ResponseWriter, msg interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(msg)
+}
+
+func writeError(rw http.ResponseWriter, status int, message string) {
+	rw.Header().Set("Content-Type", "text/plain")
+	rw.WriteHeader(status)
+	_, _ = rw.Write([]byte(message))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,87 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/longfan78/quorum-key-manager/pkg/tessera"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	nodeKey := "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+	recipients := []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}
+
+	server := NewServer(nodeKey)
+	defer server.Close()
+	client := server.Client()
+
+	t.Run("should store, send and receive a private payload", func(t *testing.T) {
+		key, err := client.StoreRaw(ctx, []byte("payload"), nodeKey)
+		require.NoError(t, err)
+		assert.Len(t, key, 64)
+
+		sentKey, err := client.SendSignedTx(ctx, key, recipients, &tessera.SendSignedTxOptions{
+			PrivacyFlag:         2,
+			MandatoryRecipients: recipients[:1],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, key, sentKey)
+		assert.Equal(t, recipients, server.Recipients(key))
+
+		received, err := client.Receive(ctx, key, recipients[1])
+		require.NoError(t, err)
+		assert.Equal(t, []byte("payload"), received.Payload)
+		assert.Equal(t, 2, received.PrivacyFlag)
+		assert.Equal(t, nodeKey, received.Sender)
+
+		_, err = client.Receive(ctx, key, "unknown")
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to store a payload from a key of another node", func(t *testing.T) {
+		_, err := client.StoreRaw(ctx, []byte("payload"), recipients[0])
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to send a payload to mandatory recipients that are not recipients", func(t *testing.T) {
+		key, err := client.StoreRaw(ctx, []byte("payload"), nodeKey)
+		require.NoError(t, err)
+
+		_, err = client.SendSignedTx(ctx, key, recipients[:1], &tessera.SendSignedTxOptions{
+			PrivacyFlag:         2,
+			MandatoryRecipients: recipients[1:],
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should create, find, retrieve and delete a privacy group", func(t *testing.T) {
+		group, err := client.CreatePrivacyGroup(ctx, nodeKey, recipients, "group", "description")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, append([]string{nodeKey}, recipients...), group.Members)
+		assert.Equal(t, "group", group.Name)
+
+		groups, err := client.FindPrivacyGroup(ctx, []string{recipients[1], nodeKey, recipients[0]})
+		require.NoError(t, err)
+		assert.Equal(t, []*tessera.PrivacyGroup{group}, groups)
+
+		retrieved, err := client.RetrievePrivacyGroup(ctx, group.PrivacyGroupID)
+		require.NoError(t, err)
+		assert.Equal(t, group, retrieved)
+
+		err = client.DeletePrivacyGroup(ctx, nodeKey, group.PrivacyGroupID)
+		require.NoError(t, err)
+
+		_, err = client.RetrievePrivacyGroup(ctx, group.PrivacyGroupID)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return the keys of the node", func(t *testing.T) {
+		info, err := client.PartyInfo(ctx)
+		require.NoError(t, err)
+		require.Len(t, info.Keys, 1)
+		assert.Equal(t, nodeKey, info.Keys[0].Key)
+	})
+}
@@ -6,17 +6,34 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/pkg/app"
 	aliasapp "github.com/longfan78/quorum-key-manager/src/aliases/app"
+	approvalsapp "github.com/longfan78/quorum-key-manager/src/approvals/app"
+	auditapp "github.com/longfan78/quorum-key-manager/src/audit/app"
+	rolesapi "github.com/longfan78/quorum-key-manager/src/auth/api/manifest"
 	authapp "github.com/longfan78/quorum-key-manager/src/auth/app"
 	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authenticator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
 	"github.com/longfan78/quorum-key-manager/src/infra/api-key/csv"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	clusterpg "github.com/longfan78/quorum-key-manager/src/infra/cluster/postgres"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt/jose"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/manifests"
+	manifestreader "github.com/longfan78/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
 	tls "github.com/longfan78/quorum-key-manager/src/infra/tls/filesystem"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/longfan78/quorum-key-manager/src/infra/watcher"
+	nodesapi "github.com/longfan78/quorum-key-manager/src/nodes/api/manifest"
 	nodesapp "github.com/longfan78/quorum-key-manager/src/nodes/app"
+	policiesapi "github.com/longfan78/quorum-key-manager/src/policies/api/manifest"
+	policiesapp "github.com/longfan78/quorum-key-manager/src/policies/app"
+	storesapi "github.com/longfan78/quorum-key-manager/src/stores/api/manifest"
 	storesapp "github.com/longfan78/quorum-key-manager/src/stores/app"
 	utilsapp "github.com/longfan78/quorum-key-manager/src/utils/app"
+	vaultsapi "github.com/longfan78/quorum-key-manager/src/vaults/api/manifest"
 	vaultsapp "github.com/longfan78/quorum-key-manager/src/vaults/app"
 )
 
@@ -55,18 +72,88 @@ func New(ctx context.Context, cfg *Config, logger log.Logger) (*app.App, error)
 	a := app.New(&app.Config{HTTP: cfg.HTTP}, logger.WithComponent("app"))
 	router := a.Router()
 
-	authService, err := authapp.RegisterService(a, logger.WithComponent("auth"), jwtValidator, apikeyClaims, rootCAs)
+	if cfg.Tracing != nil {
+		tracingProvider, err := tracing.New(ctx, cfg.Tracing, logger.WithComponent("tracing"))
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.RegisterService(tracingProvider)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Replicas are coordinated through Postgres, otherwise this replica is considered alone
+	var notifier cluster.Notifier = cluster.Standalone{}
+	var elector cluster.Elector = cluster.Standalone{}
+	if cfg.Cluster != nil {
+		clusterService, err := clusterpg.New(cfg.Postgres, cfg.Cluster, logger.WithComponent("cluster"))
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.RegisterService(clusterService)
+		if err != nil {
+			return nil, err
+		}
+
+		notifier, elector = clusterService, clusterService
+	}
+
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimit != nil {
+		limiter, err = ratelimit.New(cfg.RateLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authService, authenticatorService, err := authapp.RegisterService(a, logger.WithComponent("auth"), pgClient, jwtValidator, apikeyClaims, rootCAs, cfg.APIKeys, limiter)
 	if err != nil {
 		return nil, err
 	}
 
 	aliasService := aliasapp.RegisterService(router, logger.WithComponent("aliases"), pgClient, authService)
-	vaultsService := vaultsapp.RegisterService(logger.WithComponent("vaults"), authService)
-	storesService := storesapp.RegisterService(router, logger.WithComponent("stores"), pgClient, authService, vaultsService)
-	nodesService := nodesapp.RegisterService(router, logger.WithComponent("nodes"), authService, storesService, aliasService)
+	auditService := auditapp.RegisterService(router, logger.WithComponent("audit"), pgClient, authService)
+	vaultsService := vaultsapp.RegisterService(router, logger.WithComponent("vaults"), pgClient, authService, cfg.Vaults, notifier)
+	policiesService := policiesapp.RegisterService(router, logger.WithComponent("policies"), pgClient, authService)
+	approvalsService := approvalsapp.RegisterService(router, logger.WithComponent("approvals"), pgClient, authService, cfg.Approvals)
+	storesService, err := storesapp.RegisterService(a, logger.WithComponent("stores"), pgClient, authService, vaultsService, auditService, policiesService, approvalsService, cfg.Rotation, cfg.Expiry, cfg.Sync, cfg.Quotas, notifier, elector)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesService, err := nodesapp.RegisterService(a, logger.WithComponent("nodes"), pgClient, authService, storesService, aliasService, cfg.Nonces, cfg.Transactions, notifier, elector, limiter)
+	if err != nil {
+		return nil, err
+	}
+
 	_ = utilsapp.RegisterService(router, logger.WithComponent("utilities"))
 
-	err = initialize(ctx, cfg.Manifest, authService, vaultsService, storesService, nodesService)
+	manifestReader, err := manifestreader.New(cfg.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	mnfs, err := manifestReader.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := newManifestsKinds(
+		rolesapi.NewRolesHandler(authService),
+		policiesapi.NewPoliciesHandler(policiesService),
+		vaultsapi.NewVaultsHandler(vaultsService),
+		storesapi.NewStoresHandler(storesService),
+		nodesapi.NewNodesHandler(nodesService),
+	)
+	err = initialize(ctx, mnfs, kinds, vaultsService, storesService, nodesService)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerReloader(a, cfg, logger.WithComponent("reload"), manifestReader, mnfs, kinds, authenticatorService, apikeyClaims, rootCAs)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +161,63 @@ func New(ctx context.Context, cfg *Config, logger log.Logger) (*app.App, error)
 	return a, nil
 }
 
+func registerReloader(
+	a *app.App,
+	cfg *Config,
+	logger log.Logger,
+	manifestReader manifests.Reader,
+	mnfs map[string][]entities.Manifest,
+	kinds []manifestsKind,
+	authenticatorService *authenticator.Authenticator,
+	apikeyClaims map[string]*authtypes.UserClaims,
+	rootCAs *x509.CertPool,
+) error {
+	r := &reloader{
+		manifestReader: manifestReader,
+		authenticator:  authenticatorService,
+		logger:         logger,
+		kinds:          kinds,
+		manifests:      mnfs,
+		apiKeys:        apikeyClaims,
+		clientCAs:      rootCAs,
+	}
+	paths := []string{cfg.Manifest.Path}
+
+	if cfg.APIKey != nil {
+		apiKeyReader, err := csv.New(cfg.APIKey)
+		if err != nil {
+			return err
+		}
+		r.apiKeyReader = apiKeyReader
+		paths = append(paths, cfg.APIKey.Path)
+	}
+
+	if cfg.TLS != nil {
+		tlsReader, err := tls.New(cfg.TLS)
+		if err != nil {
+			return err
+		}
+		r.tlsReader = tlsReader
+		paths = append(paths, cfg.TLS.Path)
+
+		if cfg.HTTP.TLSConfig != nil {
+			r.serverTLSConfig(cfg.HTTP.TLSConfig)
+		}
+	}
+
+	reloadCfg := cfg.Reload
+	if reloadCfg == nil {
+		reloadCfg = &watcher.Config{}
+	}
+
+	w, err := watcher.New(reloadCfg, paths, r.reload, logger)
+	if err != nil {
+		return err
+	}
+
+	return a.RegisterService(w)
+}
+
 func getAPIKeys(ctx context.Context, cfg *csv.Config, logger log.Logger) (map[string]*authtypes.UserClaims, error) {
 	apiKeyReader, err := csv.New(cfg)
 	if err != nil {
@@ -0,0 +1,177 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/api/types"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+)
+
+type OperationsHandler struct {
+	approvals approvals.Approvals
+}
+
+func NewOperationsHandler(approvalsService approvals.Approvals) *OperationsHandler {
+	return &OperationsHandler{approvals: approvalsService}
+}
+
+func (h *OperationsHandler) Register(router *mux.Router) {
+	approvalsRouter := router.PathPrefix("/approvals").Subrouter()
+
+	approvalsRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	approvalsRouter.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.get)
+	approvalsRouter.Methods(http.MethodPost).Path("/{id}/approve").HandlerFunc(h.approve)
+	approvalsRouter.Methods(http.MethodPost).Path("/{id}/reject").HandlerFunc(h.reject)
+}
+
+// @Summary      List operations submitted for approval
+// @Description  List the operations the user can approve or has requested, most recent first
+// @Tags         Approvals
+// @Produce      json
+// @Param        status     query     string                   false  "filter by status"  Enums(pending, approved, rejected, executed, expired)
+// @Param        storeName  query     string                   false  "filter by store"
+// @Param        limit      query     int                      false  "page size"
+// @Param        page       query     int                      false  "page number"
+// @Success      200        {array}   infrahttp.PageResponse   "List of operations"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /approvals [get]
+func (h *OperationsHandler) list(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	filter, err := getOperationFilter(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	operations, err := h.approvals.List(ctx, filter, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	operationsResponse := make([]*types.OperationResponse, 0, len(operations))
+	for _, operation := range operations {
+		operationsResponse = append(operationsResponse, types.NewOperationResponse(operation))
+	}
+
+	err = infrahttp.WritePagingResponse(rw, request, operationsResponse)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Get an operation submitted for approval
+// @Description  Get an operation the user can approve or has requested
+// @Tags         Approvals
+// @Produce      json
+// @Param        id   path      int                      true  "operation identifier"
+// @Success      200  {object}  types.OperationResponse  "Operation data"
+// @Failure      400  {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Operation not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /approvals/{id} [get]
+func (h *OperationsHandler) get(rw http.ResponseWriter, request *http.Request) {
+	h.handle(rw, request, h.approvals.Get)
+}
+
+// @Summary      Approve an operation
+// @Description  Approve a pending operation. The operation can be executed by its requester once enough approvals are collected
+// @Tags         Approvals
+// @Produce      json
+// @Param        id   path      int                      true  "operation identifier"
+// @Success      200  {object}  types.OperationResponse  "Operation data"
+// @Failure      400  {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Operation not found"
+// @Failure      409  {object}  infrahttp.ErrorResponse  "Operation is not pending or user already decided"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /approvals/{id}/approve [post]
+func (h *OperationsHandler) approve(rw http.ResponseWriter, request *http.Request) {
+	h.handle(rw, request, h.approvals.Approve)
+}
+
+// @Summary      Reject an operation
+// @Description  Reject a pending operation, which can then no longer be executed
+// @Tags         Approvals
+// @Produce      json
+// @Param        id   path      int                      true  "operation identifier"
+// @Success      200  {object}  types.OperationResponse  "Operation data"
+// @Failure      400  {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Operation not found"
+// @Failure      409  {object}  infrahttp.ErrorResponse  "Operation is not pending or user already decided"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /approvals/{id}/reject [post]
+func (h *OperationsHandler) reject(rw http.ResponseWriter, request *http.Request) {
+	h.handle(rw, request, h.approvals.Reject)
+}
+
+func (h *OperationsHandler) handle(rw http.ResponseWriter, request *http.Request, action func(ctx context.Context, id uint64, userInfo *authtypes.UserInfo) (*entities.Operation, error)) {
+	ctx := request.Context()
+
+	id, err := strconv.ParseUint(mux.Vars(request)["id"], 10, 64)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError("invalid operation id"))
+		return
+	}
+
+	operation, err := action(ctx, id, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewOperationResponse(operation))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+func getOperationFilter(request *http.Request) (*entities.OperationFilter, error) {
+	query := request.URL.Query()
+	filter := &entities.OperationFilter{
+		Status:    entities.Status(query.Get("status")),
+		StoreName: query.Get("storeName"),
+	}
+
+	switch filter.Status {
+	case "", entities.PendingStatus, entities.ApprovedStatus, entities.RejectedStatus, entities.ExecutedStatus, entities.ExpiredStatus:
+	default:
+		return nil, errors.InvalidFormatError("invalid status value")
+	}
+
+	limit := query.Get("limit")
+	if limit == "" {
+		limit = infrahttp.DefaultPageSize
+	}
+
+	var err error
+	filter.Limit, err = strconv.ParseUint(limit, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid limit value")
+	}
+
+	if page := query.Get("page"); page != "" {
+		iPage, err := strconv.ParseUint(page, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid page value")
+		}
+
+		filter.Offset = iPage * filter.Limit
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+)
+
+type OperationResponse struct {
+	ID                uint64               `json:"id" example:"42"`
+	Type              string               `json:"type" example:"destroy"`
+	Resource          string               `json:"resource" example:"ethereum"`
+	StoreName         string               `json:"storeName" example:"eth-accounts"`
+	ResourceID        string               `json:"resourceId" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	PayloadHash       string               `json:"payloadHash" example:"7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069"`
+	Value             string               `json:"value,omitempty" example:"1000000000000000000"`
+	Requester         string               `json:"requester,omitempty" example:"alice"`
+	Tenant            string               `json:"tenant,omitempty" example:"tenant1"`
+	RequiredApprovals int                  `json:"requiredApprovals" example:"2"`
+	Approvals         int                  `json:"approvals" example:"1"`
+	Status            string               `json:"status" example:"pending"`
+	Decisions         []*entities.Decision `json:"decisions"`
+	ExpiresAt         time.Time            `json:"expiresAt" example:"2020-07-10T12:35:42.115395Z"`
+	CreatedAt         time.Time            `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt         time.Time            `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewOperationResponse(operation *entities.Operation) *OperationResponse {
+	decisions := operation.Decisions
+	if decisions == nil {
+		decisions = []*entities.Decision{}
+	}
+
+	return &OperationResponse{
+		ID:                operation.ID,
+		Type:              operation.Type,
+		Resource:          operation.Resource,
+		StoreName:         operation.StoreName,
+		ResourceID:        operation.ResourceID,
+		PayloadHash:       operation.PayloadHash,
+		Value:             operation.Value,
+		Requester:         operation.Requester,
+		Tenant:            operation.Tenant,
+		RequiredApprovals: operation.RequiredApprovals,
+		Approvals:         operation.Approvals(),
+		Status:            string(operation.Status),
+		Decisions:         decisions,
+		ExpiresAt:         operation.ExpiresAt,
+		CreatedAt:         operation.CreatedAt,
+		UpdatedAt:         operation.UpdatedAt,
+	}
+}
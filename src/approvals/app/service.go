@@ -0,0 +1,25 @@
+package app
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/src/approvals/api/http"
+	db "github.com/longfan78/quorum-key-manager/src/approvals/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	"github.com/longfan78/quorum-key-manager/src/approvals/service/approvals"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, cfg *entities.Config) *approvals.Approvals {
+	// Data layer
+	operationsRepository := db.NewOperations(postgresClient, logger)
+
+	// Business layer
+	approvalsService := approvals.New(operationsRepository, roles, cfg, logger)
+
+	// Service layer
+	http.NewOperationsHandler(approvalsService).Register(router)
+
+	return approvalsService
+}
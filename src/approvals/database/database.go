@@ -0,0 +1,22 @@
+package database
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Operations interface {
+	RunInTransaction(ctx context.Context, persistFunc func(dbtx Operations) error) error
+	// Lock gets an operation, preventing concurrent decisions on it until the end of the current transaction
+	Lock(ctx context.Context, id uint64) (*entities.Operation, error)
+	Get(ctx context.Context, id uint64) (*entities.Operation, error)
+	// FindActive gets the most recent pending or approved operation, not yet expired, requested by the same user with the same payload
+	FindActive(ctx context.Context, operation *entities.Operation) (*entities.Operation, error)
+	// Search gets the operations matching the filter, most recent first
+	Search(ctx context.Context, filter *entities.OperationFilter) ([]*entities.Operation, error)
+	Insert(ctx context.Context, operation *entities.Operation) (*entities.Operation, error)
+	Update(ctx context.Context, operation *entities.Operation) (*entities.Operation, error)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	database "github.com/longfan78/quorum-key-manager/src/approvals/database"
+	entities "github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	reflect "reflect"
+)
+
+// MockOperations is a mock of Operations interface
+type MockOperations struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationsMockRecorder
+}
+
+// MockOperationsMockRecorder is the mock recorder for MockOperations
+type MockOperationsMockRecorder struct {
+	mock *MockOperations
+}
+
+// NewMockOperations creates a new mock instance
+func NewMockOperations(ctrl *gomock.Controller) *MockOperations {
+	mock := &MockOperations{ctrl: ctrl}
+	mock.recorder = &MockOperationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOperations) EXPECT() *MockOperationsMockRecorder {
+	return m.recorder
+}
+
+// RunInTransaction mocks base method
+func (m *MockOperations) RunInTransaction(ctx context.Context, persistFunc func(database.Operations) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction
+func (mr *MockOperationsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockOperations)(nil).RunInTransaction), ctx, persistFunc)
+}
+
+// Lock mocks base method
+func (m *MockOperations) Lock(ctx context.Context, id uint64) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, id)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockOperationsMockRecorder) Lock(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockOperations)(nil).Lock), ctx, id)
+}
+
+// Get mocks base method
+func (m *MockOperations) Get(ctx context.Context, id uint64) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockOperationsMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOperations)(nil).Get), ctx, id)
+}
+
+// FindActive mocks base method
+func (m *MockOperations) FindActive(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, operation)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive
+func (mr *MockOperationsMockRecorder) FindActive(ctx, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockOperations)(nil).FindActive), ctx, operation)
+}
+
+// Search mocks base method
+func (m *MockOperations) Search(ctx context.Context, filter *entities.OperationFilter) ([]*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockOperationsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOperations)(nil).Search), ctx, filter)
+}
+
+// Insert mocks base method
+func (m *MockOperations) Insert(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, operation)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockOperationsMockRecorder) Insert(ctx, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockOperations)(nil).Insert), ctx, operation)
+}
+
+// Update mocks base method
+func (m *MockOperations) Update(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, operation)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockOperationsMockRecorder) Update(ctx, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOperations)(nil).Update), ctx, operation)
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+)
+
+type Operation struct {
+	tableName struct{} `pg:"approval_operations"` // nolint:unused,structcheck // reason
+
+	ID                uint64 `pg:",pk"`
+	Type              string
+	Resource          string
+	StoreName         string `pg:",use_zero"`
+	ResourceID        string `pg:",use_zero"`
+	PayloadHash       string `pg:",use_zero"`
+	Value             string
+	Requester         string `pg:",use_zero"`
+	Tenant            string `pg:",use_zero"`
+	RequiredApprovals int
+	Status            string
+	Decisions         []*entities.Decision
+	ExpiresAt         time.Time
+	CreatedAt         time.Time `pg:"default:now()"`
+	UpdatedAt         time.Time `pg:"default:now()"`
+}
+
+func NewOperation(operation *entities.Operation) *Operation {
+	return &Operation{
+		ID:                operation.ID,
+		Type:              operation.Type,
+		Resource:          operation.Resource,
+		StoreName:         operation.StoreName,
+		ResourceID:        operation.ResourceID,
+		PayloadHash:       operation.PayloadHash,
+		Value:             operation.Value,
+		Requester:         operation.Requester,
+		Tenant:            operation.Tenant,
+		RequiredApprovals: operation.RequiredApprovals,
+		Status:            string(operation.Status),
+		Decisions:         operation.Decisions,
+		ExpiresAt:         operation.ExpiresAt,
+		CreatedAt:         operation.CreatedAt,
+		UpdatedAt:         operation.UpdatedAt,
+	}
+}
+
+func (o *Operation) ToEntity() *entities.Operation {
+	return &entities.Operation{
+		ID:                o.ID,
+		Type:              o.Type,
+		Resource:          o.Resource,
+		StoreName:         o.StoreName,
+		ResourceID:        o.ResourceID,
+		PayloadHash:       o.PayloadHash,
+		Value:             o.Value,
+		Requester:         o.Requester,
+		Tenant:            o.Tenant,
+		RequiredApprovals: o.RequiredApprovals,
+		Status:            entities.Status(o.Status),
+		Decisions:         o.Decisions,
+		ExpiresAt:         o.ExpiresAt,
+		CreatedAt:         o.CreatedAt,
+		UpdatedAt:         o.UpdatedAt,
+	}
+}
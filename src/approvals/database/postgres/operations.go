@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database/models"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+type Operations struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Operations = &Operations{}
+
+func NewOperations(client postgres.Client, logger log.Logger) *Operations {
+	return &Operations{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (o Operations) RunInTransaction(ctx context.Context, persist func(dbtx database.Operations) error) error {
+	return o.client.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		o.client = dbTx
+		return persist(&o)
+	})
+}
+
+func (o *Operations) Lock(ctx context.Context, id uint64) (*entities.Operation, error) {
+	var lockedID uint64
+	err := o.client.QueryOne(ctx, &lockedID, "SELECT id FROM approval_operations WHERE id = ? FOR UPDATE", id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.NotFoundError("approval operation not found")
+		}
+
+		errMessage := "failed to lock approval operation"
+		o.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return o.Get(ctx, id)
+}
+
+func (o *Operations) Get(ctx context.Context, id uint64) (*entities.Operation, error) {
+	operation := &models.Operation{ID: id}
+
+	err := o.client.SelectPK(ctx, operation)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.NotFoundError("approval operation not found")
+		}
+
+		errMessage := "failed to get approval operation"
+		o.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return operation.ToEntity(), nil
+}
+
+func (o *Operations) FindActive(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	where := "requester = ? AND tenant = ? AND type = ? AND store_name = ? AND resource_id = ? AND payload_hash = ? AND status = ANY(?) AND expires_at > ?"
+	args := []interface{}{
+		operation.Requester,
+		operation.Tenant,
+		operation.Type,
+		operation.StoreName,
+		operation.ResourceID,
+		operation.PayloadHash,
+		pq.Array([]string{string(entities.PendingStatus), string(entities.ApprovedStatus)}),
+		time.Now(),
+	}
+
+	operations, err := o.find(ctx, where, args, 1, 0)
+	if err != nil {
+		errMessage := "failed to find active approval operation"
+		o.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(operations) == 0 {
+		return nil, errors.NotFoundError("no active approval operation")
+	}
+
+	return operations[0], nil
+}
+
+func (o *Operations) Search(ctx context.Context, filter *entities.OperationFilter) ([]*entities.Operation, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, conditionArgs ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	}
+
+	switch filter.Status {
+	case "":
+	case entities.ExpiredStatus:
+		addCondition("status = ANY(?) AND expires_at <= ?", pq.Array([]string{string(entities.PendingStatus), string(entities.ApprovedStatus)}), time.Now())
+	case entities.PendingStatus, entities.ApprovedStatus:
+		addCondition("status = ? AND expires_at > ?", string(filter.Status), time.Now())
+	default:
+		addCondition("status = ?", string(filter.Status))
+	}
+	if filter.StoreName != "" {
+		addCondition("store_name = ?", filter.StoreName)
+	}
+	if filter.Tenant != "" {
+		addCondition("tenant = ?", filter.Tenant)
+	}
+	addCondition("(resource = ANY(?) OR requester = ?)", pq.Array(filter.Resources), filter.Requester)
+
+	operations, err := o.find(ctx, strings.Join(conditions, " AND "), args, filter.Limit, filter.Offset)
+	if err != nil {
+		errMessage := "failed to search approval operations"
+		o.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return operations, nil
+}
+
+func (o *Operations) Insert(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	operationModel := models.NewOperation(operation)
+
+	err := o.client.Insert(ctx, operationModel)
+	if err != nil {
+		errMessage := "failed to insert approval operation"
+		o.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return operationModel.ToEntity(), nil
+}
+
+func (o *Operations) Update(ctx context.Context, operation *entities.Operation) (*entities.Operation, error) {
+	operationModel := models.NewOperation(operation)
+	operationModel.UpdatedAt = time.Now()
+
+	err := o.client.UpdatePK(ctx, operationModel)
+	if err != nil {
+		errMessage := "failed to update approval operation"
+		o.logger.With("id", operation.ID).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Update does not update the model, we must update and then get
+	return o.Get(ctx, operation.ID)
+}
+
+// find selects the IDs of the matching operations first as the client does not support ordering and limits on models
+func (o *Operations) find(ctx context.Context, where string, args []interface{}, limit, offset uint64) ([]*entities.Operation, error) {
+	var ids []int64
+	query := fmt.Sprintf("SELECT array_agg(id ORDER BY id DESC) FROM approval_operations WHERE %s", where)
+	if limit != 0 || offset != 0 {
+		query = fmt.Sprintf("SELECT (array_agg(id ORDER BY id DESC))[%d:%d] FROM approval_operations WHERE %s", offset+1, offset+limit, where)
+	}
+
+	err := o.client.Query(ctx, &ids, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*entities.Operation{}, nil
+	}
+
+	var operationModels []*models.Operation
+	err = o.client.SelectWhere(ctx, &operationModels, "id = ANY(?)", []string{}, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(operationModels, func(i, j int) bool {
+		return operationModels[i].ID > operationModels[j].ID
+	})
+
+	operations := make([]*entities.Operation, 0, len(operationModels))
+	for _, operationModel := range operationModels {
+		operations = append(operations, operationModel.ToEntity())
+	}
+
+	return operations, nil
+}
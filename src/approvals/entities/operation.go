@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+)
+
+type Status string
+
+const (
+	PendingStatus  Status = "pending"
+	ApprovedStatus Status = "approved"
+	RejectedStatus Status = "rejected"
+	ExecutedStatus Status = "executed"
+	// ExpiredStatus is never persisted, pending and approved operations are expired once past their expiry date
+	ExpiredStatus Status = "expired"
+)
+
+const (
+	DestroyOperation         = "destroy"
+	SignTransactionOperation = "sign-transaction"
+	SignEEAOperation         = "sign-eea"
+	SignPrivateOperation     = "sign-private"
+)
+
+// Operation is a sensitive operation that is only executed once approved by enough users
+type Operation struct {
+	ID                uint64
+	Type              string
+	Resource          string
+	StoreName         string
+	ResourceID        string
+	PayloadHash       string
+	Value             string
+	Requester         string
+	Tenant            string
+	RequiredApprovals int
+	Status            Status
+	Decisions         []*Decision
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type Decision struct {
+	Username  string    `json:"username"`
+	Approved  bool      `json:"approved"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Approvals counts the approving decisions
+func (o *Operation) Approvals() int {
+	count := 0
+	for _, decision := range o.Decisions {
+		if decision.Approved {
+			count++
+		}
+	}
+
+	return count
+}
+
+// IsExpired indicates whether a pending or approved operation can no longer be approved or executed
+func (o *Operation) IsExpired(now time.Time) bool {
+	return (o.Status == PendingStatus || o.Status == ApprovedStatus) && !now.Before(o.ExpiresAt)
+}
+
+// Request describes a sensitive operation requested by a user
+type Request struct {
+	Type       string
+	Resource   string
+	StoreName  string
+	ResourceID string
+	// Payload is hashed so that an approval only applies to the exact operation that was requested
+	Payload interface{}
+	// Value is the amount transferred by a transaction, nil for other operations
+	Value *big.Int
+}
+
+type OperationFilter struct {
+	Status    Status
+	StoreName string
+	Tenant    string
+	// Resources and Requester restrict the operations to the ones the user can approve or has requested
+	Resources []string
+	Requester string
+	Limit     uint64
+	Offset    uint64
+}
+
+type Config struct {
+	// RequiredApprovals is the number of approvals needed to execute an operation, approvals are disabled when zero
+	RequiredApprovals int
+	TTL               time.Duration
+	// ValueThreshold is the transaction value from which signatures require approvals, none do when nil
+	ValueThreshold *big.Int
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	reflect "reflect"
+)
+
+// MockApprovals is a mock of Approvals interface
+type MockApprovals struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalsMockRecorder
+}
+
+// MockApprovalsMockRecorder is the mock recorder for MockApprovals
+type MockApprovalsMockRecorder struct {
+	mock *MockApprovals
+}
+
+// NewMockApprovals creates a new mock instance
+func NewMockApprovals(ctrl *gomock.Controller) *MockApprovals {
+	mock := &MockApprovals{ctrl: ctrl}
+	mock.recorder = &MockApprovalsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApprovals) EXPECT() *MockApprovalsMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method
+func (m *MockApprovals) Authorize(ctx context.Context, req *entities.Request, userInfo *auth.UserInfo) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, req, userInfo)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize
+func (mr *MockApprovalsMockRecorder) Authorize(ctx, req, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockApprovals)(nil).Authorize), ctx, req, userInfo)
+}
+
+// Complete mocks base method
+func (m *MockApprovals) Complete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete
+func (mr *MockApprovalsMockRecorder) Complete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockApprovals)(nil).Complete), ctx, id)
+}
+
+// Get mocks base method
+func (m *MockApprovals) Get(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockApprovalsMockRecorder) Get(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockApprovals)(nil).Get), ctx, id, userInfo)
+}
+
+// List mocks base method
+func (m *MockApprovals) List(ctx context.Context, filter *entities.OperationFilter, userInfo *auth.UserInfo) ([]*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, userInfo)
+	ret0, _ := ret[0].([]*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockApprovalsMockRecorder) List(ctx, filter, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockApprovals)(nil).List), ctx, filter, userInfo)
+}
+
+// Approve mocks base method
+func (m *MockApprovals) Approve(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve
+func (mr *MockApprovalsMockRecorder) Approve(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockApprovals)(nil).Approve), ctx, id, userInfo)
+}
+
+// Reject mocks base method
+func (m *MockApprovals) Reject(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject
+func (mr *MockApprovalsMockRecorder) Reject(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockApprovals)(nil).Reject), ctx, id, userInfo)
+}
@@ -0,0 +1,31 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+
+type Approvals interface {
+	// Authorize returns the approved operation matching a request. If none exists, the request is submitted for approval
+	// and a pending approval error is returned. No operation is returned when the request does not require approvals
+	Authorize(ctx context.Context, req *entities.Request, userInfo *auth.UserInfo) (*entities.Operation, error)
+
+	// Complete marks an approved operation as executed
+	Complete(ctx context.Context, id uint64) error
+
+	// Get gets an operation by ID
+	Get(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error)
+
+	// List lists the operations the user can approve or has requested, most recent first
+	List(ctx context.Context, filter *entities.OperationFilter, userInfo *auth.UserInfo) ([]*entities.Operation, error)
+
+	// Approve approves a pending operation
+	Approve(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error)
+
+	// Reject rejects a pending operation
+	Reject(ctx context.Context, id uint64, userInfo *auth.UserInfo) (*entities.Operation, error)
+}
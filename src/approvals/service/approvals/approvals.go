@@ -0,0 +1,68 @@
+package approvals
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+// approvableResources are the resources on which operations can require approvals
+var approvableResources = []authtypes.OpResource{authtypes.ResourceSecret, authtypes.ResourceKey, authtypes.ResourceEthAccount}
+
+type Approvals struct {
+	db     database.Operations
+	roles  auth.Roles
+	cfg    *entities.Config
+	logger log.Logger
+}
+
+var _ approvals.Approvals = &Approvals{}
+
+func New(db database.Operations, roles auth.Roles, cfg *entities.Config, logger log.Logger) *Approvals {
+	return &Approvals{
+		db:     db,
+		roles:  roles,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// approverResources returns the resources on which the user can approve operations
+func (s *Approvals) approverResources(resolver *authorizator.Authorizator) []string {
+	resources := []string{}
+	for _, resource := range approvableResources {
+		if resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionApprove, Resource: resource}) == nil {
+			resources = append(resources, string(resource))
+		}
+	}
+
+	return resources
+}
+
+// withStatus reports pending and approved operations past their expiry date as expired
+func withStatus(operation *entities.Operation) *entities.Operation {
+	if operation.IsExpired(time.Now()) {
+		operation.Status = entities.ExpiredStatus
+	}
+
+	return operation
+}
+
+func hashPayload(payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,89 @@
+package approvals
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Approvals) Authorize(ctx context.Context, req *entities.Request, userInfo *authtypes.UserInfo) (*entities.Operation, error) {
+	if !s.requiresApproval(req) {
+		return nil, nil
+	}
+
+	logger := s.logger.With("type", req.Type, "store_name", req.StoreName, "resource_id", req.ResourceID)
+
+	// Users cannot submit operations they would not be allowed to execute
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: requestedAction(req), Resource: authtypes.OpResource(req.Resource)})
+	if err != nil {
+		return nil, err
+	}
+
+	payloadHash, err := hashPayload(req.Payload)
+	if err != nil {
+		errMessage := "failed to hash operation payload"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidFormatError(errMessage)
+	}
+
+	operation := &entities.Operation{
+		Type:        req.Type,
+		Resource:    req.Resource,
+		StoreName:   req.StoreName,
+		ResourceID:  req.ResourceID,
+		PayloadHash: payloadHash,
+		Requester:   userInfo.Username,
+		Tenant:      userInfo.Tenant,
+	}
+	if req.Value != nil {
+		operation.Value = req.Value.String()
+	}
+
+	active, err := s.db.FindActive(ctx, operation)
+	switch {
+	case err == nil && active.Status == entities.ApprovedStatus:
+		logger.Info("operation approved, executing", "id", active.ID)
+		return active, nil
+	case err == nil:
+		return nil, errors.PendingApprovalError("operation %d is pending approval (%d/%d)", active.ID, active.Approvals(), active.RequiredApprovals)
+	case !errors.IsNotFoundError(err):
+		return nil, err
+	}
+
+	operation.Status = entities.PendingStatus
+	operation.RequiredApprovals = s.cfg.RequiredApprovals
+	operation.ExpiresAt = time.Now().UTC().Add(s.cfg.TTL)
+
+	operation, err = s.db.Insert(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("operation submitted for approval", "id", operation.ID)
+	return nil, errors.PendingApprovalError("operation %d submitted for approval, %d approvals required", operation.ID, operation.RequiredApprovals)
+}
+
+func (s *Approvals) requiresApproval(req *entities.Request) bool {
+	if s.cfg.RequiredApprovals <= 0 {
+		return false
+	}
+
+	if req.Type == entities.DestroyOperation {
+		return true
+	}
+
+	return s.cfg.ValueThreshold != nil && req.Value != nil && req.Value.Cmp(s.cfg.ValueThreshold) >= 0
+}
+
+func requestedAction(req *entities.Request) authtypes.OpAction {
+	if req.Type == entities.DestroyOperation {
+		return authtypes.ActionDestroy
+	}
+
+	return authtypes.ActionSign
+}
@@ -0,0 +1,93 @@
+package approvals
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	dbmock "github.com/longfan78/quorum-key-manager/src/approvals/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	db := dbmock.NewMockOperations(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	cfg := &entities.Config{RequiredApprovals: 2, TTL: time.Hour, ValueThreshold: big.NewInt(1000)}
+	service := New(db, roles, cfg, logger)
+
+	ctx := context.Background()
+	userInfo := &authentities.UserInfo{Username: "alice", Tenant: "tenant1"}
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]authentities.Permission{authentities.DestroyKey, authentities.SignEth}).AnyTimes()
+
+	destroyReq := &entities.Request{Type: entities.DestroyOperation, Resource: string(authentities.ResourceKey), StoreName: "my-store", ResourceID: "my-key"}
+
+	t.Run("should submit a new operation for approval", func(t *testing.T) {
+		db.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error"))
+		db.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, operation *entities.Operation) (*entities.Operation, error) {
+			assert.Equal(t, entities.PendingStatus, operation.Status)
+			assert.Equal(t, "alice", operation.Requester)
+			assert.Equal(t, "tenant1", operation.Tenant)
+			assert.Equal(t, 2, operation.RequiredApprovals)
+			assert.NotEmpty(t, operation.PayloadHash)
+			assert.True(t, operation.ExpiresAt.After(time.Now()))
+			operation.ID = 1
+			return operation, nil
+		})
+
+		operation, err := service.Authorize(ctx, destroyReq, userInfo)
+		assert.Nil(t, operation)
+		assert.True(t, errors.IsPendingApprovalError(err))
+	})
+
+	t.Run("should not submit again an operation pending approval", func(t *testing.T) {
+		db.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(&entities.Operation{ID: 1, Status: entities.PendingStatus}, nil)
+
+		_, err := service.Authorize(ctx, destroyReq, userInfo)
+		assert.True(t, errors.IsPendingApprovalError(err))
+	})
+
+	t.Run("should return the approved operation", func(t *testing.T) {
+		approved := &entities.Operation{ID: 1, Status: entities.ApprovedStatus}
+		db.EXPECT().FindActive(gomock.Any(), gomock.Any()).Return(approved, nil)
+
+		operation, err := service.Authorize(ctx, destroyReq, userInfo)
+		require.NoError(t, err)
+		assert.Equal(t, approved, operation)
+	})
+
+	t.Run("should not require approvals for signatures below the threshold", func(t *testing.T) {
+		operation, err := service.Authorize(ctx, &entities.Request{
+			Type:     entities.SignTransactionOperation,
+			Resource: string(authentities.ResourceEthAccount),
+			Value:    big.NewInt(999),
+		}, userInfo)
+		require.NoError(t, err)
+		assert.Nil(t, operation)
+	})
+
+	t.Run("should not require approvals for signatures without value", func(t *testing.T) {
+		operation, err := service.Authorize(ctx, &entities.Request{
+			Type:     entities.SignTransactionOperation,
+			Resource: string(authentities.ResourceEthAccount),
+		}, userInfo)
+		require.NoError(t, err)
+		assert.Nil(t, operation)
+	})
+
+	t.Run("should fail to submit operations the user cannot execute", func(t *testing.T) {
+		_, err := service.Authorize(ctx, &entities.Request{Type: entities.DestroyOperation, Resource: string(authentities.ResourceSecret)}, userInfo)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
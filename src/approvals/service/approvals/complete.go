@@ -0,0 +1,35 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+)
+
+func (s *Approvals) Complete(ctx context.Context, id uint64) error {
+	logger := s.logger.With("id", id)
+
+	err := s.db.RunInTransaction(ctx, func(dbtx database.Operations) error {
+		operation, err := dbtx.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if operation.Status != entities.ApprovedStatus {
+			return errors.StatusConflictError("operation is %s", operation.Status)
+		}
+
+		operation.Status = entities.ExecutedStatus
+		_, err = dbtx.Update(ctx, operation)
+		return err
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to complete approved operation")
+		return err
+	}
+
+	logger.Info("approved operation executed successfully")
+	return nil
+}
@@ -0,0 +1,85 @@
+package approvals
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Approvals) Approve(ctx context.Context, id uint64, userInfo *authtypes.UserInfo) (*entities.Operation, error) {
+	return s.decide(ctx, id, true, userInfo)
+}
+
+func (s *Approvals) Reject(ctx context.Context, id uint64, userInfo *authtypes.UserInfo) (*entities.Operation, error) {
+	return s.decide(ctx, id, false, userInfo)
+}
+
+// decide records the decision of an approver. A single rejection rejects the operation
+func (s *Approvals) decide(ctx context.Context, id uint64, approved bool, userInfo *authtypes.UserInfo) (*entities.Operation, error) {
+	logger := s.logger.With("id", id, "approved", approved)
+	logger.Debug("deciding on operation")
+
+	var result *entities.Operation
+	err := s.db.RunInTransaction(ctx, func(dbtx database.Operations) error {
+		operation, err := dbtx.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+		if userInfo.Tenant != "" && operation.Tenant != userInfo.Tenant {
+			return errors.NotFoundError("approval operation not found")
+		}
+
+		err = resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionApprove, Resource: authtypes.OpResource(operation.Resource)})
+		if err != nil {
+			return err
+		}
+
+		if operation.Requester == userInfo.Username {
+			return errors.ForbiddenError("operations cannot be approved by their requester")
+		}
+
+		now := time.Now().UTC()
+		if operation.IsExpired(now) {
+			return errors.StatusConflictError("operation has expired")
+		}
+		if operation.Status != entities.PendingStatus {
+			return errors.StatusConflictError("operation is %s", operation.Status)
+		}
+
+		for _, decision := range operation.Decisions {
+			if decision.Username == userInfo.Username {
+				return errors.AlreadyExistsError("user already decided on this operation")
+			}
+		}
+
+		operation.Decisions = append(operation.Decisions, &entities.Decision{
+			Username:  userInfo.Username,
+			Approved:  approved,
+			CreatedAt: now,
+		})
+
+		switch {
+		case !approved:
+			operation.Status = entities.RejectedStatus
+		case operation.Approvals() >= operation.RequiredApprovals:
+			operation.Status = entities.ApprovedStatus
+		}
+
+		result, err = dbtx.Update(ctx, operation)
+		return err
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to decide on operation")
+		return nil, err
+	}
+
+	logger.Info("decision on operation recorded successfully", "status", result.Status)
+	return result, nil
+}
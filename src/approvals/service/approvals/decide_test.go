@@ -0,0 +1,109 @@
+package approvals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/database"
+	dbmock "github.com/longfan78/quorum-key-manager/src/approvals/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	db := dbmock.NewMockOperations(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	service := New(db, roles, &entities.Config{RequiredApprovals: 2, TTL: time.Hour}, logger)
+
+	ctx := context.Background()
+	bob := &authentities.UserInfo{Username: "bob", Tenant: "tenant1"}
+	carol := &authentities.UserInfo{Username: "carol", Tenant: "tenant1"}
+	alice := &authentities.UserInfo{Username: "alice", Tenant: "tenant1"}
+	approvers := []authentities.Permission{authentities.ApproveKey}
+	roles.EXPECT().UserPermissions(gomock.Any(), gomock.Any()).Return(approvers).AnyTimes()
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx database.Operations) error) error {
+		return persist(db)
+	}).AnyTimes()
+	db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, operation *entities.Operation) (*entities.Operation, error) {
+		return operation, nil
+	}).AnyTimes()
+
+	newOperation := func() *entities.Operation {
+		return &entities.Operation{
+			ID:                1,
+			Type:              entities.DestroyOperation,
+			Resource:          string(authentities.ResourceKey),
+			Requester:         "alice",
+			Tenant:            "tenant1",
+			RequiredApprovals: 2,
+			Status:            entities.PendingStatus,
+			ExpiresAt:         time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("should approve the operation once the quorum is reached", func(t *testing.T) {
+		operation := newOperation()
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(operation, nil).Times(2)
+
+		result, err := service.Approve(ctx, 1, bob)
+		require.NoError(t, err)
+		assert.Equal(t, entities.PendingStatus, result.Status)
+
+		result, err = service.Approve(ctx, 1, carol)
+		require.NoError(t, err)
+		assert.Equal(t, entities.ApprovedStatus, result.Status)
+		assert.Equal(t, 2, result.Approvals())
+	})
+
+	t.Run("should reject the operation on the first rejection", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(newOperation(), nil)
+
+		result, err := service.Reject(ctx, 1, bob)
+		require.NoError(t, err)
+		assert.Equal(t, entities.RejectedStatus, result.Status)
+	})
+
+	t.Run("should fail if the approver is the requester", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(newOperation(), nil)
+
+		_, err := service.Approve(ctx, 1, alice)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail if the approver already decided", func(t *testing.T) {
+		operation := newOperation()
+		operation.Decisions = []*entities.Decision{{Username: "bob", Approved: true}}
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(operation, nil)
+
+		_, err := service.Approve(ctx, 1, bob)
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail if the operation has expired", func(t *testing.T) {
+		operation := newOperation()
+		operation.ExpiresAt = time.Now().Add(-time.Minute)
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(operation, nil)
+
+		_, err := service.Approve(ctx, 1, bob)
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail if the approver belongs to another tenant", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), uint64(1)).Return(newOperation(), nil)
+
+		_, err := service.Approve(ctx, 1, &authentities.UserInfo{Username: "dave", Tenant: "tenant2"})
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,42 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Approvals) Get(ctx context.Context, id uint64, userInfo *authtypes.UserInfo) (*entities.Operation, error) {
+	logger := s.logger.With("id", id)
+
+	operation, err := s.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	if !s.canView(resolver, operation, userInfo) {
+		errMessage := "approval operation not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	logger.Debug("approval operation found successfully")
+	return withStatus(operation), nil
+}
+
+// canView indicates whether the user requested the operation or can decide on it
+func (s *Approvals) canView(resolver *authorizator.Authorizator, operation *entities.Operation, userInfo *authtypes.UserInfo) bool {
+	if userInfo.Tenant != "" && operation.Tenant != userInfo.Tenant {
+		return false
+	}
+
+	if operation.Requester == userInfo.Username {
+		return true
+	}
+
+	return resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionApprove, Resource: authtypes.OpResource(operation.Resource)}) == nil
+}
@@ -0,0 +1,31 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Approvals) List(ctx context.Context, filter *entities.OperationFilter, userInfo *authtypes.UserInfo) ([]*entities.Operation, error) {
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+
+	filter.Resources = s.approverResources(resolver)
+	filter.Requester = userInfo.Username
+	if userInfo.Tenant != "" {
+		filter.Tenant = userInfo.Tenant
+	}
+
+	operations, err := s.db.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operation := range operations {
+		withStatus(operation)
+	}
+
+	s.logger.Debug("approval operations listed successfully")
+	return operations, nil
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	"github.com/longfan78/quorum-key-manager/src/audit/api/types"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+)
+
+type EventsHandler struct {
+	auditor audit.Auditor
+}
+
+func NewEventsHandler(auditor audit.Auditor) *EventsHandler {
+	return &EventsHandler{auditor: auditor}
+}
+
+func (h *EventsHandler) Register(router *mux.Router) {
+	eventsRouter := router.PathPrefix("/audit/events").Subrouter()
+
+	eventsRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.search)
+}
+
+// @Summary      Search audit events
+// @Description  Search the events of the audit log, most recent first. Users belonging to a tenant only get the events of their tenant
+// @Tags         Audit
+// @Produce      json
+// @Param        username    query     string                   false  "filter by username"
+// @Param        tenant      query     string                   false  "filter by tenant"
+// @Param        storeName   query     string                   false  "filter by store"
+// @Param        resourceId  query     string                   false  "filter by key ID, secret ID or account address"
+// @Param        operation   query     string                   false  "filter by operation"
+// @Param        outcome     query     string                   false  "filter by outcome"  Enums(success, failure)
+// @Param        since       query     string                   false  "RFC3339 lower bound of the event date (inclusive)"
+// @Param        until       query     string                   false  "RFC3339 upper bound of the event date (exclusive)"
+// @Param        limit       query     int                      false  "page size"
+// @Param        page        query     int                      false  "page number"
+// @Success      200         {array}   infrahttp.PageResponse   "List of audit events"
+// @Failure      400         {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401         {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403         {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500         {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /audit/events [get]
+func (h *EventsHandler) search(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	filter, err := getEventFilter(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	events, err := h.auditor.Search(ctx, filter, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	eventsResponse := make([]*types.EventResponse, 0, len(events))
+	for _, event := range events {
+		eventsResponse = append(eventsResponse, types.NewEventResponse(event))
+	}
+
+	err = infrahttp.WritePagingResponse(rw, request, eventsResponse)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+func getEventFilter(request *http.Request) (*entities.EventFilter, error) {
+	query := request.URL.Query()
+	filter := &entities.EventFilter{
+		Username:   query.Get("username"),
+		Tenant:     query.Get("tenant"),
+		StoreName:  query.Get("storeName"),
+		ResourceID: query.Get("resourceId"),
+		Operation:  query.Get("operation"),
+		Outcome:    entities.Outcome(query.Get("outcome")),
+	}
+
+	if filter.Outcome != "" && filter.Outcome != entities.SuccessOutcome && filter.Outcome != entities.FailureOutcome {
+		return nil, errors.InvalidFormatError("invalid outcome value")
+	}
+
+	var err error
+	if filter.Since, err = getTime(query.Get("since")); err != nil {
+		return nil, errors.InvalidFormatError("invalid since value")
+	}
+	if filter.Until, err = getTime(query.Get("until")); err != nil {
+		return nil, errors.InvalidFormatError("invalid until value")
+	}
+
+	limit := query.Get("limit")
+	if limit == "" {
+		limit = infrahttp.DefaultPageSize
+	}
+
+	filter.Limit, err = strconv.ParseUint(limit, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid limit value")
+	}
+
+	if page := query.Get("page"); page != "" {
+		iPage, err := strconv.ParseUint(page, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid page value")
+		}
+
+		filter.Offset = iPage * filter.Limit
+	}
+
+	return filter, nil
+}
+
+func getTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
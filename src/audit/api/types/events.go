@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+)
+
+type EventResponse struct {
+	ID          uint64    `json:"id" example:"42"`
+	Username    string    `json:"username,omitempty" example:"alice"`
+	Tenant      string    `json:"tenant,omitempty" example:"tenant1"`
+	Operation   string    `json:"operation" example:"sign"`
+	Resource    string    `json:"resource" example:"ethereum"`
+	StoreName   string    `json:"storeName,omitempty" example:"eth-accounts"`
+	ResourceID  string    `json:"resourceId,omitempty" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	PayloadHash string    `json:"payloadHash,omitempty" example:"7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069"`
+	Outcome     string    `json:"outcome" example:"success"`
+	Error       string    `json:"error,omitempty" example:"user is not authorized to perform this operation"`
+	PrevHash    string    `json:"prevHash" example:"4e07408562bedb8b60ce05c1decfe3ad16b72230967de01f640b7e4729b49fce"`
+	Hash        string    `json:"hash" example:"4b227777d4dd1fc61c6f884f48641d02b4d121d3fd328cb08b5531fcacdabf8a"`
+	CreatedAt   time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewEventResponse(event *entities.Event) *EventResponse {
+	return &EventResponse{
+		ID:          event.ID,
+		Username:    event.Username,
+		Tenant:      event.Tenant,
+		Operation:   event.Operation,
+		Resource:    event.Resource,
+		StoreName:   event.StoreName,
+		ResourceID:  event.ResourceID,
+		PayloadHash: event.PayloadHash,
+		Outcome:     string(event.Outcome),
+		Error:       event.Error,
+		PrevHash:    event.PrevHash,
+		Hash:        event.Hash,
+		CreatedAt:   event.CreatedAt,
+	}
+}
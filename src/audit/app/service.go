@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/src/audit/api/http"
+	db "github.com/longfan78/quorum-key-manager/src/audit/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/audit/service/auditor"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles) *auditor.Auditor {
+	// Data layer
+	eventsRepository := db.NewEvents(postgresClient, logger)
+
+	// Business layer
+	auditorService := auditor.New(eventsRepository, roles, logger)
+
+	// Service layer
+	http.NewEventsHandler(auditorService).Register(router)
+
+	return auditorService
+}
@@ -0,0 +1,23 @@
+package database
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Events interface {
+	RunInTransaction(ctx context.Context, persistFunc func(dbtx Events) error) error
+	// Lock prevents concurrent appends to the audit log until the end of the current transaction
+	Lock(ctx context.Context) error
+	// FindLast gets the last event of the audit log
+	FindLast(ctx context.Context) (*entities.Event, error)
+	// FindRange gets, in order, at most limit events following the given event ID
+	FindRange(ctx context.Context, afterID, limit uint64) ([]*entities.Event, error)
+	// Search gets the events matching the filter, most recent first
+	Search(ctx context.Context, filter *entities.EventFilter) ([]*entities.Event, error)
+	// Insert appends an event to the audit log
+	Insert(ctx context.Context, event *entities.Event) (*entities.Event, error)
+}
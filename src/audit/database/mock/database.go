@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	database "github.com/longfan78/quorum-key-manager/src/audit/database"
+	entities "github.com/longfan78/quorum-key-manager/src/audit/entities"
+	reflect "reflect"
+)
+
+// MockEvents is a mock of Events interface
+type MockEvents struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsMockRecorder
+}
+
+// MockEventsMockRecorder is the mock recorder for MockEvents
+type MockEventsMockRecorder struct {
+	mock *MockEvents
+}
+
+// NewMockEvents creates a new mock instance
+func NewMockEvents(ctrl *gomock.Controller) *MockEvents {
+	mock := &MockEvents{ctrl: ctrl}
+	mock.recorder = &MockEventsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEvents) EXPECT() *MockEventsMockRecorder {
+	return m.recorder
+}
+
+// RunInTransaction mocks base method
+func (m *MockEvents) RunInTransaction(ctx context.Context, persistFunc func(database.Events) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction
+func (mr *MockEventsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockEvents)(nil).RunInTransaction), ctx, persistFunc)
+}
+
+// Lock mocks base method
+func (m *MockEvents) Lock(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockEventsMockRecorder) Lock(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockEvents)(nil).Lock), ctx)
+}
+
+// FindLast mocks base method
+func (m *MockEvents) FindLast(ctx context.Context) (*entities.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLast", ctx)
+	ret0, _ := ret[0].(*entities.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLast indicates an expected call of FindLast
+func (mr *MockEventsMockRecorder) FindLast(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLast", reflect.TypeOf((*MockEvents)(nil).FindLast), ctx)
+}
+
+// FindRange mocks base method
+func (m *MockEvents) FindRange(ctx context.Context, afterID, limit uint64) ([]*entities.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRange", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRange indicates an expected call of FindRange
+func (mr *MockEventsMockRecorder) FindRange(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRange", reflect.TypeOf((*MockEvents)(nil).FindRange), ctx, afterID, limit)
+}
+
+// Search mocks base method
+func (m *MockEvents) Search(ctx context.Context, filter *entities.EventFilter) ([]*entities.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockEventsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockEvents)(nil).Search), ctx, filter)
+}
+
+// Insert mocks base method
+func (m *MockEvents) Insert(ctx context.Context, event *entities.Event) (*entities.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, event)
+	ret0, _ := ret[0].(*entities.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockEventsMockRecorder) Insert(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockEvents)(nil).Insert), ctx, event)
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+)
+
+type Event struct {
+	tableName struct{} `pg:"audit_events"` // nolint:unused,structcheck // reason
+
+	ID          uint64 `pg:",pk"`
+	Username    string
+	Tenant      string
+	Operation   string
+	Resource    string
+	StoreName   string
+	ResourceID  string
+	PayloadHash string
+	Outcome     string
+	Error       string
+	PrevHash    string
+	Hash        string
+	CreatedAt   time.Time
+}
+
+func NewEvent(event *entities.Event) *Event {
+	return &Event{
+		ID:          event.ID,
+		Username:    event.Username,
+		Tenant:      event.Tenant,
+		Operation:   event.Operation,
+		Resource:    event.Resource,
+		StoreName:   event.StoreName,
+		ResourceID:  event.ResourceID,
+		PayloadHash: event.PayloadHash,
+		Outcome:     string(event.Outcome),
+		Error:       event.Error,
+		PrevHash:    event.PrevHash,
+		Hash:        event.Hash,
+		CreatedAt:   event.CreatedAt,
+	}
+}
+
+func (e *Event) ToEntity() *entities.Event {
+	return &entities.Event{
+		ID:          e.ID,
+		Username:    e.Username,
+		Tenant:      e.Tenant,
+		Operation:   e.Operation,
+		Resource:    e.Resource,
+		StoreName:   e.StoreName,
+		ResourceID:  e.ResourceID,
+		PayloadHash: e.PayloadHash,
+		Outcome:     entities.Outcome(e.Outcome),
+		Error:       e.Error,
+		PrevHash:    e.PrevHash,
+		Hash:        e.Hash,
+		CreatedAt:   e.CreatedAt,
+	}
+}
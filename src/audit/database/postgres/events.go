@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit/database"
+	"github.com/longfan78/quorum-key-manager/src/audit/database/models"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+// appendLockID is the key of the advisory lock serializing the appends to the audit log
+const appendLockID = 7213560391
+
+type Events struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Events = &Events{}
+
+func NewEvents(client postgres.Client, logger log.Logger) *Events {
+	return &Events{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (e Events) RunInTransaction(ctx context.Context, persist func(dbtx database.Events) error) error {
+	return e.client.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		e.client = dbTx
+		return persist(&e)
+	})
+}
+
+func (e *Events) Lock(ctx context.Context) error {
+	var locked bool
+	err := e.client.QueryOne(ctx, &locked, "SELECT true FROM pg_advisory_xact_lock(?)", appendLockID)
+	if err != nil {
+		errMessage := "failed to lock audit log"
+		e.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (e *Events) FindLast(ctx context.Context) (*entities.Event, error) {
+	events, err := e.find(ctx, "TRUE", nil, "DESC", 1, 0)
+	if err != nil {
+		errMessage := "failed to get last audit event"
+		e.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(events) == 0 {
+		return nil, errors.NotFoundError("audit log is empty")
+	}
+
+	return events[0], nil
+}
+
+func (e *Events) FindRange(ctx context.Context, afterID, limit uint64) ([]*entities.Event, error) {
+	events, err := e.find(ctx, "id > ?", []interface{}{afterID}, "ASC", limit, 0)
+	if err != nil {
+		errMessage := "failed to get audit events"
+		e.logger.With("after_id", afterID).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return events, nil
+}
+
+func (e *Events) Search(ctx context.Context, filter *entities.EventFilter) ([]*entities.Event, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+
+	if filter.Username != "" {
+		addCondition("username = ?", filter.Username)
+	}
+	if filter.Tenant != "" {
+		addCondition("tenant = ?", filter.Tenant)
+	}
+	if filter.StoreName != "" {
+		addCondition("store_name = ?", filter.StoreName)
+	}
+	if filter.ResourceID != "" {
+		addCondition("resource_id = ?", filter.ResourceID)
+	}
+	if filter.Operation != "" {
+		addCondition("operation = ?", filter.Operation)
+	}
+	if filter.Outcome != "" {
+		addCondition("outcome = ?", string(filter.Outcome))
+	}
+	if filter.Since != nil {
+		addCondition("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		addCondition("created_at < ?", *filter.Until)
+	}
+
+	where := "TRUE"
+	if len(conditions) > 0 {
+		where = strings.Join(conditions, " AND ")
+	}
+
+	events, err := e.find(ctx, where, args, "DESC", filter.Limit, filter.Offset)
+	if err != nil {
+		errMessage := "failed to search audit events"
+		e.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return events, nil
+}
+
+func (e *Events) Insert(ctx context.Context, event *entities.Event) (*entities.Event, error) {
+	eventModel := models.NewEvent(event)
+
+	err := e.client.Insert(ctx, eventModel)
+	if err != nil {
+		errMessage := "failed to insert audit event"
+		e.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return eventModel.ToEntity(), nil
+}
+
+// find selects the IDs of the matching events first as the client does not support ordering and limits on models
+func (e *Events) find(ctx context.Context, where string, args []interface{}, order string, limit, offset uint64) ([]*entities.Event, error) {
+	// Limits are applied before aggregating so that the primary key index is scanned instead of the whole table
+	var ids []int64
+	query := fmt.Sprintf("SELECT id FROM audit_events WHERE %s ORDER BY id %s", where, order)
+	if limit != 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+	if offset != 0 {
+		query = fmt.Sprintf("%s OFFSET %d", query, offset)
+	}
+	query = fmt.Sprintf("SELECT array_agg(id) FROM (%s) AS ids", query)
+
+	err := e.client.Query(ctx, &ids, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*entities.Event{}, nil
+	}
+
+	var eventModels []*models.Event
+	err = e.client.SelectWhere(ctx, &eventModels, "id = ANY(?)", []string{}, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(eventModels, func(i, j int) bool {
+		if order == "DESC" {
+			return eventModels[i].ID > eventModels[j].ID
+		}
+		return eventModels[i].ID < eventModels[j].ID
+	})
+
+	events := make([]*entities.Event, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		events = append(events, eventModel.ToEntity())
+	}
+
+	return events, nil
+}
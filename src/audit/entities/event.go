@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+type Outcome string
+
+const (
+	SuccessOutcome Outcome = "success"
+	FailureOutcome Outcome = "failure"
+)
+
+// Operations recorded in the audit log
+const (
+	CreateOperation          = "create"
+	ImportOperation          = "import"
+	SetOperation             = "set"
+	UpdateOperation          = "update"
+	RotateOperation          = "rotate"
+	DeriveOperation          = "derive"
+	EnableOperation          = "enable"
+	DisableOperation         = "disable"
+	DeleteOperation          = "delete"
+	RestoreOperation         = "restore"
+	DestroyOperation         = "destroy"
+	SignOperation            = "sign"
+	SignMessageOperation     = "sign-message"
+	SignTypedDataOperation   = "sign-typed-data"
+	SignTransactionOperation = "sign-transaction"
+	SignEEAOperation         = "sign-eea"
+	SignPrivateOperation     = "sign-private"
+	EncryptOperation         = "encrypt"
+	DecryptOperation         = "decrypt"
+)
+
+// GenesisHash is the previous hash of the first event of the audit log
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// Event is an entry of the audit log. Each event is chained to the previous one by its hash
+type Event struct {
+	ID         uint64
+	Username   string
+	Tenant     string
+	Operation  string
+	Resource   string
+	StoreName  string
+	ResourceID string
+	// PayloadHash is the hex encoded SHA-256 of the signed, encrypted or decrypted payload. Secret material is never hashed
+	PayloadHash string
+	Outcome     Outcome
+	Error       string
+	PrevHash    string
+	Hash        string
+	CreatedAt   time.Time
+}
+
+type EventFilter struct {
+	Username   string
+	Tenant     string
+	StoreName  string
+	ResourceID string
+	Operation  string
+	Outcome    Outcome
+	Since      *time.Time
+	Until      *time.Time
+	Limit      uint64
+	Offset     uint64
+}
+
+// Verification is the result of the integrity check of the audit log
+type Verification struct {
+	Valid    bool
+	Count    uint64
+	LastHash string
+	// BrokenAt is the ID of the first event failing the integrity check
+	BrokenAt uint64
+	Reason   string
+}
+
+// ComputeHash computes the hash of the event chained to its previous hash. The ID is not part of the hash as it is
+// assigned by the database
+func (e *Event) ComputeHash() string {
+	// Fields are listed explicitly so that the hash never depends on fields added later to the entity
+	payload, _ := json.Marshal([]string{
+		e.PrevHash,
+		e.Username,
+		e.Tenant,
+		e.Operation,
+		e.Resource,
+		e.StoreName,
+		e.ResourceID,
+		e.PayloadHash,
+		string(e.Outcome),
+		e.Error,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+
+	hash := sha256.Sum256(payload)
+	return hex.EncodeToString(hash[:])
+}
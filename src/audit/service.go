@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+
+// Auditor records the sensitive operations in a tamper-evident audit log
+type Auditor interface {
+	// Record appends an event to the audit log, chaining it to the last recorded event
+	Record(ctx context.Context, event *entities.Event) error
+
+	// Search gets the events matching the filter, most recent first
+	Search(ctx context.Context, filter *entities.EventFilter, userInfo *auth.UserInfo) ([]*entities.Event, error)
+
+	// Verify checks the integrity of the whole audit log
+	Verify(ctx context.Context) (*entities.Verification, error)
+}
@@ -0,0 +1,24 @@
+package auditor
+
+import (
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	"github.com/longfan78/quorum-key-manager/src/audit/database"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+type Auditor struct {
+	db     database.Events
+	roles  auth.Roles
+	logger log.Logger
+}
+
+var _ audit.Auditor = &Auditor{}
+
+func New(db database.Events, roles auth.Roles, logger log.Logger) *Auditor {
+	return &Auditor{
+		db:     db,
+		roles:  roles,
+		logger: logger,
+	}
+}
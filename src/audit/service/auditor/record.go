@@ -0,0 +1,51 @@
+package auditor
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit/database"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+)
+
+func (a *Auditor) Record(ctx context.Context, event *entities.Event) error {
+	logger := a.logger.With("operation", event.Operation, "resource", event.Resource, "store_name", event.StoreName, "resource_id", event.ResourceID)
+
+	err := a.db.RunInTransaction(ctx, func(dbtx database.Events) error {
+		// Appends are serialized so that every event is chained to the one recorded right before it
+		err := dbtx.Lock(ctx)
+		if err != nil {
+			return err
+		}
+
+		event.PrevHash = entities.GenesisHash
+		last, err := dbtx.FindLast(ctx)
+		switch {
+		case err == nil:
+			event.PrevHash = last.Hash
+		case !errors.IsNotFoundError(err):
+			return err
+		}
+
+		// Postgres stores timestamps with a microsecond precision
+		event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		event.Hash = event.ComputeHash()
+
+		recordedEvent, err := dbtx.Insert(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		event.ID = recordedEvent.ID
+		return nil
+	})
+	if err != nil {
+		errMessage := "failed to record audit event"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("audit event recorded successfully", "id", event.ID)
+	return nil
+}
@@ -0,0 +1,80 @@
+package auditor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit/database"
+	dbmock "github.com/longfan78/quorum-key-manager/src/audit/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	db := dbmock.NewMockEvents(ctrl)
+	auditor := New(db, mock.NewMockRoles(ctrl), logger)
+
+	ctx := context.Background()
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx database.Events) error) error {
+		return persist(db)
+	}).AnyTimes()
+
+	t.Run("should chain the first event to the genesis hash", func(t *testing.T) {
+		event := &entities.Event{Operation: entities.SignOperation, Resource: "keys", ResourceID: "my-key", Outcome: entities.SuccessOutcome}
+
+		db.EXPECT().Lock(gomock.Any()).Return(nil)
+		db.EXPECT().FindLast(gomock.Any()).Return(nil, errors.NotFoundError("error"))
+		db.EXPECT().Insert(gomock.Any(), event).Return(&entities.Event{ID: 1}, nil)
+
+		err := auditor.Record(ctx, event)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), event.ID)
+		assert.Equal(t, entities.GenesisHash, event.PrevHash)
+		assert.Equal(t, event.ComputeHash(), event.Hash)
+		assert.False(t, event.CreatedAt.IsZero())
+	})
+
+	t.Run("should chain the event to the last recorded event", func(t *testing.T) {
+		event := &entities.Event{Operation: entities.DestroyOperation, Resource: "keys", ResourceID: "my-key", Outcome: entities.SuccessOutcome}
+		last := &entities.Event{ID: 41, Hash: "last-hash"}
+
+		db.EXPECT().Lock(gomock.Any()).Return(nil)
+		db.EXPECT().FindLast(gomock.Any()).Return(last, nil)
+		db.EXPECT().Insert(gomock.Any(), event).Return(&entities.Event{ID: 42}, nil)
+
+		err := auditor.Record(ctx, event)
+		require.NoError(t, err)
+
+		assert.Equal(t, last.Hash, event.PrevHash)
+		assert.Equal(t, event.ComputeHash(), event.Hash)
+	})
+
+	t.Run("should fail with same error if the last event cannot be fetched", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+
+		db.EXPECT().Lock(gomock.Any()).Return(nil)
+		db.EXPECT().FindLast(gomock.Any()).Return(nil, expectedErr)
+
+		err := auditor.Record(ctx, &entities.Event{})
+		assert.True(t, errors.IsPostgresError(err))
+	})
+
+	t.Run("should fail with same error if the audit log cannot be locked", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+
+		db.EXPECT().Lock(gomock.Any()).Return(expectedErr)
+
+		err := auditor.Record(ctx, &entities.Event{})
+		assert.True(t, errors.IsPostgresError(err))
+	})
+}
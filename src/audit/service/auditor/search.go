@@ -0,0 +1,39 @@
+package auditor
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (a *Auditor) Search(ctx context.Context, filter *entities.EventFilter, userInfo *auth.UserInfo) ([]*entities.Event, error) {
+	resolver := authorizator.New(a.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, a.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAudit})
+	if err != nil {
+		return nil, err
+	}
+
+	// Users belonging to a tenant can only see the events of their tenant
+	if userInfo.Tenant != "" {
+		if filter.Tenant != "" && filter.Tenant != userInfo.Tenant {
+			errMessage := "user is not allowed to access the events of this tenant"
+			a.logger.Error(errMessage, "tenant", filter.Tenant)
+			return nil, errors.ForbiddenError(errMessage)
+		}
+
+		filter.Tenant = userInfo.Tenant
+	}
+
+	events, err := a.db.Search(ctx, filter)
+	if err != nil {
+		errMessage := "failed to search audit events"
+		a.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	a.logger.Debug("audit events found successfully", "count", len(events))
+	return events, nil
+}
@@ -0,0 +1,54 @@
+package auditor
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+)
+
+const verifyBatchSize = 1000
+
+func (a *Auditor) Verify(ctx context.Context) (*entities.Verification, error) {
+	result := &entities.Verification{
+		Valid:    true,
+		LastHash: entities.GenesisHash,
+	}
+
+	var afterID uint64
+	for {
+		events, err := a.db.FindRange(ctx, afterID, verifyBatchSize)
+		if err != nil {
+			errMessage := "failed to verify audit log"
+			a.logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+
+		for _, event := range events {
+			switch {
+			case event.PrevHash != result.LastHash:
+				result.Reason = "event is not chained to the previous event, events were removed or reordered"
+			case event.ComputeHash() != event.Hash:
+				result.Reason = "event hash does not match its content, event was modified"
+			}
+
+			if result.Reason != "" {
+				result.Valid = false
+				result.BrokenAt = event.ID
+				a.logger.Error("audit log integrity check failed", "id", event.ID, "reason", result.Reason)
+				return result, nil
+			}
+
+			result.Count++
+			result.LastHash = event.Hash
+			afterID = event.ID
+		}
+
+		if len(events) < verifyBatchSize {
+			break
+		}
+	}
+
+	a.logger.Info("audit log verified successfully", "count", result.Count, "last_hash", result.LastHash)
+	return result, nil
+}
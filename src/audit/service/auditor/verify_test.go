@@ -0,0 +1,96 @@
+package auditor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	dbmock "github.com/longfan78/quorum-key-manager/src/audit/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	db := dbmock.NewMockEvents(ctrl)
+	auditor := New(db, mock.NewMockRoles(ctrl), logger)
+
+	ctx := context.Background()
+
+	t.Run("should verify a valid audit log successfully", func(t *testing.T) {
+		events := fakeChain(3)
+
+		db.EXPECT().FindRange(gomock.Any(), uint64(0), uint64(verifyBatchSize)).Return(events, nil)
+
+		result, err := auditor.Verify(ctx)
+		require.NoError(t, err)
+
+		assert.True(t, result.Valid)
+		assert.Equal(t, uint64(3), result.Count)
+		assert.Equal(t, events[2].Hash, result.LastHash)
+	})
+
+	t.Run("should detect a modified event", func(t *testing.T) {
+		events := fakeChain(3)
+		events[1].Username = "mallory"
+
+		db.EXPECT().FindRange(gomock.Any(), uint64(0), uint64(verifyBatchSize)).Return(events, nil)
+
+		result, err := auditor.Verify(ctx)
+		require.NoError(t, err)
+
+		assert.False(t, result.Valid)
+		assert.Equal(t, uint64(1), result.Count)
+		assert.Equal(t, events[1].ID, result.BrokenAt)
+	})
+
+	t.Run("should detect a removed event", func(t *testing.T) {
+		events := fakeChain(3)
+
+		db.EXPECT().FindRange(gomock.Any(), uint64(0), uint64(verifyBatchSize)).Return([]*entities.Event{events[0], events[2]}, nil)
+
+		result, err := auditor.Verify(ctx)
+		require.NoError(t, err)
+
+		assert.False(t, result.Valid)
+		assert.Equal(t, events[2].ID, result.BrokenAt)
+	})
+
+	t.Run("should fail with same error if events cannot be fetched", func(t *testing.T) {
+		db.EXPECT().FindRange(gomock.Any(), uint64(0), uint64(verifyBatchSize)).Return(nil, errors.PostgresError("error"))
+
+		_, err := auditor.Verify(ctx)
+		assert.True(t, errors.IsPostgresError(err))
+	})
+}
+
+func fakeChain(n int) []*entities.Event {
+	var events []*entities.Event
+	prevHash := entities.GenesisHash
+	for i := 1; i <= n; i++ {
+		event := &entities.Event{
+			ID:         uint64(i),
+			Username:   "alice",
+			Operation:  entities.SignOperation,
+			Resource:   "ethereum",
+			StoreName:  "eth-accounts",
+			ResourceID: "0x664895b5fE3ddf049d2Fb508cfA03923859763C6",
+			Outcome:    entities.SuccessOutcome,
+			PrevHash:   prevHash,
+			CreatedAt:  time.Now().UTC(),
+		}
+		event.Hash = event.ComputeHash()
+		prevHash = event.Hash
+		events = append(events, event)
+	}
+
+	return events
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/types"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+)
+
+type APIKeysHandler struct {
+	apiKeys auth.APIKeys
+}
+
+func NewAPIKeysHandler(apiKeys auth.APIKeys) *APIKeysHandler {
+	return &APIKeysHandler{apiKeys: apiKeys}
+}
+
+func (h *APIKeysHandler) Register(router *mux.Router) {
+	apiKeysRouter := router.PathPrefix("/api-keys").Subrouter()
+
+	apiKeysRouter.Methods(http.MethodPost).Path("").HandlerFunc(h.issue)
+	apiKeysRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	apiKeysRouter.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.get)
+	apiKeysRouter.Methods(http.MethodPost).Path("/{id}/revoke").HandlerFunc(h.revoke)
+}
+
+// @Summary      Issues an API key
+// @Description  Issues an API key scoped to the tenant of the user, with permissions, roles and stores within the ones of the user. The key is only returned once
+// @Tags         API keys
+// @Accept       json
+// @Produce      json
+// @Param        request  body      types.IssueAPIKeyRequest    true  "Issue API key request"
+// @Success      200      {object}  types.IssuedAPIKeyResponse  "API key data and key"
+// @Failure      400      {object}  infrahttp.ErrorResponse     "Invalid request format"
+// @Failure      403      {object}  infrahttp.ErrorResponse     "Forbidden"
+// @Failure      422      {object}  infrahttp.ErrorResponse     "Invalid parameters"
+// @Failure      500      {object}  infrahttp.ErrorResponse     "Internal server error"
+// @Router       /api-keys [post]
+func (h *APIKeysHandler) issue(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	issueReq := &types.IssueAPIKeyRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, issueReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	apiKey, err := h.apiKeys.Issue(ctx, types.NewIssueAPIKeyRequest(issueReq), UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewIssuedAPIKeyResponse(apiKey))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets an API key
+// @Description  Gets an API key, without the key itself
+// @Tags         API keys
+// @Produce      json
+// @Param        id   path      int                      true  "API key identifier"
+// @Success      200  {object}  types.APIKeyResponse     "API key data"
+// @Failure      400  {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "API key not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys/{id} [get]
+func (h *APIKeysHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := getAPIKeyID(r)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	apiKey, err := h.apiKeys.Get(ctx, id, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewAPIKeyResponse(apiKey))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists API keys
+// @Description  Lists the API keys of the tenant of the user, including the revoked ones
+// @Tags         API keys
+// @Produce      json
+// @Success      200  {array}   types.APIKeyResponse     "List of API keys"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys [get]
+func (h *APIKeysHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	apiKeys, err := h.apiKeys.List(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := make([]*types.APIKeyResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		resp = append(resp, types.NewAPIKeyResponse(apiKey))
+	}
+
+	err = infrahttp.WriteJSON(rw, resp)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Revokes an API key
+// @Description  Revokes an API key, which is immediately rejected
+// @Tags         API keys
+// @Produce      json
+// @Param        id   path      int                      true  "API key identifier"
+// @Success      200  {object}  types.APIKeyResponse     "API key data"
+// @Failure      400  {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "API key not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys/{id}/revoke [post]
+func (h *APIKeysHandler) revoke(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := getAPIKeyID(r)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	apiKey, err := h.apiKeys.Revoke(ctx, id, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewAPIKeyResponse(apiKey))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+func getAPIKeyID(r *http.Request) (uint64, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return 0, errors.InvalidFormatError("invalid api key id")
+	}
+
+	return id, nil
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/base64"
+	"net"
 	"net/http"
 	"strings"
 
@@ -57,7 +58,7 @@ func (m *Auth) Middleware(next http.Handler) http.Handler {
 					return
 				}
 
-				userInfo, err := m.authenticator.AuthenticateAPIKey(r.Context(), apiKey)
+				userInfo, err := m.authenticator.AuthenticateAPIKey(r.Context(), apiKey, clientIP(r))
 				if err != nil {
 					httpinfra.WriteHTTPErrorResponse(rw, err)
 					return
@@ -87,3 +88,13 @@ func (m *Auth) Middleware(next http.Handler) http.Handler {
 		next.ServeHTTP(rw, r.WithContext(WithUserInfo(ctx, entities.NewAnonymousUser())))
 	})
 }
+
+// clientIP returns the address of the peer, forwarding headers are ignored as they can be forged by the client
+func clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return net.ParseIP(host)
+}
@@ -0,0 +1,177 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/types"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+)
+
+type RolesHandler struct {
+	roles auth.Roles
+}
+
+func NewRolesHandler(roles auth.Roles) *RolesHandler {
+	return &RolesHandler{roles: roles}
+}
+
+func (h *RolesHandler) Register(router *mux.Router) {
+	rolesRouter := router.PathPrefix("/roles").Subrouter()
+
+	rolesRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	rolesRouter.Methods(http.MethodPost).Path("/{roleName}").HandlerFunc(h.create)
+	rolesRouter.Methods(http.MethodGet).Path("/{roleName}").HandlerFunc(h.get)
+	rolesRouter.Methods(http.MethodPatch).Path("/{roleName}").HandlerFunc(h.update)
+	rolesRouter.Methods(http.MethodDelete).Path("/{roleName}").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates a role
+// @Description  Creates a role with the given set of permissions
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Param        roleName  path      string                   true  "role identifier"
+// @Param        request   body      types.CreateRoleRequest  true  "Create role request"
+// @Success      200       {object}  types.RoleResponse       "Role data"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      409       {object}  infrahttp.ErrorResponse  "Role already exists"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [post]
+func (h *RolesHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	createReq := &types.CreateRoleRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	role, err := h.roles.Create(ctx, getRoleName(r), createReq.Permissions, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewRoleResponse(role))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets a role
+// @Description  Gets a role and its permissions
+// @Tags         Roles
+// @Produce      json
+// @Param        roleName  path      string                   true  "role identifier"
+// @Success      200       {object}  types.RoleResponse       "Role data"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Role not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [get]
+func (h *RolesHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	role, err := h.roles.Get(ctx, getRoleName(r), UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewRoleResponse(role))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists roles
+// @Description  Lists the names of all the roles
+// @Tags         Roles
+// @Produce      json
+// @Success      200  {array}   string                   "List of role names"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles [get]
+func (h *RolesHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	roles, err := h.roles.List(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, roles)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Updates a role
+// @Description  Replaces the permissions of a role
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Param        roleName  path      string                   true  "role identifier"
+// @Param        request   body      types.UpdateRoleRequest  true  "Update role request"
+// @Success      200       {object}  types.RoleResponse       "Role data"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Role not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [patch]
+func (h *RolesHandler) update(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	updateReq := &types.UpdateRoleRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, updateReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	role, err := h.roles.Update(ctx, getRoleName(r), updateReq.Permissions, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewRoleResponse(role))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Deletes a role
+// @Description  Deletes a role
+// @Tags         Roles
+// @Param        roleName  path  string  true  "role identifier"
+// @Success      204       "Deleted successfully"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Role not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [delete]
+func (h *RolesHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.roles.Delete(ctx, getRoleName(r), UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func getRoleName(r *http.Request) string {
+	return mux.Vars(r)["roleName"]
+}
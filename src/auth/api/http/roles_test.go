@@ -0,0 +1,186 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/types"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities/testdata"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var reqUserInfo = &entities.UserInfo{
+	Username:    "username",
+	Roles:       []string{"role1", "role2"},
+	Permissions: []entities.Permission{"*:*"},
+}
+
+type rolesHandlerTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	router *mux.Router
+	roles  *mock.MockRoles
+	ctx    context.Context
+}
+
+func TestRolesHandler(t *testing.T) {
+	s := new(rolesHandlerTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *rolesHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.roles = mock.NewMockRoles(s.ctrl)
+
+	s.ctx = WithUserInfo(context.Background(), reqUserInfo)
+
+	s.router = mux.NewRouter()
+	NewRolesHandler(s.roles).Register(s.router)
+}
+
+func (s *rolesHandlerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *rolesHandlerTestSuite) TestCreate() {
+	role := testdata.FakeRole()
+
+	s.Run("should execute request successfully", func() {
+		roleReq := &types.CreateRoleRequest{Permissions: role.Permissions}
+		requestBytes, _ := json.Marshal(roleReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/roles/"+role.Name, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.roles.EXPECT().Create(gomock.Any(), role.Name, role.Permissions, reqUserInfo).Return(role, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewRoleResponse(role))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if request body is empty", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/roles/"+role.Name, nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 409 if role already exists", func() {
+		roleReq := &types.CreateRoleRequest{Permissions: role.Permissions}
+		requestBytes, _ := json.Marshal(roleReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/roles/"+role.Name, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.roles.EXPECT().Create(gomock.Any(), role.Name, role.Permissions, reqUserInfo).Return(nil, errors.AlreadyExistsError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *rolesHandlerTestSuite) TestGet() {
+	role := testdata.FakeRole()
+
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/roles/"+role.Name, nil).WithContext(s.ctx)
+
+		s.roles.EXPECT().Get(gomock.Any(), role.Name, reqUserInfo).Return(role, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewRoleResponse(role))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 404 if role is not found", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/roles/"+role.Name, nil).WithContext(s.ctx)
+
+		s.roles.EXPECT().Get(gomock.Any(), role.Name, reqUserInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *rolesHandlerTestSuite) TestList() {
+	s.Run("should execute request successfully", func() {
+		names := []string{"signer", "admin"}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/roles", nil).WithContext(s.ctx)
+
+		s.roles.EXPECT().List(gomock.Any(), reqUserInfo).Return(names, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(names)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 403 if user is not authorized", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/roles", nil).WithContext(s.ctx)
+
+		s.roles.EXPECT().List(gomock.Any(), reqUserInfo).Return(nil, errors.ForbiddenError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusForbidden, rw.Code)
+	})
+}
+
+func (s *rolesHandlerTestSuite) TestUpdate() {
+	role := testdata.FakeRole()
+
+	s.Run("should execute request successfully", func() {
+		roleReq := &types.UpdateRoleRequest{Permissions: role.Permissions}
+		requestBytes, _ := json.Marshal(roleReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPatch, "/roles/"+role.Name, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.roles.EXPECT().Update(gomock.Any(), role.Name, role.Permissions, reqUserInfo).Return(role, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewRoleResponse(role))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *rolesHandlerTestSuite) TestDelete() {
+	role := testdata.FakeRole()
+
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/roles/%s", role.Name), nil).WithContext(s.ctx)
+
+		s.roles.EXPECT().Delete(gomock.Any(), role.Name, reqUserInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+}
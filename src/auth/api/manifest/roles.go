@@ -42,10 +42,32 @@ func (h *RolesHandler) Create(ctx context.Context, name string, specs interface{
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.roles.Create(ctx, name, createReq.Permissions, h.userInfo)
+	_, err = h.roles.Create(ctx, name, createReq.Permissions, h.userInfo)
+	// Roles are persisted, so they might already exist from a previous run, the manifest being applied over them
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		_, err = h.roles.Update(ctx, name, createReq.Permissions, h.userInfo)
+	}
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Update updates the permissions of the roles of changed manifests
+func (h *RolesHandler) Update(ctx context.Context, mnfs []entities2.Manifest) error {
+	for _, mnf := range mnfs {
+		updateReq := &types.UpdateRoleRequest{}
+		err := json.UnmarshalYAML(mnf.Specs, updateReq)
+		if err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		_, err = h.roles.Update(ctx, mnf.Name, updateReq.Permissions, h.userInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	manifest "github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roles := mock.NewMockRoles(ctrl)
+	handler := NewRolesHandler(roles)
+
+	mnfs := []manifest.Manifest{{
+		Kind:  manifest.RoleKind,
+		Name:  "signer",
+		Specs: map[string]interface{}{"permissions": []interface{}{"sign:ethereum"}},
+	}}
+	permissions := []entities.Permission{entities.SignEth}
+
+	t.Run("should create the roles of the manifests", func(t *testing.T) {
+		roles.EXPECT().Create(gomock.Any(), "signer", permissions, gomock.Any()).Return(&entities.Role{}, nil)
+
+		err := handler.Register(ctx, mnfs)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should update the roles already existing", func(t *testing.T) {
+		roles.EXPECT().Create(gomock.Any(), "signer", permissions, gomock.Any()).Return(nil, errors.AlreadyExistsError("error"))
+		roles.EXPECT().Update(gomock.Any(), "signer", permissions, gomock.Any()).Return(&entities.Role{}, nil)
+
+		err := handler.Register(ctx, mnfs)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if a role cannot be updated", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+		roles.EXPECT().Create(gomock.Any(), "signer", permissions, gomock.Any()).Return(nil, errors.AlreadyExistsError("error"))
+		roles.EXPECT().Update(gomock.Any(), "signer", permissions, gomock.Any()).Return(nil, expectedErr)
+
+		err := handler.Register(ctx, mnfs)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
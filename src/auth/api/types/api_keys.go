@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+type IssueAPIKeyRequest struct {
+	Tenant        string                `json:"tenant,omitempty" example:"tenantOne"`
+	Username      string                `json:"username,omitempty" example:"ci"`
+	Roles         []string              `json:"roles,omitempty" example:"signer"`
+	Permissions   []entities.Permission `json:"permissions,omitempty" example:"read:keys,sign:keys"`
+	AllowedStores []string              `json:"allowedStores,omitempty" example:"eth-accounts"`
+	AllowedCIDRs  []string              `json:"allowedCIDRs,omitempty" validate:"omitempty,dive,cidr" example:"10.0.0.0/8"`
+	ExpiresAt     *time.Time            `json:"expiresAt,omitempty" example:"2030-07-09T12:35:42.115395Z"`
+}
+
+type APIKeyResponse struct {
+	ID            uint64                `json:"id" example:"1"`
+	Tenant        string                `json:"tenant,omitempty" example:"tenantOne"`
+	Username      string                `json:"username,omitempty" example:"ci"`
+	Roles         []string              `json:"roles,omitempty" example:"signer"`
+	Permissions   []entities.Permission `json:"permissions,omitempty" example:"read:keys,sign:keys"`
+	AllowedStores []string              `json:"allowedStores,omitempty" example:"eth-accounts"`
+	AllowedCIDRs  []string              `json:"allowedCIDRs,omitempty" example:"10.0.0.0/8"`
+	ExpiresAt     *time.Time            `json:"expiresAt,omitempty" example:"2030-07-09T12:35:42.115395Z"`
+	RevokedAt     *time.Time            `json:"revokedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	LastUsedAt    *time.Time            `json:"lastUsedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	CreatedAt     time.Time             `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt     time.Time             `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type IssuedAPIKeyResponse struct {
+	APIKeyResponse
+	// Key is only returned when issued and must be sent base64 encoded in a Basic authorization header
+	Key string `json:"key" example:"bWFrZSBzdXJlIHRvIHN0b3JlIHRoaXMga2V5IHNhZmVseQ"`
+}
+
+func NewIssueAPIKeyRequest(req *IssueAPIKeyRequest) *entities.APIKey {
+	return &entities.APIKey{
+		Tenant:        req.Tenant,
+		Username:      req.Username,
+		Roles:         req.Roles,
+		Permissions:   req.Permissions,
+		AllowedStores: req.AllowedStores,
+		AllowedCIDRs:  req.AllowedCIDRs,
+		ExpiresAt:     req.ExpiresAt,
+	}
+}
+
+func NewAPIKeyResponse(apiKey *entities.APIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:            apiKey.ID,
+		Tenant:        apiKey.Tenant,
+		Username:      apiKey.Username,
+		Roles:         apiKey.Roles,
+		Permissions:   apiKey.Permissions,
+		AllowedStores: apiKey.AllowedStores,
+		AllowedCIDRs:  apiKey.AllowedCIDRs,
+		ExpiresAt:     apiKey.ExpiresAt,
+		RevokedAt:     apiKey.RevokedAt,
+		LastUsedAt:    apiKey.LastUsedAt,
+		CreatedAt:     apiKey.CreatedAt,
+		UpdatedAt:     apiKey.UpdatedAt,
+	}
+}
+
+func NewIssuedAPIKeyResponse(apiKey *entities.APIKey) *IssuedAPIKeyResponse {
+	return &IssuedAPIKeyResponse{
+		APIKeyResponse: *NewAPIKeyResponse(apiKey),
+		Key:            apiKey.Key,
+	}
+}
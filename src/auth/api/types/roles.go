@@ -1,7 +1,31 @@
 package types
 
-import "github.com/longfan78/quorum-key-manager/src/auth/entities"
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
 
 type CreateRoleRequest struct {
 	Permissions []entities.Permission `json:"permissions" yaml:"permissions" validate:"required" example:"*:*"`
 }
+
+type UpdateRoleRequest struct {
+	Permissions []entities.Permission `json:"permissions" validate:"required" example:"read:keys,sign:keys"`
+}
+
+type RoleResponse struct {
+	Name        string                `json:"name" example:"signer"`
+	Permissions []entities.Permission `json:"permissions" example:"read:keys,sign:keys"`
+	CreatedAt   time.Time             `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt   time.Time             `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewRoleResponse(role *entities.Role) *RoleResponse {
+	return &RoleResponse{
+		Name:        role.Name,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
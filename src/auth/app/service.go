@@ -5,27 +5,39 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/pkg/app"
 	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	db "github.com/longfan78/quorum-key-manager/src/auth/database/postgres"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/apikeys"
 	"github.com/longfan78/quorum-key-manager/src/auth/service/authenticator"
 	"github.com/longfan78/quorum-key-manager/src/auth/service/roles"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
 	"github.com/justinas/alice"
 )
 
 func RegisterService(
 	a *app.App,
 	logger log.Logger,
+	postgresClient postgres.Client,
 	jwtValidator jwt.Validator,
 	apikeyClaims map[string]*entities.UserClaims,
 	rootCAs *x509.CertPool,
-) (*roles.Roles, error) {
+	apiKeysCfg *entities.APIKeysConfig,
+	limiter *ratelimit.Limiter,
+) (*roles.Roles, *authenticator.Authenticator, error) {
+	// Data layer
+	rolesRepository := db.NewRoles(postgresClient)
+	apiKeysRepository := db.NewAPIKeys(postgresClient)
+
 	// Business layer
 	// TODO: Create authorizator service here
 
 	var authmid alice.Constructor
-	if jwtValidator != nil || apikeyClaims != nil || rootCAs != nil {
-		autheServ := authenticator.New(jwtValidator, apikeyClaims, rootCAs, logger)
+	var autheServ *authenticator.Authenticator
+	if jwtValidator != nil || apikeyClaims != nil || rootCAs != nil || apiKeysCfg != nil {
+		autheServ = authenticator.New(jwtValidator, apikeyClaims, rootCAs, apiKeysRepository, apiKeysCfg, logger)
 		authmid = http.NewAuth(autheServ).Middleware
 		logger.Info("authentication middleware is enabled")
 	} else {
@@ -33,17 +45,27 @@ func RegisterService(
 		logger.Warn("authentication is disabled")
 	}
 
-	rolesService := roles.New(logger)
+	rolesService := roles.New(rolesRepository, logger)
 
 	// Service layer
 	httpMid := alice.New(
 		http.NewAccessLog(logger.WithComponent("accesslog")).Middleware, // TODO: Move to correct domain when it exists
 		authmid,
 	)
+	if limiter != nil {
+		httpMid = httpMid.Append(ratelimit.HTTPMiddleware(limiter))
+		logger.Info("rate limiting is enabled")
+	}
 	err := a.SetMiddleware(httpMid.Then)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	http.NewRolesHandler(rolesService).Register(a.Router())
+	if apiKeysCfg != nil {
+		http.NewAPIKeysHandler(apikeys.New(apiKeysRepository, rolesRepository, rolesService, logger)).Register(a.Router())
+		logger.Info("api keys issued by the key manager are enabled")
 	}
 
-	return rolesService, nil
+	return rolesService, autheServ, nil
 }
@@ -0,0 +1,40 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Roles interface {
+	// Insert inserts a new role
+	Insert(ctx context.Context, role *entities.Role) (*entities.Role, error)
+	// FindOne gets a role
+	FindOne(ctx context.Context, name string) (*entities.Role, error)
+	// FindMany gets the existing roles among the given names
+	FindMany(ctx context.Context, names []string) ([]*entities.Role, error)
+	// SearchNames returns the names of all roles
+	SearchNames(ctx context.Context) ([]string, error)
+	// Update updates the permissions of a role
+	Update(ctx context.Context, role *entities.Role) (*entities.Role, error)
+	// Delete deletes a role
+	Delete(ctx context.Context, name string) error
+}
+
+type APIKeys interface {
+	// Insert inserts a new API key
+	Insert(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error)
+	// FindOne gets an API key
+	FindOne(ctx context.Context, id uint64) (*entities.APIKey, error)
+	// FindOneByHash gets an API key given the sha256 hash of the key
+	FindOneByHash(ctx context.Context, hash string) (*entities.APIKey, error)
+	// Search returns the API keys of a tenant, or of all the tenants if empty
+	Search(ctx context.Context, tenant string) ([]*entities.APIKey, error)
+	// Update updates an API key
+	Update(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error)
+	// UpdateLastUsed sets the last usage of an API key
+	UpdateLastUsed(ctx context.Context, id uint64, lastUsedAt time.Time) error
+}
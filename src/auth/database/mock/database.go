@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	reflect "reflect"
+	time "time"
+)
+
+// MockRoles is a mock of Roles interface
+type MockRoles struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolesMockRecorder
+}
+
+// MockRolesMockRecorder is the mock recorder for MockRoles
+type MockRolesMockRecorder struct {
+	mock *MockRoles
+}
+
+// NewMockRoles creates a new mock instance
+func NewMockRoles(ctrl *gomock.Controller) *MockRoles {
+	mock := &MockRoles{ctrl: ctrl}
+	mock.recorder = &MockRolesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoles) EXPECT() *MockRolesMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockRoles) Insert(ctx context.Context, role *entities.Role) (*entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, role)
+	ret0, _ := ret[0].(*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockRolesMockRecorder) Insert(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRoles)(nil).Insert), ctx, role)
+}
+
+// FindOne mocks base method
+func (m *MockRoles) FindOne(ctx context.Context, name string) (*entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret0, _ := ret[0].(*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockRolesMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockRoles)(nil).FindOne), ctx, name)
+}
+
+// FindMany mocks base method
+func (m *MockRoles) FindMany(ctx context.Context, names []string) ([]*entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMany", ctx, names)
+	ret0, _ := ret[0].([]*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMany indicates an expected call of FindMany
+func (mr *MockRolesMockRecorder) FindMany(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockRoles)(nil).FindMany), ctx, names)
+}
+
+// SearchNames mocks base method
+func (m *MockRoles) SearchNames(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNames", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchNames indicates an expected call of SearchNames
+func (mr *MockRolesMockRecorder) SearchNames(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNames", reflect.TypeOf((*MockRoles)(nil).SearchNames), ctx)
+}
+
+// Update mocks base method
+func (m *MockRoles) Update(ctx context.Context, role *entities.Role) (*entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, role)
+	ret0, _ := ret[0].(*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockRolesMockRecorder) Update(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoles)(nil).Update), ctx, role)
+}
+
+// Delete mocks base method
+func (m *MockRoles) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRolesMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoles)(nil).Delete), ctx, name)
+}
+
+// MockAPIKeys is a mock of APIKeys interface
+type MockAPIKeys struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeysMockRecorder
+}
+
+// MockAPIKeysMockRecorder is the mock recorder for MockAPIKeys
+type MockAPIKeysMockRecorder struct {
+	mock *MockAPIKeys
+}
+
+// NewMockAPIKeys creates a new mock instance
+func NewMockAPIKeys(ctrl *gomock.Controller) *MockAPIKeys {
+	mock := &MockAPIKeys{ctrl: ctrl}
+	mock.recorder = &MockAPIKeysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPIKeys) EXPECT() *MockAPIKeysMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockAPIKeys) Insert(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, apiKey)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockAPIKeysMockRecorder) Insert(ctx, apiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAPIKeys)(nil).Insert), ctx, apiKey)
+}
+
+// FindOne mocks base method
+func (m *MockAPIKeys) FindOne(ctx context.Context, id uint64) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, id)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockAPIKeysMockRecorder) FindOne(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockAPIKeys)(nil).FindOne), ctx, id)
+}
+
+// FindOneByHash mocks base method
+func (m *MockAPIKeys) FindOneByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByHash", ctx, hash)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByHash indicates an expected call of FindOneByHash
+func (mr *MockAPIKeysMockRecorder) FindOneByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByHash", reflect.TypeOf((*MockAPIKeys)(nil).FindOneByHash), ctx, hash)
+}
+
+// Search mocks base method
+func (m *MockAPIKeys) Search(ctx context.Context, tenant string) ([]*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, tenant)
+	ret0, _ := ret[0].([]*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockAPIKeysMockRecorder) Search(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAPIKeys)(nil).Search), ctx, tenant)
+}
+
+// Update mocks base method
+func (m *MockAPIKeys) Update(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, apiKey)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockAPIKeysMockRecorder) Update(ctx, apiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAPIKeys)(nil).Update), ctx, apiKey)
+}
+
+// UpdateLastUsed mocks base method
+func (m *MockAPIKeys) UpdateLastUsed(ctx context.Context, id uint64, lastUsedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsed", ctx, id, lastUsedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsed indicates an expected call of UpdateLastUsed
+func (mr *MockAPIKeysMockRecorder) UpdateLastUsed(ctx, id, lastUsedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockAPIKeys)(nil).UpdateLastUsed), ctx, id, lastUsedAt)
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+type APIKey struct {
+	tableName struct{} `pg:"api_keys"` // nolint:unused,structcheck // reason
+
+	ID            uint64 `pg:",pk"`
+	Hash          string
+	Tenant        string   `pg:",use_zero"`
+	Username      string   `pg:",use_zero"`
+	Roles         []string `pg:",array"`
+	Permissions   []string `pg:",array"`
+	AllowedStores []string `pg:",array"`
+	AllowedCIDRs  []string `pg:"allowed_cidrs,array"`
+	ExpiresAt     *time.Time
+	RevokedAt     *time.Time
+	LastUsedAt    *time.Time
+	CreatedAt     time.Time `pg:"default:now()"`
+	UpdatedAt     time.Time `pg:"default:now()"`
+}
+
+func NewAPIKey(apiKey *entities.APIKey) *APIKey {
+	permissions := make([]string, len(apiKey.Permissions))
+	for i, permission := range apiKey.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	return &APIKey{
+		ID:            apiKey.ID,
+		Hash:          apiKey.Hash,
+		Tenant:        apiKey.Tenant,
+		Username:      apiKey.Username,
+		Roles:         apiKey.Roles,
+		Permissions:   permissions,
+		AllowedStores: apiKey.AllowedStores,
+		AllowedCIDRs:  apiKey.AllowedCIDRs,
+		ExpiresAt:     apiKey.ExpiresAt,
+		RevokedAt:     apiKey.RevokedAt,
+		LastUsedAt:    apiKey.LastUsedAt,
+		CreatedAt:     apiKey.CreatedAt,
+		UpdatedAt:     apiKey.UpdatedAt,
+	}
+}
+
+func (k *APIKey) ToEntity() *entities.APIKey {
+	permissions := make([]entities.Permission, len(k.Permissions))
+	for i, permission := range k.Permissions {
+		permissions[i] = entities.Permission(permission)
+	}
+
+	return &entities.APIKey{
+		ID:            k.ID,
+		Hash:          k.Hash,
+		Tenant:        k.Tenant,
+		Username:      k.Username,
+		Roles:         k.Roles,
+		Permissions:   permissions,
+		AllowedStores: k.AllowedStores,
+		AllowedCIDRs:  k.AllowedCIDRs,
+		ExpiresAt:     k.ExpiresAt,
+		RevokedAt:     k.RevokedAt,
+		LastUsedAt:    k.LastUsedAt,
+		CreatedAt:     k.CreatedAt,
+		UpdatedAt:     k.UpdatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+type Role struct {
+	tableName struct{} `pg:"roles"` // nolint:unused,structcheck // reason
+
+	Name        string    `pg:",pk"`
+	Permissions []string  `pg:",array,use_zero"`
+	CreatedAt   time.Time `pg:"default:now()"`
+	UpdatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewRole(role *entities.Role) *Role {
+	permissions := make([]string, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	return &Role{
+		Name:        role.Name,
+		Permissions: permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+func (r *Role) ToEntity() *entities.Role {
+	permissions := make([]entities.Permission, len(r.Permissions))
+	for i, permission := range r.Permissions {
+		permissions[i] = entities.Permission(permission)
+	}
+
+	return &entities.Role{
+		Name:        r.Name,
+		Permissions: permissions,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
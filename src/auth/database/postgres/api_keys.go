@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/database"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/models"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+type APIKeys struct {
+	pgClient postgres.Client
+}
+
+var _ database.APIKeys = &APIKeys{}
+
+func NewAPIKeys(pgClient postgres.Client) *APIKeys {
+	return &APIKeys{pgClient: pgClient}
+}
+
+func (k *APIKeys) Insert(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	apiKeyModel := models.NewAPIKey(apiKey)
+
+	err := k.pgClient.Insert(ctx, apiKeyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (k *APIKeys) FindOne(ctx context.Context, id uint64) (*entities.APIKey, error) {
+	apiKeyModel := &models.APIKey{ID: id}
+
+	err := k.pgClient.SelectPK(ctx, apiKeyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (k *APIKeys) FindOneByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	apiKeyModel := &models.APIKey{}
+
+	err := k.pgClient.SelectWhere(ctx, apiKeyModel, "hash = ?", []string{}, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (k *APIKeys) Search(ctx context.Context, tenant string) ([]*entities.APIKey, error) {
+	var apiKeyModels []*models.APIKey
+
+	var err error
+	if tenant == "" {
+		err = k.pgClient.Select(ctx, &apiKeyModels)
+	} else {
+		err = k.pgClient.SelectWhere(ctx, &apiKeyModels, "tenant = ?", []string{}, tenant)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(apiKeyModels, func(i, j int) bool {
+		return apiKeyModels[i].ID < apiKeyModels[j].ID
+	})
+
+	apiKeys := make([]*entities.APIKey, 0, len(apiKeyModels))
+	for _, apiKeyModel := range apiKeyModels {
+		apiKeys = append(apiKeys, apiKeyModel.ToEntity())
+	}
+
+	return apiKeys, nil
+}
+
+func (k *APIKeys) Update(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	apiKeyModel := models.NewAPIKey(apiKey)
+	apiKeyModel.UpdatedAt = time.Now()
+
+	err := k.pgClient.UpdatePK(ctx, apiKeyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update does not update the model, we must update and then get
+	return k.FindOne(ctx, apiKey.ID)
+}
+
+func (k *APIKeys) UpdateLastUsed(ctx context.Context, id uint64, lastUsedAt time.Time) error {
+	// Only the last usage is updated so that a concurrent revocation is not overwritten
+	var updatedID uint64
+	err := k.pgClient.QueryOne(ctx, &updatedID, "UPDATE api_keys SET last_used_at = ? WHERE id = ? RETURNING id", lastUsedAt, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/longfan78/quorum-key-manager/src/auth/database"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/models"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+)
+
+type Roles struct {
+	pgClient postgres.Client
+}
+
+var _ database.Roles = &Roles{}
+
+func NewRoles(pgClient postgres.Client) *Roles {
+	return &Roles{pgClient: pgClient}
+}
+
+func (r *Roles) Insert(ctx context.Context, role *entities.Role) (*entities.Role, error) {
+	roleModel := models.NewRole(role)
+
+	err := r.pgClient.Insert(ctx, roleModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleModel.ToEntity(), nil
+}
+
+func (r *Roles) FindOne(ctx context.Context, name string) (*entities.Role, error) {
+	roleModel := &models.Role{Name: name}
+
+	err := r.pgClient.SelectPK(ctx, roleModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleModel.ToEntity(), nil
+}
+
+func (r *Roles) FindMany(ctx context.Context, names []string) ([]*entities.Role, error) {
+	var roleModels []*models.Role
+
+	err := r.pgClient.SelectWhere(ctx, &roleModels, "name = ANY(?)", []string{}, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*entities.Role, 0, len(roleModels))
+	for _, roleModel := range roleModels {
+		roles = append(roles, roleModel.ToEntity())
+	}
+
+	return roles, nil
+}
+
+func (r *Roles) SearchNames(ctx context.Context) ([]string, error) {
+	var names []string
+
+	err := r.pgClient.Query(ctx, &names, "SELECT array_agg(name ORDER BY created_at ASC) FROM roles")
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+func (r *Roles) Update(ctx context.Context, role *entities.Role) (*entities.Role, error) {
+	roleModel := models.NewRole(role)
+	roleModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.UpdatePK(ctx, roleModel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update does not update the model, we must update and then get
+	return r.FindOne(ctx, role.Name)
+}
+
+func (r *Roles) Delete(ctx context.Context, name string) error {
+	err := r.pgClient.DeletePK(ctx, &models.Role{Name: name})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
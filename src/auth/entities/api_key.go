@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"net"
+	"time"
+)
+
+// APIKey is an API key issued by the key manager, of which only the hash is persisted
+type APIKey struct {
+	ID uint64
+	// Key is the API key itself, only known when the key is issued
+	Key           string
+	Hash          string
+	Tenant        string
+	Username      string
+	Roles         []string
+	Permissions   []Permission
+	AllowedStores []string
+	AllowedCIDRs  []string
+	ExpiresAt     *time.Time
+	RevokedAt     *time.Time
+	LastUsedAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// APIKeysConfig enables the API keys issued by the key manager
+type APIKeysConfig struct {
+	// LastUsedInterval is the minimum interval between two updates of the last usage of a key
+	LastUsedInterval time.Duration
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// IsAllowedIP indicates whether the key can be used from the given address, any address being allowed if no CIDR is set
+func (k *APIKey) IsAllowedIP(ip net.IP) bool {
+	if len(k.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	for _, cidr := range k.AllowedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err == nil && ip != nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,6 +10,7 @@ var ActionEncrypt OpAction = "encrypt"
 var ActionDelete OpAction = "delete"
 var ActionDestroy OpAction = "destroy"
 var ActionProxy OpAction = "proxy"
+var ActionApprove OpAction = "approve"
 
 var ResourceKey OpResource = "keys"
 var ResourceSecret OpResource = "secrets"
@@ -17,6 +18,11 @@ var ResourceEthAccount OpResource = "ethereum"
 var ResourceStore OpResource = "stores"
 var ResourceNode OpResource = "nodes"
 var ResourceAlias OpResource = "aliases"
+var ResourceRole OpResource = "roles"
+var ResourceVault OpResource = "vaults"
+var ResourceAudit OpResource = "audit"
+var ResourcePolicy OpResource = "policies"
+var ResourceAPIKey OpResource = "api-keys"
 
 type Operation struct {
 	Action   OpAction
@@ -11,6 +11,7 @@ const ReadSecret Permission = "read:secrets"
 const WriteSecret Permission = "write:secrets"
 const DeleteSecret Permission = "delete:secrets"
 const DestroySecret Permission = "destroy:secrets"
+const ApproveSecret Permission = "approve:secrets"
 
 const ReadKey Permission = "read:keys"
 const WriteKey Permission = "write:keys"
@@ -18,6 +19,7 @@ const DeleteKey Permission = "delete:keys"
 const DestroyKey Permission = "destroy:keys"
 const SignKey Permission = "sign:keys"
 const EncryptKey Permission = "encrypt:keys"
+const ApproveKey Permission = "approve:keys"
 
 const ReadEth Permission = "read:ethereum"
 const WriteEth Permission = "write:ethereum"
@@ -25,6 +27,7 @@ const DeleteEth Permission = "delete:ethereum"
 const DestroyEth Permission = "destroy:ethereum"
 const SignEth Permission = "sign:ethereum"
 const EncryptEth Permission = "encrypt:ethereum"
+const ApproveEth Permission = "approve:ethereum"
 
 const ProxyNode Permission = "proxy:nodes"
 
@@ -32,41 +35,111 @@ const ReadAlias Permission = "read:aliases"
 const WriteAlias Permission = "write:aliases"
 const DeleteAlias Permission = "delete:aliases"
 
+const ReadRole Permission = "read:roles"
+const WriteRole Permission = "write:roles"
+
+const ReadVault Permission = "read:vaults"
+const WriteVault Permission = "write:vaults"
+
+const ReadStore Permission = "read:stores"
+const WriteStore Permission = "write:stores"
+
+const ReadNode Permission = "read:nodes"
+const WriteNode Permission = "write:nodes"
+
+const ReadAudit Permission = "read:audit"
+
+const ReadPolicy Permission = "read:policies"
+const WritePolicy Permission = "write:policies"
+
+const ReadAPIKey Permission = "read:api-keys"
+const WriteAPIKey Permission = "write:api-keys"
+const DeleteAPIKey Permission = "delete:api-keys"
+
 func ListPermissions() []Permission {
 	return []Permission{
 		ReadSecret,
 		WriteSecret,
 		DeleteSecret,
 		DestroySecret,
+		ApproveSecret,
 		ReadKey,
 		WriteKey,
 		DeleteKey,
 		DestroyKey,
 		SignKey,
 		EncryptKey,
+		ApproveKey,
 		ReadEth,
 		WriteEth,
 		DeleteEth,
 		DestroyEth,
 		SignEth,
 		EncryptEth,
+		ApproveEth,
 		ProxyNode,
 		ReadAlias,
 		WriteAlias,
 		DeleteAlias,
+		ReadRole,
+		WriteRole,
+		ReadVault,
+		WriteVault,
+		ReadStore,
+		WriteStore,
+		ReadNode,
+		WriteNode,
+		ReadAudit,
+		ReadPolicy,
+		WritePolicy,
+		ReadAPIKey,
+		WriteAPIKey,
+		DeleteAPIKey,
+	}
+}
+
+// ListAdminPermissions returns the permissions administrating the key manager, which are only granted explicitly or
+// through a wildcard on their resource, such as "*:roles"
+func ListAdminPermissions() []Permission {
+	return []Permission{
+		ReadRole,
+		WriteRole,
+		ReadVault,
+		WriteVault,
+		ReadStore,
+		WriteStore,
+		ReadNode,
+		WriteNode,
+		ReadAudit,
+		ReadPolicy,
+		WritePolicy,
+		ReadAPIKey,
+		WriteAPIKey,
+		DeleteAPIKey,
 	}
 }
 
 func ListWildcardPermission(p string) []Permission {
-	all := ListPermissions()
 	parts := strings.Split(p, ":")
+	if len(parts) != 2 {
+		return nil
+	}
 	action, resource := parts[0], parts[1]
-	if action == "*" && resource == "*" {
-		return all
+
+	admin := map[Permission]bool{}
+	for _, ap := range ListAdminPermissions() {
+		admin[ap] = true
 	}
 
 	var included []Permission
-	for _, ip := range all {
+	for _, ip := range ListPermissions() {
+		if resource == "*" && admin[ip] {
+			continue
+		}
+		if action == "*" && resource == "*" {
+			included = append(included, ip)
+			continue
+		}
 		if action == "*" && strings.Contains(string(ip), fmt.Sprintf(":%s", resource)) {
 			included = append(included, ip)
 		}
@@ -8,11 +8,27 @@ import (
 
 func TestListWildcardPermission(t *testing.T) {
 	list := ListWildcardPermission("*:*")
-	assert.Equal(t, list, ListPermissions())
+	assert.Equal(t, list, []Permission{
+		ReadSecret, WriteSecret, DeleteSecret, DestroySecret, ApproveSecret,
+		ReadKey, WriteKey, DeleteKey, DestroyKey, SignKey, EncryptKey, ApproveKey,
+		ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth, ApproveEth,
+		ProxyNode, ReadAlias, WriteAlias, DeleteAlias,
+	})
 
 	list = ListWildcardPermission("read:*")
 	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth, ReadAlias})
 
+	list = ListWildcardPermission("write:*")
+	assert.Equal(t, list, []Permission{WriteSecret, WriteKey, WriteEth, WriteAlias})
+
+	list = ListWildcardPermission("*:roles")
+	assert.Equal(t, list, []Permission{ReadRole, WriteRole})
+
+	assert.Empty(t, ListWildcardPermission("*"))
+
 	list = ListWildcardPermission("*:ethereum")
-	assert.Equal(t, list, []Permission{ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth})
+	assert.Equal(t, list, []Permission{ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth, ApproveEth})
+
+	list = ListWildcardPermission("approve:*")
+	assert.Equal(t, list, []Permission{ApproveSecret, ApproveKey, ApproveEth})
 }
@@ -1,8 +1,12 @@
 package entities
 
+import "time"
+
 type Role struct {
 	Name        string
 	Permissions []Permission
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 const AnonymousRole = "anonymous"
@@ -1,6 +1,8 @@
 package testdata
 
 import (
+	"time"
+
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 )
 
@@ -11,3 +13,29 @@ func FakeUserClaims() *entities.UserClaims {
 		Roles:       []string{"guest", "admin"},
 	}
 }
+
+func FakeRole() *entities.Role {
+	return &entities.Role{
+		Name:        "signer",
+		Permissions: []entities.Permission{entities.ReadKey, entities.SignKey},
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func FakeAPIKey() *entities.APIKey {
+	expiresAt := time.Now().Add(time.Hour)
+	return &entities.APIKey{
+		ID:            1,
+		Hash:          "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		Tenant:        "tenantOne",
+		Username:      "ci",
+		Roles:         []string{"signer"},
+		Permissions:   []entities.Permission{entities.ReadKey},
+		AllowedStores: []string{"my-store"},
+		AllowedCIDRs:  []string{"10.0.0.0/8"},
+		ExpiresAt:     &expiresAt,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
@@ -22,6 +22,27 @@ type UserInfo struct {
 
 	// Permissions specify
 	Permissions []Permission
+
+	// APIKeyID identifies the API key which authenticated the user, if any
+	APIKeyID string
+
+	// AllowedStores restricts the stores the user can access, all the stores being allowed if empty
+	AllowedStores []string
+}
+
+// IsStoreAllowed indicates whether the user can access the given store
+func (u *UserInfo) IsStoreAllowed(storeName string) bool {
+	if len(u.AllowedStores) == 0 {
+		return true
+	}
+
+	for _, name := range u.AllowedStores {
+		if name == storeName {
+			return true
+		}
+	}
+
+	return false
 }
 
 func NewWildcardUser() *UserInfo {
@@ -7,8 +7,9 @@ package mock
 import (
 	context "context"
 	tls "crypto/tls"
-	entities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	net "net"
 	reflect "reflect"
 )
 
@@ -51,18 +52,18 @@ func (mr *MockAuthenticatorMockRecorder) AuthenticateJWT(ctx, token interface{})
 }
 
 // AuthenticateAPIKey mocks base method
-func (m *MockAuthenticator) AuthenticateAPIKey(ctx context.Context, apiKey []byte) (*entities.UserInfo, error) {
+func (m *MockAuthenticator) AuthenticateAPIKey(ctx context.Context, apiKey []byte, clientIP net.IP) (*entities.UserInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, apiKey)
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, apiKey, clientIP)
 	ret0, _ := ret[0].(*entities.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey
-func (mr *MockAuthenticatorMockRecorder) AuthenticateAPIKey(ctx, apiKey interface{}) *gomock.Call {
+func (mr *MockAuthenticatorMockRecorder) AuthenticateAPIKey(ctx, apiKey, clientIP interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockAuthenticator)(nil).AuthenticateAPIKey), ctx, apiKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockAuthenticator)(nil).AuthenticateAPIKey), ctx, apiKey, clientIP)
 }
 
 // AuthenticateTLS mocks base method
@@ -159,11 +160,12 @@ func (m *MockRoles) EXPECT() *MockRolesMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockRoles) Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) error {
+func (m *MockRoles) Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, name, permissions, userInfo)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
@@ -202,6 +204,35 @@ func (mr *MockRolesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoles)(nil).List), ctx, userInfo)
 }
 
+// Update mocks base method
+func (m *MockRoles) Update(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, name, permissions, userInfo)
+	ret0, _ := ret[0].(*entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockRolesMockRecorder) Update(ctx, name, permissions, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoles)(nil).Update), ctx, name, permissions, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockRoles) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRolesMockRecorder) Delete(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoles)(nil).Delete), ctx, name, userInfo)
+}
+
 // UserPermissions mocks base method
 func (m *MockRoles) UserPermissions(ctx context.Context, userInfo *entities.UserInfo) []entities.Permission {
 	m.ctrl.T.Helper()
@@ -215,3 +246,86 @@ func (mr *MockRolesMockRecorder) UserPermissions(ctx, userInfo interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPermissions", reflect.TypeOf((*MockRoles)(nil).UserPermissions), ctx, userInfo)
 }
+
+// MockAPIKeys is a mock of APIKeys interface
+type MockAPIKeys struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeysMockRecorder
+}
+
+// MockAPIKeysMockRecorder is the mock recorder for MockAPIKeys
+type MockAPIKeysMockRecorder struct {
+	mock *MockAPIKeys
+}
+
+// NewMockAPIKeys creates a new mock instance
+func NewMockAPIKeys(ctrl *gomock.Controller) *MockAPIKeys {
+	mock := &MockAPIKeys{ctrl: ctrl}
+	mock.recorder = &MockAPIKeysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPIKeys) EXPECT() *MockAPIKeysMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method
+func (m *MockAPIKeys) Issue(ctx context.Context, apiKey *entities.APIKey, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, apiKey, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue
+func (mr *MockAPIKeysMockRecorder) Issue(ctx, apiKey, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockAPIKeys)(nil).Issue), ctx, apiKey, userInfo)
+}
+
+// Get mocks base method
+func (m *MockAPIKeys) Get(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockAPIKeysMockRecorder) Get(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAPIKeys)(nil).Get), ctx, id, userInfo)
+}
+
+// List mocks base method
+func (m *MockAPIKeys) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockAPIKeysMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeys)(nil).List), ctx, userInfo)
+}
+
+// Revoke mocks base method
+func (m *MockAPIKeys) Revoke(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke
+func (mr *MockAPIKeysMockRecorder) Revoke(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeys)(nil).Revoke), ctx, id, userInfo)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"crypto/tls"
+	"net"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 )
@@ -12,7 +13,7 @@ import (
 // Authenticator retrieves user info given an authentication method
 type Authenticator interface {
 	AuthenticateJWT(ctx context.Context, token string) (*entities.UserInfo, error)
-	AuthenticateAPIKey(ctx context.Context, apiKey []byte) (*entities.UserInfo, error)
+	AuthenticateAPIKey(ctx context.Context, apiKey []byte, clientIP net.IP) (*entities.UserInfo, error)
 	AuthenticateTLS(ctx context.Context, connState *tls.ConnectionState) (*entities.UserInfo, error)
 }
 
@@ -24,8 +25,18 @@ type Authorizator interface {
 
 // Roles allows managing permissions and roles
 type Roles interface {
-	Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) error
+	Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error)
 	Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities.Role, error)
 	List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error)
+	Update(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error)
+	Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error
 	UserPermissions(ctx context.Context, userInfo *entities.UserInfo) []entities.Permission
 }
+
+// APIKeys allows issuing and revoking API keys
+type APIKeys interface {
+	Issue(ctx context.Context, apiKey *entities.APIKey, userInfo *entities.UserInfo) (*entities.APIKey, error)
+	Get(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error)
+	List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error)
+	Revoke(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error)
+}
@@ -0,0 +1,65 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/auth/database"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+const keySize = 32
+
+type APIKeys struct {
+	db      database.APIKeys
+	rolesDB database.Roles
+	roles   auth.Roles
+	logger  log.Logger
+}
+
+var _ auth.APIKeys = &APIKeys{}
+
+func New(db database.APIKeys, rolesDB database.Roles, roles auth.Roles, logger log.Logger) *APIKeys {
+	return &APIKeys{
+		db:      db,
+		rolesDB: rolesDB,
+		roles:   roles,
+		logger:  logger,
+	}
+}
+
+// findOne gets an API key, hiding the keys of other tenants
+func (i *APIKeys) findOne(ctx context.Context, id uint64, userInfo *entities.UserInfo, logger log.Logger) (*entities.APIKey, error) {
+	apiKey, err := i.db.FindOne(ctx, id)
+	if err != nil {
+		errMessage := "failed to get api key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if userInfo.Tenant != "" && apiKey.Tenant != userInfo.Tenant {
+		errMessage := "api key not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	return apiKey, nil
+}
+
+// newKey generates a random API key and returns it with its sha256 hash
+func newKey() (key, hash string, err error) {
+	b := make([]byte, keySize)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	key = base64.RawURLEncoding.EncodeToString(b)
+	return key, fmt.Sprintf("%x", sha256.Sum256([]byte(key))), nil
+}
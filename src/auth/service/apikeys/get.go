@@ -0,0 +1,26 @@
+package apikeys
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (i *APIKeys) Get(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	logger := i.logger.With("id", id)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := i.findOne(ctx, id, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("api key found successfully")
+	return apiKey, nil
+}
@@ -0,0 +1,171 @@
+package apikeys
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+func (i *APIKeys) Issue(ctx context.Context, apiKey *entities.APIKey, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	logger := i.logger.With("tenant", apiKey.Tenant, "username", apiKey.Username, "roles", apiKey.Roles, "permissions", apiKey.Permissions)
+	logger.Debug("issuing api key")
+
+	permissions := i.roles.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(permissions, userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	// Users bound to a tenant can only issue keys for their tenant
+	if userInfo.Tenant != "" {
+		if apiKey.Tenant != "" && apiKey.Tenant != userInfo.Tenant {
+			errMessage := "api keys can only be issued for the tenant of the user"
+			logger.Error(errMessage)
+			return nil, errors.ForbiddenError(errMessage)
+		}
+		apiKey.Tenant = userInfo.Tenant
+	}
+
+	// Users can only issue keys for themselves, so that the requests of a key are attributed to its issuer
+	if userInfo.Username != "" {
+		if apiKey.Username != "" && apiKey.Username != userInfo.Username {
+			errMessage := "api keys can only be issued for the user"
+			logger.Error(errMessage)
+			return nil, errors.ForbiddenError(errMessage)
+		}
+		apiKey.Username = userInfo.Username
+	}
+
+	err = i.validate(apiKey, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.checkScope(ctx, apiKey, permissions, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	key, hash, err := newKey()
+	if err != nil {
+		errMessage := "failed to generate api key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+	apiKey.Hash = hash
+
+	issuedKey, err := i.db.Insert(ctx, apiKey)
+	if err != nil {
+		errMessage := "failed to issue api key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// The key is only returned once, only its hash being persisted
+	issuedKey.Key = key
+
+	logger.Info("api key issued successfully", "id", issuedKey.ID)
+	return issuedKey, nil
+}
+
+func (i *APIKeys) validate(apiKey *entities.APIKey, logger log.Logger) error {
+	if strings.Contains(apiKey.Tenant, "|") || strings.Contains(apiKey.Username, "|") {
+		errMessage := "tenant and username must not contain '|'"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	for _, permission := range apiKey.Permissions {
+		if !strings.Contains(string(permission), ":") {
+			errMessage := "invalid permission format"
+			logger.Error(errMessage, "permission", permission)
+			return errors.InvalidParameterError(errMessage)
+		}
+	}
+
+	for _, cidr := range apiKey.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			errMessage := "invalid CIDR"
+			logger.WithError(err).Error(errMessage, "cidr", cidr)
+			return errors.InvalidParameterError(errMessage)
+		}
+	}
+
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		errMessage := "expiration date must be in the future"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	return nil
+}
+
+// checkScope verifies that the key does not grant more than the permissions and stores of the issuing user
+func (i *APIKeys) checkScope(ctx context.Context, apiKey *entities.APIKey, userPermissions []entities.Permission, userInfo *entities.UserInfo, logger log.Logger) error {
+	keyPermissions := expandPermissions(apiKey.Permissions)
+	for _, roleName := range apiKey.Roles {
+		role, err := i.rolesDB.FindOne(ctx, roleName)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				errMessage := "role does not exist"
+				logger.Error(errMessage, "role", roleName)
+				return errors.InvalidParameterError(errMessage)
+			}
+
+			errMessage := "failed to get role"
+			logger.WithError(err).Error(errMessage, "role", roleName)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		keyPermissions = append(keyPermissions, expandPermissions(role.Permissions)...)
+	}
+
+	granted := map[entities.Permission]bool{}
+	for _, permission := range userPermissions {
+		granted[permission] = true
+	}
+
+	for _, permission := range keyPermissions {
+		if !granted[permission] {
+			errMessage := "api key cannot be granted permissions the user does not have"
+			logger.Error(errMessage, "permission", permission)
+			return errors.ForbiddenError(errMessage)
+		}
+	}
+
+	if len(userInfo.AllowedStores) > 0 {
+		if len(apiKey.AllowedStores) == 0 {
+			apiKey.AllowedStores = userInfo.AllowedStores
+		}
+
+		for _, storeName := range apiKey.AllowedStores {
+			if !userInfo.IsStoreAllowed(storeName) {
+				errMessage := "api key cannot be granted access to stores the user cannot access"
+				logger.Error(errMessage, "store", storeName)
+				return errors.ForbiddenError(errMessage)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandPermissions(permissions []entities.Permission) []entities.Permission {
+	var expanded []entities.Permission
+	for _, permission := range permissions {
+		if strings.Contains(string(permission), "*") {
+			expanded = append(expanded, entities.ListWildcardPermission(string(permission))...)
+		} else {
+			expanded = append(expanded, permission)
+		}
+	}
+
+	return expanded
+}
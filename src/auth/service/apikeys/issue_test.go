@@ -0,0 +1,147 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities/testdata"
+	mock2 "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssue(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockAPIKeys(ctrl)
+	rolesDB := mock.NewMockRoles(ctrl)
+	roles := mock2.NewMockRoles(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	service := New(db, rolesDB, roles, logger)
+
+	userInfo := &entities.UserInfo{Tenant: "tenantOne", AllowedStores: []string{"my-store", "other-store"}}
+	userPermissions := []entities.Permission{entities.WriteAPIKey, entities.ReadKey, entities.SignKey}
+
+	newAPIKey := func() *entities.APIKey {
+		return &entities.APIKey{
+			Username:    "ci",
+			Roles:       []string{"signer"},
+			Permissions: []entities.Permission{entities.ReadKey},
+		}
+	}
+
+	t.Run("should issue an api key scoped to the tenant and stores of the user", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		rolesDB.EXPECT().FindOne(gomock.Any(), "signer").Return(testdata.FakeRole(), nil)
+		db.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+			assert.Equal(t, "tenantOne", apiKey.Tenant)
+			assert.Equal(t, userInfo.AllowedStores, apiKey.AllowedStores)
+			assert.Empty(t, apiKey.Key)
+			inserted := *apiKey
+			inserted.ID = 1
+			return &inserted, nil
+		})
+
+		apiKey, err := service.Issue(ctx, newAPIKey(), userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), apiKey.ID)
+		assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(apiKey.Key))), apiKey.Hash)
+	})
+
+	t.Run("should fail with ForbiddenError if the key is issued for another tenant", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		apiKey := newAPIKey()
+		apiKey.Tenant = "tenantTwo"
+
+		_, err := service.Issue(ctx, apiKey, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should issue an api key for the user", func(t *testing.T) {
+		namedUser := &entities.UserInfo{Username: "alice", Tenant: "tenantOne"}
+		roles.EXPECT().UserPermissions(gomock.Any(), namedUser).Return(userPermissions)
+		rolesDB.EXPECT().FindOne(gomock.Any(), "signer").Return(testdata.FakeRole(), nil)
+		db.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+			assert.Equal(t, "alice", apiKey.Username)
+			return apiKey, nil
+		})
+		apiKey := newAPIKey()
+		apiKey.Username = ""
+
+		_, err := service.Issue(ctx, apiKey, namedUser)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with ForbiddenError if the key is issued for another user", func(t *testing.T) {
+		namedUser := &entities.UserInfo{Username: "alice", Tenant: "tenantOne"}
+		roles.EXPECT().UserPermissions(gomock.Any(), namedUser).Return(userPermissions)
+
+		_, err := service.Issue(ctx, newAPIKey(), namedUser)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the key grants permissions the user does not have", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		apiKey := newAPIKey()
+		apiKey.Roles = nil
+		apiKey.Permissions = []entities.Permission{"*:keys"}
+
+		_, err := service.Issue(ctx, apiKey, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the key grants access to stores the user cannot access", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		rolesDB.EXPECT().FindOne(gomock.Any(), "signer").Return(testdata.FakeRole(), nil)
+		apiKey := newAPIKey()
+		apiKey.AllowedStores = []string{"my-store", "admin-store"}
+
+		_, err := service.Issue(ctx, apiKey, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if a role does not exist", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		rolesDB.EXPECT().FindOne(gomock.Any(), "signer").Return(nil, errors.NotFoundError("error"))
+
+		_, err := service.Issue(ctx, newAPIKey(), userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if expiration date is in the past", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userPermissions)
+		apiKey := newAPIKey()
+		expiresAt := time.Now().Add(-time.Hour)
+		apiKey.ExpiresAt = &expiresAt
+
+		_, err := service.Issue(ctx, apiKey, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if user cannot write api keys", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{entities.ReadKey})
+
+		_, err := service.Issue(ctx, newAPIKey(), userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
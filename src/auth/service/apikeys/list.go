@@ -0,0 +1,28 @@
+package apikeys
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+// List lists the API keys of the tenant of the user, or of all the tenants if the user has no tenant
+func (i *APIKeys) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error) {
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, i.logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := i.db.Search(ctx, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to list api keys"
+		i.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	i.logger.Debug("api keys listed successfully")
+	return apiKeys, nil
+}
@@ -0,0 +1,43 @@
+package apikeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (i *APIKeys) Revoke(ctx context.Context, id uint64, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	logger := i.logger.With("id", id)
+	logger.Debug("revoking api key")
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := i.findOne(ctx, id, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey.IsRevoked() {
+		logger.Debug("api key already revoked")
+		return apiKey, nil
+	}
+
+	now := time.Now().UTC()
+	apiKey.RevokedAt = &now
+	apiKey, err = i.db.Update(ctx, apiKey)
+	if err != nil {
+		errMessage := "failed to revoke api key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("api key revoked successfully")
+	return apiKey, nil
+}
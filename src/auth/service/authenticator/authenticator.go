@@ -6,11 +6,16 @@ import (
 	tls2 "crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/pkg/tls"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/auth/database"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
@@ -25,17 +30,30 @@ const (
 type Authenticator struct {
 	logger       log.Logger
 	jwtValidator jwt.Validator
+	mux          sync.RWMutex
 	apiKeyClaims map[string]*entities.UserClaims
 	rootCAs      *x509.CertPool
+	apiKeys      database.APIKeys
+	apiKeysCfg   *entities.APIKeysConfig
 }
 
 var _ auth.Authenticator = &Authenticator{}
 
-func New(jwtValidator jwt.Validator, apiKeyClaims map[string]*entities.UserClaims, rootCAs *x509.CertPool, logger log.Logger) *Authenticator {
+// New creates an authenticator, the API keys issued by the key manager being only accepted if apiKeysCfg is not nil
+func New(
+	jwtValidator jwt.Validator,
+	apiKeyClaims map[string]*entities.UserClaims,
+	rootCAs *x509.CertPool,
+	apiKeys database.APIKeys,
+	apiKeysCfg *entities.APIKeysConfig,
+	logger log.Logger,
+) *Authenticator {
 	return &Authenticator{
 		jwtValidator: jwtValidator,
 		apiKeyClaims: apiKeyClaims,
 		rootCAs:      rootCAs,
+		apiKeys:      apiKeys,
+		apiKeysCfg:   apiKeysCfg,
 		logger:       logger,
 	}
 }
@@ -66,8 +84,29 @@ func (authen *Authenticator) AuthenticateJWT(ctx context.Context, token string)
 	return authen.userInfoFromClaims(JWTAuthMode, claims), nil
 }
 
-func (authen *Authenticator) AuthenticateAPIKey(_ context.Context, apiKey []byte) (*entities.UserInfo, error) {
-	if authen.apiKeyClaims == nil {
+// SetAPIKeys replaces the accepted API keys, revoking the ones that are no longer present
+func (authen *Authenticator) SetAPIKeys(apiKeyClaims map[string]*entities.UserClaims) {
+	authen.mux.Lock()
+	defer authen.mux.Unlock()
+
+	authen.apiKeyClaims = apiKeyClaims
+}
+
+// SetRootCAs replaces the certificate authorities of the client certificates
+func (authen *Authenticator) SetRootCAs(rootCAs *x509.CertPool) {
+	authen.mux.Lock()
+	defer authen.mux.Unlock()
+
+	authen.rootCAs = rootCAs
+}
+
+// AuthenticateAPIKey looks up the api key in the static keys first, then in the keys issued by the key manager
+func (authen *Authenticator) AuthenticateAPIKey(ctx context.Context, apiKey []byte, clientIP net.IP) (*entities.UserInfo, error) {
+	authen.mux.RLock()
+	apiKeyClaims := authen.apiKeyClaims
+	authen.mux.RUnlock()
+
+	if apiKeyClaims == nil && authen.apiKeysCfg == nil {
 		errMessage := "api key authentication method is not enabled"
 		authen.logger.Error(errMessage)
 		return nil, errors.UnauthorizedError(errMessage)
@@ -76,19 +115,84 @@ func (authen *Authenticator) AuthenticateAPIKey(_ context.Context, apiKey []byte
 	authen.logger.Debug("extracting user info from api key")
 
 	apiKeySha256 := fmt.Sprintf("%x", sha256.Sum256(apiKey))
-	claims, ok := authen.apiKeyClaims[apiKeySha256]
-	if !ok {
-		errMessage := "invalid api key"
-		authen.logger.Warn(errMessage)
+	if claims, ok := apiKeyClaims[apiKeySha256]; ok {
+		userInfo := authen.userInfoFromClaims(APIKeyAuthMode, claims)
+		userInfo.APIKeyID = apiKeySha256
+		return userInfo, nil
+	}
+
+	if authen.apiKeysCfg != nil {
+		return authen.authenticateIssuedAPIKey(ctx, apiKeySha256, clientIP)
+	}
+
+	errMessage := "invalid api key"
+	authen.logger.Warn(errMessage)
+	return nil, errors.UnauthorizedError(errMessage)
+}
+
+func (authen *Authenticator) authenticateIssuedAPIKey(ctx context.Context, apiKeySha256 string, clientIP net.IP) (*entities.UserInfo, error) {
+	apiKey, err := authen.apiKeys.FindOneByHash(ctx, apiKeySha256)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			errMessage := "invalid api key"
+			authen.logger.Warn(errMessage)
+			return nil, errors.UnauthorizedError(errMessage)
+		}
+
+		errMessage := "failed to get api key"
+		authen.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger := authen.logger.With("id", apiKey.ID, "tenant", apiKey.Tenant)
+	now := time.Now()
+	switch {
+	case apiKey.IsRevoked():
+		errMessage := "api key is revoked"
+		logger.Warn(errMessage)
+		return nil, errors.UnauthorizedError(errMessage)
+	case apiKey.IsExpired(now):
+		errMessage := "api key is expired"
+		logger.Warn(errMessage)
+		return nil, errors.UnauthorizedError(errMessage)
+	case !apiKey.IsAllowedIP(clientIP):
+		errMessage := "api key is not allowed from this address"
+		logger.Warn(errMessage, "client_ip", clientIP.String())
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	return authen.userInfoFromClaims(APIKeyAuthMode, claims), nil
+	// The last usage is only updated once per interval to avoid a write on every request
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= authen.apiKeysCfg.LastUsedInterval {
+		err = authen.apiKeys.UpdateLastUsed(ctx, apiKey.ID, now.UTC())
+		if err != nil {
+			logger.WithError(err).Warn("failed to update last usage of api key")
+		}
+	}
+
+	permissions := make([]string, len(apiKey.Permissions))
+	for i, permission := range apiKey.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	userInfo := authen.userInfoFromClaims(APIKeyAuthMode, &entities.UserClaims{
+		Tenant:      apiKey.Tenant,
+		Permissions: permissions,
+		Roles:       apiKey.Roles,
+	})
+	userInfo.Username = apiKey.Username
+	userInfo.APIKeyID = strconv.FormatUint(apiKey.ID, 10)
+	userInfo.AllowedStores = apiKey.AllowedStores
+
+	return userInfo, nil
 }
 
 // AuthenticateTLS checks rootCAs and retrieve user info
-func (authen Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.ConnectionState) (*entities.UserInfo, error) {
-	if authen.rootCAs == nil {
+func (authen *Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.ConnectionState) (*entities.UserInfo, error) {
+	authen.mux.RLock()
+	rootCAs := authen.rootCAs
+	authen.mux.RUnlock()
+
+	if rootCAs == nil {
 		errMessage := "tls authentication method is not enabled"
 		authen.logger.Error(errMessage)
 		return nil, errors.UnauthorizedError(errMessage)
@@ -100,7 +204,7 @@ func (authen Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.C
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	err := tls.VerifyCertificateAuthority(connState.PeerCertificates, connState.ServerName, authen.rootCAs, true)
+	err := tls.VerifyCertificateAuthority(connState.PeerCertificates, connState.ServerName, rootCAs, true)
 	if err != nil {
 		errMessage := "invalid tls certificate"
 		authen.logger.WithError(err).Warn(errMessage)
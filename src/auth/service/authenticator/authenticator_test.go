@@ -6,11 +6,14 @@ import (
 	tls2 "crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	mock2 "github.com/longfan78/quorum-key-manager/src/infra/log/mock"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	mock3 "github.com/longfan78/quorum-key-manager/src/auth/database/mock"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities/testdata"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt/mock"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
@@ -76,7 +79,7 @@ func (s *authenticatorTestSuite) SetupTest() {
 	s.mockJWTValidator = mock.NewMockValidator(ctrl)
 	s.logger = testutils2.NewMockLogger(ctrl)
 
-	s.auth = New(s.mockJWTValidator, s.userClaims, caCertPool, s.logger)
+	s.auth = New(s.mockJWTValidator, s.userClaims, caCertPool, nil, nil, s.logger)
 }
 
 func (s *authenticatorTestSuite) TestAuthenticateJWT() {
@@ -107,7 +110,7 @@ func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 		userInfo, err := s.auth.AuthenticateJWT(ctx, token)
 
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), entities.NewWildcardUser().Permissions, userInfo.Permissions)
+		assert.Equal(s.T(), entities.ListWildcardPermission("*:*"), userInfo.Permissions)
 	})
 
 	s.Run("should return UnauthorizedError if the token fails validation", func() {
@@ -130,7 +133,7 @@ func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 	})
 
 	s.Run("should return UnauthorizedError if the authentication method is not enabled", func() {
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, s.logger)
 
 		userInfo, err := auth.AuthenticateJWT(ctx, token)
 
@@ -143,7 +146,7 @@ func (s *authenticatorTestSuite) TestAuthenticateAPIKey() {
 	ctx := context.Background()
 
 	s.Run("should authenticate with api key successfully", func() {
-		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey))
+		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey), nil)
 
 		require.NoError(s.T(), err)
 		assert.Equal(s.T(), "Alice", userInfo.Username)
@@ -154,29 +157,125 @@ func (s *authenticatorTestSuite) TestAuthenticateAPIKey() {
 	})
 
 	s.Run("should authenticate an api key successfully with wildcard permissions", func() {
-		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte(bobAPIKey))
+		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte(bobAPIKey), nil)
 
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), entities.NewWildcardUser().Permissions, userInfo.Permissions)
+		assert.Equal(s.T(), entities.ListWildcardPermission("*:*"), userInfo.Permissions)
 	})
 
 	s.Run("should return UnauthorizedError if api key is not found", func() {
-		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte("invalid-key"))
+		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte("invalid-key"), nil)
 
 		require.Nil(s.T(), userInfo)
 		assert.True(s.T(), errors.IsUnauthorizedError(err))
 	})
 
 	s.Run("should return UnauthorizedError if the authentication method is not enabled", func() {
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, s.logger)
 
-		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey))
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey), nil)
 
 		require.Nil(s.T(), userInfo)
 		assert.True(s.T(), errors.IsUnauthorizedError(err))
 	})
 }
 
+func (s *authenticatorTestSuite) TestAuthenticateIssuedAPIKey() {
+	ctx := context.Background()
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	apiKeys := mock3.NewMockAPIKeys(ctrl)
+	auth := New(nil, s.userClaims, nil, apiKeys, &entities.APIKeysConfig{LastUsedInterval: time.Minute}, s.logger)
+	key := "issuedAPIKey"
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(key)))
+	clientIP := net.ParseIP("10.1.2.3")
+
+	s.Run("should authenticate an issued api key successfully and update its last usage", func() {
+		apiKey := testdata.FakeAPIKey()
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(apiKey, nil)
+		apiKeys.EXPECT().UpdateLastUsed(gomock.Any(), apiKey.ID, gomock.Any()).Return(nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), apiKey.Tenant, userInfo.Tenant)
+		assert.Equal(s.T(), apiKey.Username, userInfo.Username)
+		assert.Equal(s.T(), apiKey.Roles, userInfo.Roles)
+		assert.Equal(s.T(), apiKey.Permissions, userInfo.Permissions)
+		assert.Equal(s.T(), apiKey.AllowedStores, userInfo.AllowedStores)
+		assert.Equal(s.T(), APIKeyAuthMode, userInfo.AuthMode)
+	})
+
+	s.Run("should not update the last usage of a recently used api key", func() {
+		apiKey := testdata.FakeAPIKey()
+		lastUsedAt := time.Now().Add(-time.Second)
+		apiKey.LastUsedAt = &lastUsedAt
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(apiKey, nil)
+
+		_, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should prefer the static api keys", func() {
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey), clientIP)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "Alice", userInfo.Username)
+	})
+
+	s.Run("should return UnauthorizedError if api key is revoked", func() {
+		apiKey := testdata.FakeAPIKey()
+		revokedAt := time.Now()
+		apiKey.RevokedAt = &revokedAt
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(apiKey, nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if api key is expired", func() {
+		apiKey := testdata.FakeAPIKey()
+		expiresAt := time.Now().Add(-time.Second)
+		apiKey.ExpiresAt = &expiresAt
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(apiKey, nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if client address is not allowed", func() {
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(testdata.FakeAPIKey(), nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(key), net.ParseIP("192.168.1.1"))
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if api key is not found", func() {
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(nil, errors.NotFoundError("error"))
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should fail with same error if the api key cannot be retrieved", func() {
+		apiKeys.EXPECT().FindOneByHash(gomock.Any(), hash).Return(nil, errors.PostgresError("error"))
+
+		_, err := auth.AuthenticateAPIKey(ctx, []byte(key), clientIP)
+
+		assert.True(s.T(), errors.IsPostgresError(err))
+	})
+}
+
 func (s *authenticatorTestSuite) TestAuthenticateTLS() {
 	ctx := context.Background()
 
@@ -226,7 +325,7 @@ func (s *authenticatorTestSuite) TestAuthenticateTLS() {
 		}
 		connState.HandshakeComplete = false
 
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, s.logger)
 
 		userInfo, err := auth.AuthenticateTLS(ctx, connState)
 
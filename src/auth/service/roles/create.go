@@ -3,25 +3,75 @@ package roles
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
 )
 
-func (i *Roles) Create(ctx context.Context, name string, permissions []entities.Permission, _ *entities.UserInfo) error {
+func (i *Roles) Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error) {
 	logger := i.logger.With("name", name, "permissions", permissions)
 	logger.Debug("creating role")
 
-	// TODO: Implement {Resource/Role}BAC for roles
+	userPermissions := i.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(userPermissions, userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceRole})
+	if err != nil {
+		return nil, err
+	}
 
-	if _, ok := i.roles[name]; ok {
-		errMessage := fmt.Sprintf("role %s already exist", name)
-		logger.Error(errMessage)
-		return errors.AlreadyExistsError(errMessage)
+	err = checkGrant(permissions, userPermissions, logger)
+	if err != nil {
+		return nil, err
 	}
 
-	i.createRole(ctx, name, permissions)
+	role, err := i.db.Insert(ctx, &entities.Role{Name: name, Permissions: permissions})
+	if err != nil {
+		if errors.IsStatusConflictError(err) {
+			errMessage := fmt.Sprintf("role %s already exist", name)
+			logger.Error(errMessage)
+			return nil, errors.AlreadyExistsError(errMessage)
+		}
+
+		errMessage := "failed to create role"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
 
 	logger.Info("role created successfully")
+	return role, nil
+}
+
+// checkGrant verifies that a role does not grant permissions the user does not have, roles being shared by all the
+// tenants
+func checkGrant(permissions, userPermissions []entities.Permission, logger log.Logger) error {
+	granted := map[entities.Permission]bool{}
+	for _, permission := range userPermissions {
+		granted[permission] = true
+	}
+
+	for _, permission := range expandPermissions(permissions) {
+		if !granted[permission] {
+			errMessage := "role cannot grant permissions the user does not have"
+			logger.Error(errMessage, "permission", permission)
+			return errors.ForbiddenError(errMessage)
+		}
+	}
+
 	return nil
 }
+
+func expandPermissions(permissions []entities.Permission) []entities.Permission {
+	var expanded []entities.Permission
+	for _, permission := range permissions {
+		if strings.Contains(string(permission), "*") {
+			expanded = append(expanded, entities.ListWildcardPermission(string(permission))...)
+		} else {
+			expanded = append(expanded, permission)
+		}
+	}
+
+	return expanded
+}
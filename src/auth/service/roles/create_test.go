@@ -0,0 +1,44 @@
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockRoles(ctrl)
+	service := New(db, testutils.NewMockLogger(ctrl))
+
+	userInfo := &entities.UserInfo{
+		Tenant:      "tenantOne",
+		Permissions: append(entities.ListWildcardPermission("*:*"), entities.WriteRole),
+	}
+
+	t.Run("should create a role granting permissions of the user", func(t *testing.T) {
+		permissions := []entities.Permission{"read:*", entities.SignEth}
+		db.EXPECT().Insert(gomock.Any(), &entities.Role{Name: "signer", Permissions: permissions}).Return(&entities.Role{Name: "signer"}, nil)
+
+		role, err := service.Create(ctx, "signer", permissions, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, "signer", role.Name)
+	})
+
+	t.Run("should fail with ForbiddenError if the role grants permissions the user does not have", func(t *testing.T) {
+		_, err := service.Create(ctx, "admin", []entities.Permission{"*:roles"}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -0,0 +1,29 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (i *Roles) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	logger := i.logger.With("name", name)
+
+	resolver := authorizator.New(i.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceRole})
+	if err != nil {
+		return err
+	}
+
+	err = i.db.Delete(ctx, name)
+	if err != nil {
+		errMessage := "failed to delete role"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("role deleted successfully")
+	return nil
+}
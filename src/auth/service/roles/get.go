@@ -3,19 +3,27 @@ package roles
 import (
 	"context"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
 )
 
-func (i *Roles) Get(ctx context.Context, name string, _ *entities.UserInfo) (*entities.Role, error) {
+func (i *Roles) Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities.Role, error) {
 	logger := i.logger.With("name", name)
 
-	// TODO: Implement {Resource/Role}BAC for roles
-
-	vault, err := i.getRole(ctx, name)
+	resolver := authorizator.New(i.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceRole})
 	if err != nil {
 		return nil, err
 	}
 
+	role, err := i.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get role"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
 	logger.Debug("role found successfully")
-	return vault, nil
+	return role, nil
 }
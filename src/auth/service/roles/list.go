@@ -3,15 +3,27 @@ package roles
 import (
 	"context"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
 )
 
-func (i *Roles) List(_ context.Context, _ *entities.UserInfo) ([]string, error) {
-	// TODO: Implement {Resource/Role}BAC for roles
+func (i *Roles) List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error) {
+	resolver := authorizator.New(i.UserPermissions(ctx, userInfo), userInfo.Tenant, i.logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := i.db.SearchNames(ctx)
+	if err != nil {
+		errMessage := "failed to list roles"
+		i.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
 
-	roles := make([]string, 0, len(i.roles))
-	for role := range i.roles {
-		roles = append(roles, role)
+	if roles == nil {
+		roles = []string{}
 	}
 
 	i.logger.Debug("roles listed successfully")
@@ -1,50 +1,21 @@
 package roles
 
 import (
-	"context"
-	"sync"
-
-	"github.com/longfan78/quorum-key-manager/pkg/errors"
-	"github.com/longfan78/quorum-key-manager/src/auth/entities"
-
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/auth/database"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
 )
 
 type Roles struct {
-	mux    sync.RWMutex
-	roles  map[string]*entities.Role
+	db     database.Roles
 	logger log.Logger
 }
 
 var _ auth.Roles = &Roles{}
 
-func New(logger log.Logger) *Roles {
+func New(db database.Roles, logger log.Logger) *Roles {
 	return &Roles{
-		roles:  make(map[string]*entities.Role),
+		db:     db,
 		logger: logger,
 	}
 }
-
-// TODO: Move to data layer
-func (i *Roles) createRole(_ context.Context, name string, permissions []entities.Permission) {
-	i.mux.Lock()
-	defer i.mux.Unlock()
-
-	i.roles[name] = &entities.Role{
-		Name:        name,
-		Permissions: permissions,
-	}
-}
-
-// TODO: Move to data layer
-func (i *Roles) getRole(_ context.Context, name string) (*entities.Role, error) {
-	i.mux.RLock()
-	defer i.mux.RUnlock()
-
-	if role, ok := i.roles[name]; ok {
-		return role, nil
-	}
-
-	return nil, errors.NotFoundError("role was not found")
-}
@@ -0,0 +1,35 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (i *Roles) Update(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) (*entities.Role, error) {
+	logger := i.logger.With("name", name, "permissions", permissions)
+
+	userPermissions := i.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(userPermissions, userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkGrant(permissions, userPermissions, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := i.db.Update(ctx, &entities.Role{Name: name, Permissions: permissions})
+	if err != nil {
+		errMessage := "failed to update role"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("role updated successfully")
+	return role, nil
+}
@@ -0,0 +1,44 @@
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockRoles(ctrl)
+	service := New(db, testutils.NewMockLogger(ctrl))
+
+	userInfo := &entities.UserInfo{
+		Tenant:      "tenantOne",
+		Permissions: append(entities.ListWildcardPermission("write:*"), entities.WriteRole),
+	}
+
+	t.Run("should fail with ForbiddenError if the role is escalated beyond the permissions of the user", func(t *testing.T) {
+		_, err := service.Update(ctx, "my-role", []entities.Permission{"*:*"}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should update a role granting permissions of the user", func(t *testing.T) {
+		permissions := []entities.Permission{entities.WriteKey}
+		db.EXPECT().Update(gomock.Any(), &entities.Role{Name: "my-role", Permissions: permissions}).Return(&entities.Role{Name: "my-role", Permissions: permissions}, nil)
+
+		role, err := service.Update(ctx, "my-role", permissions, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, permissions, role.Permissions)
+	})
+}
@@ -12,13 +12,18 @@ func (i *Roles) UserPermissions(ctx context.Context, userInfo *entities.UserInfo
 	}
 
 	permissions := userInfo.Permissions
+	if len(userInfo.Roles) == 0 {
+		return permissions
+	}
 
-	for _, roleName := range userInfo.Roles {
-		role, err := i.Get(ctx, roleName, userInfo)
-		if err != nil {
-			continue
-		}
+	// Roles are read directly from the data layer as permissions are being resolved
+	roles, err := i.db.FindMany(ctx, userInfo.Roles)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to get roles of user", "roles", userInfo.Roles)
+		return permissions
+	}
 
+	for _, role := range roles {
 		permissions = append(permissions, role.Permissions...)
 		for _, p := range role.Permissions {
 			permissions = append(permissions, entities.ListWildcardPermission(string(p))...)
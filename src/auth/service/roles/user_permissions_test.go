@@ -0,0 +1,48 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/auth/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserPermissions(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockRoles(ctrl)
+	service := New(db, testutils.NewMockLogger(ctrl))
+
+	userInfo := &entities.UserInfo{
+		Roles:       []string{"signer", "reader"},
+		Permissions: []entities.Permission{entities.ReadKey},
+	}
+
+	t.Run("should get the roles of the user in a single query", func(t *testing.T) {
+		db.EXPECT().FindMany(gomock.Any(), []string{"signer", "reader"}).Return([]*entities.Role{
+			{Name: "signer", Permissions: []entities.Permission{entities.SignEth}},
+			{Name: "reader", Permissions: []entities.Permission{"read:*"}},
+		}, nil)
+
+		permissions := service.UserPermissions(ctx, userInfo)
+
+		assert.Equal(t, []entities.Permission{
+			entities.ReadKey, entities.SignEth, "read:*", entities.ReadSecret, entities.ReadKey, entities.ReadEth, entities.ReadAlias,
+		}, permissions)
+	})
+
+	t.Run("should only return the permissions of the user if the roles cannot be read", func(t *testing.T) {
+		db.EXPECT().FindMany(gomock.Any(), []string{"signer", "reader"}).Return(nil, fmt.Errorf("error"))
+
+		permissions := service.UserPermissions(ctx, userInfo)
+
+		assert.Equal(t, userInfo.Permissions, permissions)
+	})
+}
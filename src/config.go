@@ -2,20 +2,42 @@ package src
 
 import (
 	"github.com/longfan78/quorum-key-manager/pkg/http/server"
+	approvals "github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/entities"
 	"github.com/longfan78/quorum-key-manager/src/infra/api-key/csv"
+	cluster "github.com/longfan78/quorum-key-manager/src/infra/cluster/postgres"
 	"github.com/longfan78/quorum-key-manager/src/infra/jwt/jose"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/zap"
 	manifestreader "github.com/longfan78/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
 	tls "github.com/longfan78/quorum-key-manager/src/infra/tls/filesystem"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/longfan78/quorum-key-manager/src/infra/watcher"
+	nodes "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	stores "github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
 type Config struct {
-	HTTP     *server.Config
-	Logger   *zap.Config
-	Postgres *client.Config
-	OIDC     *jose.Config
-	APIKey   *csv.Config
-	TLS      *tls.Config
-	Manifest *manifestreader.Config
+	HTTP         *server.Config
+	Logger       *zap.Config
+	Postgres     *client.Config
+	Vaults       *entities.VaultsConfig
+	OIDC         *jose.Config
+	APIKey       *csv.Config
+	APIKeys      *auth.APIKeysConfig
+	TLS          *tls.Config
+	Manifest     *manifestreader.Config
+	Approvals    *approvals.Config
+	Nonces       *nodes.NoncesConfig
+	Transactions *nodes.TransactionsConfig
+	Rotation     *stores.RotationConfig
+	Expiry       *stores.ExpiryConfig
+	Sync         *stores.SyncConfig
+	Quotas       *stores.QuotaConfig
+	RateLimit    *ratelimit.Config
+	Reload       *watcher.Config
+	Tracing      *tracing.Config
+	Cluster      *cluster.Config
 }
@@ -1,10 +1,11 @@
 package entities
 
 const (
-	RoleKind  string = "Role"
-	NodeKind  string = "Node"
-	StoreKind string = "Store"
-	VaultKind string = "Vault"
+	RoleKind   string = "Role"
+	NodeKind   string = "Node"
+	StoreKind  string = "Store"
+	VaultKind  string = "Vault"
+	PolicyKind string = "Policy"
 )
 
 type Manifest struct {
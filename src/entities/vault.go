@@ -17,6 +17,21 @@ type Vault struct {
 	AllowedTenants []string
 }
 
+// VaultDefinition is the persisted definition of a vault managed at runtime
+type VaultDefinition struct {
+	Name           string
+	VaultType      string
+	Config         interface{}
+	AllowedTenants []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type VaultsConfig struct {
+	// EncryptionKey is the AES-256 key encrypting the configurations of the vaults persisted in Postgres
+	EncryptionKey []byte
+}
+
 type HashicorpConfig struct {
 	MountPoint    string        `json:"mountPoint" yaml:"mount_point" validate:"required" example:"secret"`
 	Address       string        `json:"address"  yaml:"address" validate:"required" example:"https://hashicorp:8200"`
@@ -1,9 +1,14 @@
 package client
 
 import (
+	"net/http"
+
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault/keyvaultapi"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/longfan78/quorum-key-manager/src/infra/akv"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 )
 
 type AKVClient struct {
@@ -21,6 +26,21 @@ func NewClient(cfg *Config) (*AKVClient, error) {
 		return nil, err
 	}
 	client.Authorizer = authorizer
+	client.Sender = newMetricsSender(cfg.Name, autorest.CreateSender())
 
 	return &AKVClient{client: client, cfg: cfg}, nil
 }
+
+func newMetricsSender(vault string, sender autorest.Sender) autorest.Sender {
+	transport := tracing.NewTransport(metrics.NewVaultTransport(metrics.AKVBackend, vault, &senderTransport{sender: sender}))
+	return autorest.SenderFunc(transport.RoundTrip)
+}
+
+// senderTransport adapts an autorest.Sender to an http.RoundTripper
+type senderTransport struct {
+	sender autorest.Sender
+}
+
+func (t *senderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.sender.Do(req)
+}
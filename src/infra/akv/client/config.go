@@ -10,6 +10,8 @@ import (
 )
 
 type Config struct {
+	// Name of the vault, used to label the metrics of the client
+	Name                string
 	Endpoint            string
 	SubscriptionID      string
 	TenantID            string
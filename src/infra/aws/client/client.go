@@ -1,6 +1,7 @@
 package client
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -9,6 +10,8 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	awsinfra "github.com/longfan78/quorum-key-manager/src/infra/aws"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 )
 
 type AWSClient struct {
@@ -27,6 +30,14 @@ func New(cfg *Config, logger log.Logger) (*AWSClient, error) {
 		return nil, err
 	}
 
+	// The HTTP client of the session is copied as it may be shared, e.g. http.DefaultClient
+	httpClient := http.Client{}
+	if sess.Config.HTTPClient != nil {
+		httpClient = *sess.Config.HTTPClient
+	}
+	httpClient.Transport = tracing.NewTransport(metrics.NewVaultTransport(metrics.AWSBackend, cfg.Name, httpClient.Transport))
+	sess.Config.HTTPClient = &httpClient
+
 	return &AWSClient{
 		kmsClient:     kms.New(sess),
 		secretsClient: secretsmanager.New(sess),
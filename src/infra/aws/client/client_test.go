@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	kms := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		_, _ = rw.Write([]byte(`{"KeyMetadata":{"KeyId":"my-key","Enabled":true,"KeyState":"Enabled"}}`))
+	}))
+	defer kms.Close()
+
+	c, err := New(&Config{Region: "eu-west-3", AccessID: "access-id", SecretKey: "secret-key"}, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err)
+	c.kmsClient.Endpoint = kms.URL
+
+	t.Run("should record a child span for each call of the vault", func(t *testing.T) {
+		ctx, span := otel.Tracer("test").Start(context.Background(), "test")
+
+		_, err := c.DescribeKey(ctx, "my-key")
+		require.NoError(t, err)
+		_, err = c.ListKeys(ctx, 0, "")
+		require.NoError(t, err)
+		span.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 3)
+		for _, vaultSpan := range spans[:2] {
+			assert.Equal(t, trace.SpanKindClient, vaultSpan.SpanKind())
+			assert.Equal(t, span.SpanContext().SpanID(), vaultSpan.Parent().SpanID())
+		}
+	})
+}
@@ -7,6 +7,8 @@ import (
 )
 
 type Config struct {
+	// Name of the vault, used to label the metrics of the client
+	Name      string
 	Region    string
 	AccessID  string
 	SecretKey string
@@ -26,7 +26,7 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 	// Always create with same usage for key now (sign & verify)
 	keyUsage := kms.KeyUsageTypeSignVerify
 
-	out, err := c.kmsClient.CreateKey(&kms.CreateKeyInput{
+	out, err := c.kmsClient.CreateKeyWithContext(ctx, &kms.CreateKeyInput{
 		KeySpec:  &keyType,
 		KeyUsage: &keyUsage,
 		Tags:     tags,
@@ -35,7 +35,7 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 		return nil, parseKmsErrorResponse(err)
 	}
 
-	_, err = c.kmsClient.CreateAlias(&kms.CreateAliasInput{
+	_, err = c.kmsClient.CreateAliasWithContext(ctx, &kms.CreateAliasInput{
 		AliasName:   &keyID,
 		TargetKeyId: out.KeyMetadata.KeyId,
 	})
@@ -56,8 +56,8 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 	return out, nil
 }
 
-func (c *AWSClient) GetPublicKey(_ context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
-	out, err := c.kmsClient.GetPublicKey(&kms.GetPublicKeyInput{
+func (c *AWSClient) GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
+	out, err := c.kmsClient.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{
 		KeyId: &keyID,
 	})
 	if err != nil {
@@ -67,8 +67,8 @@ func (c *AWSClient) GetPublicKey(_ context.Context, keyID string) (*kms.GetPubli
 	return out, nil
 }
 
-func (c *AWSClient) DescribeKey(_ context.Context, keyID string) (*kms.DescribeKeyOutput, error) {
-	out, err := c.kmsClient.DescribeKey(&kms.DescribeKeyInput{KeyId: &keyID})
+func (c *AWSClient) DescribeKey(ctx context.Context, keyID string) (*kms.DescribeKeyOutput, error) {
+	out, err := c.kmsClient.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: &keyID})
 	if err != nil {
 		return nil, parseKmsErrorResponse(err)
 	}
@@ -76,7 +76,7 @@ func (c *AWSClient) DescribeKey(_ context.Context, keyID string) (*kms.DescribeK
 	return out, nil
 }
 
-func (c *AWSClient) ListKeys(_ context.Context, limit int64, marker string) (*kms.ListKeysOutput, error) {
+func (c *AWSClient) ListKeys(ctx context.Context, limit int64, marker string) (*kms.ListKeysOutput, error) {
 	input := &kms.ListKeysInput{}
 	if limit > 0 {
 		input.Limit = &limit
@@ -85,7 +85,7 @@ func (c *AWSClient) ListKeys(_ context.Context, limit int64, marker string) (*km
 		input.Marker = &marker
 	}
 
-	outListKeys, err := c.kmsClient.ListKeys(input)
+	outListKeys, err := c.kmsClient.ListKeysWithContext(ctx, input)
 	if err != nil {
 		return nil, parseKmsErrorResponse(err)
 	}
@@ -93,8 +93,8 @@ func (c *AWSClient) ListKeys(_ context.Context, limit int64, marker string) (*km
 	return outListKeys, nil
 }
 
-func (c *AWSClient) GetAlias(_ context.Context, keyID string) (string, error) {
-	out, err := c.kmsClient.ListAliases(&kms.ListAliasesInput{
+func (c *AWSClient) GetAlias(ctx context.Context, keyID string) (string, error) {
+	out, err := c.kmsClient.ListAliasesWithContext(ctx, &kms.ListAliasesInput{
 		KeyId: aws.String(keyID),
 		Limit: aws.Int64(1),
 	})
@@ -110,13 +110,13 @@ func (c *AWSClient) GetAlias(_ context.Context, keyID string) (string, error) {
 	return "", nil
 }
 
-func (c *AWSClient) ListTags(_ context.Context, keyID, marker string) (*kms.ListResourceTagsOutput, error) {
+func (c *AWSClient) ListTags(ctx context.Context, keyID, marker string) (*kms.ListResourceTagsOutput, error) {
 	input := &kms.ListResourceTagsInput{KeyId: &keyID}
 	if len(marker) > 0 {
 		input.Marker = &marker
 	}
 
-	tags, err := c.kmsClient.ListResourceTags(input)
+	tags, err := c.kmsClient.ListResourceTagsWithContext(ctx, input)
 	if err != nil {
 		return nil, parseKmsErrorResponse(err)
 	}
@@ -124,10 +124,10 @@ func (c *AWSClient) ListTags(_ context.Context, keyID, marker string) (*kms.List
 	return tags, nil
 }
 
-func (c *AWSClient) Sign(_ context.Context, keyID string, msg []byte, signingAlgorithm string) (*kms.SignOutput, error) {
+func (c *AWSClient) Sign(ctx context.Context, keyID string, msg []byte, signingAlgorithm string) (*kms.SignOutput, error) {
 	// Message type is always digest
 	msgType := kms.MessageTypeDigest
-	out, err := c.kmsClient.Sign(&kms.SignInput{
+	out, err := c.kmsClient.SignWithContext(ctx, &kms.SignInput{
 		KeyId:            &keyID,
 		Message:          msg,
 		MessageType:      &msgType,
@@ -141,7 +141,7 @@ func (c *AWSClient) Sign(_ context.Context, keyID string, msg []byte, signingAlg
 }
 
 func (c *AWSClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error) {
-	out, err := c.kmsClient.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+	out, err := c.kmsClient.ScheduleKeyDeletionWithContext(ctx, &kms.ScheduleKeyDeletionInput{
 		KeyId: &keyID,
 	})
 	if err != nil {
@@ -163,7 +163,7 @@ func (c *AWSClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleK
 }
 
 func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error) {
-	out, err := c.kmsClient.CancelKeyDeletion(&kms.CancelKeyDeletionInput{
+	out, err := c.kmsClient.CancelKeyDeletionWithContext(ctx, &kms.CancelKeyDeletionInput{
 		KeyId: &keyID,
 	})
 	if err != nil {
@@ -180,7 +180,7 @@ func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKe
 		return nil, err
 	}
 
-	_, err = c.kmsClient.EnableKey(&kms.EnableKeyInput{
+	_, err = c.kmsClient.EnableKeyWithContext(ctx, &kms.EnableKeyInput{
 		KeyId: &keyID,
 	})
 	if err != nil {
@@ -200,8 +200,8 @@ func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKe
 	return out, nil
 }
 
-func (c *AWSClient) TagResource(_ context.Context, keyID string, tags []*kms.Tag) (*kms.TagResourceOutput, error) {
-	outTagResource, err := c.kmsClient.TagResource(&kms.TagResourceInput{
+func (c *AWSClient) TagResource(ctx context.Context, keyID string, tags []*kms.Tag) (*kms.TagResourceOutput, error) {
+	outTagResource, err := c.kmsClient.TagResourceWithContext(ctx, &kms.TagResourceInput{
 		KeyId: &keyID,
 		Tags:  tags,
 	})
@@ -212,8 +212,8 @@ func (c *AWSClient) TagResource(_ context.Context, keyID string, tags []*kms.Tag
 	return outTagResource, nil
 }
 
-func (c *AWSClient) UntagResource(_ context.Context, keyID string, tagKeys []*string) (*kms.UntagResourceOutput, error) {
-	outUntagResource, err := c.kmsClient.UntagResource(&kms.UntagResourceInput{
+func (c *AWSClient) UntagResource(ctx context.Context, keyID string, tagKeys []*string) (*kms.UntagResourceOutput, error) {
+	outUntagResource, err := c.kmsClient.UntagResourceWithContext(ctx, &kms.UntagResourceInput{
 		KeyId:   &keyID,
 		TagKeys: tagKeys,
 	})
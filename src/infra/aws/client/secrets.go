@@ -13,7 +13,7 @@ import (
 
 const CurrentVersionMark = "AWSCURRENT"
 
-func (c *AWSClient) GetSecret(_ context.Context, id, version string) (*secretsmanager.GetSecretValueOutput, error) {
+func (c *AWSClient) GetSecret(ctx context.Context, id, version string) (*secretsmanager.GetSecretValueOutput, error) {
 	getSecretInput := &secretsmanager.GetSecretValueInput{
 		SecretId:  &id,
 		VersionId: nil,
@@ -23,15 +23,15 @@ func (c *AWSClient) GetSecret(_ context.Context, id, version string) (*secretsma
 		getSecretInput.VersionId = &version
 	}
 
-	output, err := c.secretsClient.GetSecretValue(getSecretInput)
+	output, err := c.secretsClient.GetSecretValueWithContext(ctx, getSecretInput)
 	if err != nil {
 		return nil, parseSecretsManagerErrorResponse(err)
 	}
 
 	return output, nil
 }
-func (c *AWSClient) CreateSecret(_ context.Context, id, value string) (*secretsmanager.CreateSecretOutput, error) {
-	output, err := c.secretsClient.CreateSecret(&secretsmanager.CreateSecretInput{
+func (c *AWSClient) CreateSecret(ctx context.Context, id, value string) (*secretsmanager.CreateSecretOutput, error) {
+	output, err := c.secretsClient.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
 		Name:         &id,
 		SecretString: &value,
 	})
@@ -42,8 +42,8 @@ func (c *AWSClient) CreateSecret(_ context.Context, id, value string) (*secretsm
 	return output, nil
 }
 
-func (c *AWSClient) PutSecretValue(_ context.Context, id, value string) (*secretsmanager.PutSecretValueOutput, error) {
-	output, err := c.secretsClient.PutSecretValue(&secretsmanager.PutSecretValueInput{
+func (c *AWSClient) PutSecretValue(ctx context.Context, id, value string) (*secretsmanager.PutSecretValueOutput, error) {
+	output, err := c.secretsClient.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
 		SecretId:     &id,
 		SecretString: &value,
 	})
@@ -54,7 +54,7 @@ func (c *AWSClient) PutSecretValue(_ context.Context, id, value string) (*secret
 	return output, nil
 }
 
-func (c *AWSClient) TagSecretResource(_ context.Context, id string, tags map[string]string) (*secretsmanager.TagResourceOutput, error) {
+func (c *AWSClient) TagSecretResource(ctx context.Context, id string, tags map[string]string) (*secretsmanager.TagResourceOutput, error) {
 	var inputTags []*secretsmanager.Tag
 	for key, value := range tags {
 		k, v := key, value
@@ -64,7 +64,7 @@ func (c *AWSClient) TagSecretResource(_ context.Context, id string, tags map[str
 		}
 		inputTags = append(inputTags, &inTag)
 	}
-	output, err := c.secretsClient.TagResource(&secretsmanager.TagResourceInput{
+	output, err := c.secretsClient.TagResourceWithContext(ctx, &secretsmanager.TagResourceInput{
 		SecretId: &id,
 		Tags:     inputTags,
 	})
@@ -75,8 +75,8 @@ func (c *AWSClient) TagSecretResource(_ context.Context, id string, tags map[str
 	return output, nil
 }
 
-func (c *AWSClient) DescribeSecret(_ context.Context, id string) (tags map[string]string, metadata *entities.Metadata, err error) {
-	output, err := c.secretsClient.DescribeSecret(&secretsmanager.DescribeSecretInput{
+func (c *AWSClient) DescribeSecret(ctx context.Context, id string) (tags map[string]string, metadata *entities.Metadata, err error) {
+	output, err := c.secretsClient.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
 		SecretId: &id,
 	})
 
@@ -114,7 +114,7 @@ func (c *AWSClient) DescribeSecret(_ context.Context, id string) (tags map[strin
 	return outTags, outMeta, nil
 }
 
-func (c *AWSClient) ListSecrets(_ context.Context, maxResults int64, nextToken string) (*secretsmanager.ListSecretsOutput, error) {
+func (c *AWSClient) ListSecrets(ctx context.Context, maxResults int64, nextToken string) (*secretsmanager.ListSecretsOutput, error) {
 	listInput := &secretsmanager.ListSecretsInput{}
 	if len(nextToken) > 0 {
 		listInput.NextToken = &nextToken
@@ -122,7 +122,7 @@ func (c *AWSClient) ListSecrets(_ context.Context, maxResults int64, nextToken s
 	if maxResults > 0 {
 		listInput.MaxResults = &maxResults
 	}
-	output, err := c.secretsClient.ListSecrets(listInput)
+	output, err := c.secretsClient.ListSecretsWithContext(ctx, listInput)
 	if err != nil {
 		return nil, parseSecretsManagerErrorResponse(err)
 	}
@@ -130,8 +130,8 @@ func (c *AWSClient) ListSecrets(_ context.Context, maxResults int64, nextToken s
 	return output, nil
 
 }
-func (c *AWSClient) UpdateSecret(_ context.Context, id, value, keyID, desc string) (*secretsmanager.UpdateSecretOutput, error) {
-	output, err := c.secretsClient.UpdateSecret(&secretsmanager.UpdateSecretInput{
+func (c *AWSClient) UpdateSecret(ctx context.Context, id, value, keyID, desc string) (*secretsmanager.UpdateSecretOutput, error) {
+	output, err := c.secretsClient.UpdateSecretWithContext(ctx, &secretsmanager.UpdateSecretInput{
 		SecretId:     &id,
 		SecretString: &value,
 		KmsKeyId:     &keyID,
@@ -144,8 +144,8 @@ func (c *AWSClient) UpdateSecret(_ context.Context, id, value, keyID, desc strin
 	return output, nil
 }
 
-func (c *AWSClient) RestoreSecret(_ context.Context, id string) (*secretsmanager.RestoreSecretOutput, error) {
-	output, err := c.secretsClient.RestoreSecret(&secretsmanager.RestoreSecretInput{
+func (c *AWSClient) RestoreSecret(ctx context.Context, id string) (*secretsmanager.RestoreSecretOutput, error) {
+	output, err := c.secretsClient.RestoreSecretWithContext(ctx, &secretsmanager.RestoreSecretInput{
 		SecretId: &id,
 	})
 	if err != nil {
@@ -154,8 +154,8 @@ func (c *AWSClient) RestoreSecret(_ context.Context, id string) (*secretsmanager
 
 	return output, nil
 }
-func (c *AWSClient) DeleteSecret(_ context.Context, id string) (*secretsmanager.DeleteSecretOutput, error) {
-	output, err := c.secretsClient.DeleteSecret(&secretsmanager.DeleteSecretInput{
+func (c *AWSClient) DeleteSecret(ctx context.Context, id string) (*secretsmanager.DeleteSecretOutput, error) {
+	output, err := c.secretsClient.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{
 		SecretId:                   &id,
 		ForceDeleteWithoutRecovery: common.ToPtr(false).(*bool),
 	})
@@ -168,7 +168,7 @@ func (c *AWSClient) DeleteSecret(_ context.Context, id string) (*secretsmanager.
 
 func (c *AWSClient) DestroySecret(ctx context.Context, id string) (*secretsmanager.DeleteSecretOutput, error) {
 	// check appropriate state with description
-	desc, err := c.secretsClient.DescribeSecret(&secretsmanager.DescribeSecretInput{
+	desc, err := c.secretsClient.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
 		SecretId: &id,
 	})
 	if err != nil {
@@ -184,7 +184,7 @@ func (c *AWSClient) DestroySecret(ctx context.Context, id string) (*secretsmanag
 		return nil, err
 	}
 
-	output, err := c.secretsClient.DeleteSecret(&secretsmanager.DeleteSecretInput{
+	output, err := c.secretsClient.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{
 		SecretId:                   &id,
 		ForceDeleteWithoutRecovery: common.ToPtr(true).(*bool),
 	})
@@ -0,0 +1,62 @@
+package cluster
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=cluster.go -destination=mock/cluster.go -package=mock
+
+const (
+	KindStore = "store"
+	KindVault = "vault"
+	KindNode  = "node"
+)
+
+// Kinds lists the kinds of resources in the order of their dependencies, stores depending on vaults
+var Kinds = []string{KindVault, KindStore, KindNode}
+
+const (
+	OpUpsert = "upsert"
+	OpDelete = "delete"
+	// OpResync reloads all the resources of a kind from the database, applying the changes a replica may have missed
+	OpResync = "resync"
+)
+
+// Event notifies the other replicas of a change made at runtime to a resource they keep in memory
+type Event struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Op   string `json:"op"`
+	// Origin is the replica which made the change, set when the event is sent
+	Origin string `json:"origin"`
+}
+
+// Handler applies a change made by another replica
+type Handler func(ctx context.Context, event *Event) error
+
+// Notifier propagates the changes made on one replica to the other ones
+type Notifier interface {
+	Notify(ctx context.Context, event *Event) error
+	Subscribe(kind string, handler Handler)
+}
+
+// Elector elects the replica running background jobs which must not run concurrently
+type Elector interface {
+	IsLeader() bool
+}
+
+// Standalone is used when a single replica runs: changes are not propagated and the replica is always the leader
+type Standalone struct{}
+
+var _ Notifier = Standalone{}
+var _ Elector = Standalone{}
+
+func (Standalone) Notify(context.Context, *Event) error {
+	return nil
+}
+
+func (Standalone) Subscribe(string, Handler) {}
+
+func (Standalone) IsLeader() bool {
+	return true
+}
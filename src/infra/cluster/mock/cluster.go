@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cluster.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cluster "github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, event *cluster.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockNotifier) Subscribe(kind string, handler cluster.Handler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", kind, handler)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNotifierMockRecorder) Subscribe(kind, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNotifier)(nil).Subscribe), kind, handler)
+}
+
+// MockElector is a mock of Elector interface.
+type MockElector struct {
+	ctrl     *gomock.Controller
+	recorder *MockElectorMockRecorder
+}
+
+// MockElectorMockRecorder is the mock recorder for MockElector.
+type MockElectorMockRecorder struct {
+	mock *MockElector
+}
+
+// NewMockElector creates a new mock instance.
+func NewMockElector(ctrl *gomock.Controller) *MockElector {
+	mock := &MockElector{ctrl: ctrl}
+	mock.recorder = &MockElectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockElector) EXPECT() *MockElectorMockRecorder {
+	return m.recorder
+}
+
+// IsLeader mocks base method.
+func (m *MockElector) IsLeader() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLeader")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLeader indicates an expected call of IsLeader.
+func (mr *MockElectorMockRecorder) IsLeader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLeader", reflect.TypeOf((*MockElector)(nil).IsLeader))
+}
@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
+)
+
+const (
+	channel = "qkm_cluster"
+	// leaderLockID is the key of the session advisory lock held by the leader
+	leaderLockID = 0x716b6d
+	// receiveTimeout bounds the wait for notifications so that the listener stops and resyncs on time
+	receiveTimeout = time.Second
+)
+
+// Cluster coordinates the replicas sharing a Postgres database. Changes are propagated with LISTEN/NOTIFY, resources
+// being reloaded from the database after the listener reconnects and periodically as notifications can be missed. The
+// leader is the replica holding an advisory lock, released by Postgres as soon as its session ends
+type Cluster struct {
+	cfg    *Config
+	db     *pg.DB
+	logger log.Logger
+
+	mux      sync.RWMutex
+	handlers map[string][]cluster.Handler
+
+	leader int32
+	conn   *pg.Conn
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	err    error
+}
+
+var _ cluster.Notifier = &Cluster{}
+var _ cluster.Elector = &Cluster{}
+var _ common.Runnable = &Cluster{}
+
+func New(pgCfg *client.Config, cfg *Config, logger log.Logger) (*Cluster, error) {
+	pgOptions, err := pgCfg.ToPGOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cluster{
+		cfg:      cfg,
+		db:       pg.Connect(pgOptions),
+		logger:   logger.With("replica", cfg.ReplicaID),
+		handlers: make(map[string][]cluster.Handler),
+	}, nil
+}
+
+func (c *Cluster) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	listener := c.db.Listen(ctx, channel)
+
+	c.wg.Add(2)
+	go c.listen(ctx, listener)
+	go c.elect(ctx)
+
+	c.logger.Info("cluster coordination started", "election_interval", c.cfg.ElectionInterval.String(), "resync_interval", c.cfg.ResyncInterval.String())
+	return nil
+}
+
+func (c *Cluster) Stop(ctx context.Context) error {
+	c.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("cluster coordination stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Cluster) Close() error {
+	return c.db.Close()
+}
+
+func (c *Cluster) Error() error {
+	return c.err
+}
+
+func (c *Cluster) Notify(ctx context.Context, event *cluster.Event) error {
+	notification := *event
+	notification.Origin = c.cfg.ReplicaID
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return errors.EncodingError(err.Error())
+	}
+
+	_, err = c.db.ExecContext(ctx, "SELECT pg_notify(?, ?)", channel, string(payload))
+	if err != nil {
+		errMessage := "failed to notify replicas"
+		c.logger.WithError(err).Error(errMessage, "kind", event.Kind, "name", event.Name)
+		return errors.PostgresError(errMessage)
+	}
+
+	return nil
+}
+
+func (c *Cluster) Subscribe(kind string, handler cluster.Handler) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.handlers[kind] = append(c.handlers[kind], handler)
+}
+
+func (c *Cluster) IsLeader() bool {
+	return atomic.LoadInt32(&c.leader) == 1
+}
+
+func (c *Cluster) listen(ctx context.Context, listener *pg.Listener) {
+	defer c.wg.Done()
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	lastResync := time.Now()
+	disconnected := false
+	for {
+		_, payload, err := listener.ReceiveTimeout(ctx, receiveTimeout)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err == nil:
+			c.dispatch(ctx, payload)
+		case !isTimeout(err):
+			// The listener reconnects on the next receive, changes made in the meantime not being received
+			if !disconnected {
+				c.logger.WithError(err).Warn("cluster listener disconnected")
+			}
+			disconnected = true
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(receiveTimeout):
+			}
+			continue
+		}
+
+		if disconnected {
+			c.logger.Info("cluster listener reconnected, reloading resources")
+		}
+
+		if disconnected || (c.cfg.ResyncInterval > 0 && time.Since(lastResync) >= c.cfg.ResyncInterval) {
+			c.resync(ctx)
+			disconnected, lastResync = false, time.Now()
+		}
+	}
+}
+
+func (c *Cluster) dispatch(ctx context.Context, payload string) {
+	event := &cluster.Event{}
+	err := json.Unmarshal([]byte(payload), event)
+	if err != nil {
+		c.logger.WithError(err).Warn("ignoring invalid cluster event")
+		return
+	}
+
+	if event.Origin == c.cfg.ReplicaID {
+		return
+	}
+
+	c.apply(ctx, event)
+}
+
+// resync reloads the resources of every kind from the database, in the order of their dependencies
+func (c *Cluster) resync(ctx context.Context) {
+	for _, kind := range cluster.Kinds {
+		c.apply(ctx, &cluster.Event{Kind: kind, Op: cluster.OpResync})
+	}
+}
+
+func (c *Cluster) apply(ctx context.Context, event *cluster.Event) {
+	c.mux.RLock()
+	handlers := c.handlers[event.Kind]
+	c.mux.RUnlock()
+
+	logger := c.logger.With("kind", event.Kind, "name", event.Name, "op", event.Op, "origin", event.Origin)
+	for _, handler := range handlers {
+		err := handler(ctx, event)
+		if err != nil {
+			logger.WithError(err).Error("failed to apply change of another replica")
+			continue
+		}
+
+		logger.Debug("change of another replica applied successfully")
+	}
+}
+
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+func (c *Cluster) elect(ctx context.Context) {
+	defer c.wg.Done()
+	defer c.resign()
+
+	c.campaign(ctx)
+
+	ticker := time.NewTicker(c.cfg.ElectionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.campaign(ctx)
+		}
+	}
+}
+
+// campaign tries to acquire the leader lock. Advisory locks being reentrant, the leader keeps acquiring it so that
+// it finds out whether its session, and thus the lock, was lost
+func (c *Cluster) campaign(ctx context.Context) {
+	if c.conn == nil {
+		c.conn = c.db.Conn()
+	}
+
+	var acquired bool
+	_, err := c.conn.QueryOneContext(ctx, pg.Scan(&acquired), "SELECT pg_try_advisory_lock(?)", leaderLockID)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.WithError(err).Warn("failed to acquire leader lock")
+		}
+
+		// A new session is opened on the next attempt as the lock is released with a broken one
+		_ = c.conn.Close()
+		c.conn = nil
+		acquired = false
+	}
+
+	if c.setLeader(acquired) {
+		if acquired {
+			c.logger.Info("replica elected as leader")
+		} else {
+			c.logger.Warn("replica is no longer the leader")
+		}
+	}
+}
+
+func (c *Cluster) resign() {
+	if c.conn == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.ElectionInterval)
+	defer cancel()
+
+	_, err := c.conn.ExecContext(ctx, "SELECT pg_advisory_unlock_all()")
+	if err != nil {
+		c.logger.WithError(err).Warn("failed to release leader lock")
+	}
+
+	_ = c.conn.Close()
+	c.conn = nil
+	c.setLeader(false)
+}
+
+// setLeader returns whether the leadership of the replica changed
+func (c *Cluster) setLeader(leader bool) bool {
+	var value int32
+	if leader {
+		value = 1
+	}
+
+	return atomic.SwapInt32(&c.leader, value) != value
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	logger := testutils.NewMockLogger(ctrl)
+
+	c := &Cluster{
+		cfg:      NewConfig("replica-1", 0, 0),
+		logger:   logger,
+		handlers: make(map[string][]cluster.Handler),
+	}
+
+	var received []*cluster.Event
+	c.Subscribe(cluster.KindStore, func(_ context.Context, event *cluster.Event) error {
+		received = append(received, event)
+		return nil
+	})
+
+	t.Run("should apply the changes of other replicas", func(t *testing.T) {
+		received = nil
+
+		c.dispatch(ctx, `{"kind":"store","name":"my-store","op":"upsert","origin":"replica-2"}`)
+
+		assert.Equal(t, []*cluster.Event{{Kind: cluster.KindStore, Name: "my-store", Op: cluster.OpUpsert, Origin: "replica-2"}}, received)
+	})
+
+	t.Run("should ignore the changes of the replica itself", func(t *testing.T) {
+		received = nil
+
+		c.dispatch(ctx, `{"kind":"store","name":"my-store","op":"delete","origin":"replica-1"}`)
+
+		assert.Empty(t, received)
+	})
+
+	t.Run("should ignore the changes of kinds without handlers", func(t *testing.T) {
+		received = nil
+
+		c.dispatch(ctx, `{"kind":"node","name":"my-node","op":"delete","origin":"replica-2"}`)
+
+		assert.Empty(t, received)
+	})
+
+	t.Run("should ignore invalid events", func(t *testing.T) {
+		received = nil
+
+		c.dispatch(ctx, `invalid`)
+
+		assert.Empty(t, received)
+	})
+}
+
+func TestResync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := &Cluster{
+		cfg:      NewConfig("replica-1", 0, 0),
+		logger:   testutils.NewMockLogger(ctrl),
+		handlers: make(map[string][]cluster.Handler),
+	}
+
+	var received []*cluster.Event
+	handler := func(_ context.Context, event *cluster.Event) error {
+		received = append(received, event)
+		return nil
+	}
+	c.Subscribe(cluster.KindNode, handler)
+	c.Subscribe(cluster.KindStore, handler)
+	c.Subscribe(cluster.KindVault, handler)
+
+	c.resync(context.Background())
+
+	assert.Equal(t, []*cluster.Event{
+		{Kind: cluster.KindVault, Op: cluster.OpResync},
+		{Kind: cluster.KindStore, Op: cluster.OpResync},
+		{Kind: cluster.KindNode, Op: cluster.OpResync},
+	}, received)
+}
+
+func TestSetLeader(t *testing.T) {
+	c := &Cluster{}
+
+	assert.False(t, c.IsLeader())
+	assert.True(t, c.setLeader(true))
+	assert.True(t, c.IsLeader())
+	assert.False(t, c.setLeader(true))
+	assert.True(t, c.setLeader(false))
+	assert.False(t, c.IsLeader())
+}
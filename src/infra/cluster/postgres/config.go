@@ -0,0 +1,19 @@
+package postgres
+
+import "time"
+
+type Config struct {
+	// ReplicaID identifies the replica in the cluster, so that it ignores its own changes
+	ReplicaID        string
+	ElectionInterval time.Duration
+	// ResyncInterval is the interval at which resources are reloaded from the database, 0 disabling periodic reloads
+	ResyncInterval time.Duration
+}
+
+func NewConfig(replicaID string, electionInterval, resyncInterval time.Duration) *Config {
+	return &Config{
+		ReplicaID:        replicaID,
+		ElectionInterval: electionInterval,
+		ResyncInterval:   resyncInterval,
+	}
+}
@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+)
+
+// Revisions records the last update of the persisted definitions applied by a replica, so that a resync only applies
+// the definitions changed since and finds the resources deleted by other replicas. Definitions applied after the ones
+// of a resync were read are more recent, and are left unchanged by the resync
+type Revisions struct {
+	mux       sync.RWMutex
+	revisions map[string]revision
+}
+
+type revision struct {
+	updatedAt time.Time
+	appliedAt time.Time
+}
+
+func NewRevisions() *Revisions {
+	return &Revisions{
+		revisions: make(map[string]revision),
+	}
+}
+
+func (r *Revisions) Set(name string, updatedAt time.Time) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.revisions[name] = revision{updatedAt: updatedAt, appliedAt: time.Now()}
+}
+
+func (r *Revisions) Delete(name string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	delete(r.revisions, name)
+}
+
+// Has returns whether the definition of a resource was applied, resources declared in manifests having no revision
+func (r *Revisions) Has(name string) bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	_, ok := r.revisions[name]
+	return ok
+}
+
+// Changed returns whether a definition read at readAt was updated since it was applied. Postgres storing microseconds,
+// closer updates are the same
+func (r *Revisions) Changed(name string, updatedAt, readAt time.Time) bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	rev, ok := r.revisions[name]
+	if !ok {
+		return true
+	}
+
+	if rev.appliedAt.After(readAt) {
+		return false
+	}
+
+	diff := rev.updatedAt.Sub(updatedAt)
+	return diff >= time.Microsecond || diff <= -time.Microsecond
+}
+
+// Missing returns the resources whose definition was applied but are not among the persisted ones read at readAt
+func (r *Revisions) Missing(persisted []string, readAt time.Time) []string {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	exists := make(map[string]bool, len(persisted))
+	for _, name := range persisted {
+		exists[name] = true
+	}
+
+	var missing []string
+	for name, rev := range r.revisions {
+		if !exists[name] && !rev.appliedAt.After(readAt) {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,43 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisions(t *testing.T) {
+	updatedAt := time.Date(2021, 6, 1, 12, 0, 0, 123456789, time.UTC)
+
+	t.Run("should detect the definitions changed since they were applied", func(t *testing.T) {
+		revisions := NewRevisions()
+		revisions.Set("my-vault", updatedAt)
+		readAt := time.Now()
+
+		assert.True(t, revisions.Has("my-vault"))
+		assert.False(t, revisions.Changed("my-vault", updatedAt.Round(time.Microsecond), readAt))
+		assert.True(t, revisions.Changed("my-vault", updatedAt.Add(time.Second), readAt))
+		assert.True(t, revisions.Changed("other-vault", updatedAt, readAt))
+	})
+
+	t.Run("should not change the definitions applied after they were read", func(t *testing.T) {
+		revisions := NewRevisions()
+		readAt := time.Now().Add(-time.Second)
+		revisions.Set("my-vault", updatedAt)
+
+		assert.False(t, revisions.Changed("my-vault", updatedAt.Add(-time.Hour), readAt))
+		assert.Empty(t, revisions.Missing(nil, readAt))
+	})
+
+	t.Run("should find the definitions which are not persisted anymore", func(t *testing.T) {
+		revisions := NewRevisions()
+		revisions.Set("my-vault", updatedAt)
+		revisions.Set("deleted-vault", updatedAt)
+		revisions.Delete("deleted-vault")
+		revisions.Set("other-vault", updatedAt)
+
+		assert.Equal(t, []string{"other-vault"}, revisions.Missing([]string{"my-vault"}, time.Now()))
+		assert.False(t, revisions.Has("deleted-vault"))
+	})
+}
@@ -1,8 +1,15 @@
 package client
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/hashicorp"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -20,6 +27,8 @@ func NewClient(cfg *Config) (*HashicorpVaultClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	clientConfig.HttpClient.Transport = tracing.NewTransport(metrics.NewVaultTransport(metrics.HashicorpBackend, cfg.Name, clientConfig.HttpClient.Transport))
+
 	client, err := api.NewClient(clientConfig)
 	if err != nil {
 		return nil, err
@@ -65,3 +74,87 @@ func (c *HashicorpVaultClient) Mount(path string, mountInfo *api.MountInput) err
 
 	return nil
 }
+
+// readWithContext, listWithContext, writeWithContext and deleteWithContext send the requests of the logical backend of
+// the Vault client with the context of the caller, so that they are canceled and traced with it
+func (c *HashicorpVaultClient) readWithContext(ctx context.Context, p string, data map[string][]string) (*api.Secret, error) {
+	r := c.client.NewRequest(http.MethodGet, "/v1/"+p)
+	setParams(r, data)
+
+	return c.sendRead(ctx, r)
+}
+
+func (c *HashicorpVaultClient) listWithContext(ctx context.Context, p string) (*api.Secret, error) {
+	r := c.client.NewRequest(http.MethodGet, "/v1/"+p)
+	r.Params.Set("list", "true")
+
+	return c.sendRead(ctx, r)
+}
+
+func (c *HashicorpVaultClient) writeWithContext(ctx context.Context, p string, data map[string]interface{}) (*api.Secret, error) {
+	r := c.client.NewRequest(http.MethodPut, "/v1/"+p)
+	if err := r.SetJSONBody(data); err != nil {
+		return nil, err
+	}
+
+	return c.send(ctx, r)
+}
+
+func (c *HashicorpVaultClient) deleteWithContext(ctx context.Context, p string, data map[string][]string) (*api.Secret, error) {
+	r := c.client.NewRequest(http.MethodDelete, "/v1/"+p)
+	setParams(r, data)
+
+	return c.send(ctx, r)
+}
+
+func (c *HashicorpVaultClient) send(ctx context.Context, r *api.Request) (*api.Secret, error) {
+	resp, err := c.client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ParseSecret(resp.Body)
+}
+
+// sendRead sends a read request, a path not found being read as an empty secret as with the Vault client
+func (c *HashicorpVaultClient) sendRead(ctx context.Context, r *api.Request) (*api.Secret, error) {
+	resp, err := c.client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		secret, parseErr := api.ParseSecret(resp.Body)
+		switch {
+		case parseErr == io.EOF:
+			return nil, nil
+		case parseErr != nil:
+			return nil, parseErr
+		case secret != nil && (len(secret.Warnings) > 0 || len(secret.Data) > 0):
+			return secret, nil
+		default:
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ParseSecret(resp.Body)
+}
+
+func setParams(r *api.Request, data map[string][]string) {
+	if len(data) == 0 {
+		return
+	}
+
+	values := make(url.Values)
+	for k, v := range data {
+		for _, val := range v {
+			values.Add(k, val)
+		}
+	}
+	r.Params = values
+}
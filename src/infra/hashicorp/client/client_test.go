@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	vault := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{"data":{"keys":["my-secret"]}}`))
+	}))
+	defer vault.Close()
+
+	c, err := NewClient(&Config{Address: vault.URL, MountPoint: "secret", RateLimit: 10, BurstLimit: 10})
+	require.NoError(t, err)
+
+	t.Run("should record a child span for each call of the vault", func(t *testing.T) {
+		ctx, span := otel.Tracer("test").Start(context.Background(), "test")
+
+		_, err := c.ReadMetadata(ctx, "my-secret")
+		require.NoError(t, err)
+		_, err = c.ListSecrets(ctx)
+		require.NoError(t, err)
+		_, err = c.SetSecret(ctx, "my-secret", map[string]interface{}{"value": "my-value"})
+		require.NoError(t, err)
+		span.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 4)
+		for _, vaultSpan := range spans[:3] {
+			assert.Equal(t, trace.SpanKindClient, vaultSpan.SpanKind())
+			assert.Equal(t, span.SpanContext().SpanID(), vaultSpan.Parent().SpanID())
+		}
+	})
+}
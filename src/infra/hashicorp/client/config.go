@@ -17,6 +17,8 @@ import (
 
 // Config object that be converted into an api.Config later
 type Config struct {
+	// Name of the vault, used to label the metrics of the client
+	Name          string
 	MountPoint    string
 	Address       string
 	CACert        string
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"path"
 
@@ -8,8 +9,8 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-func (c *HashicorpVaultClient) ReadData(id string, data map[string][]string) (*api.Secret, error) {
-	secret, err := c.client.Logical().ReadWithData(c.pathData(id), data)
+func (c *HashicorpVaultClient) ReadData(ctx context.Context, id string, data map[string][]string) (*api.Secret, error) {
+	secret, err := c.readWithContext(ctx, c.pathData(id), data)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -17,8 +18,8 @@ func (c *HashicorpVaultClient) ReadData(id string, data map[string][]string) (*a
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) ReadMetadata(id string) (*api.Secret, error) {
-	secret, err := c.client.Logical().Read(c.pathMetadata(id))
+func (c *HashicorpVaultClient) ReadMetadata(ctx context.Context, id string) (*api.Secret, error) {
+	secret, err := c.readWithContext(ctx, c.pathMetadata(id), nil)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -26,8 +27,8 @@ func (c *HashicorpVaultClient) ReadMetadata(id string) (*api.Secret, error) {
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) SetSecret(id string, data map[string]interface{}) (*api.Secret, error) {
-	secret, err := c.client.Logical().Write(c.pathData(id), map[string]interface{}{
+func (c *HashicorpVaultClient) SetSecret(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.writeWithContext(ctx, c.pathData(id), map[string]interface{}{
 		dataLabel: data,
 	})
 	if err != nil {
@@ -37,8 +38,8 @@ func (c *HashicorpVaultClient) SetSecret(id string, data map[string]interface{})
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) DeleteSecret(id string, data map[string][]string) error {
-	_, err := c.client.Logical().DeleteWithData(c.pathData(id), data)
+func (c *HashicorpVaultClient) DeleteSecret(ctx context.Context, id string, data map[string][]string) error {
+	_, err := c.deleteWithContext(ctx, c.pathData(id), data)
 	if err != nil {
 		return parseErrorResponse(err)
 	}
@@ -46,8 +47,8 @@ func (c *HashicorpVaultClient) DeleteSecret(id string, data map[string][]string)
 	return nil
 }
 
-func (c *HashicorpVaultClient) RestoreSecret(id string, data map[string][]string) error {
-	err := c.writePost(path.Join(c.mountPoint, "undelete", id), data)
+func (c *HashicorpVaultClient) RestoreSecret(ctx context.Context, id string, data map[string][]string) error {
+	err := c.writePost(ctx, path.Join(c.mountPoint, "undelete", id), data)
 	if err != nil {
 		return parseErrorResponse(err)
 	}
@@ -55,8 +56,8 @@ func (c *HashicorpVaultClient) RestoreSecret(id string, data map[string][]string
 	return nil
 }
 
-func (c *HashicorpVaultClient) DestroySecret(id string, data map[string][]string) error {
-	err := c.writePost(path.Join(c.mountPoint, "destroy", id), data)
+func (c *HashicorpVaultClient) DestroySecret(ctx context.Context, id string, data map[string][]string) error {
+	err := c.writePost(ctx, path.Join(c.mountPoint, "destroy", id), data)
 	if err != nil {
 		return parseErrorResponse(err)
 	}
@@ -64,8 +65,8 @@ func (c *HashicorpVaultClient) DestroySecret(id string, data map[string][]string
 	return nil
 }
 
-func (c *HashicorpVaultClient) ListSecrets() (*api.Secret, error) {
-	secret, err := c.client.Logical().List(c.pathMetadata(""))
+func (c *HashicorpVaultClient) ListSecrets(ctx context.Context) (*api.Secret, error) {
+	secret, err := c.listWithContext(ctx, c.pathMetadata(""))
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -73,7 +74,7 @@ func (c *HashicorpVaultClient) ListSecrets() (*api.Secret, error) {
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) writePost(endpoint string, data map[string][]string) error {
+func (c *HashicorpVaultClient) writePost(ctx context.Context, endpoint string, data map[string][]string) error {
 	req := c.client.NewRequest("POST", fmt.Sprintf("/v1/%s", endpoint))
 	if data != nil {
 		if err := req.SetJSONBody(data); err != nil {
@@ -81,7 +82,7 @@ func (c *HashicorpVaultClient) writePost(endpoint string, data map[string][]stri
 		}
 	}
 
-	resp, err := c.client.RawRequest(req)
+	resp, err := c.client.RawRequestWithContext(ctx, req)
 	if resp != nil {
 		defer resp.Body.Close()
 	}
@@ -1,14 +1,15 @@
 package client
 
 import (
+	"context"
 	"encoding/base64"
 	"path"
 
 	"github.com/hashicorp/vault/api"
 )
 
-func (c *HashicorpVaultClient) GetKey(id string) (*api.Secret, error) {
-	secret, err := c.client.Logical().Read(c.pathKeys(id))
+func (c *HashicorpVaultClient) GetKey(ctx context.Context, id string) (*api.Secret, error) {
+	secret, err := c.readWithContext(ctx, c.pathKeys(id), nil)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -16,8 +17,8 @@ func (c *HashicorpVaultClient) GetKey(id string) (*api.Secret, error) {
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) CreateKey(data map[string]interface{}) (*api.Secret, error) {
-	secret, err := c.client.Logical().Write(c.pathKeys(""), data)
+func (c *HashicorpVaultClient) CreateKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.writeWithContext(ctx, c.pathKeys(""), data)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -25,8 +26,8 @@ func (c *HashicorpVaultClient) CreateKey(data map[string]interface{}) (*api.Secr
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) ImportKey(data map[string]interface{}) (*api.Secret, error) {
-	secret, err := c.client.Logical().Write(c.pathKeys("import"), data)
+func (c *HashicorpVaultClient) ImportKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.writeWithContext(ctx, c.pathKeys("import"), data)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -34,8 +35,8 @@ func (c *HashicorpVaultClient) ImportKey(data map[string]interface{}) (*api.Secr
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) UpdateKey(id string, data map[string]interface{}) (*api.Secret, error) {
-	secret, err := c.client.Logical().Write(c.pathKeys(id), data)
+func (c *HashicorpVaultClient) UpdateKey(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.writeWithContext(ctx, c.pathKeys(id), data)
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -43,8 +44,8 @@ func (c *HashicorpVaultClient) UpdateKey(id string, data map[string]interface{})
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) DestroyKey(id string) error {
-	_, err := c.client.Logical().Delete(path.Join(c.pathKeys(id), "destroy"))
+func (c *HashicorpVaultClient) DestroyKey(ctx context.Context, id string) error {
+	_, err := c.deleteWithContext(ctx, path.Join(c.pathKeys(id), "destroy"), nil)
 	if err != nil {
 		return parseErrorResponse(err)
 	}
@@ -52,8 +53,8 @@ func (c *HashicorpVaultClient) DestroyKey(id string) error {
 	return nil
 }
 
-func (c *HashicorpVaultClient) ListKeys() (*api.Secret, error) {
-	secret, err := c.client.Logical().List(c.pathKeys(""))
+func (c *HashicorpVaultClient) ListKeys(ctx context.Context) (*api.Secret, error) {
+	secret, err := c.listWithContext(ctx, c.pathKeys(""))
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
@@ -61,8 +62,8 @@ func (c *HashicorpVaultClient) ListKeys() (*api.Secret, error) {
 	return secret, nil
 }
 
-func (c *HashicorpVaultClient) Sign(id string, data []byte) (*api.Secret, error) {
-	secret, err := c.client.Logical().Write(path.Join(c.pathKeys(id), "sign"), map[string]interface{}{
+func (c *HashicorpVaultClient) Sign(ctx context.Context, id string, data []byte) (*api.Secret, error) {
+	secret, err := c.writeWithContext(ctx, path.Join(c.pathKeys(id), "sign"), map[string]interface{}{
 		dataLabel: base64.URLEncoding.EncodeToString(data),
 	})
 	if err != nil {
@@ -1,6 +1,8 @@
 package hashicorp
 
 import (
+	"context"
+
 	hashicorp "github.com/hashicorp/vault/api"
 )
 
@@ -16,21 +18,21 @@ type Client interface {
 }
 
 type Kvv2Client interface {
-	ReadData(id string, data map[string][]string) (*hashicorp.Secret, error)
-	ReadMetadata(id string) (*hashicorp.Secret, error)
-	SetSecret(id string, data map[string]interface{}) (*hashicorp.Secret, error)
-	ListSecrets() (*hashicorp.Secret, error)
-	DeleteSecret(id string, data map[string][]string) error
-	RestoreSecret(id string, data map[string][]string) error
-	DestroySecret(id string, data map[string][]string) error
+	ReadData(ctx context.Context, id string, data map[string][]string) (*hashicorp.Secret, error)
+	ReadMetadata(ctx context.Context, id string) (*hashicorp.Secret, error)
+	SetSecret(ctx context.Context, id string, data map[string]interface{}) (*hashicorp.Secret, error)
+	ListSecrets(ctx context.Context) (*hashicorp.Secret, error)
+	DeleteSecret(ctx context.Context, id string, data map[string][]string) error
+	RestoreSecret(ctx context.Context, id string, data map[string][]string) error
+	DestroySecret(ctx context.Context, id string, data map[string][]string) error
 }
 
 type PluginClient interface {
-	GetKey(id string) (*hashicorp.Secret, error)
-	CreateKey(data map[string]interface{}) (*hashicorp.Secret, error)
-	ImportKey(data map[string]interface{}) (*hashicorp.Secret, error)
-	ListKeys() (*hashicorp.Secret, error)
-	UpdateKey(id string, data map[string]interface{}) (*hashicorp.Secret, error)
-	DestroyKey(id string) error
-	Sign(id string, data []byte) (*hashicorp.Secret, error)
+	GetKey(ctx context.Context, id string) (*hashicorp.Secret, error)
+	CreateKey(ctx context.Context, data map[string]interface{}) (*hashicorp.Secret, error)
+	ImportKey(ctx context.Context, data map[string]interface{}) (*hashicorp.Secret, error)
+	ListKeys(ctx context.Context) (*hashicorp.Secret, error)
+	UpdateKey(ctx context.Context, id string, data map[string]interface{}) (*hashicorp.Secret, error)
+	DestroyKey(ctx context.Context, id string) error
+	Sign(ctx context.Context, id string, data []byte) (*hashicorp.Secret, error)
 }
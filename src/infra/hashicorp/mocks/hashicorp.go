@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	api "github.com/hashicorp/vault/api"
 	reflect "reflect"
@@ -34,209 +35,209 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // ReadData mocks base method
-func (m *MockClient) ReadData(id string, data map[string][]string) (*api.Secret, error) {
+func (m *MockClient) ReadData(ctx context.Context, id string, data map[string][]string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadData", id, data)
+	ret := m.ctrl.Call(m, "ReadData", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadData indicates an expected call of ReadData
-func (mr *MockClientMockRecorder) ReadData(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReadData(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadData", reflect.TypeOf((*MockClient)(nil).ReadData), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadData", reflect.TypeOf((*MockClient)(nil).ReadData), ctx, id, data)
 }
 
 // ReadMetadata mocks base method
-func (m *MockClient) ReadMetadata(id string) (*api.Secret, error) {
+func (m *MockClient) ReadMetadata(ctx context.Context, id string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadMetadata", id)
+	ret := m.ctrl.Call(m, "ReadMetadata", ctx, id)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadMetadata indicates an expected call of ReadMetadata
-func (mr *MockClientMockRecorder) ReadMetadata(id interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReadMetadata(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMetadata", reflect.TypeOf((*MockClient)(nil).ReadMetadata), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMetadata", reflect.TypeOf((*MockClient)(nil).ReadMetadata), ctx, id)
 }
 
 // SetSecret mocks base method
-func (m *MockClient) SetSecret(id string, data map[string]interface{}) (*api.Secret, error) {
+func (m *MockClient) SetSecret(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSecret", id, data)
+	ret := m.ctrl.Call(m, "SetSecret", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetSecret indicates an expected call of SetSecret
-func (mr *MockClientMockRecorder) SetSecret(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockClient)(nil).SetSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockClient)(nil).SetSecret), ctx, id, data)
 }
 
 // ListSecrets mocks base method
-func (m *MockClient) ListSecrets() (*api.Secret, error) {
+func (m *MockClient) ListSecrets(ctx context.Context) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecrets")
+	ret := m.ctrl.Call(m, "ListSecrets", ctx)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSecrets indicates an expected call of ListSecrets
-func (mr *MockClientMockRecorder) ListSecrets() *gomock.Call {
+func (mr *MockClientMockRecorder) ListSecrets(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockClient)(nil).ListSecrets))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockClient)(nil).ListSecrets), ctx)
 }
 
 // DeleteSecret mocks base method
-func (m *MockClient) DeleteSecret(id string, data map[string][]string) error {
+func (m *MockClient) DeleteSecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecret", id, data)
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSecret indicates an expected call of DeleteSecret
-func (mr *MockClientMockRecorder) DeleteSecret(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockClient)(nil).DeleteSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockClient)(nil).DeleteSecret), ctx, id, data)
 }
 
 // RestoreSecret mocks base method
-func (m *MockClient) RestoreSecret(id string, data map[string][]string) error {
+func (m *MockClient) RestoreSecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreSecret", id, data)
+	ret := m.ctrl.Call(m, "RestoreSecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RestoreSecret indicates an expected call of RestoreSecret
-func (mr *MockClientMockRecorder) RestoreSecret(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RestoreSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockClient)(nil).RestoreSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockClient)(nil).RestoreSecret), ctx, id, data)
 }
 
 // DestroySecret mocks base method
-func (m *MockClient) DestroySecret(id string, data map[string][]string) error {
+func (m *MockClient) DestroySecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroySecret", id, data)
+	ret := m.ctrl.Call(m, "DestroySecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DestroySecret indicates an expected call of DestroySecret
-func (mr *MockClientMockRecorder) DestroySecret(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DestroySecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockClient)(nil).DestroySecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockClient)(nil).DestroySecret), ctx, id, data)
 }
 
 // GetKey mocks base method
-func (m *MockClient) GetKey(id string) (*api.Secret, error) {
+func (m *MockClient) GetKey(ctx context.Context, id string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKey", id)
+	ret := m.ctrl.Call(m, "GetKey", ctx, id)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetKey indicates an expected call of GetKey
-func (mr *MockClientMockRecorder) GetKey(id interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) GetKey(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockClient)(nil).GetKey), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockClient)(nil).GetKey), ctx, id)
 }
 
 // CreateKey mocks base method
-func (m *MockClient) CreateKey(data map[string]interface{}) (*api.Secret, error) {
+func (m *MockClient) CreateKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", data)
+	ret := m.ctrl.Call(m, "CreateKey", ctx, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateKey indicates an expected call of CreateKey
-func (mr *MockClientMockRecorder) CreateKey(data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateKey(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockClient)(nil).CreateKey), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockClient)(nil).CreateKey), ctx, data)
 }
 
 // ImportKey mocks base method
-func (m *MockClient) ImportKey(data map[string]interface{}) (*api.Secret, error) {
+func (m *MockClient) ImportKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportKey", data)
+	ret := m.ctrl.Call(m, "ImportKey", ctx, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ImportKey indicates an expected call of ImportKey
-func (mr *MockClientMockRecorder) ImportKey(data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ImportKey(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockClient)(nil).ImportKey), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockClient)(nil).ImportKey), ctx, data)
 }
 
 // ListKeys mocks base method
-func (m *MockClient) ListKeys() (*api.Secret, error) {
+func (m *MockClient) ListKeys(ctx context.Context) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListKeys")
+	ret := m.ctrl.Call(m, "ListKeys", ctx)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListKeys indicates an expected call of ListKeys
-func (mr *MockClientMockRecorder) ListKeys() *gomock.Call {
+func (mr *MockClientMockRecorder) ListKeys(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockClient)(nil).ListKeys))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockClient)(nil).ListKeys), ctx)
 }
 
 // UpdateKey mocks base method
-func (m *MockClient) UpdateKey(id string, data map[string]interface{}) (*api.Secret, error) {
+func (m *MockClient) UpdateKey(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateKey", id, data)
+	ret := m.ctrl.Call(m, "UpdateKey", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateKey indicates an expected call of UpdateKey
-func (mr *MockClientMockRecorder) UpdateKey(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) UpdateKey(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKey", reflect.TypeOf((*MockClient)(nil).UpdateKey), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKey", reflect.TypeOf((*MockClient)(nil).UpdateKey), ctx, id, data)
 }
 
 // DestroyKey mocks base method
-func (m *MockClient) DestroyKey(id string) error {
+func (m *MockClient) DestroyKey(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroyKey", id)
+	ret := m.ctrl.Call(m, "DestroyKey", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DestroyKey indicates an expected call of DestroyKey
-func (mr *MockClientMockRecorder) DestroyKey(id interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DestroyKey(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyKey", reflect.TypeOf((*MockClient)(nil).DestroyKey), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyKey", reflect.TypeOf((*MockClient)(nil).DestroyKey), ctx, id)
 }
 
 // Sign mocks base method
-func (m *MockClient) Sign(id string, data []byte) (*api.Secret, error) {
+func (m *MockClient) Sign(ctx context.Context, id string, data []byte) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Sign", id, data)
+	ret := m.ctrl.Call(m, "Sign", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Sign indicates an expected call of Sign
-func (mr *MockClientMockRecorder) Sign(id, data interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) Sign(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockClient)(nil).Sign), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockClient)(nil).Sign), ctx, id, data)
 }
 
 // SetToken mocks base method
@@ -318,105 +319,105 @@ func (m *MockKvv2Client) EXPECT() *MockKvv2ClientMockRecorder {
 }
 
 // ReadData mocks base method
-func (m *MockKvv2Client) ReadData(id string, data map[string][]string) (*api.Secret, error) {
+func (m *MockKvv2Client) ReadData(ctx context.Context, id string, data map[string][]string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadData", id, data)
+	ret := m.ctrl.Call(m, "ReadData", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadData indicates an expected call of ReadData
-func (mr *MockKvv2ClientMockRecorder) ReadData(id, data interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) ReadData(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadData", reflect.TypeOf((*MockKvv2Client)(nil).ReadData), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadData", reflect.TypeOf((*MockKvv2Client)(nil).ReadData), ctx, id, data)
 }
 
 // ReadMetadata mocks base method
-func (m *MockKvv2Client) ReadMetadata(id string) (*api.Secret, error) {
+func (m *MockKvv2Client) ReadMetadata(ctx context.Context, id string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadMetadata", id)
+	ret := m.ctrl.Call(m, "ReadMetadata", ctx, id)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadMetadata indicates an expected call of ReadMetadata
-func (mr *MockKvv2ClientMockRecorder) ReadMetadata(id interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) ReadMetadata(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMetadata", reflect.TypeOf((*MockKvv2Client)(nil).ReadMetadata), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMetadata", reflect.TypeOf((*MockKvv2Client)(nil).ReadMetadata), ctx, id)
 }
 
 // SetSecret mocks base method
-func (m *MockKvv2Client) SetSecret(id string, data map[string]interface{}) (*api.Secret, error) {
+func (m *MockKvv2Client) SetSecret(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSecret", id, data)
+	ret := m.ctrl.Call(m, "SetSecret", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetSecret indicates an expected call of SetSecret
-func (mr *MockKvv2ClientMockRecorder) SetSecret(id, data interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) SetSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockKvv2Client)(nil).SetSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockKvv2Client)(nil).SetSecret), ctx, id, data)
 }
 
 // ListSecrets mocks base method
-func (m *MockKvv2Client) ListSecrets() (*api.Secret, error) {
+func (m *MockKvv2Client) ListSecrets(ctx context.Context) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecrets")
+	ret := m.ctrl.Call(m, "ListSecrets", ctx)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSecrets indicates an expected call of ListSecrets
-func (mr *MockKvv2ClientMockRecorder) ListSecrets() *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) ListSecrets(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockKvv2Client)(nil).ListSecrets))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockKvv2Client)(nil).ListSecrets), ctx)
 }
 
 // DeleteSecret mocks base method
-func (m *MockKvv2Client) DeleteSecret(id string, data map[string][]string) error {
+func (m *MockKvv2Client) DeleteSecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecret", id, data)
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSecret indicates an expected call of DeleteSecret
-func (mr *MockKvv2ClientMockRecorder) DeleteSecret(id, data interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) DeleteSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockKvv2Client)(nil).DeleteSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockKvv2Client)(nil).DeleteSecret), ctx, id, data)
 }
 
 // RestoreSecret mocks base method
-func (m *MockKvv2Client) RestoreSecret(id string, data map[string][]string) error {
+func (m *MockKvv2Client) RestoreSecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreSecret", id, data)
+	ret := m.ctrl.Call(m, "RestoreSecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RestoreSecret indicates an expected call of RestoreSecret
-func (mr *MockKvv2ClientMockRecorder) RestoreSecret(id, data interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) RestoreSecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockKvv2Client)(nil).RestoreSecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockKvv2Client)(nil).RestoreSecret), ctx, id, data)
 }
 
 // DestroySecret mocks base method
-func (m *MockKvv2Client) DestroySecret(id string, data map[string][]string) error {
+func (m *MockKvv2Client) DestroySecret(ctx context.Context, id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroySecret", id, data)
+	ret := m.ctrl.Call(m, "DestroySecret", ctx, id, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DestroySecret indicates an expected call of DestroySecret
-func (mr *MockKvv2ClientMockRecorder) DestroySecret(id, data interface{}) *gomock.Call {
+func (mr *MockKvv2ClientMockRecorder) DestroySecret(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockKvv2Client)(nil).DestroySecret), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockKvv2Client)(nil).DestroySecret), ctx, id, data)
 }
 
 // MockPluginClient is a mock of PluginClient interface
@@ -443,105 +444,105 @@ func (m *MockPluginClient) EXPECT() *MockPluginClientMockRecorder {
 }
 
 // GetKey mocks base method
-func (m *MockPluginClient) GetKey(id string) (*api.Secret, error) {
+func (m *MockPluginClient) GetKey(ctx context.Context, id string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKey", id)
+	ret := m.ctrl.Call(m, "GetKey", ctx, id)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetKey indicates an expected call of GetKey
-func (mr *MockPluginClientMockRecorder) GetKey(id interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) GetKey(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockPluginClient)(nil).GetKey), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockPluginClient)(nil).GetKey), ctx, id)
 }
 
 // CreateKey mocks base method
-func (m *MockPluginClient) CreateKey(data map[string]interface{}) (*api.Secret, error) {
+func (m *MockPluginClient) CreateKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", data)
+	ret := m.ctrl.Call(m, "CreateKey", ctx, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateKey indicates an expected call of CreateKey
-func (mr *MockPluginClientMockRecorder) CreateKey(data interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) CreateKey(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockPluginClient)(nil).CreateKey), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockPluginClient)(nil).CreateKey), ctx, data)
 }
 
 // ImportKey mocks base method
-func (m *MockPluginClient) ImportKey(data map[string]interface{}) (*api.Secret, error) {
+func (m *MockPluginClient) ImportKey(ctx context.Context, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportKey", data)
+	ret := m.ctrl.Call(m, "ImportKey", ctx, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ImportKey indicates an expected call of ImportKey
-func (mr *MockPluginClientMockRecorder) ImportKey(data interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) ImportKey(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockPluginClient)(nil).ImportKey), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockPluginClient)(nil).ImportKey), ctx, data)
 }
 
 // ListKeys mocks base method
-func (m *MockPluginClient) ListKeys() (*api.Secret, error) {
+func (m *MockPluginClient) ListKeys(ctx context.Context) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListKeys")
+	ret := m.ctrl.Call(m, "ListKeys", ctx)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListKeys indicates an expected call of ListKeys
-func (mr *MockPluginClientMockRecorder) ListKeys() *gomock.Call {
+func (mr *MockPluginClientMockRecorder) ListKeys(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockPluginClient)(nil).ListKeys))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockPluginClient)(nil).ListKeys), ctx)
 }
 
 // UpdateKey mocks base method
-func (m *MockPluginClient) UpdateKey(id string, data map[string]interface{}) (*api.Secret, error) {
+func (m *MockPluginClient) UpdateKey(ctx context.Context, id string, data map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateKey", id, data)
+	ret := m.ctrl.Call(m, "UpdateKey", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateKey indicates an expected call of UpdateKey
-func (mr *MockPluginClientMockRecorder) UpdateKey(id, data interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) UpdateKey(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKey", reflect.TypeOf((*MockPluginClient)(nil).UpdateKey), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKey", reflect.TypeOf((*MockPluginClient)(nil).UpdateKey), ctx, id, data)
 }
 
 // DestroyKey mocks base method
-func (m *MockPluginClient) DestroyKey(id string) error {
+func (m *MockPluginClient) DestroyKey(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroyKey", id)
+	ret := m.ctrl.Call(m, "DestroyKey", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DestroyKey indicates an expected call of DestroyKey
-func (mr *MockPluginClientMockRecorder) DestroyKey(id interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) DestroyKey(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyKey", reflect.TypeOf((*MockPluginClient)(nil).DestroyKey), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyKey", reflect.TypeOf((*MockPluginClient)(nil).DestroyKey), ctx, id)
 }
 
 // Sign mocks base method
-func (m *MockPluginClient) Sign(id string, data []byte) (*api.Secret, error) {
+func (m *MockPluginClient) Sign(ctx context.Context, id string, data []byte) (*api.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Sign", id, data)
+	ret := m.ctrl.Call(m, "Sign", ctx, id, data)
 	ret0, _ := ret[0].(*api.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Sign indicates an expected call of Sign
-func (mr *MockPluginClientMockRecorder) Sign(id, data interface{}) *gomock.Call {
+func (mr *MockPluginClientMockRecorder) Sign(ctx, id, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPluginClient)(nil).Sign), id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPluginClient)(nil).Sign), ctx, id, data)
 }
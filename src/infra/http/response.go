@@ -18,16 +18,20 @@ const (
 
 func WriteHTTPErrorResponse(rw http.ResponseWriter, err error) {
 	switch {
-	case errors.IsAlreadyExistsError(err) || errors.IsStatusConflictError(err):
+	case errors.IsAlreadyExistsError(err) || errors.IsStatusConflictError(err) || errors.IsDisabledError(err):
 		writeErrorResponse(rw, http.StatusConflict, err)
 	case errors.IsNotFoundError(err):
 		writeErrorResponse(rw, http.StatusNotFound, err)
+	case errors.IsExpiredError(err):
+		writeErrorResponse(rw, http.StatusGone, err)
 	case errors.IsUnauthorizedError(err):
 		writeErrorResponse(rw, http.StatusUnauthorized, err)
 	case errors.IsForbiddenError(err):
 		writeErrorResponse(rw, http.StatusForbidden, err)
 	case errors.IsInvalidFormatError(err):
 		writeErrorResponse(rw, http.StatusBadRequest, err)
+	case errors.IsPendingApprovalError(err):
+		writeErrorResponse(rw, http.StatusAccepted, err)
 	case errors.IsTooManyRequestError(err):
 		writeErrorResponse(rw, http.StatusTooManyRequests, err)
 	case errors.IsInvalidParameterError(err), errors.IsEncodingError(err):
@@ -124,3 +128,24 @@ func WritePagingResponse(rw http.ResponseWriter, req *http.Request, data interfa
 
 	return WriteJSON(rw, res)
 }
+
+func WriteCursorPagingResponse(rw http.ResponseWriter, req *http.Request, data interface{}, nextCursor string) error {
+	res := PageResponse{
+		Data: data,
+	}
+
+	if nextCursor != "" {
+		nextBaseURL, _ := url.Parse(req.Host)
+		nextParams := req.URL.Query()
+		nextParams.Set("cursor", nextCursor)
+
+		nextBaseURL.RawQuery = nextParams.Encode()
+		res.Paging.Next = nextBaseURL.String()
+		if req.TLS != nil {
+			res.Paging.Next = "https://" + res.Paging.Next
+		}
+		res.Paging.Cursor = nextCursor
+	}
+
+	return WriteJSON(rw, res)
+}
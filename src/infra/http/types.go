@@ -13,4 +13,5 @@ type PageResponse struct {
 type PagePagingResponse struct {
 	Previous string `json:"previous,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=1"`
 	Next     string `json:"next,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=3"`
+	Cursor   string `json:"cursor,omitempty" example:"eyJjIjoiMjAyMS0wNi0wMVQwMDowMDowMFoiLCJpIjoibXkta2V5In0"`
 }
@@ -1,5 +1,9 @@
 package log
 
+import (
+	"context"
+)
+
 //go:generate mockgen -source=logger.go -destination=mock/logger.go -package=mocks
 
 type Logger interface {
@@ -12,5 +16,6 @@ type Logger interface {
 	Fatal(msg string, keysAndValues ...interface{}) Logger
 	WithError(err error) Logger
 	With(args ...interface{}) Logger
+	WithContext(ctx context.Context) Logger
 	Write(p []byte) (n int, err error)
 }
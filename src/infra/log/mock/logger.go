@@ -8,6 +8,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	"context"
 )
 
 // MockLogger is a mock of Logger interface
@@ -193,6 +194,20 @@ func (mr *MockLoggerMockRecorder) With(args ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "With", reflect.TypeOf((*MockLogger)(nil).With), args...)
 }
 
+// WithContext mock base method
+func (m *MockLogger) WithContext(ctx context.Context) log.Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(log.Logger)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext
+func (mr *MockLoggerMockRecorder) WithContext(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockLogger)(nil).WithContext), ctx)
+}
+
 // Write mock base method
 func (m *MockLogger) Write(p []byte) (int, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ func NewMockLogger(ctrl *gomock.Controller) *mock.MockLogger {
 	mockLogger.EXPECT().Fatal(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
 	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockLogger.EXPECT().WithError(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockLogger.EXPECT().WithComponent(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockLogger.EXPECT().Write(gomock.Any()).Return(0, nil).AnyTimes()
 
@@ -1,8 +1,11 @@
 package zap
 
 import (
+	"context"
+
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
 	"go.elastic.co/ecszap"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -81,6 +84,17 @@ func (l Logger) With(args ...interface{}) log.Logger {
 	return &l
 }
 
+// WithContext adds the identifiers of the span of the context, if any, so that logs can be correlated with traces
+func (l Logger) WithContext(ctx context.Context) log.Logger {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return &l
+	}
+
+	l.logger = l.logger.With("trace.id", spanCtx.TraceID().String(), "span.id", spanCtx.SpanID().String())
+	return &l
+}
+
 func (l Logger) WithComponent(component string) log.Logger {
 	l.logger = l.logger.Desugar().Named(component).Sugar()
 	return &l
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const unknownRoute = "unknown"
+
+// HTTPMiddleware measures the requests served by a router, labelled by the template of the matched route to keep
+// the cardinality bounded
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		labels := prometheus.Labels{"route": routeTemplate(req)}
+
+		h := promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(labels), next)
+		h = promhttp.InstrumentHandlerCounter(httpRequestsTotal.MustCurryWith(labels), h)
+		h.ServeHTTP(rw, req)
+	})
+}
+
+func routeTemplate(req *http.Request) string {
+	route := mux.CurrentRoute(req)
+	if route == nil {
+		return unknownRoute
+	}
+
+	tpl, err := route.GetPathTemplate()
+	if err != nil {
+		return unknownRoute
+	}
+
+	return tpl
+}
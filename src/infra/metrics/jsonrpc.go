@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+)
+
+// JSONRPCMiddleware measures the requests served by the handler of a node. Responses holding an error count as failures
+func JSONRPCMiddleware(node string, h jsonrpc.Handler) jsonrpc.Handler {
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		start := time.Now()
+		rec := &outcomeResponseWriter{rw: rw}
+		h.ServeRPC(rec, msg)
+
+		method := methodLabel(msg.Method)
+		jsonrpcRequestDuration.WithLabelValues(node, method).Observe(time.Since(start).Seconds())
+		jsonrpcRequestsTotal.WithLabelValues(node, method, outcome(rec.failed)).Inc()
+	})
+}
+
+// OtherMethod labels the JSON-RPC methods unknown to the key manager, whose names are chosen freely by clients
+const OtherMethod = "other"
+
+// knownMethods are the methods intercepted by the key manager and the standard methods of Ethereum, GoQuorum and Besu
+// nodes, which bound the cardinality of the method label
+var knownMethods = map[string]bool{
+	"eth_accounts":                            true,
+	"eth_blockNumber":                         true,
+	"eth_call":                                true,
+	"eth_chainId":                             true,
+	"eth_coinbase":                            true,
+	"eth_estimateGas":                         true,
+	"eth_feeHistory":                          true,
+	"eth_gasPrice":                            true,
+	"eth_getBalance":                          true,
+	"eth_getBlockByHash":                      true,
+	"eth_getBlockByNumber":                    true,
+	"eth_getBlockTransactionCountByHash":      true,
+	"eth_getBlockTransactionCountByNumber":    true,
+	"eth_getCode":                             true,
+	"eth_getFilterChanges":                    true,
+	"eth_getFilterLogs":                       true,
+	"eth_getLogs":                             true,
+	"eth_getProof":                            true,
+	"eth_getStorageAt":                        true,
+	"eth_getTransactionByBlockHashAndIndex":   true,
+	"eth_getTransactionByBlockNumberAndIndex": true,
+	"eth_getTransactionByHash":                true,
+	"eth_getTransactionCount":                 true,
+	"eth_getTransactionReceipt":               true,
+	"eth_getUncleByBlockHashAndIndex":         true,
+	"eth_getUncleByBlockNumberAndIndex":       true,
+	"eth_getUncleCountByBlockHash":            true,
+	"eth_getUncleCountByBlockNumber":          true,
+	"eth_maxPriorityFeePerGas":                true,
+	"eth_mining":                              true,
+	"eth_newBlockFilter":                      true,
+	"eth_newFilter":                           true,
+	"eth_newPendingTransactionFilter":         true,
+	"eth_protocolVersion":                     true,
+	"eth_sendRawPrivateTransaction":           true,
+	"eth_sendRawTransaction":                  true,
+	"eth_sendTransaction":                     true,
+	"eth_sign":                                true,
+	"eth_signTransaction":                     true,
+	"eth_signTypedData":                       true,
+	"eth_signTypedData_v3":                    true,
+	"eth_signTypedData_v4":                    true,
+	"eth_subscribe":                           true,
+	"eth_syncing":                             true,
+	"eth_uninstallFilter":                     true,
+	"eth_unsubscribe":                         true,
+	"net_listening":                           true,
+	"net_peerCount":                           true,
+	"net_version":                             true,
+	"web3_clientVersion":                      true,
+	"web3_sha3":                               true,
+	"personal_ecRecover":                      true,
+	"personal_sign":                           true,
+	"eea_createPrivacyGroup":                  true,
+	"eea_sendRawTransaction":                  true,
+	"eea_sendTransaction":                     true,
+	"priv_createPrivacyGroup":                 true,
+	"priv_deletePrivacyGroup":                 true,
+	"priv_distributeRawTransaction":           true,
+	"priv_findPrivacyGroup":                   true,
+	"priv_getCode":                            true,
+	"priv_getEeaTransactionCount":             true,
+	"priv_getLogs":                            true,
+	"priv_getPrivacyPrecompileAddress":        true,
+	"priv_getPrivateTransaction":              true,
+	"priv_getTransactionCount":                true,
+	"priv_getTransactionReceipt":              true,
+	"priv_call":                               true,
+}
+
+func methodLabel(method string) string {
+	if knownMethods[method] {
+		return method
+	}
+
+	return OtherMethod
+}
+
+type outcomeResponseWriter struct {
+	rw     jsonrpc.ResponseWriter
+	failed bool
+}
+
+func (rw *outcomeResponseWriter) WriteMsg(msg *jsonrpc.ResponseMsg) error {
+	if msg.Error != nil {
+		rw.failed = true
+	}
+
+	err := rw.rw.WriteMsg(msg)
+	if err != nil {
+		rw.failed = true
+	}
+
+	return err
+}
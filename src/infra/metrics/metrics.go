@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "key_manager"
+
+const (
+	SuccessOutcome = "success"
+	FailureOutcome = "failure"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests by route, method and status code",
+	}, []string{"route", "method", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by route, method and status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	storeOperationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "operations_total",
+		Help:      "Total number of store operations by store, resource, operation and outcome",
+	}, []string{"store", "resource", "operation", "outcome"})
+
+	vaultRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "vault",
+		Name:      "requests_total",
+		Help:      "Total number of calls to vault backends by backend, vault and outcome",
+	}, []string{"backend", "vault", "outcome"})
+
+	vaultRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "vault",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of calls to vault backends by backend and vault",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "vault"})
+
+	jsonrpcRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "jsonrpc",
+		Name:      "requests_total",
+		Help:      "Total number of JSON-RPC requests served by the node proxy by node, method and outcome",
+	}, []string{"node", "method", "outcome"})
+
+	jsonrpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "jsonrpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of JSON-RPC requests served by the node proxy by node and method",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"node", "method"})
+
+	storeSyncDrift = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "sync_drift_items",
+		Help:      "Number of items which drifted from the vault at the last synchronization of a store, by store and drift",
+	}, []string{"store", "drift"})
+
+	rateLimitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ratelimit",
+		Name:      "rejected_total",
+		Help:      "Total number of requests rejected by the rate limiter by layer and operation",
+	}, []string{"layer", "operation"})
+
+	reloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "reload",
+		Name:      "total",
+		Help:      "Total number of reloads of manifests, API keys and TLS CAs by source and outcome",
+	}, []string{"source", "outcome"})
+)
+
+// ObserveStoreOperation counts an operation performed on a store
+func ObserveStoreOperation(store, resource, operation, outcome string) {
+	storeOperationsTotal.WithLabelValues(store, resource, operation, outcome).Inc()
+}
+
+// ObserveStoreSync records the drift found by the last synchronization of a store
+func ObserveStoreSync(store string, added, deleted, updated, failed int) {
+	storeSyncDrift.WithLabelValues(store, "added").Set(float64(added))
+	storeSyncDrift.WithLabelValues(store, "deleted").Set(float64(deleted))
+	storeSyncDrift.WithLabelValues(store, "updated").Set(float64(updated))
+	storeSyncDrift.WithLabelValues(store, "failed").Set(float64(failed))
+}
+
+// ObserveRateLimited counts a request rejected by the rate limiter
+func ObserveRateLimited(layer, operation string) {
+	rateLimitedTotal.WithLabelValues(layer, operation).Inc()
+}
+
+// ObserveReload counts a reload of a configuration source
+func ObserveReload(source string, err error) {
+	reloadsTotal.WithLabelValues(source, outcome(err != nil)).Inc()
+}
+
+func outcome(failed bool) string {
+	if failed {
+		return FailureOutcome
+	}
+
+	return SuccessOutcome
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(HTTPMiddleware)
+	router.HandleFunc("/stores/{storeName}/keys/{id}", func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/stores/my-store/keys/my-key", nil))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(httpRequestsTotal.WithLabelValues("/stores/{storeName}/keys/{id}", "get", "404")))
+}
+
+func TestVaultTransport(t *testing.T) {
+	t.Run("should count 5xx responses as failures", func(t *testing.T) {
+		next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable}, nil
+		})
+
+		_, err := NewVaultTransport(HashicorpBackend, "failing-vault", next).RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), testutil.ToFloat64(vaultRequestsTotal.WithLabelValues(HashicorpBackend, "failing-vault", FailureOutcome)))
+	})
+
+	t.Run("should count transport errors as failures", func(t *testing.T) {
+		next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("error")
+		})
+
+		_, err := NewVaultTransport(AWSBackend, "unreachable-vault", next).RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Error(t, err)
+		assert.Equal(t, float64(1), testutil.ToFloat64(vaultRequestsTotal.WithLabelValues(AWSBackend, "unreachable-vault", FailureOutcome)))
+	})
+
+	t.Run("should count successful responses", func(t *testing.T) {
+		next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+
+		_, err := NewVaultTransport(AKVBackend, "my-vault", next).RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), testutil.ToFloat64(vaultRequestsTotal.WithLabelValues(AKVBackend, "my-vault", SuccessOutcome)))
+	})
+}
+
+func TestJSONRPCMiddleware(t *testing.T) {
+	h := JSONRPCMiddleware("my-node", jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		if msg.Method == "eth_sendTransaction" {
+			_ = jsonrpc.WriteError(rw, fmt.Errorf("error"))
+			return
+		}
+		_ = jsonrpc.WriteResult(rw, "0x1")
+	}))
+
+	h.ServeRPC(&discardResponseWriter{}, (&jsonrpc.RequestMsg{}).WithMethod("eth_blockNumber"))
+	h.ServeRPC(&discardResponseWriter{}, (&jsonrpc.RequestMsg{}).WithMethod("eth_sendTransaction"))
+	h.ServeRPC(&discardResponseWriter{}, (&jsonrpc.RequestMsg{}).WithMethod("random_method1"))
+	h.ServeRPC(&discardResponseWriter{}, (&jsonrpc.RequestMsg{}).WithMethod("random_method2"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(jsonrpcRequestsTotal.WithLabelValues("my-node", "eth_blockNumber", SuccessOutcome)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(jsonrpcRequestsTotal.WithLabelValues("my-node", "eth_sendTransaction", FailureOutcome)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(jsonrpcRequestsTotal.WithLabelValues("my-node", OtherMethod, SuccessOutcome)))
+}
+
+type discardResponseWriter struct{}
+
+func (rw *discardResponseWriter) WriteMsg(*jsonrpc.ResponseMsg) error {
+	return nil
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	HashicorpBackend = "hashicorp"
+	AKVBackend       = "akv"
+	AWSBackend       = "aws"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// NewVaultTransport measures the calls of a vault client. Transport errors and 5xx or 429 responses count as failures
+func NewVaultTransport(backend, vault string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.RoundTrip(req)
+
+		vaultRequestDuration.WithLabelValues(backend, vault).Observe(time.Since(start).Seconds())
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		vaultRequestsTotal.WithLabelValues(backend, vault, outcome(failed)).Inc()
+
+		return resp, err
+	})
+}
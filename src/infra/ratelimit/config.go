@@ -0,0 +1,45 @@
+package ratelimit
+
+import "fmt"
+
+// Requests are limited per tenant, per user of a tenant or per API key
+const (
+	KeyTenant = "tenant"
+	KeyUser   = "user"
+	KeyAPIKey = "api-key"
+)
+
+type Config struct {
+	// Key is the identity requests are limited by
+	Key  string
+	Sign Budget
+	Read Budget
+}
+
+// Budget is the number of requests per second allowed for a class of operations, with bursts up to Burst requests
+type Budget struct {
+	Rate  float64
+	Burst int
+}
+
+func NewConfig(key string, sign, read Budget) *Config {
+	return &Config{
+		Key:  key,
+		Sign: sign,
+		Read: read,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.Key {
+	case KeyTenant, KeyUser, KeyAPIKey:
+	default:
+		return fmt.Errorf("invalid rate limit key %q, expected %q, %q or %q", cfg.Key, KeyTenant, KeyUser, KeyAPIKey)
+	}
+
+	if cfg.Sign.Rate < 0 || cfg.Read.Rate < 0 {
+		return fmt.Errorf("rate limits cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+)
+
+// HTTPMiddleware limits the requests of the authenticated user, so it must be wrapped by the authentication.
+// JSON-RPC requests to nodes are limited per method by JSONRPCMiddleware instead
+func HTTPMiddleware(limiter *Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if isJSONRPCPath(r.URL.Path) {
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			operation := httpOperation(r)
+			retryAfter, allowed := limiter.Allow(auth.UserInfoFromContext(r.Context()), operation)
+			if !allowed {
+				metrics.ObserveRateLimited("http", operation)
+				rw.Header().Set("Retry-After", strconv.Itoa(RetryAfterSeconds(retryAfter)))
+				infrahttp.WriteHTTPErrorResponse(rw, limitError(operation, retryAfter))
+				return
+			}
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// httpOperation classifies signing, encryption and decryption with keys and accounts as signing, anything else as reading
+func httpOperation(r *http.Request) string {
+	if r.Method != http.MethodPost {
+		return OperationRead
+	}
+
+	action := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	if strings.HasPrefix(action, "sign") || action == "encrypt" || action == "decrypt" {
+		return OperationSign
+	}
+
+	return OperationRead
+}
+
+// isJSONRPCPath indicates whether the path is served by a node, i.e. /nodes/{nodeName} but not the definition nor the
+// transactions of the node
+func isJSONRPCPath(path string) bool {
+	if !strings.HasPrefix(path, "/nodes/") {
+		return false
+	}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	return len(parts) < 3 || (parts[2] != "definition" && parts[2] != "transactions")
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+)
+
+var signMethods = map[string]bool{
+	"eth_sendTransaction": true,
+	"eth_sign":            true,
+	"eth_signTransaction": true,
+	"eea_sendTransaction": true,
+}
+
+// JSONRPCMiddleware limits each request served by the handler of a node, including the requests of batches and websocket
+// connections. A single request over HTTP is rejected with a 429 status and a Retry-After header
+func JSONRPCMiddleware(limiter *Limiter, h jsonrpc.Handler) jsonrpc.Handler {
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		operation := jsonrpcOperation(msg.Method)
+		retryAfter, allowed := limiter.Allow(auth.UserInfoFromContext(msg.Context()), operation)
+		if !allowed {
+			metrics.ObserveRateLimited("jsonrpc", operation)
+			seconds := RetryAfterSeconds(retryAfter)
+
+			if ww, ok := rw.(common.WriterWrapper); ok {
+				if httpRw, ok := ww.Writer().(http.ResponseWriter); ok {
+					httpRw.Header().Set("Retry-After", strconv.Itoa(seconds))
+					httpRw.Header().Set("Content-Type", "application/json")
+					httpRw.WriteHeader(http.StatusTooManyRequests)
+				}
+			}
+
+			_ = jsonrpc.WriteError(jsonrpc.RWWithVersion(msg.Version)(jsonrpc.RWWithID(msg.ID)(rw)), jsonrpc.LimitExceededError(limitError(operation, retryAfter), seconds))
+			return
+		}
+
+		h.ServeRPC(rw, msg)
+	})
+}
+
+func jsonrpcOperation(method string) string {
+	if signMethods[method] || strings.HasPrefix(method, "personal_sign") || strings.HasPrefix(method, "eth_signTypedData") {
+		return OperationSign
+	}
+
+	return OperationRead
+}
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"golang.org/x/time/rate"
+)
+
+// Operations are limited with separate budgets, signing being the most expensive for the vaults
+const (
+	OperationSign = "sign"
+	OperationRead = "read"
+)
+
+// Buckets not used for idleTimeout are dropped, as they are full again by then
+const idleTimeout = 10 * time.Minute
+
+// Limiter limits the requests of each tenant, user or API key with token buckets
+type Limiter struct {
+	cfg *Config
+
+	mux       sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func New(cfg *Config) (*Limiter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		cfg:       cfg,
+		buckets:   make(map[string]*bucket),
+		lastPrune: time.Now(),
+	}, nil
+}
+
+// Allow consumes a request of the user for the operation. If its budget is exhausted, the request is rejected with the
+// delay after which it would be allowed
+func (l *Limiter) Allow(userInfo *entities.UserInfo, operation string) (retryAfter time.Duration, allowed bool) {
+	budget := l.budget(operation)
+	if budget.Rate == 0 {
+		return 0, true
+	}
+
+	now := time.Now()
+	limiter := l.limiter(operation+"/"+l.key(userInfo), budget, now)
+
+	reservation := limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Second, false
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// The request is rejected rather than delayed, so its token is given back
+		reservation.CancelAt(now)
+		return delay, false
+	}
+
+	return 0, true
+}
+
+func (l *Limiter) budget(operation string) Budget {
+	if operation == OperationSign {
+		return l.cfg.Sign
+	}
+
+	return l.cfg.Read
+}
+
+func (l *Limiter) key(userInfo *entities.UserInfo) string {
+	switch {
+	case l.cfg.Key == KeyAPIKey && userInfo.APIKeyID != "":
+		return "api-key|" + userInfo.APIKeyID
+	case l.cfg.Key == KeyTenant:
+		return "tenant|" + userInfo.Tenant
+	default:
+		return "user|" + userInfo.Tenant + "|" + userInfo.Username
+	}
+}
+
+func (l *Limiter) limiter(key string, budget Budget, now time.Time) *rate.Limiter {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if now.Sub(l.lastPrune) >= idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) >= idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		burst := budget.Burst
+		if burst < 1 {
+			burst = int(math.Max(1, math.Ceil(budget.Rate)))
+		}
+
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(budget.Rate), burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	return b.limiter
+}
+
+// RetryAfterSeconds is the value of the Retry-After header for a delay, rounded up to the second
+func RetryAfterSeconds(retryAfter time.Duration) int {
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+func limitError(operation string, retryAfter time.Duration) error {
+	return errors.TooManyRequestError("%s rate limit exceeded, retry after %ds", operation, RetryAfterSeconds(retryAfter))
+}
@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllow(t *testing.T) {
+	alice := &entities.UserInfo{Tenant: "tenantOne", Username: "alice", APIKeyID: "1"}
+	bob := &entities.UserInfo{Tenant: "tenantOne", Username: "bob", APIKeyID: "2"}
+	eve := &entities.UserInfo{Tenant: "tenantTwo", Username: "eve"}
+
+	t.Run("should limit each tenant with separate budgets for signing and reading", func(t *testing.T) {
+		limiter, err := New(NewConfig(KeyTenant, Budget{Rate: 0.001, Burst: 1}, Budget{Rate: 0.001, Burst: 2}))
+		require.NoError(t, err)
+
+		_, allowed := limiter.Allow(alice, OperationSign)
+		assert.True(t, allowed)
+		retryAfter, allowed := limiter.Allow(bob, OperationSign)
+		assert.False(t, allowed)
+		assert.True(t, retryAfter > 0)
+
+		_, allowed = limiter.Allow(eve, OperationSign)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(alice, OperationRead)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(bob, OperationRead)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(bob, OperationRead)
+		assert.False(t, allowed)
+	})
+
+	t.Run("should limit each user of a tenant", func(t *testing.T) {
+		limiter, err := New(NewConfig(KeyUser, Budget{Rate: 0.001, Burst: 1}, Budget{}))
+		require.NoError(t, err)
+
+		_, allowed := limiter.Allow(alice, OperationSign)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(bob, OperationSign)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(alice, OperationSign)
+		assert.False(t, allowed)
+	})
+
+	t.Run("should limit each api key and not limit operations without budget", func(t *testing.T) {
+		limiter, err := New(NewConfig(KeyAPIKey, Budget{Rate: 0.001, Burst: 1}, Budget{}))
+		require.NoError(t, err)
+
+		_, allowed := limiter.Allow(alice, OperationSign)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(&entities.UserInfo{Tenant: "tenantOne", Username: "alice", APIKeyID: "3"}, OperationSign)
+		assert.True(t, allowed)
+		_, allowed = limiter.Allow(alice, OperationSign)
+		assert.False(t, allowed)
+
+		for i := 0; i < 10; i++ {
+			_, allowed = limiter.Allow(alice, OperationRead)
+			assert.True(t, allowed)
+		}
+	})
+
+	t.Run("should fail with invalid key", func(t *testing.T) {
+		_, err := New(NewConfig("ip", Budget{Rate: 1}, Budget{}))
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	limiter, err := New(NewConfig(KeyTenant, Budget{Rate: 0.001, Burst: 1}, Budget{Rate: 0.001, Burst: 1}))
+	require.NoError(t, err)
+
+	handler := auth.NewNoAuth().Middleware(HTTPMiddleware(limiter)(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(method, path, nil))
+		return rw
+	}
+
+	t.Run("should reject signing requests exceeding the budget with 429 and Retry-After", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/stores/my-store/keys/my-key/sign").Code)
+
+		rw := serve(http.MethodPost, "/stores/my-store/ethereum/0x123/sign-transaction")
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+		assert.NotEmpty(t, rw.Header().Get("Retry-After"))
+	})
+
+	t.Run("should limit reads with their own budget", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/stores/my-store/keys/my-key").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "/stores/my-store/keys").Code)
+	})
+
+	t.Run("should leave JSON-RPC requests to the JSON-RPC middleware", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/nodes/my-node").Code)
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/nodes/my-node/").Code)
+	})
+
+	t.Run("should limit the transactions of a node as reads", func(t *testing.T) {
+		assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "/nodes/my-node/transactions").Code)
+	})
+}
@@ -0,0 +1,19 @@
+package tracing
+
+// Config configures the export of spans to an OpenTelemetry collector
+type Config struct {
+	// Endpoint is the host and port of the OTLP/HTTP collector
+	Endpoint string
+	// Insecure disables TLS towards the collector
+	Insecure bool
+	// SampleRatio is the ratio of the traces started by the key manager that are sampled
+	SampleRatio float64
+}
+
+func NewConfig(endpoint string, insecure bool, sampleRatio float64) *Config {
+	return &Config{
+		Endpoint:    endpoint,
+		Insecure:    insecure,
+		SampleRatio: sampleRatio,
+	}
+}
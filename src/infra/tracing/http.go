@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPMiddleware starts a span for each request served, continuing the trace of the caller given in the W3C
+// traceparent header. It must wrap the authentication so that the whole request is traced
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "", otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+		return fmt.Sprintf("HTTP %s", req.Method)
+	}))
+}
+
+// RouteMiddleware names the span of a request after the template of its route, only known once the request is routed
+func RouteMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if route := mux.CurrentRoute(req); route != nil {
+			if tpl, err := route.GetPathTemplate(); err == nil {
+				span := trace.SpanFromContext(req.Context())
+				span.SetName(fmt.Sprintf("%s %s", req.Method, tpl))
+				span.SetAttributes(semconv.HTTPRouteKey.String(tpl))
+			}
+		}
+
+		next.ServeHTTP(rw, req)
+	})
+}
+
+// NewTransport records a span for each request sent downstream and propagates the trace context in the W3C
+// traceparent header. Requests sent outside of a trace, e.g. by clients ignoring the context, are not traced
+func NewTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return otelhttp.NewTransport(next, otelhttp.WithFilter(func(req *http.Request) bool {
+		return trace.SpanContextFromContext(req.Context()).IsValid()
+	}))
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHTTPTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var downstreamTraceparent string
+	downstream := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		downstreamTraceparent = req.Header.Get("traceparent")
+	}))
+	defer downstream.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	router := mux.NewRouter()
+	router.Use(RouteMiddleware)
+	router.Methods(http.MethodPost).Path("/nodes/{nodeName}").HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		downstreamReq, _ := http.NewRequestWithContext(req.Context(), http.MethodPost, downstream.URL, nil)
+		resp, err := client.Do(downstreamReq)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	})
+	handler := HTTPMiddleware(router)
+
+	t.Run("should continue the trace of the caller and propagate it downstream", func(t *testing.T) {
+		traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+		req := httptest.NewRequest(http.MethodPost, "/nodes/quorum", nil)
+		req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		clientSpan, serverSpan := spans[0], spans[1]
+
+		assert.Equal(t, "POST /nodes/{nodeName}", serverSpan.Name())
+		assert.Equal(t, trace.SpanKindServer, serverSpan.SpanKind())
+		assert.Equal(t, traceID, serverSpan.SpanContext().TraceID().String())
+		assert.Equal(t, serverSpan.SpanContext().SpanID(), clientSpan.Parent().SpanID())
+		assert.Equal(t, "00-"+traceID+"-"+clientSpan.SpanContext().SpanID().String()+"-01", downstreamTraceparent)
+	})
+
+	t.Run("should not trace requests sent outside of a trace", func(t *testing.T) {
+		ended := len(recorder.Ended())
+
+		resp, err := client.Post(downstream.URL, "application/json", nil)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		assert.Len(t, recorder.Ended(), ended)
+		assert.Empty(t, downstreamTraceparent)
+	})
+}
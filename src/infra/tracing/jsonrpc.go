@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+)
+
+// JSONRPCMiddleware starts a span for each request served by the handler of a node, so that the calls to the stores
+// and to the downstream node are grouped by JSON-RPC method. Responses holding an error mark the span as failed
+func JSONRPCMiddleware(node string, h jsonrpc.Handler) jsonrpc.Handler {
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		ctx, span := StartSpan(msg.Context(), fmt.Sprintf("jsonrpc %s", msg.Method),
+			semconv.RPCSystemKey.String("jsonrpc"),
+			semconv.RPCMethodKey.String(msg.Method),
+			attribute.String("node", node),
+		)
+		defer span.End()
+
+		rec := &errorResponseWriter{rw: rw}
+		h.ServeRPC(rec, msg.WithContext(ctx))
+
+		if rec.err != nil {
+			span.SetStatus(codes.Error, rec.err.Error())
+		}
+	})
+}
+
+type errorResponseWriter struct {
+	rw  jsonrpc.ResponseWriter
+	err error
+}
+
+func (rw *errorResponseWriter) WriteMsg(msg *jsonrpc.ResponseMsg) error {
+	if msg.Error != nil {
+		rw.err = msg.Error
+	}
+
+	err := rw.rw.WriteMsg(msg)
+	if err != nil {
+		rw.err = err
+	}
+
+	return err
+}
@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ServiceName = "quorum-key-manager"
+	tracerName  = "github.com/longfan78/quorum-key-manager"
+)
+
+// Provider exports the spans of the key manager to an OTLP collector. It is registered as the global tracer provider
+// so that spans are recorded by every layer, and flushes the pending spans when stopped
+type Provider struct {
+	provider *sdktrace.TracerProvider
+}
+
+var _ common.Runnable = &Provider{}
+
+func New(ctx context.Context, cfg *Config, logger log.Logger) (*Provider, error) {
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.WithError(err).Warn("failed to export spans")
+	}))
+
+	logger.Info("tracing is enabled", "endpoint", cfg.Endpoint, "sample_ratio", cfg.SampleRatio)
+	return &Provider{provider: provider}, nil
+}
+
+func (p *Provider) Start(_ context.Context) error {
+	return nil
+}
+
+func (p *Provider) Stop(ctx context.Context) error {
+	return p.provider.Shutdown(ctx)
+}
+
+func (p *Provider) Close() error {
+	return nil
+}
+
+func (p *Provider) Error() error {
+	return nil
+}
+
+// StartSpan starts a span, child of the span of the context if any
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error of an operation, if any, and ends its span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,16 @@
+package watcher
+
+import "time"
+
+type Config struct {
+	// WatchFiles reloads when the watched files change, otherwise only SIGHUP triggers a reload
+	WatchFiles bool
+	Debounce   time.Duration
+}
+
+func NewConfig(watchFiles bool, debounce time.Duration) *Config {
+	return &Config{
+		WatchFiles: watchFiles,
+		Debounce:   debounce,
+	}
+}
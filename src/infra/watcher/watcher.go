@@ -0,0 +1,167 @@
+package watcher
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+// ReloadFunc is called when a watched file changes or the process receives SIGHUP
+type ReloadFunc func(ctx context.Context)
+
+// Watcher triggers a reload on SIGHUP and on changes of the watched files. Changes are debounced as editors and
+// Kubernetes config maps update files in several steps
+type Watcher struct {
+	paths    []string
+	debounce time.Duration
+	reload   ReloadFunc
+	watcher  *fsnotify.Watcher
+	signals  chan os.Signal
+	logger   log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Watcher{}
+
+func New(cfg *Config, paths []string, reload ReloadFunc, logger log.Logger) (*Watcher, error) {
+	w := &Watcher{
+		debounce: cfg.Debounce,
+		reload:   reload,
+		signals:  make(chan os.Signal, 1),
+		logger:   logger,
+		done:     make(chan struct{}),
+	}
+
+	if !cfg.WatchFiles || len(paths) == 0 {
+		return w, nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		errMessage := "failed to instantiate watcher"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	for _, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			_ = watcher.Close()
+			return nil, errors.InvalidParameterError(err.Error())
+		}
+
+		// Parent directories are watched as files replaced by a rename, e.g. by Kubernetes, are no longer watched
+		dir := absPath
+		if info, err := os.Stat(absPath); err != nil || !info.IsDir() {
+			dir = filepath.Dir(absPath)
+		}
+
+		err = watcher.Add(dir)
+		if err != nil {
+			_ = watcher.Close()
+			errMessage := "failed to watch path"
+			logger.WithError(err).Error(errMessage, "path", path)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		w.paths = append(w.paths, dir)
+	}
+	w.watcher = watcher
+
+	return w, nil
+}
+
+func (w *Watcher) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	signal.Notify(w.signals, syscall.SIGHUP)
+	go w.run(ctx)
+
+	w.logger.Info("reload watcher started", "paths", w.paths)
+	return nil
+}
+
+func (w *Watcher) Stop(ctx context.Context) error {
+	signal.Stop(w.signals)
+	w.cancel()
+
+	select {
+	case <-w.done:
+		w.logger.Info("reload watcher stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Watcher) Close() error {
+	if w.watcher != nil {
+		return w.watcher.Close()
+	}
+
+	return nil
+}
+
+func (w *Watcher) Error() error {
+	return w.err
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	defer close(w.done)
+
+	var events <-chan fsnotify.Event
+	var watchErrors <-chan error
+	if w.watcher != nil {
+		events = w.watcher.Events
+		watchErrors = w.watcher.Errors
+	}
+
+	// The timer only fires once changes have settled for the debounce duration
+	timer := time.NewTimer(0)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.signals:
+			w.logger.Info("SIGHUP received, reloading")
+			w.reload(ctx)
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			// Every change in the watched directories triggers a reload as mounted files, e.g. Kubernetes config maps,
+			// are updated by swapping symlinks whose names differ from the watched files
+			w.logger.Debug("watched file changed", "file", event.Name, "op", event.Op.String())
+			timer.Reset(w.debounce)
+		case <-timer.C:
+			w.logger.Info("watched files changed, reloading")
+			w.reload(ctx)
+		case err, ok := <-watchErrors:
+			if !ok {
+				return
+			}
+
+			// Failing to watch files must not take the server down as SIGHUP still triggers reloads
+			w.err = err
+			w.logger.WithError(err).Error("failed to watch file events")
+		}
+	}
+}
@@ -0,0 +1,76 @@
+//go:build !windows
+// +build !windows
+
+package watcher
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "watcher")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "apikeys.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte("initial"), 0600))
+
+	reloads := make(chan struct{}, 10)
+	w, err := New(NewConfig(true, 50*time.Millisecond), []string{path}, func(context.Context) {
+		reloads <- struct{}{}
+	}, logger)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Start(ctx))
+	defer func() {
+		assert.NoError(t, w.Stop(ctx))
+		assert.NoError(t, w.Close())
+	}()
+
+	t.Run("should reload once when a watched file changes several times", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(path, []byte("first"), 0600))
+		require.NoError(t, ioutil.WriteFile(path, []byte("second"), 0600))
+
+		assertReloaded(t, reloads)
+		assertNotReloaded(t, reloads)
+	})
+
+	t.Run("should reload on SIGHUP", func(t *testing.T) {
+		require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+
+		assertReloaded(t, reloads)
+	})
+}
+
+func assertReloaded(t *testing.T, reloads <-chan struct{}) {
+	select {
+	case <-reloads:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a reload")
+	}
+}
+
+func assertNotReloaded(t *testing.T, reloads <-chan struct{}) {
+	select {
+	case <-reloads:
+		t.Fatal("unexpected reload")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -3,56 +3,39 @@ package src
 import (
 	"context"
 
-	"github.com/longfan78/quorum-key-manager/src/auth"
-	rolesapi "github.com/longfan78/quorum-key-manager/src/auth/api/manifest"
 	"github.com/longfan78/quorum-key-manager/src/entities"
-	manifestreader "github.com/longfan78/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/longfan78/quorum-key-manager/src/nodes"
-	nodesapi "github.com/longfan78/quorum-key-manager/src/nodes/api/manifest"
 	"github.com/longfan78/quorum-key-manager/src/stores"
-	storesapi "github.com/longfan78/quorum-key-manager/src/stores/api/manifest"
 	"github.com/longfan78/quorum-key-manager/src/vaults"
-	vaultsapi "github.com/longfan78/quorum-key-manager/src/vaults/api/manifest"
 )
 
 func initialize(
 	ctx context.Context,
-	cfg *manifestreader.Config,
-	rolesService auth.Roles,
+	mnfs map[string][]entities.Manifest,
+	kinds []manifestsKind,
 	vaultsService vaults.Vaults,
 	storesService stores.Stores,
 	nodesService nodes.Nodes,
 ) error {
-	manifestReader, err := manifestreader.New(cfg)
-	if err != nil {
-		return err
-	}
-
-	manifests, err := manifestReader.Load(ctx)
-	if err != nil {
-		return err
-	}
-
-	// Note that order is important here as stores depend on the existing vaults, do not use a switch!
-
-	err = rolesapi.NewRolesHandler(rolesService).Register(ctx, manifests[entities.RoleKind])
-	if err != nil {
-		return err
-	}
-
-	err = vaultsapi.NewVaultsHandler(vaultsService).Register(ctx, manifests[entities.VaultKind])
-	if err != nil {
-		return err
-	}
-
-	err = storesapi.NewStoresHandler(storesService).Register(ctx, manifests[entities.StoreKind])
-	if err != nil {
-		return err
-	}
-
-	err = nodesapi.NewNodesHandler(nodesService).Register(ctx, manifests[entities.NodeKind])
-	if err != nil {
-		return err
+	// Note that order is important here as stores depend on the existing vaults, kinds are registered in order
+	for _, k := range kinds {
+		err := k.handler.Register(ctx, mnfs[k.kind])
+		if err != nil {
+			return err
+		}
+
+		// Resources created through the API are loaded after the manifests ones as they can depend on them
+		switch k.kind {
+		case entities.VaultKind:
+			err = vaultsService.Load(ctx)
+		case entities.StoreKind:
+			err = storesService.Load(ctx)
+		case entities.NodeKind:
+			err = nodesService.Load(ctx)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
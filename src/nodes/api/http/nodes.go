@@ -0,0 +1,192 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/api/types"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type NodesHandler struct {
+	nodes nodes.Nodes
+}
+
+func NewNodesHandler(nodesService nodes.Nodes) *NodesHandler {
+	return &NodesHandler{nodes: nodesService}
+}
+
+// Register registers the management routes. Every other request under /nodes/{nodeName} is proxied to the node
+// so it must be called before the JSON-RPC routes are registered
+func (h *NodesHandler) Register(router *mux.Router) {
+	nodesRouter := router.PathPrefix("/nodes").Subrouter()
+
+	nodesRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	nodesRouter.Methods(http.MethodPost).Path("/{nodeName}/definition").HandlerFunc(h.create)
+	nodesRouter.Methods(http.MethodGet).Path("/{nodeName}/definition").HandlerFunc(h.get)
+	nodesRouter.Methods(http.MethodPatch).Path("/{nodeName}/definition").HandlerFunc(h.update)
+	nodesRouter.Methods(http.MethodDelete).Path("/{nodeName}/definition").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates a node
+// @Description  Starts a node proxy and persists its definition so that it is restored on restart
+// @Tags         Nodes
+// @Accept       json
+// @Produce      json
+// @Param        nodeName  path      string                   true  "node identifier"
+// @Param        request   body      types.CreateNodeRequest  true  "Create node request"
+// @Success      200       {object}  types.NodeResponse       "Node data"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      409       {object}  infrahttp.ErrorResponse  "Node already exists"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes/{nodeName}/definition [post]
+func (h *NodesHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	createReq := &types.CreateNodeRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	node, err := h.nodes.Register(ctx, &entities.NodeDefinition{
+		Name:           getNodeName(r),
+		Config:         createReq.Specs,
+		AllowedTenants: createReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewNodeResponse(node))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets a node
+// @Description  Gets the definition of a node created through the API
+// @Tags         Nodes
+// @Produce      json
+// @Param        nodeName  path      string                   true  "node identifier"
+// @Success      200       {object}  types.NodeResponse       "Node data"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Node not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes/{nodeName}/definition [get]
+func (h *NodesHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, err := h.nodes.Inspect(ctx, getNodeName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewNodeResponse(node))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists nodes
+// @Description  Lists the names of all the nodes, including the ones declared in manifests
+// @Tags         Nodes
+// @Produce      json
+// @Success      200  {array}   string                   "List of node names"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes [get]
+func (h *NodesHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeNames, err := h.nodes.List(ctx, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if nodeNames == nil {
+		nodeNames = []string{}
+	}
+
+	err = infrahttp.WriteJSON(rw, nodeNames)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Updates a node
+// @Description  Replaces the specs and allowed tenants of a node created through the API and restarts it
+// @Tags         Nodes
+// @Accept       json
+// @Produce      json
+// @Param        nodeName  path      string                   true  "node identifier"
+// @Param        request   body      types.UpdateNodeRequest  true  "Update node request"
+// @Success      200       {object}  types.NodeResponse       "Node data"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Node not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes/{nodeName}/definition [patch]
+func (h *NodesHandler) update(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	updateReq := &types.UpdateNodeRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, updateReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	node, err := h.nodes.Update(ctx, &entities.NodeDefinition{
+		Name:           getNodeName(r),
+		Config:         updateReq.Specs,
+		AllowedTenants: updateReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewNodeResponse(node))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Deletes a node
+// @Description  Stops and deletes a node created through the API
+// @Tags         Nodes
+// @Param        nodeName  path  string  true  "node identifier"
+// @Success      204       "Deleted successfully"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Node not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes/{nodeName}/definition [delete]
+func (h *NodesHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.nodes.Delete(ctx, getNodeName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func getNodeName(r *http.Request) string {
+	return mux.Vars(r)["nodeName"]
+}
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authhttp "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/nodes/api"
+	"github.com/longfan78/quorum-key-manager/src/nodes/api/types"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/longfan78/quorum-key-manager/src/nodes/mock"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var reqUserInfo = &authtypes.UserInfo{
+	Username:    "username",
+	Roles:       []string{"role1", "role2"},
+	Permissions: []authtypes.Permission{"*:*"},
+}
+
+type nodesHandlerTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	router *mux.Router
+	nodes  *mock.MockNodes
+	ctx    context.Context
+}
+
+func TestNodesHandler(t *testing.T) {
+	s := new(nodesHandlerTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *nodesHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.nodes = mock.NewMockNodes(s.ctrl)
+
+	s.ctx = authhttp.WithUserInfo(context.Background(), reqUserInfo)
+
+	s.router = mux.NewRouter()
+	NewNodesHandler(s.nodes).Register(s.router)
+	api.New(s.nodes).Register(s.router)
+}
+
+func (s *nodesHandlerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *nodesHandlerTestSuite) TestCreate() {
+	node := fakeNodeDefinition()
+
+	s.Run("should execute request successfully", func() {
+		nodeReq := &types.CreateNodeRequest{Specs: node.Config, AllowedTenants: node.AllowedTenants}
+		requestBytes, _ := json.Marshal(nodeReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/nodes/"+node.Name+"/definition", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Register(gomock.Any(), &entities.NodeDefinition{
+			Name:           node.Name,
+			Config:         node.Config,
+			AllowedTenants: node.AllowedTenants,
+		}, reqUserInfo).Return(node, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewNodeResponse(node))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if specs are missing", func() {
+		requestBytes, _ := json.Marshal(&types.CreateNodeRequest{AllowedTenants: node.AllowedTenants})
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/nodes/"+node.Name+"/definition", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 409 if node already exists", func() {
+		nodeReq := &types.CreateNodeRequest{Specs: node.Config}
+		requestBytes, _ := json.Marshal(nodeReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/nodes/"+node.Name+"/definition", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Register(gomock.Any(), gomock.Any(), reqUserInfo).Return(nil, errors.AlreadyExistsError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *nodesHandlerTestSuite) TestGet() {
+	node := fakeNodeDefinition()
+
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/nodes/"+node.Name+"/definition", nil).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Inspect(gomock.Any(), node.Name, reqUserInfo).Return(node, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewNodeResponse(node))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 404 if node is not found", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/nodes/"+node.Name+"/definition", nil).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Inspect(gomock.Any(), node.Name, reqUserInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *nodesHandlerTestSuite) TestList() {
+	s.Run("should execute request successfully", func() {
+		names := []string{"besu", "quorum"}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/nodes", nil).WithContext(s.ctx)
+
+		s.nodes.EXPECT().List(gomock.Any(), reqUserInfo).Return(names, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(names)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *nodesHandlerTestSuite) TestUpdate() {
+	node := fakeNodeDefinition()
+
+	s.Run("should execute request successfully", func() {
+		nodeReq := &types.UpdateNodeRequest{Specs: node.Config, AllowedTenants: node.AllowedTenants}
+		requestBytes, _ := json.Marshal(nodeReq)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPatch, "/nodes/"+node.Name+"/definition", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Update(gomock.Any(), &entities.NodeDefinition{
+			Name:           node.Name,
+			Config:         node.Config,
+			AllowedTenants: node.AllowedTenants,
+		}, reqUserInfo).Return(node, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(types.NewNodeResponse(node))
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *nodesHandlerTestSuite) TestDelete() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodDelete, "/nodes/my-node/definition", nil).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Delete(gomock.Any(), "my-node", reqUserInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+}
+
+func (s *nodesHandlerTestSuite) TestProxy() {
+	s.Run("should not intercept JSON-RPC requests sent to the node", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/nodes/my-node", bytes.NewReader([]byte(`{"jsonrpc":"2.0","method":"eth_chainId","id":1}`))).WithContext(s.ctx)
+
+		s.nodes.EXPECT().Get(gomock.Any(), "my-node", reqUserInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func fakeNodeDefinition() *entities.NodeDefinition {
+	return &entities.NodeDefinition{
+		Name: "my-node",
+		Config: &proxynode.Config{
+			RPC: &proxynode.DownstreamConfig{Addr: "http://geth:8545"},
+		},
+		AllowedTenants: []string{"tenant1"},
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+		UpdatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+}
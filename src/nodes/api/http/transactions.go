@@ -0,0 +1,161 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/api/types"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type TransactionsHandler struct {
+	transactions nodes.Transactions
+}
+
+func NewTransactionsHandler(transactionsService nodes.Transactions) *TransactionsHandler {
+	return &TransactionsHandler{transactions: transactionsService}
+}
+
+// Register registers the transaction routes. It must be called before the JSON-RPC routes are registered
+func (h *TransactionsHandler) Register(router *mux.Router) {
+	txRouter := router.PathPrefix("/nodes/{nodeName}/transactions").Subrouter()
+
+	txRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.search)
+	txRouter.Methods(http.MethodGet).Path("/{hash}").HandlerFunc(h.get)
+}
+
+// @Summary      Gets a transaction
+// @Description  Gets the status of a transaction sent through a node with eth_sendTransaction
+// @Tags         Nodes
+// @Produce      json
+// @Param        nodeName  path      string                     true  "node identifier"
+// @Param        hash      path      string                     true  "transaction hash"
+// @Success      200       {object}  types.TransactionResponse  "Transaction data"
+// @Failure      400       {object}  infrahttp.ErrorResponse    "Invalid transaction hash"
+// @Failure      403       {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse    "Transaction not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /nodes/{nodeName}/transactions/{hash} [get]
+func (h *TransactionsHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	hash, err := getTxHash(r)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	tx, err := h.transactions.Get(ctx, getNodeName(r), hash, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewTransactionResponse(tx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Search transactions
+// @Description  Search the transactions sent through a node with eth_sendTransaction, most recent first. Users belonging to a tenant only get the transactions of their tenant
+// @Tags         Nodes
+// @Produce      json
+// @Param        nodeName  path      string                   true   "node identifier"
+// @Param        account   query     string                   false  "filter by sender address"
+// @Param        status    query     string                   false  "filter by status"  Enums(pending, mined, failed, dropped)
+// @Param        limit     query     int                      false  "page size"
+// @Param        page      query     int                      false  "page number"
+// @Success      200       {array}   infrahttp.PageResponse   "List of transactions"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nodes/{nodeName}/transactions [get]
+func (h *TransactionsHandler) search(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := getTransactionFilter(r)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	txs, err := h.transactions.Search(ctx, filter, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	txsResponse := make([]*types.TransactionResponse, 0, len(txs))
+	for _, tx := range txs {
+		txsResponse = append(txsResponse, types.NewTransactionResponse(tx))
+	}
+
+	err = infrahttp.WritePagingResponse(rw, r, txsResponse)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+func getTxHash(r *http.Request) (common.Hash, error) {
+	hash, err := hexutil.Decode(mux.Vars(r)["hash"])
+	if err != nil || len(hash) != common.HashLength {
+		return common.Hash{}, errors.InvalidFormatError("invalid transaction hash")
+	}
+
+	return common.BytesToHash(hash), nil
+}
+
+func getTransactionFilter(r *http.Request) (*entities.TransactionFilter, error) {
+	query := r.URL.Query()
+	filter := &entities.TransactionFilter{
+		Node:   getNodeName(r),
+		Status: entities.TransactionStatus(query.Get("status")),
+	}
+
+	switch filter.Status {
+	case "", entities.TransactionPending, entities.TransactionMined, entities.TransactionFailed, entities.TransactionDropped:
+	default:
+		return nil, errors.InvalidFormatError("invalid status value")
+	}
+
+	if account := query.Get("account"); account != "" {
+		if !common.IsHexAddress(account) {
+			return nil, errors.InvalidFormatError("invalid account value")
+		}
+
+		from := common.HexToAddress(account)
+		filter.From = &from
+	}
+
+	limit := query.Get("limit")
+	if limit == "" {
+		limit = infrahttp.DefaultPageSize
+	}
+
+	var err error
+	filter.Limit, err = strconv.ParseUint(limit, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid limit value")
+	}
+
+	if page := query.Get("page"); page != "" {
+		iPage, err := strconv.ParseUint(page, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid page value")
+		}
+
+		filter.Offset = iPage * filter.Limit
+	}
+
+	return filter, nil
+}
@@ -41,6 +41,11 @@ func (h *NodesHandler) Create(ctx context.Context, name string, allowedTenants [
 		return errors.InvalidFormatError(err.Error())
 	}
 
+	err = config.Validate()
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
 	err = h.nodes.Create(ctx, name, config.SetDefault(), allowedTenants, h.userInfo)
 	if err != nil {
 		return err
@@ -48,3 +53,26 @@ func (h *NodesHandler) Create(ctx context.Context, name string, allowedTenants [
 
 	return nil
 }
+
+// Update restarts the nodes of changed manifests with their new configuration
+func (h *NodesHandler) Update(ctx context.Context, mnfs []entities2.Manifest) error {
+	for _, mnf := range mnfs {
+		config := &proxynode.Config{}
+		err := json.UnmarshalYAML(mnf.Specs, config)
+		if err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		err = config.Validate()
+		if err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		err = h.nodes.Replace(ctx, mnf.Name, config.SetDefault(), mnf.AllowedTenants, h.userInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+type CreateNodeRequest struct {
+	Specs          *proxynode.Config `json:"specs" validate:"required"`
+	AllowedTenants []string          `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+type UpdateNodeRequest struct {
+	Specs          *proxynode.Config `json:"specs" validate:"required"`
+	AllowedTenants []string          `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+// NodeResponse does not contain the node specs as downstream headers and transports can hold credentials
+type NodeResponse struct {
+	Name           string    `json:"name" example:"my-quorum-node"`
+	AllowedTenants []string  `json:"allowedTenants" example:"tenant1,tenant2"`
+	CreatedAt      time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewNodeResponse(node *entities.NodeDefinition) *NodeResponse {
+	return &NodeResponse{
+		Name:           node.Name,
+		AllowedTenants: node.AllowedTenants,
+		CreatedAt:      node.CreatedAt,
+		UpdatedAt:      node.UpdatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type TransactionResponse struct {
+	Hash            string        `json:"hash" example:"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"`
+	Node            string        `json:"node" example:"my-quorum-node"`
+	ChainID         string        `json:"chainId" example:"1337"`
+	From            string        `json:"from" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	Nonce           uint64        `json:"nonce" example:"12"`
+	Raw             hexutil.Bytes `json:"raw" example:"0xf86c0a..." swaggertype:"string"`
+	Private         bool          `json:"private" example:"false"`
+	Status          string        `json:"status" example:"mined"`
+	BlockNumber     *uint64       `json:"blockNumber,omitempty" example:"1042"`
+	BlockHash       string        `json:"blockHash,omitempty" example:"0x2a1d4ac3b2ca26b6c6c0b1fcd9b4a4b7e1ea6e1a8a3c1b1a3f2e6d9ab9b5b2c1"`
+	GasUsed         *uint64       `json:"gasUsed,omitempty" example:"21000"`
+	ContractAddress string        `json:"contractAddress,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	Confirmed       bool          `json:"confirmed" example:"true"`
+	Reorgs          int           `json:"reorgs" example:"0"`
+	CreatedAt       time.Time     `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt       time.Time     `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewTransactionResponse(tx *entities.Transaction) *TransactionResponse {
+	resp := &TransactionResponse{
+		Hash:        tx.Hash.Hex(),
+		Node:        tx.Node,
+		ChainID:     tx.ChainID,
+		From:        tx.From.Hex(),
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Private:     tx.Private,
+		Status:      string(tx.Status),
+		BlockNumber: tx.BlockNumber,
+		GasUsed:     tx.GasUsed,
+		Confirmed:   tx.Confirmed,
+		Reorgs:      tx.Reorgs,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+
+	if tx.BlockHash != nil {
+		resp.BlockHash = tx.BlockHash.Hex()
+	}
+
+	if tx.ContractAddress != nil {
+		resp.ContractAddress = tx.ContractAddress.Hex()
+	}
+
+	return resp
+}
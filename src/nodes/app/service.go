@@ -1,27 +1,72 @@
 package app
 
 import (
+	"github.com/longfan78/quorum-key-manager/pkg/app"
 	"github.com/longfan78/quorum-key-manager/src/aliases"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
+	nodesservice "github.com/longfan78/quorum-key-manager/src/nodes"
 	"github.com/longfan78/quorum-key-manager/src/nodes/api"
+	"github.com/longfan78/quorum-key-manager/src/nodes/api/http"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/memory"
+	db "github.com/longfan78/quorum-key-manager/src/nodes/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
 	"github.com/longfan78/quorum-key-manager/src/nodes/service/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/service/nonces"
+	"github.com/longfan78/quorum-key-manager/src/nodes/service/transactions"
+	"github.com/longfan78/quorum-key-manager/src/nodes/service/watcher"
 	"github.com/longfan78/quorum-key-manager/src/stores"
-	"github.com/gorilla/mux"
 )
 
 func RegisterService(
-	router *mux.Router,
+	a *app.App,
 	logger log.Logger,
+	postgresClient postgres.Client,
 	authService auth.Roles,
 	storesService stores.Stores,
 	aliasService aliases.Aliases,
-) *nodes.Nodes {
+	noncesCfg *entities.NoncesConfig,
+	txCfg *entities.TransactionsConfig,
+	notifier cluster.Notifier,
+	elector cluster.Elector,
+	limiter *ratelimit.Limiter,
+) (*nodes.Nodes, error) {
+	// Data layer
+	nodesRepository := db.NewNodes(postgresClient)
+	var noncesRepository database.Nonces = memory.NewNonces()
+	if noncesCfg.Persisted {
+		noncesRepository = db.NewNonces(postgresClient, logger)
+	}
+	txRepository := db.NewTransactions(postgresClient, logger)
+
 	// Business layer
-	nodesService := nodes.New(storesService, authService, aliasService, logger)
+	noncesService := nonces.New(noncesRepository, logger)
+	txService := transactions.New(txRepository, authService, logger)
+	// Sent transactions are only recorded when they are watched
+	var txRecorder nodesservice.Transactions
+	if txCfg != nil {
+		txRecorder = txService
+	}
+	nodesService := nodes.New(nodesRepository, storesService, authService, aliasService, noncesService, txRecorder, notifier, limiter, logger)
+	notifier.Subscribe(cluster.KindNode, nodesService.Refresh)
+
+	if txCfg != nil {
+		err := a.RegisterService(watcher.New(txRepository, nodesService, txCfg, elector, logger.WithComponent("transactions")))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Service layer
+	// Management routes must be registered before the JSON-RPC proxy which catches every /nodes/{nodeName} request
+	router := a.Router()
+	http.NewNodesHandler(nodesService).Register(router)
+	http.NewTransactionsHandler(txService).Register(router)
 	api.New(nodesService).Register(router)
 
-	return nodesService
+	return nodesService, nil
 }
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Nodes interface {
+	// Insert inserts a new node definition
+	Insert(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error)
+	// FindOne gets a node definition
+	FindOne(ctx context.Context, name string) (*entities.NodeDefinition, error)
+	// FindAll gets all the node definitions
+	FindAll(ctx context.Context) ([]*entities.NodeDefinition, error)
+	// Update updates a node definition
+	Update(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error)
+	// Delete deletes a node definition
+	Delete(ctx context.Context, name string) error
+}
+
+type Nonces interface {
+	// Increment atomically allocates the next nonce of an account, failing with a not found error if the nonce is unknown
+	Increment(ctx context.Context, key *entities.NonceKey) (uint64, error)
+	// Init allocates the given nonce of an account, or the next one if the nonce was concurrently initialized
+	Init(ctx context.Context, key *entities.NonceKey, nonce uint64) (uint64, error)
+	// Release gives back an allocated nonce if it is the last one allocated, returning whether it was given back
+	Release(ctx context.Context, key *entities.NonceKey, nonce uint64) (bool, error)
+	// Delete forgets the nonce of an account
+	Delete(ctx context.Context, key *entities.NonceKey) error
+}
+
+type Transactions interface {
+	// Insert starts tracking a transaction, failing with a conflict error if the transaction is already tracked
+	Insert(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error)
+	// FindOne gets a transaction sent through a node
+	FindOne(ctx context.Context, node string, hash common.Hash) (*entities.Transaction, error)
+	// Search gets the transactions matching the filter, most recent first
+	Search(ctx context.Context, filter *entities.TransactionFilter) ([]*entities.Transaction, error)
+	// FindUnsettled gets the transactions neither confirmed nor dropped
+	FindUnsettled(ctx context.Context) ([]*entities.Transaction, error)
+	// Update updates the status and the receipt fields of a transaction
+	Update(ctx context.Context, tx *entities.Transaction) error
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+// Nonces keeps the nonces in memory when they are not shared between replicas
+type Nonces struct {
+	mux    sync.Mutex
+	nonces map[entities.NonceKey]uint64
+}
+
+var _ database.Nonces = &Nonces{}
+
+func NewNonces() *Nonces {
+	return &Nonces{
+		mux:    sync.Mutex{},
+		nonces: make(map[entities.NonceKey]uint64),
+	}
+}
+
+func (n *Nonces) Increment(_ context.Context, key *entities.NonceKey) (uint64, error) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	nonce, ok := n.nonces[*key]
+	if !ok {
+		return 0, errors.NotFoundError("nonce not found")
+	}
+
+	n.nonces[*key] = nonce + 1
+	return nonce, nil
+}
+
+func (n *Nonces) Init(_ context.Context, key *entities.NonceKey, nonce uint64) (uint64, error) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	if next, ok := n.nonces[*key]; ok {
+		nonce = next
+	}
+
+	n.nonces[*key] = nonce + 1
+	return nonce, nil
+}
+
+func (n *Nonces) Release(_ context.Context, key *entities.NonceKey, nonce uint64) (bool, error) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	if next, ok := n.nonces[*key]; !ok || next != nonce+1 {
+		return false, nil
+	}
+
+	n.nonces[*key] = nonce
+	return true, nil
+}
+
+func (n *Nonces) Delete(_ context.Context, key *entities.NonceKey) error {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	delete(n.nonces, *key)
+	return nil
+}
@@ -0,0 +1,289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	reflect "reflect"
+)
+
+// MockNodes is a mock of Nodes interface
+type MockNodes struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodesMockRecorder
+}
+
+// MockNodesMockRecorder is the mock recorder for MockNodes
+type MockNodesMockRecorder struct {
+	mock *MockNodes
+}
+
+// NewMockNodes creates a new mock instance
+func NewMockNodes(ctrl *gomock.Controller) *MockNodes {
+	mock := &MockNodes{ctrl: ctrl}
+	mock.recorder = &MockNodesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNodes) EXPECT() *MockNodesMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockNodes) Insert(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, node)
+	ret0, _ := ret[0].(*entities.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockNodesMockRecorder) Insert(ctx, node interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockNodes)(nil).Insert), ctx, node)
+}
+
+// FindOne mocks base method
+func (m *MockNodes) FindOne(ctx context.Context, name string) (*entities.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret0, _ := ret[0].(*entities.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockNodesMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockNodes)(nil).FindOne), ctx, name)
+}
+
+// FindAll mocks base method
+func (m *MockNodes) FindAll(ctx context.Context) ([]*entities.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entities.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockNodesMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockNodes)(nil).FindAll), ctx)
+}
+
+// Update mocks base method
+func (m *MockNodes) Update(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, node)
+	ret0, _ := ret[0].(*entities.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockNodesMockRecorder) Update(ctx, node interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNodes)(nil).Update), ctx, node)
+}
+
+// Delete mocks base method
+func (m *MockNodes) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockNodesMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNodes)(nil).Delete), ctx, name)
+}
+
+// MockNonces is a mock of Nonces interface
+type MockNonces struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoncesMockRecorder
+}
+
+// MockNoncesMockRecorder is the mock recorder for MockNonces
+type MockNoncesMockRecorder struct {
+	mock *MockNonces
+}
+
+// NewMockNonces creates a new mock instance
+func NewMockNonces(ctrl *gomock.Controller) *MockNonces {
+	mock := &MockNonces{ctrl: ctrl}
+	mock.recorder = &MockNoncesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNonces) EXPECT() *MockNoncesMockRecorder {
+	return m.recorder
+}
+
+// Increment mocks base method
+func (m *MockNonces) Increment(ctx context.Context, key *entities.NonceKey) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, key)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment
+func (mr *MockNoncesMockRecorder) Increment(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockNonces)(nil).Increment), ctx, key)
+}
+
+// Init mocks base method
+func (m *MockNonces) Init(ctx context.Context, key *entities.NonceKey, nonce uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", ctx, key, nonce)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Init indicates an expected call of Init
+func (mr *MockNoncesMockRecorder) Init(ctx, key, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockNonces)(nil).Init), ctx, key, nonce)
+}
+
+// Release mocks base method
+func (m *MockNonces) Release(ctx context.Context, key *entities.NonceKey, nonce uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key, nonce)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release
+func (mr *MockNoncesMockRecorder) Release(ctx, key, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockNonces)(nil).Release), ctx, key, nonce)
+}
+
+// Delete mocks base method
+func (m *MockNonces) Delete(ctx context.Context, key *entities.NonceKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockNoncesMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNonces)(nil).Delete), ctx, key)
+}
+
+// MockTransactions is a mock of Transactions interface
+type MockTransactions struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionsMockRecorder
+}
+
+// MockTransactionsMockRecorder is the mock recorder for MockTransactions
+type MockTransactionsMockRecorder struct {
+	mock *MockTransactions
+}
+
+// NewMockTransactions creates a new mock instance
+func NewMockTransactions(ctrl *gomock.Controller) *MockTransactions {
+	mock := &MockTransactions{ctrl: ctrl}
+	mock.recorder = &MockTransactionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactions) EXPECT() *MockTransactionsMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockTransactions) Insert(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, tx)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockTransactionsMockRecorder) Insert(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransactions)(nil).Insert), ctx, tx)
+}
+
+// FindOne mocks base method
+func (m *MockTransactions) FindOne(ctx context.Context, node string, hash common.Hash) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, node, hash)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockTransactionsMockRecorder) FindOne(ctx, node, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockTransactions)(nil).FindOne), ctx, node, hash)
+}
+
+// Search mocks base method
+func (m *MockTransactions) Search(ctx context.Context, filter *entities.TransactionFilter) ([]*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockTransactionsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTransactions)(nil).Search), ctx, filter)
+}
+
+// FindUnsettled mocks base method
+func (m *MockTransactions) FindUnsettled(ctx context.Context) ([]*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnsettled", ctx)
+	ret0, _ := ret[0].([]*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnsettled indicates an expected call of FindUnsettled
+func (mr *MockTransactionsMockRecorder) FindUnsettled(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnsettled", reflect.TypeOf((*MockTransactions)(nil).FindUnsettled), ctx)
+}
+
+// Update mocks base method
+func (m *MockTransactions) Update(ctx context.Context, tx *entities.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockTransactionsMockRecorder) Update(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactions)(nil).Update), ctx, tx)
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+type Node struct {
+	tableName struct{} `pg:"nodes"` // nolint:unused,structcheck // reason
+
+	Name           string `pg:",pk"`
+	Config         *proxynode.Config
+	AllowedTenants []string  `pg:",array,use_zero"`
+	CreatedAt      time.Time `pg:"default:now()"`
+	UpdatedAt      time.Time `pg:"default:now()"`
+}
+
+func NewNode(node *entities.NodeDefinition) *Node {
+	return &Node{
+		Name:           node.Name,
+		Config:         node.Config,
+		AllowedTenants: node.AllowedTenants,
+		CreatedAt:      node.CreatedAt,
+		UpdatedAt:      node.UpdatedAt,
+	}
+}
+
+func (n *Node) ToEntity() *entities.NodeDefinition {
+	return &entities.NodeDefinition{
+		Name:           n.Name,
+		Config:         n.Config,
+		AllowedTenants: n.AllowedTenants,
+		CreatedAt:      n.CreatedAt,
+		UpdatedAt:      n.UpdatedAt,
+	}
+}
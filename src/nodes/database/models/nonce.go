@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type Nonce struct {
+	tableName struct{} `pg:"nonces"` // nolint:unused,structcheck // reason
+
+	Node      string    `pg:",pk"`
+	ChainID   string    `pg:",pk"`
+	Address   string    `pg:",pk"`
+	Nonce     uint64    `pg:",use_zero"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+func NewNonce(key *entities.NonceKey) *Nonce {
+	return &Nonce{
+		Node:    key.Node,
+		ChainID: key.ChainID,
+		Address: key.Address.Hex(),
+	}
+}
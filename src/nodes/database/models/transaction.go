@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type Transaction struct {
+	tableName struct{} `pg:"transactions"` // nolint:unused,structcheck // reason
+
+	ID              uint64 `pg:",pk"`
+	Hash            string
+	Node            string
+	ChainID         string
+	FromAddress     string
+	Nonce           uint64 `pg:",use_zero"`
+	Raw             []byte
+	Private         bool `pg:",use_zero"`
+	Tenant          string
+	Status          string
+	BlockNumber     *uint64
+	BlockHash       *string
+	GasUsed         *uint64
+	ContractAddress *string
+	Confirmed       bool      `pg:",use_zero"`
+	Reorgs          int       `pg:",use_zero"`
+	CreatedAt       time.Time `pg:"default:now()"`
+	UpdatedAt       time.Time `pg:"default:now()"`
+}
+
+func NewTransaction(tx *entities.Transaction) *Transaction {
+	model := &Transaction{
+		ID:          tx.ID,
+		Hash:        tx.Hash.Hex(),
+		Node:        tx.Node,
+		ChainID:     tx.ChainID,
+		FromAddress: tx.From.Hex(),
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Private:     tx.Private,
+		Tenant:      tx.Tenant,
+		Status:      string(tx.Status),
+		BlockNumber: tx.BlockNumber,
+		GasUsed:     tx.GasUsed,
+		Confirmed:   tx.Confirmed,
+		Reorgs:      tx.Reorgs,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+
+	if tx.BlockHash != nil {
+		blockHash := tx.BlockHash.Hex()
+		model.BlockHash = &blockHash
+	}
+
+	if tx.ContractAddress != nil {
+		contractAddress := tx.ContractAddress.Hex()
+		model.ContractAddress = &contractAddress
+	}
+
+	return model
+}
+
+func (tx *Transaction) ToEntity() *entities.Transaction {
+	entity := &entities.Transaction{
+		ID:          tx.ID,
+		Hash:        common.HexToHash(tx.Hash),
+		Node:        tx.Node,
+		ChainID:     tx.ChainID,
+		From:        common.HexToAddress(tx.FromAddress),
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Private:     tx.Private,
+		Tenant:      tx.Tenant,
+		Status:      entities.TransactionStatus(tx.Status),
+		BlockNumber: tx.BlockNumber,
+		GasUsed:     tx.GasUsed,
+		Confirmed:   tx.Confirmed,
+		Reorgs:      tx.Reorgs,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+
+	if tx.BlockHash != nil {
+		blockHash := common.HexToHash(*tx.BlockHash)
+		entity.BlockHash = &blockHash
+	}
+
+	if tx.ContractAddress != nil {
+		contractAddress := common.HexToAddress(*tx.ContractAddress)
+		entity.ContractAddress = &contractAddress
+	}
+
+	return entity
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/models"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+type Nodes struct {
+	pgClient postgres.Client
+}
+
+var _ database.Nodes = &Nodes{}
+
+func NewNodes(pgClient postgres.Client) *Nodes {
+	return &Nodes{pgClient: pgClient}
+}
+
+func (n *Nodes) Insert(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error) {
+	nodeModel := models.NewNode(node)
+
+	err := n.pgClient.Insert(ctx, nodeModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeModel.ToEntity(), nil
+}
+
+func (n *Nodes) FindOne(ctx context.Context, name string) (*entities.NodeDefinition, error) {
+	nodeModel := &models.Node{Name: name}
+
+	err := n.pgClient.SelectPK(ctx, nodeModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeModel.ToEntity(), nil
+}
+
+func (n *Nodes) FindAll(ctx context.Context) ([]*entities.NodeDefinition, error) {
+	var nodeModels []*models.Node
+
+	err := n.pgClient.Select(ctx, &nodeModels)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*entities.NodeDefinition
+	for _, nodeModel := range nodeModels {
+		nodes = append(nodes, nodeModel.ToEntity())
+	}
+
+	return nodes, nil
+}
+
+func (n *Nodes) Update(ctx context.Context, node *entities.NodeDefinition) (*entities.NodeDefinition, error) {
+	nodeModel := models.NewNode(node)
+	nodeModel.UpdatedAt = time.Now()
+
+	err := n.pgClient.UpdatePK(ctx, nodeModel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update does not update the model, we must update and then get
+	return n.FindOne(ctx, node.Name)
+}
+
+func (n *Nodes) Delete(ctx context.Context, name string) error {
+	err := n.pgClient.DeletePK(ctx, &models.Node{Name: name})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
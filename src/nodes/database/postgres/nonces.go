@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/models"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+// The stored nonce is the next nonce to allocate, the allocated one is returned
+const incrementNonceQuery = `
+UPDATE nonces SET nonce = nonce + 1, updated_at = now()
+WHERE node = ? AND chain_id = ? AND address = ?
+RETURNING nonce - 1`
+
+const initNonceQuery = `
+INSERT INTO nonces (node, chain_id, address, nonce) VALUES (?, ?, ?, CAST(? AS BIGINT) + 1)
+ON CONFLICT (node, chain_id, address) DO UPDATE SET nonce = nonces.nonce + 1, updated_at = now()
+RETURNING nonce - 1`
+
+// A nonce is only given back if no later nonce was allocated
+const releaseNonceQuery = `
+UPDATE nonces SET nonce = nonce - 1, updated_at = now()
+WHERE node = ? AND chain_id = ? AND address = ? AND nonce = CAST(? AS BIGINT) + 1
+RETURNING nonce`
+
+type Nonces struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Nonces = &Nonces{}
+
+func NewNonces(client postgres.Client, logger log.Logger) *Nonces {
+	return &Nonces{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (n *Nonces) Increment(ctx context.Context, key *entities.NonceKey) (uint64, error) {
+	var nonce uint64
+	err := n.client.QueryOne(ctx, &nonce, incrementNonceQuery, key.Node, key.ChainID, key.Address.Hex())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return 0, errors.NotFoundError("nonce not found")
+		}
+
+		errMessage := "failed to increment nonce"
+		n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex()).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nonce, nil
+}
+
+func (n *Nonces) Init(ctx context.Context, key *entities.NonceKey, nonce uint64) (uint64, error) {
+	var allocated uint64
+	err := n.client.QueryOne(ctx, &allocated, initNonceQuery, key.Node, key.ChainID, key.Address.Hex(), nonce)
+	if err != nil {
+		errMessage := "failed to initialize nonce"
+		n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex()).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return allocated, nil
+}
+
+func (n *Nonces) Release(ctx context.Context, key *entities.NonceKey, nonce uint64) (bool, error) {
+	var next uint64
+	err := n.client.QueryOne(ctx, &next, releaseNonceQuery, key.Node, key.ChainID, key.Address.Hex(), nonce)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		errMessage := "failed to release nonce"
+		n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex()).WithError(err).Error(errMessage)
+		return false, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return true, nil
+}
+
+func (n *Nonces) Delete(ctx context.Context, key *entities.NonceKey) error {
+	err := n.client.ForceDeletePK(ctx, models.NewNonce(key))
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to delete nonce"
+		n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex()).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
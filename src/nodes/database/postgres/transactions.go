@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/models"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+// The client only updates non zero fields, the receipt fields being cleared when a transaction goes back to the pool
+const updateTransactionQuery = `
+UPDATE transactions SET status = ?, block_number = ?, block_hash = ?, gas_used = ?, contract_address = ?, confirmed = ?, reorgs = ?, updated_at = now()
+WHERE id = ?
+RETURNING id`
+
+type Transactions struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Transactions = &Transactions{}
+
+func NewTransactions(client postgres.Client, logger log.Logger) *Transactions {
+	return &Transactions{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (t *Transactions) Insert(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+	txModel := models.NewTransaction(tx)
+
+	err := t.client.Insert(ctx, txModel)
+	if err != nil {
+		if errors.IsStatusConflictError(err) {
+			return nil, err
+		}
+
+		errMessage := "failed to insert transaction"
+		t.logger.With("node", tx.Node, "hash", tx.Hash.Hex()).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return txModel.ToEntity(), nil
+}
+
+func (t *Transactions) FindOne(ctx context.Context, node string, hash common.Hash) (*entities.Transaction, error) {
+	txModel := &models.Transaction{}
+
+	err := t.client.SelectWhere(ctx, txModel, "node = ? AND hash = ?", []string{}, node, hash.Hex())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.NotFoundError("transaction not found")
+		}
+
+		errMessage := "failed to get transaction"
+		t.logger.With("node", node, "hash", hash.Hex()).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return txModel.ToEntity(), nil
+}
+
+func (t *Transactions) Search(ctx context.Context, filter *entities.TransactionFilter) ([]*entities.Transaction, error) {
+	conditions := []string{"node = ?"}
+	args := []interface{}{filter.Node}
+	addCondition := func(condition string, arg interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+
+	if filter.From != nil {
+		addCondition("from_address = ?", filter.From.Hex())
+	}
+	if filter.Status != "" {
+		addCondition("status = ?", string(filter.Status))
+	}
+	if filter.Tenant != "" {
+		addCondition("tenant = ?", filter.Tenant)
+	}
+
+	txs, err := t.find(ctx, strings.Join(conditions, " AND "), args, filter.Limit, filter.Offset)
+	if err != nil {
+		errMessage := "failed to search transactions"
+		t.logger.With("node", filter.Node).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return txs, nil
+}
+
+func (t *Transactions) FindUnsettled(ctx context.Context) ([]*entities.Transaction, error) {
+	var txModels []*models.Transaction
+
+	err := t.client.SelectWhere(ctx, &txModels, "NOT confirmed AND status <> ?", []string{}, string(entities.TransactionDropped))
+	if err != nil {
+		errMessage := "failed to get unsettled transactions"
+		t.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	txs := make([]*entities.Transaction, 0, len(txModels))
+	for _, txModel := range txModels {
+		txs = append(txs, txModel.ToEntity())
+	}
+
+	return txs, nil
+}
+
+func (t *Transactions) Update(ctx context.Context, tx *entities.Transaction) error {
+	txModel := models.NewTransaction(tx)
+
+	var id uint64
+	err := t.client.QueryOne(ctx, &id, updateTransactionQuery, txModel.Status, txModel.BlockNumber, txModel.BlockHash,
+		txModel.GasUsed, txModel.ContractAddress, txModel.Confirmed, txModel.Reorgs, txModel.ID)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.NotFoundError("transaction not found")
+		}
+
+		errMessage := "failed to update transaction"
+		t.logger.With("node", tx.Node, "hash", tx.Hash.Hex()).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+// find selects the IDs of the matching transactions first as the client does not support ordering and limits on models
+func (t *Transactions) find(ctx context.Context, where string, args []interface{}, limit, offset uint64) ([]*entities.Transaction, error) {
+	// Limits are applied before aggregating so that the primary key index is scanned instead of the whole table
+	var ids []int64
+	query := fmt.Sprintf("SELECT id FROM transactions WHERE %s ORDER BY id DESC", where)
+	if limit != 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+	if offset != 0 {
+		query = fmt.Sprintf("%s OFFSET %d", query, offset)
+	}
+	query = fmt.Sprintf("SELECT array_agg(id) FROM (%s) AS ids", query)
+
+	err := t.client.Query(ctx, &ids, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*entities.Transaction{}, nil
+	}
+
+	var txModels []*models.Transaction
+	err = t.client.SelectWhere(ctx, &txModels, "id = ANY(?)", []string{}, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(txModels, func(i, j int) bool {
+		return txModels[i].ID > txModels[j].ID
+	})
+
+	txs := make([]*entities.Transaction, 0, len(txModels))
+	for _, txModel := range txModels {
+		txs = append(txs, txModel.ToEntity())
+	}
+
+	return txs, nil
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"time"
+
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 )
 
@@ -9,3 +11,12 @@ type Node struct {
 	Node           *proxynode.Node
 	AllowedTenants []string
 }
+
+// NodeDefinition is the persisted definition of a node managed at runtime
+type NodeDefinition struct {
+	Name           string
+	Config         *proxynode.Config
+	AllowedTenants []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceKey identifies the nonce sequence of an account on a chain reached through a node
+type NonceKey struct {
+	Node    string
+	ChainID string
+	Address common.Address
+}
+
+type NoncesConfig struct {
+	// Persisted shares the allocated nonces between replicas through Postgres
+	Persisted bool
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type TransactionStatus string
+
+const (
+	// TransactionPending is a transaction sent to the node and not mined yet
+	TransactionPending TransactionStatus = "pending"
+	// TransactionMined is a transaction mined successfully
+	TransactionMined TransactionStatus = "mined"
+	// TransactionFailed is a transaction mined and reverted
+	TransactionFailed TransactionStatus = "failed"
+	// TransactionDropped is a transaction evicted from the pool or replaced by another transaction with the same nonce
+	TransactionDropped TransactionStatus = "dropped"
+)
+
+// Transaction is a transaction sent through a proxy node, tracked until it is confirmed or dropped
+type Transaction struct {
+	ID      uint64
+	Hash    common.Hash
+	Node    string
+	ChainID string
+	From    common.Address
+	Nonce   uint64
+	// Raw is the RLP encoded signed transaction
+	Raw     []byte
+	Private bool
+	Tenant  string
+	Status  TransactionStatus
+	// BlockNumber, BlockHash, GasUsed and ContractAddress are set from the receipt once the transaction is mined
+	BlockNumber     *uint64
+	BlockHash       *common.Hash
+	GasUsed         *uint64
+	ContractAddress *common.Address
+	// Confirmed indicates the transaction is mined deep enough not to be watched anymore
+	Confirmed bool
+	// Reorgs counts the chain reorganizations having moved the transaction to another block or back to the pool
+	Reorgs    int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsSettled indicates the transaction is not watched anymore
+func (tx *Transaction) IsSettled() bool {
+	return tx.Confirmed || tx.Status == TransactionDropped
+}
+
+type TransactionFilter struct {
+	Node   string
+	From   *common.Address
+	Status TransactionStatus
+	Tenant string
+	Limit  uint64
+	Offset uint64
+}
+
+type TransactionsConfig struct {
+	// WatchInterval is the interval between two polls of the receipts of the unsettled transactions
+	WatchInterval time.Duration
+	// Confirmations is the number of blocks on top of the block of a transaction for it to be confirmed
+	Confirmations uint64
+	// DropTimeout is the duration after which a pending transaction unknown to the node is dropped
+	DropTimeout time.Duration
+}
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+func (i *Interceptor) eeaCreatePrivacyGroup(ctx context.Context, creator, name, description string, addresses []string) (string, error) {
+	logger := i.logger.WithContext(ctx)
+	logger.Debug("creating privacy group")
+
+	sess := proxynode.SessionFromContext(ctx)
+	userInfo := http.UserInfoFromContext(ctx)
+
+	if creator == "" {
+		errMessage := "creator not specified"
+		logger.Error(errMessage)
+		return "", jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	creator, err := i.aliases.ReplaceSimple(ctx, creator, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to replace alias in creator")
+		return "", err
+	}
+
+	addresses, err = i.aliases.Replace(ctx, addresses, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to replace aliases in addresses")
+		return "", err
+	}
+
+	group, err := sess.ClientPrivTxManager().CreatePrivacyGroup(ctx, creator, addresses, name, description)
+	if err != nil {
+		logger.WithError(err).Error("failed to create privacy group on Tessera")
+		return "", errors.BlockchainNodeError(err.Error())
+	}
+
+	logger.Info("privacy group created successfully", "privacy_group_id", group.PrivacyGroupID)
+	return group.PrivacyGroupID, nil
+}
+
+func (i *Interceptor) EEACreatePrivacyGroup() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.eeaCreatePrivacyGroup)
+	return h
+}
@@ -0,0 +1,154 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum/mock"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/pkg/tessera"
+	tesserautils "github.com/longfan78/quorum-key-manager/pkg/tessera/testutils"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	mockstoremanager "github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivacyGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	i, stores, aliases := newInterceptor(ctrl)
+	accountsStore := mockstoremanager.NewMockEthStore(ctrl)
+
+	nodeKey := "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+	members := []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}
+	tesseraServer := tesserautils.NewServer(nodeKey)
+	defer tesseraServer.Close()
+
+	userInfo := &entities.UserInfo{Tenant: "tenant", Username: "username"}
+	session := proxynode.NewMockSession(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = http.WithUserInfo(ctx, userInfo)
+
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+	session.EXPECT().ClientPrivTxManager().Return(tesseraServer.Client()).AnyTimes()
+
+	aliases.EXPECT().Replace(gomock.Any(), gomock.Any(), userInfo).DoAndReturn(func(_ context.Context, addrs []string, _ *entities.UserInfo) ([]string, error) {
+		return addrs, nil
+	}).AnyTimes()
+	aliases.EXPECT().ReplaceSimple(gomock.Any(), gomock.Any(), userInfo).DoAndReturn(func(_ context.Context, addr string, _ *entities.UserInfo) (string, error) {
+		return addr, nil
+	}).AnyTimes()
+
+	var privacyGroupID string
+	t.Run("should create a privacy group on Tessera", func(t *testing.T) {
+		resp := serveRPC(t, i, ctx, `{"jsonrpc":"2.0","method":"eea_createPrivacyGroup","params":["A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=","group","description",["KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=","eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="]],"id":1}`)
+
+		require.Nil(t, resp.Error)
+		require.NoError(t, resp.UnmarshalResult(&privacyGroupID))
+		assert.NotEmpty(t, privacyGroupID)
+	})
+
+	t.Run("should fail to create a privacy group from a key of another node", func(t *testing.T) {
+		resp := serveRPC(t, i, ctx, `{"jsonrpc":"2.0","method":"eea_createPrivacyGroup","params":["KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=","group","description",[]],"id":1}`)
+
+		assert.NotNil(t, resp.Error)
+	})
+
+	t.Run("should find the privacy group of the members", func(t *testing.T) {
+		resp := serveRPC(t, i, ctx, `{"jsonrpc":"2.0","method":"priv_findPrivacyGroup","params":[["eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg=","A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=","KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s="]],"id":1}`)
+
+		require.Nil(t, resp.Error)
+		var groups []*tessera.PrivacyGroup
+		require.NoError(t, resp.UnmarshalResult(&groups))
+		require.Len(t, groups, 1)
+		assert.Equal(t, privacyGroupID, groups[0].PrivacyGroupID)
+		assert.Equal(t, "group", groups[0].Name)
+	})
+
+	t.Run("should send a private transaction to the members of the privacy group", func(t *testing.T) {
+		from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+		nonce := uint64(1)
+		gas := uint64(21000)
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			Nonce:    &nonce,
+			Gas:      &gas,
+			GasPrice: big.NewInt(1),
+			Data:     &[]byte{0xab, 0xcd},
+			PrivateArgs: *(&ethereum.PrivateArgs{}).
+				WithPrivateFrom(nodeKey).
+				WithPrivacyGroupID(privacyGroupID).
+				WithPrivacyFlag(ethereum.MandatoryRecipientsPrivacyFlag).
+				WithMandatoryFor(members[:1]),
+		}
+
+		stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1), nil)
+		accountsStore.EXPECT().SignPrivate(gomock.Any(), from, gomock.Any()).Return([]byte("signature"), nil)
+
+		var payloadKey []byte
+		ethCaller.EXPECT().SendRawPrivateTransaction(gomock.Any(), []byte("signature"), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ []byte, args *ethereum.PrivateArgs) (ethcommon.Hash, error) {
+				assert.ElementsMatch(t, members, *args.PrivateFor)
+				assert.Nil(t, args.PrivacyGroupID)
+				assert.Equal(t, members[:1], *args.MandatoryFor)
+
+				// The node distributes the payload stored by the proxy
+				payloadKey = *msg.Data
+				_, err := tesseraServer.Client().SendSignedTx(ctx, payloadKey, *args.PrivateFor, &tessera.SendSignedTxOptions{
+					PrivacyFlag:         int(*args.PrivacyFlag),
+					MandatoryRecipients: *args.MandatoryFor,
+				})
+				return ethcommon.HexToHash("0x01"), err
+			})
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+		assert.Equal(t, ethcommon.HexToHash("0x01"), *hash)
+
+		received, err := tesseraServer.Client().Receive(ctx, payloadKey, members[1])
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xab, 0xcd}, received.Payload)
+	})
+
+	t.Run("should fail to send a private transaction with mandatory recipients that are not recipients", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:     ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2"),
+			GasPrice: big.NewInt(1),
+			Gas:      new(uint64),
+			PrivateArgs: *(&ethereum.PrivateArgs{}).
+				WithPrivateFrom(nodeKey).
+				WithPrivateFor(members[:1]).
+				WithPrivacyFlag(ethereum.MandatoryRecipientsPrivacyFlag).
+				WithMandatoryFor(members[1:]),
+		}
+
+		_, err := i.ethSendTransaction(ctx, msg)
+		assert.Error(t, err)
+	})
+}
+
+func serveRPC(t *testing.T, h jsonrpc.Handler, ctx context.Context, body string) *jsonrpc.ResponseMsg {
+	rec := httptest.NewRecorder()
+
+	msg := new(jsonrpc.RequestMsg)
+	require.NoError(t, json.Unmarshal([]byte(body), msg))
+	h.ServeRPC(jsonrpc.NewResponseWriter(rec), msg.WithContext(ctx))
+
+	resp := new(jsonrpc.ResponseMsg)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+	return resp
+}
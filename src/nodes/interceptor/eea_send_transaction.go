@@ -11,13 +11,17 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
 	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	nodesentities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/guarded"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.SendEEATxMsg) (*ethcommon.Hash, error) {
-	i.logger.Debug("sending EEA transaction")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("sending EEA transaction")
 
 	userInfo := http.UserInfoFromContext(ctx)
 
@@ -33,7 +37,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 		// extract aliases from PrivateFor
 		*msg.PrivateFor, err = i.aliases.Replace(ctx, *msg.PrivateFor, userInfo)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to replace aliases in privateFor")
+			logger.WithError(err).Error("failed to replace aliases in privateFor")
 			return nil, err
 		}
 	}
@@ -42,7 +46,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 
 		*msg.PrivateFrom, err = i.aliases.ReplaceSimple(ctx, *msg.PrivateFrom, userInfo)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to replace alias")
+			logger.WithError(err).Error("failed to replace alias")
 			return nil, err
 		}
 	}
@@ -53,7 +57,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 			var alias *entities.Alias
 			alias, err = i.aliases.Get(ctx, reg, key, userInfo)
 			if err != nil {
-				i.logger.WithError(err).Error("failed to get alias for privacyGroupID")
+				logger.WithError(err).Error("failed to get alias for privacyGroupID")
 				return nil, err
 			}
 
@@ -61,7 +65,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 			case entities.AliasKindString:
 				*msg.PrivacyGroupID, err = alias.String()
 				if err != nil {
-					i.logger.WithError(err).Error("wrong alias value, should be a string")
+					logger.WithError(err).Error("wrong alias value, should be a string")
 					return nil, err
 				}
 			case entities.AliasKindArray:
@@ -73,7 +77,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 				var aliasArray []string
 				aliasArray, err = alias.Array()
 				if err != nil {
-					i.logger.WithError(err).Error("wrong alias value, should be a string")
+					logger.WithError(err).Error("wrong alias value, should be a string")
 					return nil, err
 				}
 
@@ -82,7 +86,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 			default:
 				msg := "wrong alias type"
 				err = fmt.Errorf(msg)
-				i.logger.WithError(err).Error(msg)
+				logger.WithError(err).Error(msg)
 				return nil, err
 			}
 		}
@@ -95,7 +99,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 		} else {
 			if msg.PrivateFor == nil {
 				errMessage := "missing privateFor"
-				i.logger.Error(errMessage)
+				logger.Error(errMessage)
 				return nil, errors.InvalidFormatError(errMessage)
 			}
 
@@ -106,7 +110,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 			n, err = sess.EthCaller().Priv().GetEeaTransactionCount(ctx, msg.From, privateFrom, *msg.PrivateFor)
 		}
 		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch transaction count (EEA transaction)")
+			logger.WithError(err).Error("failed to fetch transaction count (EEA transaction)")
 			return nil, errors.BlockchainNodeError(err.Error())
 		}
 
@@ -116,7 +120,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 	if msg.GasPrice == nil {
 		gasPrice, err2 := sess.EthCaller().Eth().GasPrice(ctx)
 		if err2 != nil {
-			i.logger.WithError(err2).Error("failed to fetch gas price (EEA transaction)")
+			logger.WithError(err2).Error("failed to fetch gas price (EEA transaction)")
 			return nil, errors.BlockchainNodeError(err2.Error())
 		}
 
@@ -140,7 +144,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 
 		gas, err2 := sess.EthCaller().Eth().EstimateGas(ctx, callMsg)
 		if err2 != nil {
-			i.logger.WithError(err2).Error("failed to estimate gas (EEA transaction)")
+			logger.WithError(err2).Error("failed to estimate gas (EEA transaction)")
 			return nil, errors.BlockchainNodeError(err2.Error())
 		}
 
@@ -154,12 +158,13 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 	// Get ChainID from Node
 	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to fetch chainID (EEA transaction)")
+		logger.WithError(err).Error("failed to fetch chainID (EEA transaction)")
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 
 	// Sign
-	sig, err := store.SignEEA(ctx, msg.From, chainID, msg.TxData(), &msg.PrivateArgs)
+	signCtx, reservations := guarded.WithReservations(ctx)
+	sig, err := store.SignEEA(signCtx, msg.From, chainID, msg.TxData(), &msg.PrivateArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -167,11 +172,25 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 	// Submit transaction to downstream node
 	hash, err := sess.EthCaller().EEA().SendRawTransaction(ctx, sig)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw EEA transaction")
+		logger.WithError(err).Error("failed to send raw EEA transaction")
+		if isRejected(err) {
+			reservations.Release(ctx)
+		}
+
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 
-	i.logger.Info("EEA transaction sent successfully", "tx_hash", hash)
+	if i.transactions != nil {
+		i.recordTx(ctx, sess, &nodesentities.Transaction{
+			Hash:    hash,
+			From:    msg.From,
+			Nonce:   *msg.Nonce,
+			Raw:     sig,
+			Private: true,
+		})
+	}
+
+	logger.Info("EEA transaction sent successfully", "tx_hash", hash)
 	return &hash, nil
 }
 
@@ -11,10 +11,15 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	mockethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum/mock"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	nodesentities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	nodesmock "github.com/longfan78/quorum-key-manager/src/nodes/mock"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 	mockaccounts "github.com/longfan78/quorum-key-manager/src/stores/mock"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEEASendTransaction(t *testing.T) {
@@ -114,4 +119,42 @@ func TestEEASendTransaction(t *testing.T) {
 			assertHandlerScenario(t, tt)
 		})
 	}
+
+	t.Run("should record the sent tx when transactions are tracked", func(t *testing.T) {
+		transactions := nodesmock.NewMockTransactions(ctrl)
+		trackingInterceptor := New(stores, aliases, nodesmock.NewMockNonces(ctrl), transactions, "node", testutils.NewMockLogger(ctrl))
+
+		from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+		nonce := uint64(5)
+		gas := uint64(21000)
+		msg := &ethereum.SendEEATxMsg{
+			From:        from,
+			Nonce:       &nonce,
+			Gas:         &gas,
+			GasPrice:    big.NewInt(1000000000),
+			PrivateArgs: *(&ethereum.PrivateArgs{}).WithPrivacyGroupID("kAbelwaVW7okoEn1+okO+AbA4Hhz/7DaCOWVQz9nx5M="),
+		}
+		expectedSig := ethcommon.FromHex("0xa6122e27")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+		aliases.EXPECT().Parse("kAbelwaVW7okoEn1+okO+AbA4Hhz/7DaCOWVQz9nx5M=").Return("", "", false)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil).Times(2)
+		accountsStore.EXPECT().SignEEA(gomock.Any(), from, big.NewInt(1998), gomock.Any(), gomock.Any()).Return(expectedSig, nil)
+		eeaCaller.EXPECT().SendRawTransaction(gomock.Any(), expectedSig).Return(expectedHash, nil)
+		transactions.EXPECT().Record(gomock.Any(), &nodesentities.Transaction{
+			Hash:    expectedHash,
+			Node:    "node",
+			ChainID: "1998",
+			From:    from,
+			Nonce:   nonce,
+			Raw:     expectedSig,
+			Private: true,
+		}).Return(nil)
+
+		hash, err := trackingInterceptor.eeaSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedHash, *hash)
+	})
 }
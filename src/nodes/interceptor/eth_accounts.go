@@ -9,14 +9,16 @@ import (
 )
 
 func (i *Interceptor) ethAccounts(ctx context.Context) ([]ethcommon.Address, error) {
-	i.logger.Debug("listing ETH accounts")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("listing ETH accounts")
 
 	addresses, err := i.stores.ListAllAccounts(ctx, http.UserInfoFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	i.logger.Debug("ETH accounts fetched successfully")
+	logger.Debug("ETH accounts fetched successfully")
 	return addresses, nil
 }
 
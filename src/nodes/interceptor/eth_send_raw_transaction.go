@@ -0,0 +1,69 @@
+package interceptor
+
+import (
+	"context"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+// ethSendRawTransaction sends a transaction signed by the client, tracking it if its sender can be recovered
+func (i *Interceptor) ethSendRawTransaction(ctx context.Context, raw hexutil.Bytes) (*ethcommon.Hash, error) {
+	logger := i.logger.WithContext(ctx)
+	logger.Debug("sending raw transaction")
+
+	sess := proxynode.SessionFromContext(ctx)
+
+	hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, raw)
+	if err != nil {
+		logger.WithError(err).Error("failed to send raw transaction")
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+
+	tx, from, err := decodeRawTx(raw)
+	if err != nil {
+		logger.WithError(err).Warn("failed to decode raw transaction, transaction not tracked", "tx_hash", hash)
+	} else {
+		i.recordTx(ctx, sess, &entities.Transaction{
+			Hash:  hash,
+			From:  from,
+			Nonce: tx.Nonce(),
+			Raw:   raw,
+		})
+	}
+
+	logger.Info("raw transaction sent successfully", "tx_hash", hash)
+	return &hash, nil
+}
+
+// decodeRawTx decodes a signed transaction and recovers its sender
+func decodeRawTx(raw []byte) (*types.Transaction, ethcommon.Address, error) {
+	tx := new(types.Transaction)
+	err := tx.UnmarshalBinary(raw)
+	if err != nil {
+		return nil, ethcommon.Address{}, err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.LatestSignerForChainID(tx.ChainId())
+	}
+
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return nil, ethcommon.Address{}, err
+	}
+
+	return tx, from, nil
+}
+
+func (i *Interceptor) EthSendRawTransaction() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.ethSendRawTransaction)
+	return h
+}
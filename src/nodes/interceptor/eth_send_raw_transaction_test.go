@@ -0,0 +1,91 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mockethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum/mock"
+	aliasmock "github.com/longfan78/quorum-key-manager/src/aliases/mock"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	nodesentities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	nodesmock "github.com/longfan78/quorum-key-manager/src/nodes/mock"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	mockstores "github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+func TestEthSendRawTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := proxynode.NewMockSession(ctrl)
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	transactions := nodesmock.NewMockTransactions(ctrl)
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+
+	userInfo := &entities.UserInfo{Tenant: "tenant", Username: "username"}
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = http.WithUserInfo(ctx, userInfo)
+	chainID := big.NewInt(1998)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privKey.PublicKey)
+	to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	signedTx, err := types.SignTx(types.NewTransaction(9, to, big.NewInt(1), 21000, big.NewInt(1000), nil), types.NewEIP155Signer(chainID), privKey)
+	require.NoError(t, err)
+	raw, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	i := New(mockstores.NewMockStores(ctrl), aliasmock.NewMockAliases(ctrl), nodesmock.NewMockNonces(ctrl), transactions, "node", testutils.NewMockLogger(ctrl))
+
+	t.Run("should send and record a raw transaction", func(t *testing.T) {
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte(raw)).Return(signedTx.Hash(), nil)
+		ethCaller.EXPECT().ChainID(ctx).Return(chainID, nil)
+		transactions.EXPECT().Record(ctx, &nodesentities.Transaction{
+			Hash:    signedTx.Hash(),
+			Node:    "node",
+			ChainID: chainID.String(),
+			From:    from,
+			Nonce:   9,
+			Raw:     raw,
+			Tenant:  userInfo.Tenant,
+		}).Return(nil)
+
+		hash, err := i.ethSendRawTransaction(ctx, raw)
+		require.NoError(t, err)
+
+		assert.Equal(t, signedTx.Hash(), *hash)
+	})
+
+	t.Run("should send a raw transaction which cannot be decoded without recording it", func(t *testing.T) {
+		invalidRaw := []byte("invalid")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+		ethCaller.EXPECT().SendRawTransaction(ctx, invalidRaw).Return(expectedHash, nil)
+
+		hash, err := i.ethSendRawTransaction(ctx, invalidRaw)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedHash, *hash)
+	})
+
+	t.Run("should fail if the node rejects the raw transaction", func(t *testing.T) {
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte(raw)).Return(ethcommon.Hash{}, fmt.Errorf("nonce too low"))
+
+		_, err := i.ethSendRawTransaction(ctx, raw)
+
+		assert.Error(t, err)
+	})
+}
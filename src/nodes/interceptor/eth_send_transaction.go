@@ -2,18 +2,42 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/guarded"
 )
 
+// Error message returned by the nodes when a transaction nonce was already used
+const nonceTooLowMessage = "nonce too low"
+
+// rejectionMessages are parts of the error messages returned by the nodes when they reject a transaction, which is
+// then not pending
+var rejectionMessages = []string{
+	nonceTooLowMessage,
+	"invalid",
+	"intrinsic gas",
+	"insufficient funds",
+	"exceeds account balance",
+	"underpriced",
+	"below configured minimum",
+	"exceeds block gas limit",
+	"fee cap",
+	"oversized data",
+	"negative value",
+}
+
 func (i *Interceptor) ethSendTransaction(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
 	switch {
 	case msg.IsPrivate():
@@ -29,7 +53,9 @@ func (i *Interceptor) ethSendTransaction(ctx context.Context, msg *ethereum.Send
 }
 
 func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
-	i.logger.Debug("sending Quorum private transaction")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("sending Quorum private transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
 	userInfo := http.UserInfoFromContext(ctx)
@@ -38,7 +64,7 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 	var err error
 	*msg.PrivateFrom, err = i.aliases.ReplaceSimple(ctx, *msg.PrivateFrom, userInfo)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to replace alias")
+		logger.WithError(err).Error("failed to replace alias")
 		return nil, err
 	}
 
@@ -46,7 +72,7 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		var gasPrice *big.Int
 		gasPrice, err = sess.EthCaller().Eth().GasPrice(ctx)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch gas price")
+			logger.WithError(err).Error("failed to fetch gas price")
 			return nil, errors.BlockchainNodeError(err.Error())
 		}
 
@@ -58,11 +84,6 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
-
 	if msg.Data == nil {
 		msg.Data = new([]byte)
 	}
@@ -71,7 +92,7 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		// extract aliases from PrivateFor
 		*msg.PrivateFor, err = i.aliases.Replace(ctx, *msg.PrivateFor, userInfo)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to replace aliases in privateFor")
+			logger.WithError(err).Error("failed to replace aliases in privateFor")
 			return nil, err
 		}
 	}
@@ -80,9 +101,18 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		var privacyGroup []string
 		privacyGroup, err = i.aliases.Replace(ctx, []string{*msg.PrivacyGroupID}, userInfo)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to replace aliases in privacyGroupID")
+			logger.WithError(err).Error("failed to replace aliases in privacyGroupID")
 			return nil, err
 		}
+
+		// A privacy group ID which is not an alias is a Tessera privacy group, of which the members are the recipients
+		if len(privacyGroup) == 1 && privacyGroup[0] == *msg.PrivacyGroupID {
+			privacyGroup, err = i.privacyGroupMembers(ctx, sess, *msg.PrivacyGroupID, *msg.PrivateFrom)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if msg.PrivateFor == nil {
 			msg.PrivateFor = &[]string{}
 		}
@@ -91,39 +121,108 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		msg.PrivacyGroupID = nil
 	}
 
+	if msg.MandatoryFor != nil {
+		*msg.MandatoryFor, err = i.aliases.Replace(ctx, *msg.MandatoryFor, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to replace aliases in mandatoryFor")
+			return nil, err
+		}
+	}
+
+	err = checkMandatoryRecipients(&msg.PrivateArgs)
+	if err != nil {
+		logger.WithError(err).Error("invalid mandatory recipients")
+		return nil, jsonrpc.InvalidParamsError(err)
+	}
+
 	// Store payload on Tessera
 	key, err := sess.ClientPrivTxManager().StoreRaw(ctx, *msg.Data, *msg.PrivateFrom)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to store raw payload on Tessera", "private_from", *msg.PrivateFrom)
+		logger.WithError(err).Error("failed to store raw payload on Tessera", "private_from", *msg.PrivateFrom)
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 	// Switch message data
 	*msg.Data = key
 
-	raw, err := i.ethSignTransaction(ctx, msg)
+	hash, err := i.signAndSend(ctx, sess, msg, func(raw hexutil.Bytes) (ethcommon.Hash, error) {
+		hash, sendErr := sess.EthCaller().Eth().SendRawPrivateTransaction(ctx, raw, &msg.PrivateArgs)
+		if sendErr != nil {
+			logger.WithError(sendErr).Error("failed to send raw quorum private transaction")
+			return ethcommon.Hash{}, errors.BlockchainNodeError(sendErr.Error())
+		}
+
+		return hash, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	hash, err := sess.EthCaller().Eth().SendRawPrivateTransaction(ctx, *raw, &msg.PrivateArgs)
+	logger.Info("quorum private transaction sent successfully", "tx_hash", hash)
+	return &hash, nil
+}
+
+// privacyGroupMembers returns the members of a Tessera privacy group, except the sender
+func (i *Interceptor) privacyGroupMembers(ctx context.Context, sess proxynode.Session, privacyGroupID, privateFrom string) ([]string, error) {
+	logger := i.logger.WithContext(ctx).With("privacy_group_id", privacyGroupID)
+
+	group, err := sess.ClientPrivTxManager().RetrievePrivacyGroup(ctx, privacyGroupID)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw quorum private transaction")
+		logger.WithError(err).Error("failed to retrieve privacy group from Tessera")
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 
-	i.logger.Info("quorum private transaction sent successfully", "tx_hash", hash)
-	return &hash, nil
+	var members []string
+	for _, member := range group.Members {
+		if member != privateFrom {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+// checkMandatoryRecipients checks that mandatory recipients are set with, and only with, the mandatory recipients
+// privacy flag, and that they are all recipients of the transaction
+func checkMandatoryRecipients(args *ethereum.PrivateArgs) error {
+	isMandatoryRecipients := args.PrivacyFlag != nil && *args.PrivacyFlag == ethereum.MandatoryRecipientsPrivacyFlag
+	hasMandatoryRecipients := args.MandatoryFor != nil && len(*args.MandatoryFor) > 0
+
+	switch {
+	case isMandatoryRecipients && !hasMandatoryRecipients:
+		return errors.InvalidParameterError("mandatoryFor must be set with the mandatory recipients privacy flag")
+	case !isMandatoryRecipients && hasMandatoryRecipients:
+		return errors.InvalidParameterError("mandatoryFor can only be set with the mandatory recipients privacy flag")
+	case !hasMandatoryRecipients:
+		return nil
+	}
+
+	recipients := map[string]bool{}
+	if args.PrivateFor != nil {
+		for _, recipient := range *args.PrivateFor {
+			recipients[recipient] = true
+		}
+	}
+
+	for _, mandatory := range *args.MandatoryFor {
+		if !recipients[mandatory] {
+			return errors.InvalidParameterError(fmt.Sprintf("mandatory recipient %s is not in privateFor", mandatory))
+		}
+	}
+
+	return nil
 }
 
 func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
-	i.logger.Debug("sending ETH legacy transaction")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("sending ETH legacy transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
 
 	if msg.GasPrice == nil {
 		gasPrice, err := sess.EthCaller().Eth().GasPrice(ctx)
 		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch gas price")
+			logger.WithError(err).Error("failed to fetch gas price")
 			return nil, errors.BlockchainNodeError(err.Error())
 		}
 
@@ -135,39 +234,38 @@ func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg)
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
+	hash, err := i.signAndSend(ctx, sess, msg, func(raw hexutil.Bytes) (ethcommon.Hash, error) {
+		hash, sendErr := sess.EthCaller().Eth().SendRawTransaction(ctx, raw)
+		if sendErr != nil {
+			logger.WithError(sendErr).Error("failed to send raw legacy transaction")
+			return ethcommon.Hash{}, errors.BlockchainNodeError(sendErr.Error())
+		}
 
-	raw, err := i.ethSignTransaction(ctx, msg)
+		return hash, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw legacy transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
-
-	i.logger.Info("legacy transaction sent successfully", "tx_hash", hash)
+	logger.Info("legacy transaction sent successfully", "tx_hash", hash)
 	return &hash, nil
 }
 
 func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
-	i.logger.Debug("sending ETH transaction")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("sending ETH transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
 
 	baseFee, err := sess.EthCaller().Eth().BaseFeePerGas(ctx, ethereum.LatestBlockNumber)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to retrieve base fee from latest block")
+		logger.WithError(err).Error("failed to retrieve base fee from latest block")
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 
 	if baseFee == nil {
-		i.logger.Warn("cannot send a dynamic fee transaction to a pre-London node, reverting to legacy tx")
+		logger.Warn("cannot send a dynamic fee transaction to a pre-London node, reverting to legacy tx")
 		return i.sendLegacyTx(ctx, msg)
 	}
 
@@ -177,7 +275,7 @@ func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*eth
 			maxPriorityFeePerGas = msg.GasTipCap
 		}
 		msg.GasFeeCap = new(big.Int).Add(baseFee, maxPriorityFeePerGas)
-		i.logger.
+		logger.
 			With("max_fee_per_gas", msg.GasFeeCap, "base_fee", baseFee, "max_priority_fee_per_gas", maxPriorityFeePerGas).
 			Debug("'maxFeePerGas' set with previous block 'baseFeePerGas' + miner tip")
 	}
@@ -187,27 +285,26 @@ func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*eth
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
+	hash, err := i.signAndSend(ctx, sess, msg, func(raw hexutil.Bytes) (ethcommon.Hash, error) {
+		hash, sendErr := sess.EthCaller().Eth().SendRawTransaction(ctx, raw)
+		if sendErr != nil {
+			logger.WithError(sendErr).Error("failed to send raw transaction")
+			return ethcommon.Hash{}, errors.BlockchainNodeError(sendErr.Error())
+		}
 
-	raw, err := i.ethSignTransaction(ctx, msg)
+		return hash, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
-
-	i.logger.Info("ETH transaction sent successfully", "tx_hash", hash)
+	logger.Info("ETH transaction sent successfully", "tx_hash", hash)
 	return &hash, nil
 }
 
 func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) error {
+	logger := i.logger.WithContext(ctx)
+
 	if msg.Gas == nil {
 		callMsg := &ethereum.CallMsg{
 			From:       &msg.From,
@@ -221,7 +318,7 @@ func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *
 		}
 		gas, err := sess.EthCaller().Eth().EstimateGas(ctx, callMsg)
 		if err != nil {
-			i.logger.WithError(err).With("gas_price", msg.GasPrice).Error("failed to estimate gas")
+			logger.WithError(err).With("gas_price", msg.GasPrice).Error("failed to estimate gas")
 			return errors.BlockchainNodeError(err.Error())
 		}
 
@@ -231,18 +328,150 @@ func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *
 	return nil
 }
 
-func (i *Interceptor) fillNonce(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) error {
-	if msg.Nonce == nil {
-		n, err := sess.EthCaller().Eth().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber)
-		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch nonce", "from_account", msg.From)
-			return errors.BlockchainNodeError(err.Error())
+// signAndSend allocates the nonce of the transaction, if not provided, before signing and sending it.
+// The nonce is resynchronized with the node and the transaction sent again once if the node rejects the nonce as too low
+func (i *Interceptor) signAndSend(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg, send func(raw hexutil.Bytes) (ethcommon.Hash, error)) (ethcommon.Hash, error) {
+	logger := i.logger.WithContext(ctx)
+
+	if msg.Nonce != nil {
+		return i.signAndSendOnce(ctx, sess, msg, send)
+	}
+
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to fetch chainID")
+		return ethcommon.Hash{}, errors.BlockchainNodeError(err.Error())
+	}
+	key := &entities.NonceKey{Node: i.node, ChainID: chainID.String(), Address: msg.From}
+
+	hash, err := i.sendWithNextNonce(ctx, sess, key, msg, send)
+	if err != nil && isNonceTooLow(err) {
+		// Transactions were sent without the key manager, so the nonce is fetched from the node on next allocation
+		logger.Warn("nonce too low, resynchronizing nonce with the node", "from_account", msg.From, "nonce", *msg.Nonce)
+		if resetErr := i.nonces.Reset(ctx, key); resetErr != nil {
+			logger.WithError(resetErr).Warn("failed to reset nonce", "from_account", msg.From)
 		}
 
-		msg.Nonce = &n
+		return i.sendWithNextNonce(ctx, sess, key, msg, send)
 	}
 
-	return nil
+	return hash, err
+}
+
+func (i *Interceptor) sendWithNextNonce(ctx context.Context, sess proxynode.Session, key *entities.NonceKey, msg *ethereum.SendTxMsg, send func(raw hexutil.Bytes) (ethcommon.Hash, error)) (ethcommon.Hash, error) {
+	n, err := i.nonces.Next(ctx, key, func(ctx context.Context) (uint64, error) {
+		return sess.EthCaller().Eth().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber)
+	})
+	if err != nil {
+		return ethcommon.Hash{}, err
+	}
+	msg.Nonce = &n
+
+	signCtx, reservations := guarded.WithReservations(ctx)
+	raw, err := i.ethSignTransaction(signCtx, msg)
+	if err != nil {
+		// The transaction was not sent, so the nonce is given back to avoid gaps
+		i.releaseNonce(ctx, key, n)
+		return ethcommon.Hash{}, err
+	}
+
+	hash, err := i.sendSigned(ctx, sess, msg, *raw, reservations, send)
+	if err != nil {
+		// The nonce is only given back when the node rejected the transaction, as a transaction that timed out or is
+		// already known may be pending. A nonce too low was used by another transaction and is not given back either
+		if isRejected(err) && !isNonceTooLow(err) {
+			i.releaseNonce(ctx, key, n)
+		}
+
+		return ethcommon.Hash{}, err
+	}
+
+	return hash, nil
+}
+
+func (i *Interceptor) releaseNonce(ctx context.Context, key *entities.NonceKey, n uint64) {
+	err := i.nonces.Release(ctx, key, n)
+	if err != nil {
+		i.logger.WithContext(ctx).WithError(err).Warn("failed to release nonce", "from_account", key.Address, "nonce", n)
+	}
+}
+
+func isNonceTooLow(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), nonceTooLowMessage)
+}
+
+// isRejected indicates whether the node rejected a transaction. Errors reaching the node, or reading its answer, are
+// not rejections as the transaction may have been accepted
+func isRejected(err error) bool {
+	errMessage := strings.ToLower(err.Error())
+	if strings.Contains(errMessage, "downstream") {
+		return false
+	}
+
+	for _, rejectionMessage := range rejectionMessages {
+		if strings.Contains(errMessage, rejectionMessage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *Interceptor) signAndSendOnce(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg, send func(raw hexutil.Bytes) (ethcommon.Hash, error)) (ethcommon.Hash, error) {
+	signCtx, reservations := guarded.WithReservations(ctx)
+	raw, err := i.ethSignTransaction(signCtx, msg)
+	if err != nil {
+		return ethcommon.Hash{}, err
+	}
+
+	return i.sendSigned(ctx, sess, msg, *raw, reservations, send)
+}
+
+// sendSigned sends a signed transaction, releasing the daily spendings reserved by its signature when the node rejects it
+func (i *Interceptor) sendSigned(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg, raw hexutil.Bytes, reservations *guarded.Reservations, send func(raw hexutil.Bytes) (ethcommon.Hash, error)) (ethcommon.Hash, error) {
+	hash, err := send(raw)
+	if err != nil {
+		if isRejected(err) {
+			reservations.Release(ctx)
+		}
+
+		return ethcommon.Hash{}, err
+	}
+
+	if i.transactions != nil {
+		i.recordTx(ctx, sess, &entities.Transaction{
+			Hash:    hash,
+			From:    msg.From,
+			Nonce:   *msg.Nonce,
+			Raw:     raw,
+			Private: msg.IsPrivate(),
+		})
+	}
+
+	return hash, nil
+}
+
+// recordTx starts tracking a sent transaction, completed with the node, chain and tenant it was sent with. The
+// transaction is sent whether or not it can be tracked
+func (i *Interceptor) recordTx(ctx context.Context, sess proxynode.Session, tx *entities.Transaction) {
+	logger := i.logger.WithContext(ctx).With("tx_hash", tx.Hash)
+
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch chainID, transaction not tracked")
+		return
+	}
+
+	tx.Node = i.node
+	tx.ChainID = chainID.String()
+	if userInfo := http.UserInfoFromContext(ctx); userInfo != nil {
+		tx.Tenant = userInfo.Tenant
+	}
+
+	err = i.transactions.Record(ctx, tx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to record transaction, transaction not tracked")
+	}
 }
 
 func (i *Interceptor) EthSendTransaction() jsonrpc.Handler {
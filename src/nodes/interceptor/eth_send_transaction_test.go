@@ -2,23 +2,29 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
 
 	aliasmock "github.com/longfan78/quorum-key-manager/src/aliases/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	nodesentities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	noncesmock "github.com/longfan78/quorum-key-manager/src/nodes/mock"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mockaccounts "github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	mockethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum/mock"
 	mocktessera "github.com/longfan78/quorum-key-manager/pkg/tessera/mock"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 )
 
@@ -54,7 +60,13 @@ func TestEthSendTransaction(t *testing.T) {
 	session.EXPECT().ClientPrivTxManager().Return(tesseraClient).AnyTimes()
 	stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil).AnyTimes()
 
-	i := New(stores, aliases, testutils.NewMockLogger(ctrl))
+	nonces := noncesmock.NewMockNonces(ctrl)
+	nonces.EXPECT().Next(gomock.Any(), &nodesentities.NonceKey{Node: "node", ChainID: chainID.String(), Address: from}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *nodesentities.NonceKey, fetch nodes.NonceFetcher) (uint64, error) {
+			return fetch(ctx)
+		}).AnyTimes()
+
+	i := New(stores, aliases, nonces, nil, "node", testutils.NewMockLogger(ctrl))
 
 	t.Run("should send a private tx successfully", func(t *testing.T) {
 		privateFor := []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}
@@ -81,8 +93,8 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignPrivate(gomock.Any(), msg.From, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(ctx, expectedSignedTx, privateArgs).Return(expectedHash, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
 		aliases.EXPECT().Replace(gomock.Any(), privateFor, userInfo).Return(privateFor, nil)
@@ -119,8 +131,8 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignPrivate(gomock.Any(), msg.From, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(ctx, expectedSignedTx, privateArgs).Return(expectedHash, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
 		aliases.EXPECT().Replace(gomock.Any(), privateFor, userInfo).Return(privateForExp, nil)
@@ -165,8 +177,8 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignPrivate(gomock.Any(), msg.From, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(gomock.Any(), expectedSignedTx, privateArgsExp).Return(expectedHash, nil)
 		aliases.EXPECT().Replace(gomock.Any(), []string{*privateArgs.PrivacyGroupID}, userInfo).Return(privateForExp, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
@@ -194,8 +206,8 @@ func TestEthSendTransaction(t *testing.T) {
 
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
@@ -224,8 +236,8 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().BaseFeePerGas(ctx, ethereum.LatestBlockNumber).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
@@ -253,8 +265,32 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().GasPrice(ctx).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
+
+	t.Run("should not allocate the nonce when provided", func(t *testing.T) {
+		nonce := uint64(7)
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      new(uint64),
+			Nonce:    &nonce,
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
 		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *types.Transaction) ([]byte, error) {
+			assert.Equal(t, nonce, tx.Nonce())
+			return expectedSignedTx, nil
+		})
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
@@ -262,4 +298,126 @@ func TestEthSendTransaction(t *testing.T) {
 
 		assert.Equal(t, hash.Hex(), expectedHash.Hex())
 	})
+
+	t.Run("should resynchronize the nonce and send the tx again when the nonce is too low", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      new(uint64),
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+		key := &nodesentities.NonceKey{Node: "node", ChainID: chainID.String(), Address: from}
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
+		gomock.InOrder(
+			ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil),
+			ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(3), nil),
+		)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil).Times(2)
+		gomock.InOrder(
+			ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(ethcommon.Hash{}, fmt.Errorf("nonce too low")),
+			ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil),
+		)
+		nonces.EXPECT().Reset(gomock.Any(), key).Return(nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+		assert.Equal(t, uint64(3), *msg.Nonce)
+	})
+
+	t.Run("should release the nonce without resynchronizing it when signing fails", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      new(uint64),
+		}
+		expectedErr := errors.PolicyViolationError("error")
+		key := &nodesentities.NonceKey{Node: "node", ChainID: chainID.String(), Address: from}
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(4), nil)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(nil, expectedErr)
+		nonces.EXPECT().Release(gomock.Any(), key, uint64(4)).Return(nil)
+
+		_, err := i.ethSendTransaction(ctx, msg)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should release the nonce when the node rejects the tx", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      new(uint64),
+		}
+		expectedSignedTx := []byte("mysignature")
+		key := &nodesentities.NonceKey{Node: "node", ChainID: chainID.String(), Address: from}
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(5), nil)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(ethcommon.Hash{}, fmt.Errorf("insufficient funds for gas * price + value"))
+		nonces.EXPECT().Release(gomock.Any(), key, uint64(5)).Return(nil)
+
+		_, err := i.ethSendTransaction(ctx, msg)
+		assert.Equal(t, errors.BlockchainNodeError("insufficient funds for gas * price + value"), err)
+	})
+
+	t.Run("should keep the nonce consumed when the tx may have been sent", func(t *testing.T) {
+		for _, sendErr := range []error{
+			fmt.Errorf("Downstream error"),
+			fmt.Errorf("Invalid downstream JSON-RPC response"),
+			fmt.Errorf("already known"),
+		} {
+			msg := &ethereum.SendTxMsg{
+				From:     from,
+				GasPrice: gasPrice,
+				Gas:      new(uint64),
+			}
+			expectedSignedTx := []byte("mysignature")
+
+			ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+			ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(6), nil)
+			accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+			ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(ethcommon.Hash{}, sendErr)
+
+			_, err := i.ethSendTransaction(ctx, msg)
+			assert.Equal(t, errors.BlockchainNodeError(sendErr.Error()), err)
+		}
+	})
+
+	t.Run("should record the sent tx when transactions are tracked", func(t *testing.T) {
+		transactions := noncesmock.NewMockTransactions(ctrl)
+		trackingInterceptor := New(stores, aliases, nonces, transactions, "node", testutils.NewMockLogger(ctrl))
+
+		nonce := uint64(7)
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      new(uint64),
+			Nonce:    &nonce,
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		transactions.EXPECT().Record(ctx, &nodesentities.Transaction{
+			Hash:    expectedHash,
+			Node:    "node",
+			ChainID: chainID.String(),
+			From:    from,
+			Nonce:   nonce,
+			Raw:     expectedSignedTx,
+			Tenant:  userInfo.Tenant,
+		}).Return(nil)
+
+		hash, err := trackingInterceptor.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
 }
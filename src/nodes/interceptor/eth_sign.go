@@ -10,7 +10,7 @@ import (
 )
 
 func (i *Interceptor) ethSign(ctx context.Context, from ethcommon.Address, data hexutil.Bytes) (*hexutil.Bytes, error) {
-	logger := i.logger.With("from_account", from.Hex())
+	logger := i.logger.WithContext(ctx).With("from_account", from.Hex())
 	logger.Debug("signing payload")
 
 	store, err := i.stores.EthereumByAddr(ctx, from, http.UserInfoFromContext(ctx))
@@ -14,17 +14,19 @@ import (
 )
 
 func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.SendTxMsg) (*hexutil.Bytes, error) {
-	i.logger.Debug("signing ETH transaction")
+	logger := i.logger.WithContext(ctx)
+
+	logger.Debug("signing ETH transaction")
 
 	if msg.Gas == nil {
 		errMessage := "gas not specified"
-		i.logger.Error(errMessage)
+		logger.Error(errMessage)
 		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
 	}
 
 	if msg.Nonce == nil {
 		errMessage := "nonce not specified"
-		i.logger.Error(errMessage)
+		logger.Error(errMessage)
 		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
 	}
 
@@ -42,7 +44,7 @@ func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.Send
 	sess := proxynode.SessionFromContext(ctx)
 	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
 	if err != nil {
-		i.logger.WithError(err).Error("failed to fetch chainID")
+		logger.WithError(err).Error("failed to fetch chainID")
 		return nil, errors.BlockchainNodeError(err.Error())
 	}
 
@@ -60,7 +62,7 @@ func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.Send
 		return nil, err
 	}
 
-	i.logger.Info("ETH transaction signed successfully")
+	logger.Info("ETH transaction signed successfully")
 	return (*hexutil.Bytes)(&sig), nil
 }
 
@@ -0,0 +1,80 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+)
+
+// typedDataArg is EIP-712 typed data, sent as a JSON object or, by wallets, as a string holding the JSON object
+type typedDataArg core.TypedData
+
+func (arg *typedDataArg) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		b = []byte(s)
+	}
+
+	var typedData struct {
+		core.TypedData
+		Domain struct {
+			core.TypedDataDomain
+			ChainID json.RawMessage `json:"chainId"`
+		} `json:"domain"`
+	}
+	if err := json.Unmarshal(b, &typedData); err != nil {
+		return err
+	}
+
+	// Wallets send the chain ID as a number, which go-ethereum only accepts as a string
+	if chainID := strings.Trim(string(typedData.Domain.ChainID), `"`); chainID != "" && chainID != "null" {
+		typedData.Domain.ChainId = new(math.HexOrDecimal256)
+		if err := typedData.Domain.ChainId.UnmarshalText([]byte(chainID)); err != nil {
+			return err
+		}
+	}
+
+	*arg = typedDataArg(typedData.TypedData)
+	arg.Domain = typedData.Domain.TypedDataDomain
+
+	return nil
+}
+
+// ethSignTypedData signs EIP-712 typed data, for eth_signTypedData and its _v3 and _v4 variants which share the same
+// parameters. The legacy typed data of eth_signTypedData_v1 is not supported
+func (i *Interceptor) ethSignTypedData(ctx context.Context, from ethcommon.Address, typedData *typedDataArg) (*hexutil.Bytes, error) {
+	logger := i.logger.WithContext(ctx).With("from_account", from.Hex())
+	logger.Debug("signing typed data")
+
+	if typedData == nil {
+		errMessage := "typed data not specified"
+		logger.Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	store, err := i.stores.EthereumByAddr(ctx, from, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignTypedData(ctx, from, (*core.TypedData)(typedData))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("typed data signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+func (i *Interceptor) EthSignTypedData() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.ethSignTypedData)
+	return h
+}
@@ -0,0 +1,107 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	mockaccounts "github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+const testTypedData = `{"types":{"EIP712Domain":[{"name":"name","type":"string"},{"name":"version","type":"string"},{"name":"chainId","type":"uint256"}],"Mail":[{"name":"from","type":"address"},{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"Ether Mail","version":"1","chainId":1},"message":{"from":"0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826","contents":"Hello, Bob!"}}`
+
+func TestEthSignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &entities.UserInfo{
+		Username:    "username",
+		Permissions: []entities.Permission{"sign:ethereum"},
+	}
+
+	i, stores, _ := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+	expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	expectedTypedData := gomock.AssignableToTypeOf(&core.TypedData{})
+	typedDataString, _ := json.Marshal(testTypedData)
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Signature",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, expectedTypedData).DoAndReturn(func(_ context.Context, _ ethcommon.Address, typedData *core.TypedData) ([]byte, error) {
+					if typedData.PrimaryType != "Mail" || typedData.Message["contents"] != "Hello, Bob!" || (*big.Int)(typedData.Domain.ChainId).Int64() != 1 {
+						return nil, fmt.Errorf("unexpected typed data")
+					}
+					return ethcommon.FromHex("0xa6122e27"), nil
+				})
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, testTypedData)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Signature v3",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, expectedTypedData).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v3","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, testTypedData)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Signature v4 with typed data as string",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, expectedTypedData).DoAndReturn(func(_ context.Context, _ ethcommon.Address, typedData *core.TypedData) ([]byte, error) {
+					if typedData.PrimaryType != "Mail" || (*big.Int)(typedData.Domain.ChainId).Int64() != 1 {
+						return nil, fmt.Errorf("unexpected typed data")
+					}
+					return ethcommon.FromHex("0xa6122e27"), nil
+				})
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, typedDataString)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:             "Missing typed data",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: typed data not specified"}},"id":null}`),
+		},
+		{
+			desc:    "Error signing",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, expectedTypedData).Return(nil, errors.InvalidParameterError("failed to format typed data"))
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, testTypedData)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"IR500: failed to format typed data"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
 	"github.com/longfan78/quorum-key-manager/src/aliases"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 	"github.com/longfan78/quorum-key-manager/src/stores"
 )
@@ -13,6 +14,10 @@ type Interceptor struct {
 	handler jsonrpc.Handler
 	logger  log.Logger
 	aliases aliases.Aliases
+	nonces  nodes.Nonces
+	// transactions tracks the sent transactions, nil if transactions are not tracked
+	transactions nodes.Transactions
+	node         string
 }
 
 func (i *Interceptor) ServeRPC(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
@@ -27,21 +32,43 @@ func (i *Interceptor) newHandler() jsonrpc.Handler {
 	// Set JSON-RPC interceptors
 	v2Router.Method("eth_accounts").Handle(i.EthAccounts())
 	v2Router.Method("eth_sendTransaction").Handle(i.EthSendTransaction())
+	if i.transactions != nil {
+		// Raw transactions are only intercepted to be tracked
+		v2Router.Method("eth_sendRawTransaction").Handle(i.EthSendRawTransaction())
+	}
 	v2Router.Method("eth_sign").Handle(i.EthSign())
 	v2Router.Method("eth_signTransaction").Handle(i.EthSignTransaction())
+	v2Router.Method("eth_signTypedData").Handle(i.EthSignTypedData())
+	v2Router.Method("eth_signTypedData_v3").Handle(i.EthSignTypedData())
+	v2Router.Method("eth_signTypedData_v4").Handle(i.EthSignTypedData())
 	v2Router.Method("eea_sendTransaction").Handle(i.EEASendTransaction())
+	v2Router.Method("eea_createPrivacyGroup").Handle(i.EEACreatePrivacyGroup())
+	v2Router.Method("priv_findPrivacyGroup").Handle(i.PrivFindPrivacyGroup())
+
+	v2Router.Method("personal_sign").Handle(i.PersonalSign())
+	v2Router.Method("personal_ecRecover").Handle(i.PersonalECRecover())
 
-	// Silence JSON-RPC personal
+	// Silence other JSON-RPC personal methods
 	v2Router.MethodPrefix("personal_").Handle(jsonrpc.MethodNotFoundHandler())
 
 	return jsonrpc.LoggedHandler(jsonrpc.DefaultRWHandler(router), i.logger)
 }
 
-func New(storesConnector stores.Stores, aliasService aliases.Aliases, logger log.Logger) *Interceptor {
+func New(
+	storesConnector stores.Stores,
+	aliasService aliases.Aliases,
+	noncesService nodes.Nonces,
+	transactionsService nodes.Transactions,
+	nodeName string,
+	logger log.Logger,
+) *Interceptor {
 	i := &Interceptor{
-		stores:  storesConnector,
-		aliases: aliasService,
-		logger:  logger,
+		stores:       storesConnector,
+		aliases:      aliasService,
+		nonces:       noncesService,
+		transactions: transactionsService,
+		node:         nodeName,
+		logger:       logger,
 	}
 
 	i.handler = i.newHandler()
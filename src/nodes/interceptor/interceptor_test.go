@@ -3,14 +3,26 @@ package interceptor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
 	aliasmock "github.com/longfan78/quorum-key-manager/src/aliases/mock"
+	authhttp "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	authmock "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	noncesmock "github.com/longfan78/quorum-key-manager/src/nodes/mock"
+	policiesdbmock "github.com/longfan78/quorum-key-manager/src/policies/database/mock"
+	policyentities "github.com/longfan78/quorum-key-manager/src/policies/entities"
+	"github.com/longfan78/quorum-key-manager/src/policies/service/policies"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/guarded"
+	dbmock "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	mockstoremanager "github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +32,7 @@ import (
 func newInterceptor(ctrl *gomock.Controller) (*Interceptor, *mockstoremanager.MockStores, *aliasmock.MockAliases) {
 	stores := mockstoremanager.NewMockStores(ctrl)
 	aliases := aliasmock.NewMockAliases(ctrl)
-	i := New(stores, aliases, testutils.NewMockLogger(ctrl))
+	i := New(stores, aliases, noncesmock.NewMockNonces(ctrl), nil, "node", testutils.NewMockLogger(ctrl))
 
 	return i, stores, aliases
 }
@@ -76,3 +88,64 @@ func TestPersonal(t *testing.T) {
 		})
 	}
 }
+
+func TestRawSigningPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &authentities.UserInfo{
+		Username:    "username",
+		Permissions: []authentities.Permission{"sign:ethereum"},
+	}
+
+	i, stores, _ := newInterceptor(ctrl)
+	ctx := authhttp.WithUserInfo(context.TODO(), userInfo)
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	// The store of the account evaluates the policies as in production, its underlying store must never be called
+	logger := testutils.NewMockLogger(ctrl)
+	roles := authmock.NewMockRoles(ctrl)
+	roles.EXPECT().UserPermissions(gomock.Any(), gomock.Any()).Return(authentities.ListPermissions()).AnyTimes()
+	policiesService := policies.New(policiesdbmock.NewMockSpendings(ctrl), roles, logger)
+	require.NoError(t, policiesService.Create(ctx, &policyentities.Policy{
+		Name:            "no-raw-signing",
+		Stores:          []string{"eth-store"},
+		AllowRawSigning: false,
+	}, authentities.NewWildcardUser()))
+
+	accountsDB := dbmock.NewMockETHAccounts(ctrl)
+	accountsDB.EXPECT().Get(gomock.Any(), from.Hex()).Return(&storesentities.ETHAccount{Address: from}, nil).AnyTimes()
+	ethStore := guarded.NewEthStore(mockstoremanager.NewMockEthStore(ctrl), policiesService, accountsDB, "eth-store", logger)
+	stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(ethStore, nil).AnyTimes()
+
+	expectedRespBody := []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"IR610: rejected by policy no-raw-signing: signing arbitrary data is not allowed"}},"id":null}`)
+	tests := []*testHandlerCase{
+		{
+			desc:             "personal_sign",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f","0x78e6e236592597c09d5c137c2af40aecd42d12a2"]}`),
+			expectedRespBody: expectedRespBody,
+		},
+		{
+			desc:             "eth_signTypedData_v3",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v3","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, testTypedData)),
+			expectedRespBody: expectedRespBody,
+		},
+		{
+			desc:             "eth_signTypedData_v4",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",%s]}`, testTypedData)),
+			expectedRespBody: expectedRespBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
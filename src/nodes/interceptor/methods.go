@@ -0,0 +1,29 @@
+package interceptor
+
+import (
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+// RestrictMethods serves the methods allowed to the user by the rules of the node, before any interception
+func RestrictMethods(rules *proxynode.MethodsConfig, h jsonrpc.Handler) jsonrpc.Handler {
+	if rules == nil {
+		return h
+	}
+
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		var tenant string
+		var roles []string
+		if userInfo := http.UserInfoFromContext(msg.Context()); userInfo != nil {
+			tenant, roles = userInfo.Tenant, userInfo.Roles
+		}
+
+		if !rules.Allows(tenant, roles, msg.Method) {
+			_ = jsonrpc.WriteError(jsonrpc.RWWithVersion(msg.Version)(jsonrpc.RWWithID(msg.ID)(rw)), jsonrpc.MethodNotAllowedError(msg.Method))
+			return
+		}
+
+		h.ServeRPC(rw, msg)
+	})
+}
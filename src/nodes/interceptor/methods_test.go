@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictMethods(t *testing.T) {
+	rules := &proxynode.MethodsConfig{
+		MethodRules: proxynode.MethodRules{
+			Deny: []string{"admin_*", "debug_*", "miner_*"},
+		},
+		Tenants: map[string]*proxynode.MethodRules{
+			"tenantReadOnly": {Deny: []string{"eth_sendTransaction", "eth_sendRawTransaction"}},
+		},
+		Roles: map[string]*proxynode.MethodRules{
+			"operator": {Allow: []string{"admin_*"}},
+			"auditor":  {Deny: []string{"admin_addPeer"}},
+		},
+	}
+
+	handler := RestrictMethods(rules, jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		_ = jsonrpc.WriteResult(rw, msg.Method)
+	})))
+
+	app := http.WithUserInfo(context.Background(), &entities.UserInfo{Tenant: "tenantOne", Roles: []string{"developer"}})
+	operator := http.WithUserInfo(context.Background(), &entities.UserInfo{Tenant: "tenantOne", Roles: []string{"operator"}})
+	operatorAuditor := http.WithUserInfo(context.Background(), &entities.UserInfo{Tenant: "tenantOne", Roles: []string{"operator", "auditor"}})
+	readOnly := http.WithUserInfo(context.Background(), &entities.UserInfo{Tenant: "tenantReadOnly", Roles: []string{"operator"}})
+
+	tests := []*testHandlerCase{
+		{
+			desc:             "method not denied",
+			ctx:              app,
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"eth_blockNumber","error":null,"id":1}`),
+		},
+		{
+			desc:             "method denied by prefix",
+			ctx:              app,
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"debug_traceTransaction","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"debug_traceTransaction\" not allowed","data":null},"id":1}`),
+		},
+		{
+			desc:             "method denied to the node granted to a role",
+			ctx:              operator,
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"admin_peers","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"admin_peers","error":null,"id":1}`),
+		},
+		{
+			desc:             "method denied by another role of the user",
+			ctx:              operatorAuditor,
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"admin_addPeer","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"admin_addPeer\" not allowed","data":null},"id":1}`),
+		},
+		{
+			desc:             "method denied to the tenant",
+			ctx:              readOnly,
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_sendRawTransaction","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"eth_sendRawTransaction\" not allowed","data":null},"id":1}`),
+		},
+		{
+			desc:             "method denied without user",
+			ctx:              context.Background(),
+			handler:          handler,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"miner_start","params":[],"id":1}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"miner_start\" not allowed","data":null},"id":1}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+
+	t.Run("should only allow the methods of the allow list", func(t *testing.T) {
+		allowList := &proxynode.MethodsConfig{MethodRules: proxynode.MethodRules{Allow: []string{"eth_*", "net_version"}}}
+
+		assert.True(t, allowList.Allows("", nil, "eth_call"))
+		assert.True(t, allowList.Allows("", nil, "net_version"))
+		assert.False(t, allowList.Allows("", nil, "net_peerCount"))
+		assert.False(t, allowList.Allows("", nil, "admin_peers"))
+	})
+}
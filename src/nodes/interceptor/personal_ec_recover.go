@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+)
+
+// personalECRecover returns the address that signed an EIP-191 message with personal_sign
+func (i *Interceptor) personalECRecover(ctx context.Context, data, sig hexutil.Bytes) (*ethcommon.Address, error) {
+	logger := i.logger.WithContext(ctx)
+
+	if len(sig) != crypto.SignatureLength {
+		errMessage := "signature must be exactly 65 bytes"
+		logger.Error(errMessage, "signature_length", len(sig))
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	// Signatures of personal_sign have a recovery ID of 27 or 28
+	rsv := make([]byte, crypto.SignatureLength)
+	copy(rsv, sig)
+	if rsv[crypto.RecoveryIDOffset] >= 27 {
+		rsv[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(ethereum.GetEIP191EncodedData(data)), rsv)
+	if err != nil {
+		errMessage := "failed to recover address from signature"
+		logger.WithError(err).Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	addr := crypto.PubkeyToAddress(*pubKey)
+	logger.Debug("address recovered successfully", "address", addr.Hex())
+	return &addr, nil
+}
+
+func (i *Interceptor) PersonalECRecover() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.personalECRecover)
+	return h
+}
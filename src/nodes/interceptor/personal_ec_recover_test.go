@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalECRecover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	i, _, _ := newInterceptor(ctrl)
+
+	privKey, err := crypto.HexToECDSA("56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e2e")
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	data := []byte("my data to sign")
+	sig, err := crypto.Sign(crypto.Keccak256(ethereum.GetEIP191EncodedData(data)), privKey)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+
+	tests := []*testHandlerCase{
+		{
+			desc:             "Recovered address",
+			handler:          i.handler,
+			ctx:              context.TODO(),
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"personal_ecRecover","params":["%s","%s"]}`, hexutil.Encode(data), hexutil.Encode(sig))),
+			expectedRespBody: []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":"%s","error":null,"id":null}`, strings.ToLower(addr.Hex()))),
+		},
+		{
+			desc:             "Invalid signature length",
+			handler:          i.handler,
+			ctx:              context.TODO(),
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"personal_ecRecover","params":["%s","0xa6122e27"]}`, hexutil.Encode(data))),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: signature must be exactly 65 bytes"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+)
+
+// personalSign signs an EIP-191 message, the password sent by wallets after the address being ignored
+func (i *Interceptor) personalSign(ctx context.Context, data hexutil.Bytes, from ethcommon.Address) (*hexutil.Bytes, error) {
+	logger := i.logger.WithContext(ctx).With("from_account", from.Hex())
+	logger.Debug("signing message")
+
+	store, err := i.stores.EthereumByAddr(ctx, from, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignMessage(ctx, from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("message signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+func (i *Interceptor) PersonalSign() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.personalSign)
+	return h
+}
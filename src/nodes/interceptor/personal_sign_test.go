@@ -0,0 +1,76 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	mockaccounts "github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+func TestPersonalSign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &entities.UserInfo{
+		Username:    "username",
+		Permissions: []entities.Permission{"sign:ethereum"},
+	}
+
+	i, stores, _ := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+	expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Signature",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f","0x78e6e236592597c09d5c137c2af40aecd42d12a2"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Signature with password",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f","0x78e6e236592597c09d5c137c2af40aecd42d12a2","password"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Account not found",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(nil, errors.NotFoundError("account not found"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f","0x78e6e236592597c09d5c137c2af40aecd42d12a2"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"ST100: account not found"}},"id":null}`),
+		},
+		{
+			desc:             "Other personal methods are not found",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_unlockAccount","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2","password"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method not found","data":null},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
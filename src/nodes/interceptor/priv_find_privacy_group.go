@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/pkg/tessera"
+	"github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+func (i *Interceptor) privFindPrivacyGroup(ctx context.Context, addresses []string) ([]*tessera.PrivacyGroup, error) {
+	logger := i.logger.WithContext(ctx)
+	logger.Debug("finding privacy groups")
+
+	sess := proxynode.SessionFromContext(ctx)
+
+	addresses, err := i.aliases.Replace(ctx, addresses, http.UserInfoFromContext(ctx))
+	if err != nil {
+		logger.WithError(err).Error("failed to replace aliases in addresses")
+		return nil, err
+	}
+
+	groups, err := sess.ClientPrivTxManager().FindPrivacyGroup(ctx, addresses)
+	if err != nil {
+		logger.WithError(err).Error("failed to find privacy groups on Tessera")
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+
+	logger.Debug("privacy groups found successfully", "count", len(groups))
+	return groups, nil
+}
+
+func (i *Interceptor) PrivFindPrivacyGroup() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.privFindPrivacyGroup)
+	return h
+}
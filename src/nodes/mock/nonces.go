@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: nonces.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	nodes "github.com/longfan78/quorum-key-manager/src/nodes"
+	entities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	reflect "reflect"
+)
+
+// MockNonces is a mock of Nonces interface
+type MockNonces struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoncesMockRecorder
+}
+
+// MockNoncesMockRecorder is the mock recorder for MockNonces
+type MockNoncesMockRecorder struct {
+	mock *MockNonces
+}
+
+// NewMockNonces creates a new mock instance
+func NewMockNonces(ctrl *gomock.Controller) *MockNonces {
+	mock := &MockNonces{ctrl: ctrl}
+	mock.recorder = &MockNoncesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNonces) EXPECT() *MockNoncesMockRecorder {
+	return m.recorder
+}
+
+// Next mocks base method
+func (m *MockNonces) Next(ctx context.Context, key *entities.NonceKey, fetch nodes.NonceFetcher) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, key, fetch)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next
+func (mr *MockNoncesMockRecorder) Next(ctx, key, fetch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockNonces)(nil).Next), ctx, key, fetch)
+}
+
+// Release mocks base method
+func (m *MockNonces) Release(ctx context.Context, key *entities.NonceKey, nonce uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release
+func (mr *MockNoncesMockRecorder) Release(ctx, key, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockNonces)(nil).Release), ctx, key, nonce)
+}
+
+// Reset mocks base method
+func (m *MockNonces) Reset(ctx context.Context, key *entities.NonceKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset
+func (mr *MockNoncesMockRecorder) Reset(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockNonces)(nil).Reset), ctx, key)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	entities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+	entities0 "github.com/longfan78/quorum-key-manager/src/nodes/entities"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -49,6 +50,20 @@ func (mr *MockNodesMockRecorder) Create(ctx, name, config, allowedTenants, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNodes)(nil).Create), ctx, name, config, allowedTenants, userInfo)
 }
 
+// Replace mocks base method
+func (m *MockNodes) Replace(ctx context.Context, name string, config *proxynode.Config, allowedTenants []string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, name, config, allowedTenants, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace
+func (mr *MockNodesMockRecorder) Replace(ctx, name, config, allowedTenants, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockNodes)(nil).Replace), ctx, name, config, allowedTenants, userInfo)
+}
+
 // Get mocks base method
 func (m *MockNodes) Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*proxynode.Node, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +93,76 @@ func (mr *MockNodesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNodes)(nil).List), ctx, userInfo)
 }
+
+// Register mocks base method
+func (m *MockNodes) Register(ctx context.Context, node *entities0.NodeDefinition, userInfo *entities.UserInfo) (*entities0.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, node, userInfo)
+	ret0, _ := ret[0].(*entities0.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register
+func (mr *MockNodesMockRecorder) Register(ctx, node, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockNodes)(nil).Register), ctx, node, userInfo)
+}
+
+// Inspect mocks base method
+func (m *MockNodes) Inspect(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities0.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect", ctx, name, userInfo)
+	ret0, _ := ret[0].(*entities0.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inspect indicates an expected call of Inspect
+func (mr *MockNodesMockRecorder) Inspect(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockNodes)(nil).Inspect), ctx, name, userInfo)
+}
+
+// Update mocks base method
+func (m *MockNodes) Update(ctx context.Context, node *entities0.NodeDefinition, userInfo *entities.UserInfo) (*entities0.NodeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, node, userInfo)
+	ret0, _ := ret[0].(*entities0.NodeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockNodesMockRecorder) Update(ctx, node, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNodes)(nil).Update), ctx, node, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockNodes) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockNodesMockRecorder) Delete(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNodes)(nil).Delete), ctx, name, userInfo)
+}
+
+// Load mocks base method
+func (m *MockNodes) Load(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load
+func (mr *MockNodesMockRecorder) Load(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockNodes)(nil).Load), ctx)
+}
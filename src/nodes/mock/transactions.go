@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transactions.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities "github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	reflect "reflect"
+)
+
+// MockTransactions is a mock of Transactions interface
+type MockTransactions struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionsMockRecorder
+}
+
+// MockTransactionsMockRecorder is the mock recorder for MockTransactions
+type MockTransactionsMockRecorder struct {
+	mock *MockTransactions
+}
+
+// NewMockTransactions creates a new mock instance
+func NewMockTransactions(ctrl *gomock.Controller) *MockTransactions {
+	mock := &MockTransactions{ctrl: ctrl}
+	mock.recorder = &MockTransactionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactions) EXPECT() *MockTransactionsMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method
+func (m *MockTransactions) Record(ctx context.Context, tx *entities.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record
+func (mr *MockTransactionsMockRecorder) Record(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockTransactions)(nil).Record), ctx, tx)
+}
+
+// Get mocks base method
+func (m *MockTransactions) Get(ctx context.Context, node string, hash common.Hash, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, node, hash, userInfo)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTransactionsMockRecorder) Get(ctx, node, hash, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactions)(nil).Get), ctx, node, hash, userInfo)
+}
+
+// Search mocks base method
+func (m *MockTransactions) Search(ctx context.Context, filter *entities.TransactionFilter, userInfo *auth.UserInfo) ([]*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, userInfo)
+	ret0, _ := ret[0].([]*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockTransactionsMockRecorder) Search(ctx, filter, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTransactions)(nil).Search), ctx, filter, userInfo)
+}
@@ -1,6 +1,10 @@
 package proxynode
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
 	"github.com/longfan78/quorum-key-manager/pkg/http/request"
 	"github.com/longfan78/quorum-key-manager/pkg/http/response"
@@ -82,21 +86,164 @@ func (cfg *DownstreamConfig) SetDefault() *DownstreamConfig {
 	return cfg
 }
 
+const defaultMaxBatchSize = 100
+
+// Strategies selecting the RPC endpoint serving a request
+const (
+	RoundRobin   = "round-robin"
+	LeastLatency = "least-latency"
+)
+
+// LoadBalancingConfig configures how requests are balanced between the RPC endpoints of a node
+type LoadBalancingConfig struct {
+	Strategy string `json:"strategy,omitempty" yaml:"strategy,omitempty" validate:"omitempty,oneof=round-robin least-latency" example:"round-robin" enums:"round-robin,least-latency"`
+	// HealthCheckInterval is the period at which the block number of each endpoint is checked
+	HealthCheckInterval *json.Duration `json:"healthCheckInterval,omitempty" yaml:"health_check_interval,omitempty" swaggertype:"string" example:"10s"`
+	// MaxBlockLag is the number of blocks an endpoint can lag behind the most advanced endpoint while being healthy
+	MaxBlockLag uint64 `json:"maxBlockLag,omitempty" yaml:"max_block_lag,omitempty" example:"5"`
+	// MaxErrors is the number of consecutive errors after which an endpoint is unhealthy
+	MaxErrors int `json:"maxErrors,omitempty" yaml:"max_errors,omitempty" example:"3"`
+}
+
+func (cfg *LoadBalancingConfig) SetDefault() *LoadBalancingConfig {
+	if cfg.Strategy == "" {
+		cfg.Strategy = RoundRobin
+	}
+
+	if cfg.HealthCheckInterval == nil {
+		cfg.HealthCheckInterval = &json.Duration{Duration: 10 * time.Second}
+	}
+
+	if cfg.MaxBlockLag == 0 {
+		cfg.MaxBlockLag = 5
+	}
+
+	if cfg.MaxErrors == 0 {
+		cfg.MaxErrors = 3
+	}
+
+	return cfg
+}
+
+func (cfg *LoadBalancingConfig) Validate() error {
+	// A ticker cannot run with a period of zero
+	if cfg.HealthCheckInterval != nil && cfg.HealthCheckInterval.Duration <= 0 {
+		return fmt.Errorf("health check interval must be positive, got %s", cfg.HealthCheckInterval.Duration)
+	}
+
+	return nil
+}
+
+// MethodRules lists allowed and denied JSON-RPC methods, a method ending with * standing for all the methods with its prefix
+type MethodRules struct {
+	// Allow lists the allowed methods, all methods being allowed if empty
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty" example:"eth_*,net_version"`
+	// Deny lists the denied methods, taking precedence over Allow
+	Deny []string `json:"deny,omitempty" yaml:"deny,omitempty" example:"admin_*,debug_*,miner_*"`
+}
+
+// MethodsConfig restricts the JSON-RPC methods served by a node. The rules of the tenant and roles of a user take
+// precedence over the rules of the node, so that they can grant methods denied to others
+type MethodsConfig struct {
+	MethodRules `yaml:",inline"`
+	Tenants     map[string]*MethodRules `json:"tenants,omitempty" yaml:"tenants,omitempty"`
+	Roles       map[string]*MethodRules `json:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// Allows indicates whether a user of the tenant with the roles can call the method. A method denied by a rule of the
+// tenant or of a role is denied, otherwise a method allowed by one of these rules is allowed
+func (cfg *MethodsConfig) Allows(tenant string, roles []string, method string) bool {
+	if cfg == nil {
+		return true
+	}
+
+	refinements := []*MethodRules{cfg.Tenants[tenant]}
+	for _, role := range roles {
+		refinements = append(refinements, cfg.Roles[role])
+	}
+
+	allowed := false
+	for _, rules := range refinements {
+		if rules == nil {
+			continue
+		}
+
+		if matchesMethod(rules.Deny, method) {
+			return false
+		}
+
+		allowed = allowed || matchesMethod(rules.Allow, method)
+	}
+
+	if allowed {
+		return true
+	}
+
+	return !matchesMethod(cfg.Deny, method) && (len(cfg.Allow) == 0 || matchesMethod(cfg.Allow, method))
+}
+
+func matchesMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(method, strings.TrimSuffix(pattern, "*")) || pattern == method {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Config is the cfg format for a Hashicorp Vault secret store
 type Config struct {
-	RPC           *DownstreamConfig `json:"rpc,omitempty" yaml:"rpc,omitempty"`
-	PrivTxManager *DownstreamConfig `json:"tessera,omitempty" yaml:"tessera,omitempty"`
+	RPC *DownstreamConfig `json:"rpc,omitempty" yaml:"rpc,omitempty"`
+	// RPCs are endpoints of the node served in addition to RPC, for instance the replicas of a Quorum node
+	RPCs          []*DownstreamConfig  `json:"rpcs,omitempty" yaml:"rpcs,omitempty" validate:"omitempty,dive,required"`
+	LoadBalancing *LoadBalancingConfig `json:"loadBalancing,omitempty" yaml:"load_balancing,omitempty"`
+	PrivTxManager *DownstreamConfig    `json:"tessera,omitempty" yaml:"tessera,omitempty"`
+	Methods       *MethodsConfig       `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// MaxBatchSize is the maximum number of requests of a JSON-RPC batch
+	MaxBatchSize int `json:"maxBatchSize,omitempty" yaml:"max_batch_size,omitempty" example:"100"`
 }
 
 func (cfg *Config) SetDefault() *Config {
-	if cfg.RPC == nil {
+	if cfg.RPC == nil && len(cfg.RPCs) == 0 {
 		cfg.RPC = new(DownstreamConfig)
 	}
-	cfg.RPC.SetDefault()
+
+	for _, rpc := range cfg.Endpoints() {
+		rpc.SetDefault()
+	}
+
+	if cfg.LoadBalancing == nil {
+		cfg.LoadBalancing = new(LoadBalancingConfig)
+	}
+	cfg.LoadBalancing.SetDefault()
 
 	if cfg.PrivTxManager != nil {
 		cfg.PrivTxManager.SetDefault()
 	}
 
+	if cfg.MaxBatchSize == 0 {
+		cfg.MaxBatchSize = defaultMaxBatchSize
+	}
+
 	return cfg
 }
+
+// Validate checks the values of the configuration that defaults cannot fix
+func (cfg *Config) Validate() error {
+	if cfg.LoadBalancing != nil {
+		return cfg.LoadBalancing.Validate()
+	}
+
+	return nil
+}
+
+// Endpoints returns the RPC endpoints of the node, RPC being the first one
+func (cfg *Config) Endpoints() []*DownstreamConfig {
+	var endpoints []*DownstreamConfig
+	if cfg.RPC != nil {
+		endpoints = append(endpoints, cfg.RPC)
+	}
+
+	return append(endpoints, cfg.RPCs...)
+}
@@ -0,0 +1,27 @@
+package proxynode
+
+import (
+	"testing"
+	"time"
+
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Run("should accept the default configuration", func(t *testing.T) {
+		assert.NoError(t, (&Config{}).SetDefault().Validate())
+	})
+
+	t.Run("should reject a health check interval of zero", func(t *testing.T) {
+		cfg := (&Config{LoadBalancing: &LoadBalancingConfig{HealthCheckInterval: &jsonutils.Duration{}}}).SetDefault()
+
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("should reject a negative health check interval", func(t *testing.T) {
+		cfg := (&Config{LoadBalancing: &LoadBalancingConfig{HealthCheckInterval: &jsonutils.Duration{Duration: -time.Second}}}).SetDefault()
+
+		assert.Error(t, cfg.Validate())
+	})
+}
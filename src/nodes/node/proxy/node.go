@@ -3,9 +3,12 @@ package proxynode
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
@@ -15,7 +18,6 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/http/transport"
 	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
 	"github.com/longfan78/quorum-key-manager/pkg/tessera"
-	"github.com/longfan78/quorum-key-manager/pkg/websocket"
 	gorillamux "github.com/gorilla/mux"
 	gorillawebsocket "github.com/gorilla/websocket"
 )
@@ -26,18 +28,19 @@ type Node struct {
 	// Handler is the JSON-RPC handler
 	Handler jsonrpc.Handler
 
-	rpc        *httpDownstream
+	rpc        *pool
 	privTxMngr *httpDownstream
 
-	wsHandler   *websocket.Proxy
 	httpHandler http.Handler
+
+	maxBatchSize int
 }
 
 // New creates a Node
 func New(cfg *Config, logger log.Logger) (*Node, error) {
-	n := new(Node)
+	n := &Node{maxBatchSize: cfg.MaxBatchSize}
 	var err error
-	n.rpc, err = newhttpDownstream(cfg.RPC)
+	n.rpc, err = newPool(cfg.Endpoints(), cfg.LoadBalancing, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -54,29 +57,26 @@ func New(cfg *Config, logger log.Logger) (*Node, error) {
 	router.Methods(http.MethodPost).HandlerFunc(n.serveHTTP)
 	n.httpHandler = router
 
-	// Set websocket proxy
-	websocketProxy := websocket.NewProxy(cfg.RPC.Proxy.WebSocket, logger)
-	websocketProxy.ReqPreparer = n.rpc.reqPreparer
-	websocketProxy.RespModifier = n.rpc.respModifier
-	websocketProxy.Interceptor = n.interceptWS
-	websocketProxy.ErrorHandler = n.rpc.errorHandler
-	n.wsHandler = websocketProxy
+	// Set websocket proxies
+	for _, ep := range n.rpc.endpoints {
+		ep.wsHandler.Interceptor = n.interceptWS
+	}
 
 	return n, nil
 }
 
 func (n *Node) Start(ctx context.Context) error {
-	return n.wsHandler.Start(ctx)
+	return n.rpc.start(ctx)
 }
 
 func (n *Node) Stop(ctx context.Context) error {
-	return n.wsHandler.Stop(ctx)
+	return n.rpc.stop(ctx)
 }
 
 func (n *Node) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	if gorillawebsocket.IsWebSocketUpgrade(req) {
-		// we serve websocket
-		n.wsHandler.ServeHTTP(rw, req)
+		// A websocket session sticks to the endpoint it is connected to
+		n.rpc.pick(nil).wsHandler.ServeHTTP(rw, req)
 		return
 	}
 
@@ -84,24 +84,16 @@ func (n *Node) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 }
 
 func (n *Node) serveHTTP(rw http.ResponseWriter, req *http.Request) {
-	// Create ResponseWriter
-	rpcRw := jsonrpc.NewResponseWriter(rw)
-
-	// Parse request body
-	msg := new(jsonrpc.RequestMsg)
-	err := json.NewDecoder(req.Body).Decode(msg)
+	// Read request body
+	b, err := ioutil.ReadAll(req.Body)
 	req.Body.Close()
 	if err != nil {
-		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(rw), jsonrpc.ParseError(err))
 		return
 	}
 
-	// Attach session to context
-	jsonrpcClient := n.newHTTPJSONRPCClient(req)
-	ctx := WithSession(req.Context(), n.newSession(jsonrpcClient, msg))
-
-	// Serve
-	n.handler().ServeRPC(rpcRw, msg.WithContext(ctx))
+	// Attach session to context and serve
+	n.serve(req.Context(), rw, n.newHTTPJSONRPCClient(req), b)
 }
 
 func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillawebsocket.Conn) (clientErrors, serverErrors <-chan error) {
@@ -123,24 +115,14 @@ func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillaw
 				return
 			}
 
-			// Create ResponseWriter
+			// Create writer
 			w, err := clientConn.NextWriter(typ)
 			if err != nil {
 				continue
 			}
-			rpcRw := jsonrpc.NewResponseWriter(w)
-
-			// Unmarshal input message
-			msg := new(jsonrpc.RequestMsg)
-			err = json.Unmarshal(b, msg)
-			if err != nil {
-				_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
-				continue
-			}
 
 			// Create and attach session to context then handle message
-			sess := n.newSession(jsonrpcClient, msg)
-			n.handler().ServeRPC(rpcRw, msg.WithContext(WithSession(ctx, sess)))
+			n.serve(ctx, w, jsonrpcClient, b)
 
 			// Close writer so message is sent through connection
 			w.Close()
@@ -150,6 +132,35 @@ func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillaw
 	return clientErrs, jsonrpcClient.Errors()
 }
 
+// serve handles a single JSON-RPC request or a batch of requests, each request being given its own session
+func (n *Node) serve(ctx context.Context, w io.Writer, jsonrpcClient jsonrpc.Client, b []byte) {
+	rpcRw := jsonrpc.NewResponseWriter(w)
+
+	if jsonrpc.IsBatch(b) {
+		msgs, err := jsonrpc.UnmarshalBatch(b, n.maxBatchSize)
+		if err != nil {
+			_ = jsonrpc.WriteError(rpcRw, err)
+			return
+		}
+
+		for i, msg := range msgs {
+			msgs[i] = msg.WithContext(WithSession(ctx, n.newSession(jsonrpcClient, msg)))
+		}
+
+		_ = jsonrpc.ServeBatch(w, n.handler(), msgs)
+		return
+	}
+
+	msg := new(jsonrpc.RequestMsg)
+	err := json.Unmarshal(b, msg)
+	if err != nil {
+		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		return
+	}
+
+	n.handler().ServeRPC(rpcRw, msg.WithContext(WithSession(ctx, n.newSession(jsonrpcClient, msg))))
+}
+
 func (n *Node) handler() jsonrpc.Handler {
 	if n.Handler != nil {
 		return n.Handler
@@ -165,19 +176,45 @@ func (n *Node) newSession(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg)
 	}
 }
 
+// newHTTPJSONRPCClient creates a client sending all the requests of an incoming request to a single endpoint of the
+// node, so that an intercepted request reading the nonce and sending the transaction observes the chain of one endpoint
 func (n *Node) newHTTPJSONRPCClient(req *http.Request) jsonrpc.Client {
-	httpClient := httpclient.CombineDecorators(
-		httpclient.WithModifier(n.rpc.respModifier),
-		httpclient.WithRequest(req),
-		httpclient.WithPreparer(n.rpc.reqPreparer),
-		httpclient.WithPreparer(
-			request.CombinePreparer(
-				request.RemoveConnectionHeaders(),
-				request.ForwardedFor(),
+	return newPinnedClient(n.rpc, func(ep *endpoint) jsonrpc.Client {
+		httpClient := httpclient.CombineDecorators(
+			httpclient.WithModifier(ep.respModifier),
+			httpclient.WithRequest(req),
+			httpclient.WithPreparer(ep.reqPreparer),
+			httpclient.WithPreparer(
+				request.CombinePreparer(
+					request.RemoveConnectionHeaders(),
+					request.ForwardedFor(),
+				),
 			),
-		),
-	)(n.rpc.client)
-	return jsonrpc.NewHTTPClient(httpClient)
+		)(ep.client)
+		return jsonrpc.NewHTTPClient(httpClient)
+	})
+}
+
+// EthCaller creates a caller of the node for the requests originated by QKM, failing over between the endpoints
+func (n *Node) EthCaller() ethereum.Caller {
+	jsonrpcClient := &client{
+		pool: n.rpc,
+		newClient: func(ep *endpoint) jsonrpc.Client {
+			return ep.jsonrpcClient()
+		},
+	}
+
+	return newEthCaller(jsonrpcClient, new(jsonrpc.RequestMsg).WithVersion("2.0"))
+}
+
+// PinnedEthCaller creates a caller of the node for the requests originated by QKM, sending all its requests to a single
+// endpoint so that they observe the same state of the chain
+func (n *Node) PinnedEthCaller() ethereum.Caller {
+	jsonrpcClient := newPinnedClient(n.rpc, func(ep *endpoint) jsonrpc.Client {
+		return ep.jsonrpcClient()
+	})
+
+	return newEthCaller(jsonrpcClient, new(jsonrpc.RequestMsg).WithVersion("2.0"))
 }
 
 func newEthCaller(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg) ethereum.Caller {
@@ -221,6 +258,9 @@ func newhttpDownstream(cfg *DownstreamConfig) (*httpDownstream, error) {
 		return nil, err
 	}
 
+	// Propagate the trace of the request to the node and to Tessera
+	n.transport = tracing.NewTransport(n.transport)
+
 	n.reqPreparer, err = request.Proxy(cfg.Proxy.Request)
 	if err != nil {
 		return nil, err
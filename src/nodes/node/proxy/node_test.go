@@ -1,6 +1,7 @@
 package proxynode
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -87,6 +88,109 @@ func TestRPCNodeHTTP(t *testing.T) {
 	assert.Equal(t, expectedRespBody, rec.Body.Bytes()[:(rec.Body.Len()-1)], "WriteMsg should write correct body")
 }
 
+func TestRPCNodeHTTPBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcServer := httptest.NewServer(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rpcRw := jsonrpc.NewResponseWriter(rw)
+
+			msg := new(jsonrpc.RequestMsg)
+			err := json.NewDecoder(req.Body).Decode(msg)
+			req.Body.Close()
+			if err != nil {
+				_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+				return
+			}
+
+			jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rpcRw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+				_ = jsonrpc.WriteResult(rpcRw, msg.Params)
+			})).ServeRPC(rpcRw, msg)
+		}),
+	)
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+		MaxBatchSize: 2,
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	t.Run("should proxy each request of the batch and reply in order", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(
+			`[{"jsonrpc":"2.0","method":"testMethod","params":"msg-1","id":1},{"jsonrpc":"2.0","method":"testMethod","params":"msg-2","id":2}]`,
+		)))
+
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, "StatusCode should be OK")
+		var resps []*jsonrpc.ResponseMsg
+		err := json.Unmarshal(rec.Body.Bytes(), &resps)
+		require.NoError(t, err, "Body should be a batch of responses")
+		require.Len(t, resps, 2, "Batch should have a response per request")
+		assertResponse(t, resps[0], "2.0", 1, "msg-1")
+		assertResponse(t, resps[1], "2.0", 2, "msg-2")
+	})
+
+	t.Run("should reject batch exceeding max batch size", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(
+			`[{"jsonrpc":"2.0","method":"m","id":1},{"jsonrpc":"2.0","method":"m","id":2},{"jsonrpc":"2.0","method":"m","id":3}]`,
+		)))
+
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		resp := new(jsonrpc.ResponseMsg)
+		err := json.Unmarshal(rec.Body.Bytes(), resp)
+		require.NoError(t, err, "Body should be a single response")
+		assert.Equal(t, -32600, resp.Error.Code, "Error should be an invalid request")
+	})
+}
+
+func TestRPCNodeHTTPPinning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var calls1, calls2 int32
+	srv1, srv2 := newRPCServer(10, &calls1), newRPCServer(10, &calls2)
+	defer srv1.Close()
+	defer srv2.Close()
+
+	cfg := (&Config{
+		RPC:  &DownstreamConfig{Addr: srv1.URL},
+		RPCs: []*DownstreamConfig{{Addr: srv2.URL}},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	// The handler sends several requests downstream for a single incoming request, as interceptors do
+	n.Handler = jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		var resp *jsonrpc.ResponseMsg
+		for i := 0; i < 4; i++ {
+			resp, err = SessionFromContext(msg.Context()).ClientRPC().Do(msg)
+			require.NoError(t, err)
+		}
+		_ = rw.WriteMsg(resp)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/", nil)
+	_ = request.WriteJSON(req, new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("testMethod").WithID("test-id").WithParams("test-message"))
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, "StatusCode should be OK")
+	assert.Equal(t, int32(4), calls1+calls2, "All requests should be sent downstream")
+	assert.True(t, calls1 == 0 || calls2 == 0, "All requests should be sent to the same endpoint")
+}
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:    1024,
 	WriteBufferSize:   1024,
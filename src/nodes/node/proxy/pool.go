@@ -0,0 +1,394 @@
+package proxynode
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
+	"github.com/longfan78/quorum-key-manager/pkg/http/request"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/pkg/websocket"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+// endpoint is an RPC endpoint of a node, with its health as observed by health checks and requests
+type endpoint struct {
+	*httpDownstream
+	wsHandler *websocket.Proxy
+
+	logger log.Logger
+
+	mux     sync.RWMutex
+	healthy bool
+	errors  int
+	latency time.Duration
+}
+
+func newEndpoint(cfg *DownstreamConfig, logger log.Logger) (*endpoint, error) {
+	downstream, err := newhttpDownstream(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ep := &endpoint{
+		httpDownstream: downstream,
+		logger:         logger.With("endpoint", redactedAddr(cfg.Addr)),
+		healthy:        true,
+	}
+
+	ep.wsHandler = websocket.NewProxy(cfg.Proxy.WebSocket, logger)
+	ep.wsHandler.ReqPreparer = downstream.reqPreparer
+	ep.wsHandler.RespModifier = downstream.respModifier
+	ep.wsHandler.ErrorHandler = downstream.errorHandler
+
+	return ep, nil
+}
+
+func (ep *endpoint) isHealthy() bool {
+	ep.mux.RLock()
+	defer ep.mux.RUnlock()
+	return ep.healthy
+}
+
+func (ep *endpoint) getLatency() time.Duration {
+	ep.mux.RLock()
+	defer ep.mux.RUnlock()
+	return ep.latency
+}
+
+// succeeded records a successful call, the latency being smoothed over the last calls
+func (ep *endpoint) succeeded(latency time.Duration) {
+	ep.mux.Lock()
+	defer ep.mux.Unlock()
+
+	ep.errors = 0
+	if ep.latency == 0 {
+		ep.latency = latency
+	} else {
+		ep.latency = (4*ep.latency + latency) / 5
+	}
+}
+
+// failed records a failed call, the endpoint being unhealthy after maxErrors consecutive failures
+func (ep *endpoint) failed(err error, maxErrors int) {
+	ep.mux.Lock()
+	defer ep.mux.Unlock()
+
+	ep.errors++
+	if ep.healthy && ep.errors >= maxErrors {
+		ep.healthy = false
+		ep.logger.WithError(err).Warn("node endpoint is unhealthy", "errors", ep.errors)
+	}
+}
+
+func (ep *endpoint) setHealthy(healthy bool, reason string) {
+	ep.mux.Lock()
+	defer ep.mux.Unlock()
+
+	if healthy == ep.healthy {
+		return
+	}
+
+	ep.healthy = healthy
+	if healthy {
+		ep.logger.Info("node endpoint is healthy again")
+	} else {
+		ep.logger.Warn("node endpoint is unhealthy", "reason", reason)
+	}
+}
+
+// jsonrpcClient creates a client for the requests originated by QKM, not forwarded from a user
+func (ep *endpoint) jsonrpcClient() jsonrpc.Client {
+	httpClient := httpclient.CombineDecorators(
+		httpclient.WithModifier(ep.respModifier),
+		httpclient.WithPreparer(ep.reqPreparer),
+		httpclient.WithPreparer(request.RemoveConnectionHeaders()),
+	)(ep.client)
+
+	return jsonrpc.NewHTTPClient(httpClient)
+}
+
+// checkBlockNumber calls eth_blockNumber, returning false if the endpoint failed to answer
+func (ep *endpoint) checkBlockNumber(ctx context.Context, maxErrors int) (uint64, bool) {
+	msg := new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_blockNumber").WithID(1).WithContext(ctx)
+
+	start := time.Now()
+	resp, err := ep.jsonrpcClient().Do(msg)
+	if err == nil {
+		err = resp.Err()
+	}
+
+	var blockNumber hexutil.Uint64
+	if err == nil {
+		err = resp.UnmarshalResult(&blockNumber)
+	}
+
+	if err != nil {
+		ep.failed(err, maxErrors)
+		return 0, false
+	}
+
+	ep.succeeded(time.Since(start))
+	return uint64(blockNumber), true
+}
+
+// pool balances requests between the RPC endpoints of a node, avoiding unhealthy endpoints
+type pool struct {
+	endpoints []*endpoint
+	cfg       *LoadBalancingConfig
+	next      uint64
+
+	logger log.Logger
+
+	stopped chan struct{}
+	done    chan struct{}
+}
+
+func newPool(cfgs []*DownstreamConfig, lbCfg *LoadBalancingConfig, logger log.Logger) (*pool, error) {
+	p := &pool{
+		cfg:     lbCfg,
+		logger:  logger,
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	for _, cfg := range cfgs {
+		ep, err := newEndpoint(cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		p.endpoints = append(p.endpoints, ep)
+	}
+
+	return p, nil
+}
+
+// pick selects the endpoint of a request among the endpoints not tried yet. Unhealthy endpoints are only selected
+// if all the others are unhealthy, as they may have recovered since their last check
+func (p *pool) pick(tried map[*endpoint]bool) *endpoint {
+	var healthy, unhealthy []*endpoint
+	for _, ep := range p.endpoints {
+		switch {
+		case tried[ep]:
+		case ep.isHealthy():
+			healthy = append(healthy, ep)
+		default:
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+
+	candidates := healthy
+	if len(candidates) == 0 {
+		candidates = unhealthy
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil
+	case len(candidates) == 1:
+		return candidates[0]
+	case p.cfg.Strategy == LeastLatency:
+		return leastLatency(candidates)
+	default:
+		return candidates[atomic.AddUint64(&p.next, 1)%uint64(len(candidates))]
+	}
+}
+
+// leastLatency selects the endpoint with the lowest latency, endpoints without latency yet being selected first
+func leastLatency(candidates []*endpoint) *endpoint {
+	selected := candidates[0]
+	latency := selected.getLatency()
+	for _, ep := range candidates[1:] {
+		if l := ep.getLatency(); l < latency {
+			selected, latency = ep, l
+		}
+	}
+
+	return selected
+}
+
+func (p *pool) start(ctx context.Context) error {
+	for _, ep := range p.endpoints {
+		if err := ep.wsHandler.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	// A single endpoint serves every request whatever its health
+	if len(p.endpoints) < 2 {
+		close(p.done)
+		return nil
+	}
+
+	go p.runHealthChecks()
+
+	return nil
+}
+
+func (p *pool) stop(ctx context.Context) error {
+	select {
+	case <-p.stopped:
+	default:
+		close(p.stopped)
+	}
+
+	var err error
+	for _, ep := range p.endpoints {
+		if wsErr := ep.wsHandler.Stop(ctx); wsErr != nil {
+			err = wsErr
+		}
+	}
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return err
+}
+
+func (p *pool) runHealthChecks() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.cfg.HealthCheckInterval.Duration)
+	defer ticker.Stop()
+
+	for {
+		p.checkHealth()
+
+		select {
+		case <-ticker.C:
+		case <-p.stopped:
+			return
+		}
+	}
+}
+
+// checkHealth checks all the endpoints, those lagging more than MaxBlockLag blocks behind the most advanced one being
+// unhealthy
+func (p *pool) checkHealth() {
+	ctx, cancel := context.WithTimeout(context.Background(), p.cfg.HealthCheckInterval.Duration)
+	defer cancel()
+
+	blockNumbers := make([]uint64, len(p.endpoints))
+	answered := make([]bool, len(p.endpoints))
+	wg := sync.WaitGroup{}
+	for i, ep := range p.endpoints {
+		wg.Add(1)
+		go func(i int, ep *endpoint) {
+			defer wg.Done()
+			blockNumbers[i], answered[i] = ep.checkBlockNumber(ctx, p.cfg.MaxErrors)
+		}(i, ep)
+	}
+	wg.Wait()
+
+	var head uint64
+	for i := range p.endpoints {
+		if answered[i] && blockNumbers[i] > head {
+			head = blockNumbers[i]
+		}
+	}
+
+	for i, ep := range p.endpoints {
+		if !answered[i] {
+			continue
+		}
+
+		ep.setHealthy(head-blockNumbers[i] <= p.cfg.MaxBlockLag, "lagging behind other endpoints")
+	}
+}
+
+// client is a JSON-RPC client failing over to the next endpoint when an endpoint cannot be reached or fails
+type client struct {
+	pool      *pool
+	newClient func(*endpoint) jsonrpc.Client
+}
+
+func (c *client) Do(msg *jsonrpc.RequestMsg) (*jsonrpc.ResponseMsg, error) {
+	tried := make(map[*endpoint]bool)
+
+	var err error
+	for ep := c.pool.pick(tried); ep != nil; ep = c.pool.pick(tried) {
+		tried[ep] = true
+
+		start := time.Now()
+		var resp *jsonrpc.ResponseMsg
+		resp, err = c.newClient(ep).Do(msg)
+		if !isDownstreamFailure(err) {
+			if err == nil {
+				ep.succeeded(time.Since(start))
+			}
+			return resp, err
+		}
+
+		ep.failed(err, c.pool.cfg.MaxErrors)
+		if msg.Context().Err() != nil {
+			break
+		}
+	}
+
+	return nil, err
+}
+
+// pinnedClient is a JSON-RPC client sending all its requests to a single endpoint, so that successive requests observe
+// the chain of the same endpoint
+type pinnedClient struct {
+	pool      *pool
+	ep        *endpoint
+	newClient func(*endpoint) jsonrpc.Client
+}
+
+func newPinnedClient(p *pool, newClient func(*endpoint) jsonrpc.Client) jsonrpc.Client {
+	ep := p.pick(nil)
+	if ep == nil {
+		return &client{pool: p, newClient: newClient}
+	}
+
+	return &pinnedClient{pool: p, ep: ep, newClient: newClient}
+}
+
+func (c *pinnedClient) Do(msg *jsonrpc.RequestMsg) (*jsonrpc.ResponseMsg, error) {
+	start := time.Now()
+	resp, err := c.newClient(c.ep).Do(msg)
+	switch {
+	case err == nil:
+		c.ep.succeeded(time.Since(start))
+	case isDownstreamFailure(err):
+		c.ep.failed(err, c.pool.cfg.MaxErrors)
+	}
+
+	return resp, err
+}
+
+// isDownstreamFailure indicates whether the endpoint failed to answer, as opposed to a JSON-RPC error of the node
+func isDownstreamFailure(err error) bool {
+	errMsg, ok := err.(*jsonrpc.ErrorMsg)
+	if !ok {
+		return false
+	}
+
+	switch errMsg.Code {
+	case -32000, -32003:
+		return true
+	case -32001:
+		data, _ := errMsg.Data.(map[string]interface{})
+		status, _ := data["status"].(int)
+		return status >= 500
+	default:
+		return false
+	}
+}
+
+func redactedAddr(addr string) string {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return ""
+	}
+
+	return u.Redacted()
+}
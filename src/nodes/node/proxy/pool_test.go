@@ -0,0 +1,185 @@
+package proxynode
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	httpclient "github.com/longfan78/quorum-key-manager/pkg/http/client"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	"github.com/longfan78/quorum-key-manager/pkg/jsonrpc"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRPCServer serves eth_blockNumber with the block number of the server and echoes the params of other methods
+func newRPCServer(blockNumber uint64, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		msg := new(jsonrpc.RequestMsg)
+		_ = json.NewDecoder(req.Body).Decode(msg)
+		req.Body.Close()
+
+		jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rpcRw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+			if msg.Method == "eth_blockNumber" {
+				_ = jsonrpc.WriteResult(rpcRw, hexutil.Uint64(blockNumber))
+				return
+			}
+
+			atomic.AddInt32(calls, 1)
+			_ = jsonrpc.WriteResult(rpcRw, msg.Params)
+		})).ServeRPC(jsonrpc.NewResponseWriter(rw), msg)
+	}))
+}
+
+func newTestPool(t *testing.T, ctrl *gomock.Controller, strategy string, addrs ...string) *pool {
+	cfg := (&Config{LoadBalancing: &LoadBalancingConfig{Strategy: strategy, HealthCheckInterval: &jsonutils.Duration{Duration: time.Second}}}).SetDefault()
+	for _, addr := range addrs {
+		cfg.RPCs = append(cfg.RPCs, (&DownstreamConfig{Addr: addr}).SetDefault())
+	}
+	cfg.RPC = nil
+
+	p, err := newPool(cfg.Endpoints(), cfg.LoadBalancing, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err)
+
+	return p
+}
+
+func newTestClient(p *pool) jsonrpc.Client {
+	return &client{
+		pool: p,
+		newClient: func(ep *endpoint) jsonrpc.Client {
+			return jsonrpc.NewHTTPClient(httpclient.WithPreparer(ep.reqPreparer)(ep.client))
+		},
+	}
+}
+
+func TestPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msg := new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("testMethod").WithID("test-id").WithParams("test-message")
+
+	t.Run("should balance requests between endpoints with round-robin", func(t *testing.T) {
+		var calls1, calls2 int32
+		srv1, srv2 := newRPCServer(10, &calls1), newRPCServer(10, &calls2)
+		defer srv1.Close()
+		defer srv2.Close()
+
+		c := newTestClient(newTestPool(t, ctrl, RoundRobin, srv1.URL, srv2.URL))
+		for i := 0; i < 4; i++ {
+			_, err := c.Do(msg)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(2), calls1)
+		assert.Equal(t, int32(2), calls2)
+	})
+
+	t.Run("should fail over to the next endpoint when an endpoint is down", func(t *testing.T) {
+		var calls int32
+		srv := newRPCServer(10, &calls)
+		defer srv.Close()
+		down := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer down.Close()
+
+		p := newTestPool(t, ctrl, RoundRobin, down.URL, srv.URL)
+		c := newTestClient(p)
+		// The endpoint down is unhealthy after failing MaxErrors requests, each request failing over to the other endpoint
+		for i := 0; i < 6; i++ {
+			resp, err := c.Do(msg)
+			require.NoError(t, err)
+
+			var res string
+			require.NoError(t, resp.UnmarshalResult(&res))
+			assert.Equal(t, "test-message", res)
+		}
+
+		assert.Equal(t, int32(6), calls)
+		assert.False(t, p.endpoints[0].isHealthy())
+		assert.True(t, p.endpoints[1].isHealthy())
+	})
+
+	t.Run("should not fail over on invalid requests", func(t *testing.T) {
+		var calls1, calls2 int32
+		srv1, srv2 := newRPCServer(10, &calls1), newRPCServer(10, &calls2)
+		defer srv1.Close()
+		defer srv2.Close()
+
+		_, err := newTestClient(newTestPool(t, ctrl, RoundRobin, srv1.URL, srv2.URL)).Do(new(jsonrpc.RequestMsg).WithVersion("2.0"))
+
+		assert.Error(t, err)
+		assert.Equal(t, int32(0), calls1+calls2)
+	})
+
+	t.Run("should mark endpoints lagging behind as unhealthy", func(t *testing.T) {
+		var calls1, calls2, calls3 int32
+		srv1, srv2, srv3 := newRPCServer(100, &calls1), newRPCServer(98, &calls2), newRPCServer(50, &calls3)
+		defer srv1.Close()
+		defer srv2.Close()
+		defer srv3.Close()
+
+		p := newTestPool(t, ctrl, RoundRobin, srv1.URL, srv2.URL, srv3.URL)
+		p.checkHealth()
+
+		assert.True(t, p.endpoints[0].isHealthy())
+		assert.True(t, p.endpoints[1].isHealthy())
+		assert.False(t, p.endpoints[2].isHealthy())
+
+		c := newTestClient(p)
+		for i := 0; i < 4; i++ {
+			_, err := c.Do(msg)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(0), calls3)
+	})
+
+	t.Run("should serve requests with unhealthy endpoints if no endpoint is healthy", func(t *testing.T) {
+		var calls int32
+		srv := newRPCServer(10, &calls)
+		defer srv.Close()
+
+		p := newTestPool(t, ctrl, RoundRobin, srv.URL, srv.URL)
+		p.endpoints[0].setHealthy(false, "test")
+		p.endpoints[1].setHealthy(false, "test")
+
+		_, err := newTestClient(p).Do(msg)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("should send all the requests of a pinned client to the same endpoint", func(t *testing.T) {
+		var calls1, calls2 int32
+		srv1, srv2 := newRPCServer(10, &calls1), newRPCServer(10, &calls2)
+		defer srv1.Close()
+		defer srv2.Close()
+
+		c := newPinnedClient(newTestPool(t, ctrl, RoundRobin, srv1.URL, srv2.URL), func(ep *endpoint) jsonrpc.Client {
+			return jsonrpc.NewHTTPClient(httpclient.WithPreparer(ep.reqPreparer)(ep.client))
+		})
+		for i := 0; i < 4; i++ {
+			_, err := c.Do(msg)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(4), calls1+calls2)
+		assert.True(t, calls1 == 0 || calls2 == 0)
+	})
+
+	t.Run("should select the endpoint with the least latency", func(t *testing.T) {
+		p := newTestPool(t, ctrl, LeastLatency, "http://node1:8545", "http://node2:8545")
+		p.endpoints[0].succeeded(50 * time.Millisecond)
+		p.endpoints[1].succeeded(10 * time.Millisecond)
+
+		assert.Equal(t, p.endpoints[1], p.pick(nil))
+		assert.Equal(t, p.endpoints[0], p.pick(map[*endpoint]bool{p.endpoints[1]: true}))
+	})
+}
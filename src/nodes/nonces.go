@@ -0,0 +1,24 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+//go:generate mockgen -source=nonces.go -destination=mock/nonces.go -package=mock
+
+// NonceFetcher returns the pending nonce of an account from the downstream node
+type NonceFetcher func(ctx context.Context) (uint64, error)
+
+// Nonces allocates the nonces of the transactions sent through the proxy nodes
+type Nonces interface {
+	// Next atomically allocates the next nonce of an account, fetching it from the node if unknown
+	Next(ctx context.Context, key *entities.NonceKey, fetch NonceFetcher) (uint64, error)
+
+	// Release gives back an allocated nonce that was not used, only if no later nonce was allocated meanwhile
+	Release(ctx context.Context, key *entities.NonceKey, nonce uint64) error
+
+	// Reset forgets the nonce of an account so that it is fetched from the node on next allocation
+	Reset(ctx context.Context, key *entities.NonceKey) error
+}
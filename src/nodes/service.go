@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	nodes "github.com/longfan78/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 )
 
@@ -14,9 +15,27 @@ type Nodes interface {
 	// Create creates a new node
 	Create(ctx context.Context, name string, config *proxynode.Config, allowedTenants []string, userInfo *entities.UserInfo) error
 
+	// Replace restarts an existing node with a new configuration
+	Replace(ctx context.Context, name string, config *proxynode.Config, allowedTenants []string, userInfo *entities.UserInfo) error
+
 	// Get returns a node by name
 	Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*proxynode.Node, error)
 
 	// List returns a list of nodes
 	List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error)
+
+	// Register creates a node from a definition and persists it
+	Register(ctx context.Context, node *nodes.NodeDefinition, userInfo *entities.UserInfo) (*nodes.NodeDefinition, error)
+
+	// Inspect gets the definition of a persisted node
+	Inspect(ctx context.Context, name string, userInfo *entities.UserInfo) (*nodes.NodeDefinition, error)
+
+	// Update updates the definition of a persisted node and restarts it
+	Update(ctx context.Context, node *nodes.NodeDefinition, userInfo *entities.UserInfo) (*nodes.NodeDefinition, error)
+
+	// Delete stops and deletes a persisted node
+	Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error
+
+	// Load starts all the persisted nodes
+	Load(ctx context.Context) error
 }
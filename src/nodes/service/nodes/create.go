@@ -5,7 +5,6 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
-	"github.com/longfan78/quorum-key-manager/src/nodes/interceptor"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 )
 
@@ -20,19 +19,8 @@ func (i *Nodes) Create(ctx context.Context, name string, config *proxynode.Confi
 		return errors.AlreadyExistsError(errMessage)
 	}
 
-	prxNode, err := proxynode.New(config, i.logger)
+	prxNode, err := i.startNode(ctx, name, config)
 	if err != nil {
-		logger.WithError(err).Error("failed to create node")
-		return err
-	}
-
-	// Set interceptor on proxy node
-	prxNode.Handler = interceptor.New(i.storesService, i.aliases, i.logger)
-
-	// Start node
-	err = prxNode.Start(ctx)
-	if err != nil {
-		logger.WithError(err).Error("error starting node")
 		return err
 	}
 
@@ -0,0 +1,47 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+func (i *Nodes) Delete(ctx context.Context, name string, userInfo *authtypes.UserInfo) error {
+	logger := i.logger.With("name", name)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceNode})
+	if err != nil {
+		return err
+	}
+
+	node, err := i.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get node"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(node.AllowedTenants)
+	if err != nil {
+		return err
+	}
+
+	err = i.db.Delete(ctx, name)
+	if err != nil {
+		errMessage := "failed to delete node"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if prxNode := i.deleteNode(ctx, name); prxNode != nil {
+		i.stopNode(ctx, prxNode)
+	}
+	i.notify(ctx, cluster.OpDelete, name)
+
+	logger.Info("node deleted successfully")
+	return nil
+}
@@ -0,0 +1,35 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (i *Nodes) Inspect(ctx context.Context, name string, userInfo *authtypes.UserInfo) (*entities.NodeDefinition, error) {
+	logger := i.logger.With("name", name)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionRead, Resource: authtypes.ResourceNode})
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := i.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get node"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(node.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("node definition found successfully")
+	return node, nil
+}
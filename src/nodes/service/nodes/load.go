@@ -0,0 +1,39 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+)
+
+func (i *Nodes) Load(ctx context.Context) error {
+	nodes, err := i.db.FindAll(ctx)
+	if err != nil {
+		errMessage := "failed to load nodes"
+		i.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	for _, node := range nodes {
+		logger := i.logger.With("name", node.Name)
+
+		// Nodes declared in manifests take precedence over persisted ones
+		if i.getNode(ctx, node.Name) != nil {
+			logger.Warn("node already declared in manifests, skipping persisted definition")
+			continue
+		}
+
+		// A node that cannot be started must not prevent the others from being loaded
+		prxNode, err := i.startFromDefinition(ctx, node)
+		if err != nil {
+			logger.WithError(err).Error("failed to load node")
+			continue
+		}
+
+		i.createNode(ctx, node.Name, prxNode, node.AllowedTenants)
+		i.revisions.Set(node.Name, node.UpdatedAt)
+	}
+
+	i.logger.Info("nodes loaded successfully", "count", len(nodes))
+	return nil
+}
@@ -4,34 +4,54 @@ import (
 	"context"
 	"sync"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/json"
 	"github.com/longfan78/quorum-key-manager/src/aliases"
 	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
 	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/longfan78/quorum-key-manager/src/nodes/interceptor"
 	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
 	"github.com/longfan78/quorum-key-manager/src/stores"
 
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/infra/ratelimit"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
 )
 
 type Nodes struct {
+	db            database.Nodes
 	storesService stores.Stores
 	roles         auth.Roles
 	aliases       aliases.Aliases
+	nonces        nodes.Nonces
+	transactions  nodes.Transactions
 	mux           sync.RWMutex
 	nodes         map[string]*entities.Node
+	notifier      cluster.Notifier
+	revisions     *cluster.Revisions
+	limiter       *ratelimit.Limiter
 	logger        log.Logger
 }
 
 var _ nodes.Nodes = &Nodes{}
 
-func New(storesService stores.Stores, rolesService auth.Roles, aliasesService aliases.Aliases, logger log.Logger) *Nodes {
+func New(db database.Nodes, storesService stores.Stores, rolesService auth.Roles, aliasesService aliases.Aliases, noncesService nodes.Nonces, transactionsService nodes.Transactions, notifier cluster.Notifier, limiter *ratelimit.Limiter, logger log.Logger) *Nodes {
 	return &Nodes{
+		db:            db,
 		storesService: storesService,
 		roles:         rolesService,
 		aliases:       aliasesService,
+		nonces:        noncesService,
+		transactions:  transactionsService,
 		mux:           sync.RWMutex{},
 		nodes:         make(map[string]*entities.Node),
+		notifier:      notifier,
+		revisions:     cluster.NewRevisions(),
+		limiter:       limiter,
 		logger:        logger,
 	}
 }
@@ -59,3 +79,70 @@ func (i *Nodes) getNode(_ context.Context, name string) *entities.Node {
 
 	return nil
 }
+
+// TODO: Move to data layer
+func (i *Nodes) deleteNode(_ context.Context, name string) *entities.Node {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	i.revisions.Delete(name)
+	node, ok := i.nodes[name]
+	if !ok {
+		return nil
+	}
+
+	delete(i.nodes, name)
+	return node
+}
+
+func (i *Nodes) startNode(ctx context.Context, name string, config *proxynode.Config) (*proxynode.Node, error) {
+	prxNode, err := proxynode.New(config, i.logger)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to create node")
+		return nil, err
+	}
+
+	// Set interceptor on proxy node
+	handler := interceptor.RestrictMethods(config.Methods, interceptor.New(i.storesService, i.aliases, i.nonces, i.transactions, name, i.logger))
+	prxNode.Handler = metrics.JSONRPCMiddleware(name, tracing.JSONRPCMiddleware(name, handler))
+	if i.limiter != nil {
+		prxNode.Handler = ratelimit.JSONRPCMiddleware(i.limiter, prxNode.Handler)
+	}
+
+	// Start node
+	err = prxNode.Start(ctx)
+	if err != nil {
+		i.logger.WithError(err).Error("error starting node")
+		return nil, err
+	}
+
+	return prxNode, nil
+}
+
+func (i *Nodes) startFromDefinition(ctx context.Context, node *entities.NodeDefinition) (*proxynode.Node, error) {
+	// Defaults are applied on a copy so that only the user provided configuration is persisted
+	config := &proxynode.Config{}
+	if err := json.UnmarshalJSON(node.Config, config); err != nil {
+		return nil, errors.InvalidFormatError(err.Error())
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, errors.InvalidFormatError(err.Error())
+	}
+
+	return i.startNode(ctx, node.Name, config.SetDefault())
+}
+
+func (i *Nodes) stopNode(ctx context.Context, node *entities.Node) {
+	if err := node.Node.Stop(ctx); err != nil {
+		i.logger.WithError(err).Warn("failed to stop node", "name", node.Name)
+	}
+}
+
+// notify propagates a change to the other replicas, the change being applied locally whether it succeeds or not
+func (i *Nodes) notify(ctx context.Context, op, name string) {
+	err := i.notifier.Notify(ctx, &cluster.Event{Kind: cluster.KindNode, Name: name, Op: op})
+	if err != nil {
+		i.logger.WithError(err).Warn("failed to propagate node change", "name", name, "op", op)
+	}
+}
@@ -0,0 +1,88 @@
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+// Refresh applies a change made to a node by another replica, reading its definition from the database
+func (i *Nodes) Refresh(ctx context.Context, event *cluster.Event) error {
+	switch event.Op {
+	case cluster.OpDelete:
+		if prxNode := i.deleteNode(ctx, event.Name); prxNode != nil {
+			i.stopNode(ctx, prxNode)
+		}
+
+		return nil
+	case cluster.OpResync:
+		return i.resync(ctx)
+	}
+
+	node, err := i.db.FindOne(ctx, event.Name)
+	if err != nil {
+		return err
+	}
+
+	return i.replaceFromDefinition(ctx, node)
+}
+
+// resync applies the changes made to the persisted nodes that the replica missed
+func (i *Nodes) resync(ctx context.Context) error {
+	readAt := time.Now()
+	nodes, err := i.db.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, node := range nodes {
+		names = append(names, node.Name)
+		logger := i.logger.With("name", node.Name)
+
+		// Nodes declared in manifests take precedence over persisted ones
+		if !i.revisions.Has(node.Name) && i.getNode(ctx, node.Name) != nil {
+			continue
+		}
+
+		if !i.revisions.Changed(node.Name, node.UpdatedAt, readAt) {
+			continue
+		}
+
+		err = i.replaceFromDefinition(ctx, node)
+		if err != nil {
+			logger.WithError(err).Error("failed to resync node")
+			continue
+		}
+
+		logger.Info("node resynced successfully")
+	}
+
+	for _, name := range i.revisions.Missing(names, readAt) {
+		if prxNode := i.deleteNode(ctx, name); prxNode != nil {
+			i.stopNode(ctx, prxNode)
+		}
+		i.logger.Info("node deleted by another replica removed", "name", name)
+	}
+
+	return nil
+}
+
+// replaceFromDefinition starts the node of a persisted definition, then stops the node it replaces
+func (i *Nodes) replaceFromDefinition(ctx context.Context, node *entities.NodeDefinition) error {
+	prxNode, err := i.startFromDefinition(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	previousNode := i.getNode(ctx, node.Name)
+	i.createNode(ctx, node.Name, prxNode, node.AllowedTenants)
+	if previousNode != nil {
+		i.stopNode(ctx, previousNode)
+	}
+	i.revisions.Set(node.Name, node.UpdatedAt)
+
+	return nil
+}
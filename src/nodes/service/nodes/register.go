@@ -0,0 +1,48 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (i *Nodes) Register(ctx context.Context, node *entities.NodeDefinition, userInfo *authtypes.UserInfo) (*entities.NodeDefinition, error) {
+	logger := i.logger.With("name", node.Name, "allowed_tenants", node.AllowedTenants)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceNode})
+	if err != nil {
+		return nil, err
+	}
+
+	if i.getNode(ctx, node.Name) != nil {
+		errMessage := "node already exists"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	}
+
+	prxNode, err := i.startFromDefinition(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	createdNode, err := i.db.Insert(ctx, node)
+	if err != nil {
+		i.stopNode(ctx, &entities.Node{Name: node.Name, Node: prxNode})
+
+		errMessage := "failed to persist node"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	i.createNode(ctx, node.Name, prxNode, node.AllowedTenants)
+	i.revisions.Set(node.Name, createdNode.UpdatedAt)
+	i.notify(ctx, cluster.OpUpsert, node.Name)
+
+	logger.Info("node persisted successfully")
+	return createdNode, nil
+}
@@ -0,0 +1,39 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	proxynode "github.com/longfan78/quorum-key-manager/src/nodes/node/proxy"
+)
+
+func (i *Nodes) Replace(ctx context.Context, name string, config *proxynode.Config, allowedTenants []string, userInfo *entities.UserInfo) error {
+	logger := i.logger.With("name", name, "allowed_tenants", allowedTenants)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceNode})
+	if err != nil {
+		return err
+	}
+
+	previousNode := i.getNode(ctx, name)
+	if previousNode == nil {
+		errMessage := "node was not found"
+		logger.Error(errMessage)
+		return errors.NotFoundError(errMessage)
+	}
+
+	// The new node is started before the current one is stopped so that the node remains available
+	prxNode, err := i.startNode(ctx, name, config)
+	if err != nil {
+		return err
+	}
+
+	i.createNode(ctx, name, prxNode, allowedTenants)
+	i.stopNode(ctx, previousNode)
+
+	logger.Info("node replaced successfully")
+	return nil
+}
@@ -0,0 +1,59 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (i *Nodes) Update(ctx context.Context, node *entities.NodeDefinition, userInfo *authtypes.UserInfo) (*entities.NodeDefinition, error) {
+	logger := i.logger.With("name", node.Name)
+
+	resolver := authorizator.New(i.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceNode})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.db.FindOne(ctx, node.Name)
+	if err != nil {
+		errMessage := "failed to get node"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(current.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new node is started before the current one is stopped so that the node remains available
+	prxNode, err := i.startFromDefinition(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedNode, err := i.db.Update(ctx, node)
+	if err != nil {
+		i.stopNode(ctx, &entities.Node{Name: node.Name, Node: prxNode})
+
+		errMessage := "failed to update node"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	previousNode := i.getNode(ctx, node.Name)
+	i.createNode(ctx, node.Name, prxNode, node.AllowedTenants)
+	if previousNode != nil {
+		i.stopNode(ctx, previousNode)
+	}
+	i.revisions.Set(node.Name, updatedNode.UpdatedAt)
+	i.notify(ctx, cluster.OpUpsert, node.Name)
+
+	logger.Info("node updated successfully")
+	return updatedNode, nil
+}
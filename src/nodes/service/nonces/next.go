@@ -0,0 +1,38 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (n *Nonces) Next(ctx context.Context, key *entities.NonceKey, fetch nodes.NonceFetcher) (uint64, error) {
+	logger := n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex())
+
+	nonce, err := n.db.Increment(ctx, key)
+	if err == nil {
+		logger.Debug("nonce allocated successfully", "nonce", nonce)
+		return nonce, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return 0, err
+	}
+
+	pending, err := fetch(ctx)
+	if err != nil {
+		errMessage := "failed to fetch nonce"
+		logger.WithError(err).Error(errMessage)
+		return 0, errors.BlockchainNodeError(err.Error())
+	}
+
+	// Another allocation may have initialized the nonce meanwhile, in which case the next one is allocated
+	nonce, err = n.db.Init(ctx, key, pending)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Debug("nonce synchronized and allocated successfully", "nonce", nonce)
+	return nonce, nil
+}
@@ -0,0 +1,70 @@
+package nonces
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockNonces(ctrl)
+	service := New(db, testutils.NewMockLogger(ctrl))
+
+	ctx := context.Background()
+	key := &entities.NonceKey{Node: "node", ChainID: "1", Address: common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")}
+	fetchCalls := 0
+	fetch := func(context.Context) (uint64, error) {
+		fetchCalls++
+		return 5, nil
+	}
+
+	t.Run("should allocate the next nonce without fetching it from the node", func(t *testing.T) {
+		fetchCalls = 0
+		db.EXPECT().Increment(gomock.Any(), key).Return(uint64(8), nil)
+
+		nonce, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), nonce)
+		assert.Equal(t, 0, fetchCalls)
+	})
+
+	t.Run("should fetch the nonce from the node if unknown", func(t *testing.T) {
+		fetchCalls = 0
+		db.EXPECT().Increment(gomock.Any(), key).Return(uint64(0), errors.NotFoundError("error"))
+		db.EXPECT().Init(gomock.Any(), key, uint64(5)).Return(uint64(5), nil)
+
+		nonce, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), nonce)
+		assert.Equal(t, 1, fetchCalls)
+	})
+
+	t.Run("should fail with a node error if the nonce cannot be fetched", func(t *testing.T) {
+		db.EXPECT().Increment(gomock.Any(), key).Return(uint64(0), errors.NotFoundError("error"))
+
+		_, err := service.Next(ctx, key, func(context.Context) (uint64, error) {
+			return 0, fmt.Errorf("error")
+		})
+		assert.Equal(t, errors.BlockchainNodeError("error"), err)
+	})
+
+	t.Run("should fail if the nonce cannot be allocated", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+		db.EXPECT().Increment(gomock.Any(), key).Return(uint64(0), expectedErr)
+
+		_, err := service.Next(ctx, key, fetch)
+		assert.Equal(t, expectedErr, err)
+	})
+}
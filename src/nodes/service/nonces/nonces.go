@@ -0,0 +1,21 @@
+package nonces
+
+import (
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+)
+
+type Nonces struct {
+	db     database.Nonces
+	logger log.Logger
+}
+
+var _ nodes.Nonces = &Nonces{}
+
+func New(db database.Nonces, logger log.Logger) *Nonces {
+	return &Nonces{
+		db:     db,
+		logger: logger,
+	}
+}
@@ -0,0 +1,25 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (n *Nonces) Release(ctx context.Context, key *entities.NonceKey, nonce uint64) error {
+	logger := n.logger.With("node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex(), "nonce", nonce)
+
+	released, err := n.db.Release(ctx, key, nonce)
+	if err != nil {
+		return err
+	}
+
+	// A later nonce was allocated meanwhile, so the nonce is left unused rather than allocated twice
+	if !released {
+		logger.Debug("nonce not released, later nonces already allocated")
+		return nil
+	}
+
+	logger.Debug("nonce released successfully")
+	return nil
+}
@@ -0,0 +1,68 @@
+package nonces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/memory"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	key := &entities.NonceKey{Node: "node", ChainID: "1", Address: common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")}
+	fetch := func(context.Context) (uint64, error) {
+		return 5, nil
+	}
+
+	t.Run("should allocate a released nonce again", func(t *testing.T) {
+		service := New(memory.NewNonces(), testutils.NewMockLogger(ctrl))
+
+		nonce, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+
+		err = service.Release(ctx, key, nonce)
+		require.NoError(t, err)
+
+		next, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, nonce, next)
+	})
+
+	t.Run("should not release a nonce once later nonces are allocated", func(t *testing.T) {
+		service := New(memory.NewNonces(), testutils.NewMockLogger(ctrl))
+
+		nonce, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+		later, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+
+		err = service.Release(ctx, key, nonce)
+		require.NoError(t, err)
+
+		next, err := service.Next(ctx, key, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, later+1, next)
+	})
+
+	t.Run("should fail if the nonce cannot be released", func(t *testing.T) {
+		db := mock.NewMockNonces(ctrl)
+		service := New(db, testutils.NewMockLogger(ctrl))
+		expectedErr := errors.PostgresError("error")
+
+		db.EXPECT().Release(gomock.Any(), key, uint64(5)).Return(false, expectedErr)
+
+		err := service.Release(ctx, key, 5)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,17 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (n *Nonces) Reset(ctx context.Context, key *entities.NonceKey) error {
+	err := n.db.Delete(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	n.logger.Debug("nonce reset successfully", "node", key.Node, "chain_id", key.ChainID, "address", key.Address.Hex())
+	return nil
+}
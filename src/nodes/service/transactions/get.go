@@ -0,0 +1,36 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (t *Transactions) Get(ctx context.Context, node string, hash common.Hash, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	logger := t.logger.With("node", node, "hash", hash.Hex())
+
+	resolver := authorizator.New(t.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, t.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceNode})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.db.FindOne(ctx, node, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users belonging to a tenant can only see the transactions of their tenant
+	if userInfo.Tenant != "" && tx.Tenant != userInfo.Tenant {
+		errMessage := "transaction not found"
+		logger.Debug(errMessage, "tenant", userInfo.Tenant)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	logger.Debug("transaction found successfully")
+	return tx, nil
+}
@@ -0,0 +1,52 @@
+package transactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/nodes/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbmock.NewMockTransactions(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	txService := New(db, roles, testutils.NewMockLogger(ctrl))
+
+	ctx := context.Background()
+	user := &authentities.UserInfo{Tenant: "tenantOne", Username: "alice"}
+	hash := common.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+	t.Run("should get a transaction of the tenant of the user", func(t *testing.T) {
+		tx := &entities.Transaction{Hash: hash, Node: "my-node", Tenant: "tenantOne"}
+
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]authentities.Permission{authentities.ReadNode})
+		db.EXPECT().FindOne(gomock.Any(), "my-node", hash).Return(tx, nil)
+
+		result, err := txService.Get(ctx, "my-node", hash, user)
+		require.NoError(t, err)
+
+		assert.Equal(t, tx, result)
+	})
+
+	t.Run("should fail with not found error if the transaction belongs to another tenant", func(t *testing.T) {
+		tx := &entities.Transaction{Hash: hash, Node: "my-node", Tenant: "tenantTwo"}
+
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]authentities.Permission{authentities.ReadNode})
+		db.EXPECT().FindOne(gomock.Any(), "my-node", hash).Return(tx, nil)
+
+		_, err := txService.Get(ctx, "my-node", hash, user)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
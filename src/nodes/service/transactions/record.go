@@ -0,0 +1,29 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (t *Transactions) Record(ctx context.Context, tx *entities.Transaction) error {
+	logger := t.logger.With("node", tx.Node, "hash", tx.Hash.Hex(), "from", tx.From.Hex(), "nonce", tx.Nonce)
+
+	tx.Status = entities.TransactionPending
+	recordedTx, err := t.db.Insert(ctx, tx)
+	switch {
+	// The same transaction sent twice is tracked once
+	case errors.IsStatusConflictError(err):
+		logger.Debug("transaction already tracked")
+		return nil
+	case err != nil:
+		errMessage := "failed to record transaction"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	tx.ID = recordedTx.ID
+	logger.Debug("transaction recorded successfully", "id", tx.ID)
+	return nil
+}
@@ -0,0 +1,35 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+func (t *Transactions) Search(ctx context.Context, filter *entities.TransactionFilter, userInfo *auth.UserInfo) ([]*entities.Transaction, error) {
+	logger := t.logger.With("node", filter.Node)
+
+	resolver := authorizator.New(t.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, t.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceNode})
+	if err != nil {
+		return nil, err
+	}
+
+	// Users belonging to a tenant can only see the transactions of their tenant
+	if userInfo.Tenant != "" {
+		filter.Tenant = userInfo.Tenant
+	}
+
+	txs, err := t.db.Search(ctx, filter)
+	if err != nil {
+		errMessage := "failed to search transactions"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("transactions found successfully", "count", len(txs))
+	return txs, nil
+}
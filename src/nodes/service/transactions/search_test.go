@@ -0,0 +1,58 @@
+package transactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/nodes/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbmock.NewMockTransactions(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	txService := New(db, roles, testutils.NewMockLogger(ctrl))
+
+	ctx := context.Background()
+	user := &authentities.UserInfo{Tenant: "tenantOne", Username: "alice"}
+	admin := authentities.NewWildcardUser()
+	txs := []*entities.Transaction{{Hash: common.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), Node: "my-node"}}
+
+	t.Run("should only search the transactions of the tenant of the user", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]authentities.Permission{authentities.ReadNode})
+		db.EXPECT().Search(gomock.Any(), &entities.TransactionFilter{Node: "my-node", Tenant: "tenantOne", Limit: 10}).Return(txs, nil)
+
+		result, err := txService.Search(ctx, &entities.TransactionFilter{Node: "my-node", Tenant: "tenantTwo", Limit: 10}, user)
+		require.NoError(t, err)
+
+		assert.Equal(t, txs, result)
+	})
+
+	t.Run("should search the transactions of all the tenants for users without tenant", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), admin).Return(authentities.ListPermissions())
+		db.EXPECT().Search(gomock.Any(), &entities.TransactionFilter{Node: "my-node"}).Return(txs, nil)
+
+		result, err := txService.Search(ctx, &entities.TransactionFilter{Node: "my-node"}, admin)
+		require.NoError(t, err)
+
+		assert.Equal(t, txs, result)
+	})
+
+	t.Run("should fail with forbidden error if the user cannot read nodes", func(t *testing.T) {
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]authentities.Permission{})
+
+		_, err := txService.Search(ctx, &entities.TransactionFilter{Node: "my-node"}, user)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
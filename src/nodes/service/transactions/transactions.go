@@ -0,0 +1,24 @@
+package transactions
+
+import (
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+)
+
+type Transactions struct {
+	db     database.Transactions
+	roles  auth.Roles
+	logger log.Logger
+}
+
+var _ nodes.Transactions = &Transactions{}
+
+func New(db database.Transactions, roles auth.Roles, logger log.Logger) *Transactions {
+	return &Transactions{
+		db:     db,
+		roles:  roles,
+		logger: logger,
+	}
+}
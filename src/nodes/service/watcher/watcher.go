@@ -0,0 +1,267 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/nodes"
+	"github.com/longfan78/quorum-key-manager/src/nodes/database"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+// Watcher periodically polls the receipts of the transactions sent through the nodes until they are confirmed, detecting
+// the transactions dropped from the pool and the chain reorganizations
+type Watcher struct {
+	db            database.Transactions
+	interval      time.Duration
+	confirmations uint64
+	dropTimeout   time.Duration
+	elector       cluster.Elector
+	logger        log.Logger
+
+	// ethCaller returns a caller of a node reached with the access of the tenant having sent the transactions, all the
+	// calls of a watch round going to the same endpoint so that a lagging endpoint cannot revert or drop transactions
+	ethCaller func(ctx context.Context, node, tenant string) (ethereum.EthCaller, error)
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Watcher{}
+
+func New(db database.Transactions, nodesService nodes.Nodes, cfg *entities.TransactionsConfig, elector cluster.Elector, logger log.Logger) *Watcher {
+	return &Watcher{
+		db:            db,
+		interval:      cfg.WatchInterval,
+		confirmations: cfg.Confirmations,
+		dropTimeout:   cfg.DropTimeout,
+		elector:       elector,
+		logger:        logger,
+		ethCaller: func(ctx context.Context, node, tenant string) (ethereum.EthCaller, error) {
+			userInfo := authtypes.NewWildcardUser()
+			userInfo.Tenant = tenant
+
+			n, err := nodesService.Get(ctx, node, userInfo)
+			if err != nil {
+				return nil, err
+			}
+
+			return n.PinnedEthCaller().Eth(), nil
+		},
+		done: make(chan struct{}),
+	}
+}
+
+func (w *Watcher) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	go w.run(ctx)
+
+	w.logger.Info("transaction watcher started", "interval", w.interval.String())
+	return nil
+}
+
+func (w *Watcher) Stop(ctx context.Context) error {
+	w.cancel()
+
+	select {
+	case <-w.done:
+		w.logger.Info("transaction watcher stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Watcher) Close() error {
+	return nil
+}
+
+func (w *Watcher) Error() error {
+	return w.err
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			// Only the leader watches the transactions when several replicas share the database
+			if !w.elector.IsLeader() {
+				continue
+			}
+
+			w.Watch(ctx, now)
+		}
+	}
+}
+
+// Watch updates the status of the transactions neither confirmed nor dropped
+func (w *Watcher) Watch(ctx context.Context, now time.Time) {
+	txs, err := w.db.FindUnsettled(ctx)
+	if err != nil {
+		w.logger.WithError(err).Error("failed to get transactions to watch")
+		return
+	}
+
+	type nodeKey struct {
+		node   string
+		tenant string
+	}
+
+	var keys []nodeKey
+	txsByNode := make(map[nodeKey][]*entities.Transaction)
+	for _, tx := range txs {
+		key := nodeKey{node: tx.Node, tenant: tx.Tenant}
+		if _, ok := txsByNode[key]; !ok {
+			keys = append(keys, key)
+		}
+		txsByNode[key] = append(txsByNode[key], tx)
+	}
+
+	for _, key := range keys {
+		w.watchNode(ctx, key.node, key.tenant, txsByNode[key], now)
+	}
+}
+
+func (w *Watcher) watchNode(ctx context.Context, node, tenant string, txs []*entities.Transaction, now time.Time) {
+	logger := w.logger.With("node", node)
+
+	caller, err := w.ethCaller(ctx, node, tenant)
+	if err != nil {
+		logger.WithError(err).Error("failed to get node to watch transactions")
+		return
+	}
+
+	head, err := caller.BlockNumber(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to get block number")
+		return
+	}
+
+	for _, tx := range txs {
+		txLogger := logger.With("hash", tx.Hash.Hex())
+
+		updated, err := w.watchTransaction(ctx, caller, tx, head, now)
+		if err != nil {
+			txLogger.WithError(err).Error("failed to watch transaction")
+			continue
+		}
+
+		if !updated {
+			continue
+		}
+
+		err = w.db.Update(ctx, tx)
+		if err != nil {
+			txLogger.WithError(err).Error("failed to update transaction")
+			continue
+		}
+
+		txLogger.Info("transaction updated successfully", "status", tx.Status, "confirmed", tx.Confirmed, "reorgs", tx.Reorgs)
+	}
+}
+
+// watchTransaction updates a transaction from its receipt, returning whether it changed
+func (w *Watcher) watchTransaction(ctx context.Context, caller ethereum.EthCaller, tx *entities.Transaction, head uint64, now time.Time) (bool, error) {
+	receipt, err := caller.GetTransactionReceipt(ctx, tx.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	if receipt != nil {
+		return w.mined(tx, receipt, head), nil
+	}
+
+	// A mined transaction without receipt went back to the pool after a chain reorganization
+	if tx.BlockHash != nil {
+		tx.Status = entities.TransactionPending
+		tx.BlockNumber, tx.BlockHash, tx.GasUsed, tx.ContractAddress = nil, nil, nil, nil
+		tx.Reorgs++
+		return true, nil
+	}
+
+	return w.dropped(ctx, caller, tx, now)
+}
+
+func (w *Watcher) mined(tx *entities.Transaction, receipt *ethereum.Receipt, head uint64) bool {
+	status := entities.TransactionMined
+	if uint64(receipt.Status) == ethereum.ReceiptStatusFailed {
+		status = entities.TransactionFailed
+	}
+	blockNumber := uint64(receipt.BlockNumber)
+	confirmed := head >= blockNumber+w.confirmations
+
+	if tx.BlockHash != nil && *tx.BlockHash == receipt.BlockHash && tx.Status == status && tx.Confirmed == confirmed {
+		return false
+	}
+
+	// The transaction was moved to another block by a chain reorganization
+	if tx.BlockHash != nil && *tx.BlockHash != receipt.BlockHash {
+		tx.Reorgs++
+	}
+
+	gasUsed := uint64(receipt.GasUsed)
+	blockHash := receipt.BlockHash
+	tx.Status = status
+	tx.BlockNumber = &blockNumber
+	tx.BlockHash = &blockHash
+	tx.GasUsed = &gasUsed
+	tx.ContractAddress = receipt.ContractAddress
+	tx.Confirmed = confirmed
+
+	return true
+}
+
+// dropped marks a pending transaction as dropped if the node does not know it anymore and either another public
+// transaction with the same nonce was mined or the transaction is pending for longer than the drop timeout
+func (w *Watcher) dropped(ctx context.Context, caller ethereum.EthCaller, tx *entities.Transaction, now time.Time) (bool, error) {
+	txInfo, err := caller.GetTransactionByHash(ctx, tx.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	if txInfo != nil {
+		return false, nil
+	}
+
+	// The nonce of a private transaction may be the nonce of its privacy group, so only its timeout is considered
+	replaced := false
+	if !tx.Private {
+		nonce, err := caller.GetTransactionCount(ctx, tx.From, ethereum.LatestBlockNumber)
+		if err != nil {
+			return false, err
+		}
+		replaced = nonce > tx.Nonce
+	}
+
+	if !replaced && (w.dropTimeout == 0 || now.Sub(tx.CreatedAt) < w.dropTimeout) {
+		return false, nil
+	}
+
+	// The transaction may have been mined since its receipt was polled, dropped transactions not being watched anymore
+	receipt, err := caller.GetTransactionReceipt(ctx, tx.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	if receipt != nil {
+		return false, nil
+	}
+
+	tx.Status = entities.TransactionDropped
+	return true, nil
+}
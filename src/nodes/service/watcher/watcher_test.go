@@ -0,0 +1,241 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/nodes/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+	"github.com/longfan78/quorum-key-manager/src/nodes/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbmock.NewMockTransactions(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	cfg := &entities.TransactionsConfig{WatchInterval: time.Second, Confirmations: 6, DropTimeout: time.Hour}
+
+	w := New(db, mock.NewMockNodes(ctrl), cfg, cluster.Standalone{}, testutils.NewMockLogger(ctrl))
+	w.ethCaller = func(_ context.Context, node, tenant string) (ethereum.EthCaller, error) {
+		assert.Equal(t, "my-node", node)
+		assert.Equal(t, "tenantOne", tenant)
+		return ethCaller, nil
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+	blockHash := common.HexToHash("0x2a1d4ac3b2ca26b6c6c0b1fcd9b4a4b7e1ea6e1a8a3c1b1a3f2e6d9ab9b5b2c1")
+	otherBlockHash := common.HexToHash("0x8a4b6c2fa7e2d1b3c5e7f9a1b3d5e7f9a1b3c5d7e9f1a3b5c7d9e1f3a5b7c9d1")
+
+	newTx := func(status entities.TransactionStatus) *entities.Transaction {
+		return &entities.Transaction{
+			ID:        1,
+			Hash:      common.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"),
+			Node:      "my-node",
+			From:      common.HexToAddress("0x664895b5fE3ddf049d2Fb508cfA03923859763C6"),
+			Nonce:     12,
+			Tenant:    "tenantOne",
+			Status:    status,
+			CreatedAt: now.Add(-time.Minute),
+		}
+	}
+	newMinedTx := func(blockNumber uint64, blockHash common.Hash) *entities.Transaction {
+		tx := newTx(entities.TransactionMined)
+		gasUsed := uint64(21000)
+		tx.BlockNumber, tx.BlockHash, tx.GasUsed = &blockNumber, &blockHash, &gasUsed
+		return tx
+	}
+	receipt := func(blockNumber uint64, blockHash common.Hash, status uint64) *ethereum.Receipt {
+		return &ethereum.Receipt{
+			BlockHash:   blockHash,
+			BlockNumber: hexutil.Uint64(blockNumber),
+			Status:      hexutil.Uint64(status),
+			GasUsed:     hexutil.Uint64(21000),
+		}
+	}
+
+	t.Run("should update a pending transaction mined", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(102), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(receipt(100, blockHash, ethereum.ReceiptStatusSuccessful), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionMined, tx.Status)
+		assert.Equal(t, uint64(100), *tx.BlockNumber)
+		assert.Equal(t, blockHash, *tx.BlockHash)
+		assert.Equal(t, uint64(21000), *tx.GasUsed)
+		assert.False(t, tx.Confirmed)
+		assert.Equal(t, 0, tx.Reorgs)
+	})
+
+	t.Run("should mark a reverted transaction as failed", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(receipt(100, blockHash, ethereum.ReceiptStatusFailed), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionFailed, tx.Status)
+	})
+
+	t.Run("should confirm a transaction mined deep enough", func(t *testing.T) {
+		tx := newMinedTx(100, blockHash)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(106), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(receipt(100, blockHash, ethereum.ReceiptStatusSuccessful), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.True(t, tx.Confirmed)
+		assert.True(t, tx.IsSettled())
+	})
+
+	t.Run("should not update a transaction not confirmed yet", func(t *testing.T) {
+		tx := newMinedTx(100, blockHash)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(105), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(receipt(100, blockHash, ethereum.ReceiptStatusSuccessful), nil)
+
+		w.Watch(ctx, now)
+
+		assert.False(t, tx.Confirmed)
+	})
+
+	t.Run("should detect a transaction moved to another block by a reorg", func(t *testing.T) {
+		tx := newMinedTx(100, blockHash)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(103), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(receipt(101, otherBlockHash, ethereum.ReceiptStatusSuccessful), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionMined, tx.Status)
+		assert.Equal(t, uint64(101), *tx.BlockNumber)
+		assert.Equal(t, otherBlockHash, *tx.BlockHash)
+		assert.Equal(t, 1, tx.Reorgs)
+	})
+
+	t.Run("should detect a transaction sent back to the pool by a reorg", func(t *testing.T) {
+		tx := newMinedTx(100, blockHash)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionPending, tx.Status)
+		assert.Nil(t, tx.BlockNumber)
+		assert.Nil(t, tx.BlockHash)
+		assert.Equal(t, 1, tx.Reorgs)
+	})
+
+	t.Run("should not update a transaction pending in the pool", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), tx.Hash).Return(&ethereum.TxInfo{Hash: tx.Hash}, nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionPending, tx.Status)
+	})
+
+	t.Run("should drop a transaction replaced by another transaction with the same nonce", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil).Times(2)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), tx.From, ethereum.LatestBlockNumber).Return(uint64(13), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionDropped, tx.Status)
+		assert.True(t, tx.IsSettled())
+	})
+
+	t.Run("should drop a transaction unknown to the node after the drop timeout", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+		tx.CreatedAt = now.Add(-2 * time.Hour)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil).Times(2)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), tx.From, ethereum.LatestBlockNumber).Return(uint64(12), nil)
+		db.EXPECT().Update(gomock.Any(), tx).Return(nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionDropped, tx.Status)
+	})
+
+	t.Run("should not drop a transaction unknown to the node before the drop timeout", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), tx.From, ethereum.LatestBlockNumber).Return(uint64(12), nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionPending, tx.Status)
+	})
+
+	t.Run("should not drop a private transaction on its nonce before the drop timeout", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+		tx.Private = true
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), tx.Hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), tx.Hash).Return(nil, nil)
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionPending, tx.Status)
+	})
+
+	t.Run("should not update the transactions if the node cannot be reached", func(t *testing.T) {
+		tx := newTx(entities.TransactionPending)
+
+		db.EXPECT().FindUnsettled(gomock.Any()).Return([]*entities.Transaction{tx}, nil)
+		ethCaller.EXPECT().BlockNumber(gomock.Any()).Return(uint64(0), fmt.Errorf("error"))
+
+		w.Watch(ctx, now)
+
+		assert.Equal(t, entities.TransactionPending, tx.Status)
+	})
+}
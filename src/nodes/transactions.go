@@ -0,0 +1,23 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/nodes/entities"
+)
+
+//go:generate mockgen -source=transactions.go -destination=mock/transactions.go -package=mock
+
+// Transactions tracks the transactions sent through the proxy nodes
+type Transactions interface {
+	// Record starts tracking a transaction sent to a node
+	Record(ctx context.Context, tx *entities.Transaction) error
+
+	// Get returns a transaction sent through a node
+	Get(ctx context.Context, node string, hash common.Hash, userInfo *auth.UserInfo) (*entities.Transaction, error)
+
+	// Search returns the transactions sent through a node matching the filter, most recent first
+	Search(ctx context.Context, filter *entities.TransactionFilter, userInfo *auth.UserInfo) ([]*entities.Transaction, error)
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/policies"
+	"github.com/longfan78/quorum-key-manager/src/policies/api/types"
+)
+
+type PoliciesHandler struct {
+	policies policies.Policies
+}
+
+func NewPoliciesHandler(policiesService policies.Policies) *PoliciesHandler {
+	return &PoliciesHandler{policies: policiesService}
+}
+
+func (h *PoliciesHandler) Register(router *mux.Router) {
+	policiesRouter := router.PathPrefix("/policies").Subrouter()
+
+	policiesRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	policiesRouter.Methods(http.MethodGet).Path("/{policyName}").HandlerFunc(h.get)
+}
+
+// @Summary      Gets a signing policy
+// @Description  Gets the rules of a signing policy declared in the manifests
+// @Tags         Policies
+// @Produce      json
+// @Param        policyName  path      string                   true  "policy identifier"
+// @Success      200         {object}  types.PolicyResponse     "Policy data"
+// @Failure      403         {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404         {object}  infrahttp.ErrorResponse  "Policy not found"
+// @Failure      500         {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies/{policyName} [get]
+func (h *PoliciesHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policy, err := h.policies.Get(ctx, mux.Vars(r)["policyName"], auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewPolicyResponse(policy))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists signing policies
+// @Description  Lists the names of all the signing policies
+// @Tags         Policies
+// @Produce      json
+// @Success      200  {array}   string                   "List of policy names"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies [get]
+func (h *PoliciesHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	names, err := h.policies.List(ctx, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, names)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
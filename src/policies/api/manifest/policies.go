@@ -0,0 +1,73 @@
+package manifest
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/json"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/policies"
+	"github.com/longfan78/quorum-key-manager/src/policies/api/types"
+)
+
+type PoliciesHandler struct {
+	policies policies.Policies
+	userInfo *auth.UserInfo
+}
+
+func NewPoliciesHandler(policiesService policies.Policies) *PoliciesHandler {
+	return &PoliciesHandler{
+		policies: policiesService,
+		userInfo: auth.NewWildcardUser(), // This handler always use the wildcard user because it's a manifest handler
+	}
+}
+
+func (h *PoliciesHandler) Register(ctx context.Context, mnfs []entities.Manifest) error {
+	for _, mnf := range mnfs {
+		err := h.Create(ctx, mnf.Name, mnf.Specs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *PoliciesHandler) Create(ctx context.Context, name string, specs interface{}) error {
+	policySpecs := &types.PolicySpecs{}
+	err := json.UnmarshalYAML(specs, policySpecs)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	policy, err := policySpecs.ToEntity(name)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	return h.policies.Create(ctx, policy, h.userInfo)
+}
+
+// Update replaces the policies of changed manifests
+func (h *PoliciesHandler) Update(ctx context.Context, mnfs []entities.Manifest) error {
+	for _, mnf := range mnfs {
+		policySpecs := &types.PolicySpecs{}
+		err := json.UnmarshalYAML(mnf.Specs, policySpecs)
+		if err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		policy, err := policySpecs.ToEntity(mnf.Name)
+		if err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		err = h.policies.Update(ctx, policy, h.userInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
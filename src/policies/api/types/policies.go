@@ -0,0 +1,199 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+const clockLayout = "15:04"
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+type PolicySpecs struct {
+	Stores           []string          `json:"stores,omitempty" example:"eth-hot-wallet"`
+	AllowedTo        []string          `json:"allowedTo,omitempty" validate:"omitempty,dive,isHexAddress" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	AllowedSelectors []string          `json:"allowedSelectors,omitempty" validate:"omitempty,dive,hexadecimal,len=10" example:"0xa9059cbb"`
+	MaxValue         string            `json:"maxValue,omitempty" validate:"omitempty,number" example:"1000000000000000000"`
+	MaxGasPrice      string            `json:"maxGasPrice,omitempty" validate:"omitempty,number" example:"100000000000"`
+	ChainIDs         []string          `json:"chainIDs,omitempty" validate:"omitempty,dive,number" example:"1"`
+	DailyLimit       string            `json:"dailyLimit,omitempty" validate:"omitempty,number" example:"10000000000000000000"`
+	AllowRawSigning  bool              `json:"allowRawSigning,omitempty" example:"false"`
+	TimeWindows      []*TimeWindowSpec `json:"timeWindows,omitempty" validate:"omitempty,dive"`
+}
+
+type TimeWindowSpec struct {
+	Days     []string `json:"days,omitempty" validate:"omitempty,dive,oneof=sun mon tue wed thu fri sat" example:"mon,tue,wed,thu,fri"`
+	Start    string   `json:"start" validate:"required" example:"08:00"`
+	End      string   `json:"end" validate:"required" example:"20:00"`
+	Timezone string   `json:"timezone,omitempty" example:"Europe/Paris"`
+}
+
+type PolicyResponse struct {
+	Name             string            `json:"name" example:"hot-wallet-guardrails"`
+	Stores           []string          `json:"stores,omitempty" example:"eth-hot-wallet"`
+	AllowedTo        []string          `json:"allowedTo,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	AllowedSelectors []string          `json:"allowedSelectors,omitempty" example:"0xa9059cbb"`
+	MaxValue         string            `json:"maxValue,omitempty" example:"1000000000000000000"`
+	MaxGasPrice      string            `json:"maxGasPrice,omitempty" example:"100000000000"`
+	ChainIDs         []string          `json:"chainIDs,omitempty" example:"1"`
+	DailyLimit       string            `json:"dailyLimit,omitempty" example:"10000000000000000000"`
+	AllowRawSigning  bool              `json:"allowRawSigning" example:"false"`
+	TimeWindows      []*TimeWindowSpec `json:"timeWindows,omitempty"`
+}
+
+func (s *PolicySpecs) ToEntity(name string) (*entities.Policy, error) {
+	policy := &entities.Policy{
+		Name:            name,
+		Stores:          s.Stores,
+		AllowRawSigning: s.AllowRawSigning,
+	}
+
+	for _, to := range s.AllowedTo {
+		policy.AllowedTo = append(policy.AllowedTo, common.HexToAddress(to))
+	}
+
+	for _, selector := range s.AllowedSelectors {
+		policy.AllowedSelectors = append(policy.AllowedSelectors, strings.ToLower(selector))
+	}
+
+	var err error
+	if policy.MaxValue, err = parseAmount("maxValue", s.MaxValue); err != nil {
+		return nil, err
+	}
+	if policy.MaxGasPrice, err = parseAmount("maxGasPrice", s.MaxGasPrice); err != nil {
+		return nil, err
+	}
+	if policy.DailyLimit, err = parseAmount("dailyLimit", s.DailyLimit); err != nil {
+		return nil, err
+	}
+
+	for _, chainID := range s.ChainIDs {
+		id, err := parseAmount("chainIDs", chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		policy.ChainIDs = append(policy.ChainIDs, id)
+	}
+
+	for _, spec := range s.TimeWindows {
+		window, err := spec.toEntity()
+		if err != nil {
+			return nil, err
+		}
+
+		policy.TimeWindows = append(policy.TimeWindows, window)
+	}
+
+	return policy, nil
+}
+
+func (s *TimeWindowSpec) toEntity() (*entities.TimeWindow, error) {
+	window := &entities.TimeWindow{Location: time.UTC}
+
+	if s.Timezone != "" {
+		location, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window timezone %s", s.Timezone)
+		}
+
+		window.Location = location
+	}
+
+	var err error
+	if window.Start, err = parseClock(s.Start); err != nil {
+		return nil, err
+	}
+	if window.End, err = parseClock(s.End); err != nil {
+		return nil, err
+	}
+
+	for _, day := range s.Days {
+		window.Days = append(window.Days, weekdays[day])
+	}
+
+	return window, nil
+}
+
+func NewPolicyResponse(policy *entities.Policy) *PolicyResponse {
+	resp := &PolicyResponse{
+		Name:             policy.Name,
+		Stores:           policy.Stores,
+		AllowedSelectors: policy.AllowedSelectors,
+		MaxValue:         formatAmount(policy.MaxValue),
+		MaxGasPrice:      formatAmount(policy.MaxGasPrice),
+		DailyLimit:       formatAmount(policy.DailyLimit),
+		AllowRawSigning:  policy.AllowRawSigning,
+	}
+
+	for _, to := range policy.AllowedTo {
+		resp.AllowedTo = append(resp.AllowedTo, to.Hex())
+	}
+
+	for _, chainID := range policy.ChainIDs {
+		resp.ChainIDs = append(resp.ChainIDs, chainID.String())
+	}
+
+	for _, window := range policy.TimeWindows {
+		spec := &TimeWindowSpec{
+			Start:    formatClock(window.Start),
+			End:      formatClock(window.End),
+			Timezone: window.Location.String(),
+		}
+
+		for _, day := range window.Days {
+			spec.Days = append(spec.Days, strings.ToLower(day.String()[:3]))
+		}
+
+		resp.TimeWindows = append(resp.TimeWindows, spec)
+	}
+
+	return resp
+}
+
+func parseAmount(field, value string) (*big.Int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s %s", field, value)
+	}
+
+	return amount, nil
+}
+
+func formatAmount(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+
+	return amount.String()
+}
+
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time window bound %s, expected HH:MM", value)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return time.Time{}.Add(d).Format(clockLayout)
+}
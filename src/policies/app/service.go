@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/policies/api/http"
+	db "github.com/longfan78/quorum-key-manager/src/policies/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/policies/service/policies"
+)
+
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles) *policies.Policies {
+	// Data layer
+	spendingsRepository := db.NewSpendings(postgresClient, logger)
+
+	// Business layer
+	policiesService := policies.New(spendingsRepository, roles, logger)
+
+	// Service layer
+	http.NewPoliciesHandler(policiesService).Register(router)
+
+	return policiesService
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Spendings interface {
+	// Add atomically adds a spending to the amount spent during its day, failing with a policy violation if it exceeds the limit
+	Add(ctx context.Context, spending *entities.Spending, limit *big.Int) error
+	// Subtract removes a spending from the amount spent during its day
+	Subtract(ctx context.Context, spending *entities.Spending) error
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/policies/entities"
+	big "math/big"
+	reflect "reflect"
+)
+
+// MockSpendings is a mock of Spendings interface
+type MockSpendings struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpendingsMockRecorder
+}
+
+// MockSpendingsMockRecorder is the mock recorder for MockSpendings
+type MockSpendingsMockRecorder struct {
+	mock *MockSpendings
+}
+
+// NewMockSpendings creates a new mock instance
+func NewMockSpendings(ctrl *gomock.Controller) *MockSpendings {
+	mock := &MockSpendings{ctrl: ctrl}
+	mock.recorder = &MockSpendingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSpendings) EXPECT() *MockSpendingsMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method
+func (m *MockSpendings) Add(ctx context.Context, spending *entities.Spending, limit *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, spending, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add
+func (mr *MockSpendingsMockRecorder) Add(ctx, spending, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSpendings)(nil).Add), ctx, spending, limit)
+}
+
+// Subtract mocks base method
+func (m *MockSpendings) Subtract(ctx context.Context, spending *entities.Spending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subtract", ctx, spending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subtract indicates an expected call of Subtract
+func (mr *MockSpendingsMockRecorder) Subtract(ctx, spending interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subtract", reflect.TypeOf((*MockSpendings)(nil).Subtract), ctx, spending)
+}
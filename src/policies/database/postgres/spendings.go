@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/policies/database"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+const dayLayout = "2006-01-02"
+
+// The insertion is skipped, and no row returned, when the spending would exceed the limit
+const addSpendingQuery = `
+INSERT INTO policy_spendings (policy, address, day, amount)
+SELECT ?, ?, CAST(? AS DATE), CAST(? AS NUMERIC) WHERE CAST(? AS NUMERIC) <= CAST(? AS NUMERIC)
+ON CONFLICT (policy, address, day) DO UPDATE SET amount = policy_spendings.amount + EXCLUDED.amount
+WHERE policy_spendings.amount + EXCLUDED.amount <= CAST(? AS NUMERIC)
+RETURNING amount`
+
+const subtractSpendingQuery = `
+UPDATE policy_spendings SET amount = GREATEST(amount - CAST(? AS NUMERIC), 0)
+WHERE policy = ? AND address = ? AND day = CAST(? AS DATE)
+RETURNING amount`
+
+type Spendings struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Spendings = &Spendings{}
+
+func NewSpendings(client postgres.Client, logger log.Logger) *Spendings {
+	return &Spendings{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (s *Spendings) Add(ctx context.Context, spending *entities.Spending, limit *big.Int) error {
+	logger := s.logger.With("policy", spending.Policy, "address", spending.Address.Hex())
+
+	var total string
+	amount, day, max := spending.Amount.String(), spending.Day.Format(dayLayout), limit.String()
+	err := s.client.QueryOne(ctx, &total, addSpendingQuery, spending.Policy, spending.Address.Hex(), day, amount, amount, max, max)
+	if err != nil && errors.IsNotFoundError(err) {
+		errMessage := fmt.Sprintf("daily limit of policy %s exceeded", spending.Policy)
+		logger.Warn(errMessage)
+		return errors.PolicyViolationError(errMessage)
+	}
+	if err != nil {
+		errMessage := "failed to add policy spending"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Spendings) Subtract(ctx context.Context, spending *entities.Spending) error {
+	var total string
+	err := s.client.QueryOne(ctx, &total, subtractSpendingQuery, spending.Amount.String(), spending.Policy, spending.Address.Hex(), spending.Day.Format(dayLayout))
+	if err != nil {
+		errMessage := "failed to subtract policy spending"
+		s.logger.With("policy", spending.Policy, "address", spending.Address.Hex()).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
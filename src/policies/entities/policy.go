@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccountTag is the tag binding additional policies, as a comma separated list of names, to an Ethereum account
+const AccountTag = "policy"
+
+// Policy restricts the transactions that can be signed by the accounts of Ethereum stores. Empty rules are not enforced
+type Policy struct {
+	Name string
+	// Stores are the stores the policy applies to, in addition to the accounts binding it through their tags
+	Stores []string
+	// AllowedTo restricts the recipients of the transactions. Contract deployments are rejected when set
+	AllowedTo []common.Address
+	// AllowedSelectors restricts the functions called by transactions carrying data, as 0x prefixed 4 bytes selectors
+	AllowedSelectors []string
+	MaxValue         *big.Int
+	// MaxGasPrice applies to the gas price of legacy transactions and to the fee cap of dynamic fee transactions
+	MaxGasPrice *big.Int
+	ChainIDs    []*big.Int
+	// DailyLimit is the maximum value, in wei, transferred per account and per UTC day
+	DailyLimit *big.Int
+	// AllowRawSigning allows signing arbitrary data, which cannot be evaluated, with the accounts the policy applies to
+	AllowRawSigning bool
+	TimeWindows     []*TimeWindow
+}
+
+// TimeWindow is a period of the week during which signing is allowed. The window spans over midnight when End is before Start
+type TimeWindow struct {
+	Days     []time.Weekday
+	Start    time.Duration
+	End      time.Duration
+	Location *time.Location
+}
+
+// Contains indicates whether a point in time falls within the window
+func (w *TimeWindow) Contains(t time.Time) bool {
+	t = t.In(w.Location)
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+
+	if w.Start <= w.End {
+		return w.includesDay(t.Weekday()) && sinceMidnight >= w.Start && sinceMidnight < w.End
+	}
+
+	// The window started the day before
+	if sinceMidnight < w.End {
+		return w.includesDay((t.Weekday() + 6) % 7)
+	}
+
+	return w.includesDay(t.Weekday()) && sinceMidnight >= w.Start
+}
+
+func (w *TimeWindow) includesDay(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+
+	return false
+}
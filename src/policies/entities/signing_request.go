@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SigningRequest is a signature requested on an Ethereum account, evaluated against the policies applying to it
+type SigningRequest struct {
+	StoreName string
+	Address   common.Address
+	// Policies are the names of the policies bound to the account through its tags
+	Policies []string
+	// Transaction is nil when signing arbitrary data
+	Transaction *Transaction
+}
+
+// Transaction holds the fields of a transaction evaluated by the policies
+type Transaction struct {
+	To       *common.Address
+	Data     []byte
+	Value    *big.Int
+	GasPrice *big.Int
+	ChainID  *big.Int
+	// Private is set when the data is the hash of a payload stored in the private transaction manager and cannot be evaluated
+	Private bool
+}
+
+// Spending is an amount reserved on the daily limit of a policy
+type Spending struct {
+	Policy  string
+	Address common.Address
+	Day     time.Time
+	Amount  *big.Int
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities "github.com/longfan78/quorum-key-manager/src/policies/entities"
+	reflect "reflect"
+)
+
+// MockPolicies is a mock of Policies interface
+type MockPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesMockRecorder
+}
+
+// MockPoliciesMockRecorder is the mock recorder for MockPolicies
+type MockPoliciesMockRecorder struct {
+	mock *MockPolicies
+}
+
+// NewMockPolicies creates a new mock instance
+func NewMockPolicies(ctrl *gomock.Controller) *MockPolicies {
+	mock := &MockPolicies{ctrl: ctrl}
+	mock.recorder = &MockPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPolicies) EXPECT() *MockPoliciesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockPolicies) Create(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, policy, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockPoliciesMockRecorder) Create(ctx, policy, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPolicies)(nil).Create), ctx, policy, userInfo)
+}
+
+// Update mocks base method
+func (m *MockPolicies) Update(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, policy, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockPoliciesMockRecorder) Update(ctx, policy, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPolicies)(nil).Update), ctx, policy, userInfo)
+}
+
+// Get mocks base method
+func (m *MockPolicies) Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name, userInfo)
+	ret0, _ := ret[0].(*entities.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockPoliciesMockRecorder) Get(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPolicies)(nil).Get), ctx, name, userInfo)
+}
+
+// List mocks base method
+func (m *MockPolicies) List(ctx context.Context, userInfo *auth.UserInfo) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockPoliciesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPolicies)(nil).List), ctx, userInfo)
+}
+
+// Evaluate mocks base method
+func (m *MockPolicies) Evaluate(ctx context.Context, req *entities.SigningRequest) ([]*entities.Spending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, req)
+	ret0, _ := ret[0].([]*entities.Spending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate
+func (mr *MockPoliciesMockRecorder) Evaluate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockPolicies)(nil).Evaluate), ctx, req)
+}
+
+// Refund mocks base method
+func (m *MockPolicies) Refund(ctx context.Context, spendings []*entities.Spending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, spendings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund
+func (mr *MockPoliciesMockRecorder) Refund(ctx, spendings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPolicies)(nil).Refund), ctx, spendings)
+}
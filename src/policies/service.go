@@ -0,0 +1,30 @@
+package policies
+
+import (
+	"context"
+
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+
+type Policies interface {
+	// Create creates a signing policy
+	Create(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) error
+
+	// Update replaces a signing policy
+	Update(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) error
+
+	// Get gets a signing policy by name
+	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Policy, error)
+
+	// List lists the names of all the signing policies
+	List(ctx context.Context, userInfo *auth.UserInfo) ([]string, error)
+
+	// Evaluate checks a signing request against the policies applying to it and reserves its value on their daily limits
+	Evaluate(ctx context.Context, req *entities.SigningRequest) ([]*entities.Spending, error)
+
+	// Refund releases the spendings reserved for a signature that failed
+	Refund(ctx context.Context, spendings []*entities.Spending) error
+}
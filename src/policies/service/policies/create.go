@@ -0,0 +1,36 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Create(ctx context.Context, policy *entities.Policy, userInfo *authtypes.UserInfo) error {
+	logger := s.logger.With("name", policy.Name)
+	logger.Debug("creating policy")
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourcePolicy})
+	if err != nil {
+		return err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, ok := s.policies[policy.Name]; ok {
+		errMessage := fmt.Sprintf("policy %s already exist", policy.Name)
+		logger.Error(errMessage)
+		return errors.AlreadyExistsError(errMessage)
+	}
+
+	s.policies[policy.Name] = policy
+
+	logger.Info("policy created successfully")
+	return nil
+}
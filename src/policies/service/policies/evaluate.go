@@ -0,0 +1,207 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Evaluate(ctx context.Context, req *entities.SigningRequest) ([]*entities.Spending, error) {
+	logger := s.logger.With("store_name", req.StoreName, "address", req.Address.Hex())
+
+	applicable, err := s.applicablePolicies(req)
+	if err != nil {
+		logger.WithError(err).Warn("signing request rejected")
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, policy := range applicable {
+		if violation := check(policy, req, now); violation != "" {
+			errMessage := fmt.Sprintf("rejected by policy %s: %s", policy.Name, violation)
+			logger.Warn(errMessage)
+			return nil, errors.PolicyViolationError(errMessage)
+		}
+	}
+
+	spendings, err := s.reserve(ctx, applicable, req, now)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("signing request allowed by policies", "policies", len(applicable))
+	return spendings, nil
+}
+
+// applicablePolicies returns the policies bound to the store and to the account. An unknown policy bound to the account
+// rejects the request as the guardrails it was supposed to enforce cannot be guaranteed
+func (s *Policies) applicablePolicies(req *entities.SigningRequest) ([]*entities.Policy, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	var applicable []*entities.Policy
+	seen := make(map[string]bool)
+	for _, policy := range s.policies {
+		for _, storeName := range policy.Stores {
+			if storeName == req.StoreName {
+				applicable = append(applicable, policy)
+				seen[policy.Name] = true
+				break
+			}
+		}
+	}
+
+	for _, name := range req.Policies {
+		if seen[name] {
+			continue
+		}
+
+		policy, ok := s.policies[name]
+		if !ok {
+			return nil, errors.PolicyViolationError("account is bound to unknown policy %s", name)
+		}
+
+		applicable = append(applicable, policy)
+		seen[name] = true
+	}
+
+	return applicable, nil
+}
+
+// reserve adds the value of the transaction to the daily spendings of the policies, rolling back on failure
+func (s *Policies) reserve(ctx context.Context, applicable []*entities.Policy, req *entities.SigningRequest, now time.Time) ([]*entities.Spending, error) {
+	if req.Transaction == nil || req.Transaction.Value == nil || req.Transaction.Value.Sign() <= 0 {
+		return nil, nil
+	}
+
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	var spendings []*entities.Spending
+	for _, policy := range applicable {
+		if policy.DailyLimit == nil {
+			continue
+		}
+
+		spending := &entities.Spending{
+			Policy:  policy.Name,
+			Address: req.Address,
+			Day:     day,
+			Amount:  req.Transaction.Value,
+		}
+
+		err := s.db.Add(ctx, spending, policy.DailyLimit)
+		if err != nil {
+			if refundErr := s.Refund(ctx, spendings); refundErr != nil {
+				s.logger.WithError(refundErr).Error("failed to refund policy spendings")
+			}
+
+			return nil, err
+		}
+
+		spendings = append(spendings, spending)
+	}
+
+	return spendings, nil
+}
+
+// check returns the reason why the policy rejects the request, if any
+func check(policy *entities.Policy, req *entities.SigningRequest, now time.Time) string {
+	if len(policy.TimeWindows) > 0 && !inTimeWindows(policy.TimeWindows, now) {
+		return "signing is not allowed at this time"
+	}
+
+	tx := req.Transaction
+	if tx == nil {
+		if !policy.AllowRawSigning {
+			return "signing arbitrary data is not allowed"
+		}
+
+		return ""
+	}
+
+	if len(policy.ChainIDs) > 0 {
+		if tx.ChainID == nil {
+			return "chain ID of the transaction cannot be determined"
+		}
+		if !containsBigInt(policy.ChainIDs, tx.ChainID) {
+			return fmt.Sprintf("chain ID %s is not allowed", tx.ChainID)
+		}
+	}
+
+	if len(policy.AllowedTo) > 0 {
+		if tx.To == nil {
+			return "contract deployments are not allowed"
+		}
+
+		allowed := false
+		for _, to := range policy.AllowedTo {
+			if to == *tx.To {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Sprintf("recipient %s is not allowed", tx.To.Hex())
+		}
+	}
+
+	if len(policy.AllowedSelectors) > 0 && (tx.Private || len(tx.Data) > 0) {
+		if tx.Private {
+			return "data of private transactions cannot be evaluated"
+		}
+		if len(tx.Data) < 4 {
+			return "transaction data is not a function call"
+		}
+
+		selector := hexutil.Encode(tx.Data[:4])
+		if !containsString(policy.AllowedSelectors, selector) {
+			return fmt.Sprintf("function selector %s is not allowed", selector)
+		}
+	}
+
+	if policy.MaxValue != nil && tx.Value != nil && tx.Value.Cmp(policy.MaxValue) > 0 {
+		return fmt.Sprintf("value %s exceeds the maximum of %s", tx.Value, policy.MaxValue)
+	}
+
+	if policy.MaxGasPrice != nil && tx.GasPrice != nil && tx.GasPrice.Cmp(policy.MaxGasPrice) > 0 {
+		return fmt.Sprintf("gas price %s exceeds the maximum of %s", tx.GasPrice, policy.MaxGasPrice)
+	}
+
+	return ""
+}
+
+func inTimeWindows(windows []*entities.TimeWindow, now time.Time) bool {
+	for _, window := range windows {
+		if window.Contains(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsBigInt(list []*big.Int, value *big.Int) bool {
+	for _, item := range list {
+		if item.Cmp(value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package policies
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/policies/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	db := dbmock.NewMockSpendings(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	service := New(db, roles, logger)
+
+	ctx := context.Background()
+	userInfo := authentities.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(authentities.ListPermissions()).AnyTimes()
+
+	token := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	transfer := common.FromHex("0xa9059cbb000000000000000000000000")
+	account := common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+
+	require.NoError(t, service.Create(ctx, &entities.Policy{
+		Name:             "hot-wallet",
+		Stores:           []string{"eth-hot"},
+		AllowedTo:        []common.Address{token},
+		AllowedSelectors: []string{"0xa9059cbb"},
+		MaxValue:         big.NewInt(1000),
+		MaxGasPrice:      big.NewInt(100),
+		ChainIDs:         []*big.Int{big.NewInt(1)},
+		DailyLimit:       big.NewInt(1500),
+	}, userInfo))
+	require.NoError(t, service.Create(ctx, &entities.Policy{
+		Name:            "closed",
+		AllowRawSigning: true,
+		TimeWindows:     []*entities.TimeWindow{{Start: 0, End: 0, Location: time.UTC}},
+	}, userInfo))
+
+	newRequest := func() *entities.SigningRequest {
+		return &entities.SigningRequest{
+			StoreName: "eth-hot",
+			Address:   account,
+			Transaction: &entities.Transaction{
+				To:       &token,
+				Data:     transfer,
+				Value:    big.NewInt(500),
+				GasPrice: big.NewInt(50),
+				ChainID:  big.NewInt(1),
+			},
+		}
+	}
+
+	t.Run("should allow a compliant transaction and reserve its value", func(t *testing.T) {
+		req := newRequest()
+
+		db.EXPECT().Add(gomock.Any(), gomock.Any(), big.NewInt(1500)).DoAndReturn(func(_ context.Context, spending *entities.Spending, _ *big.Int) error {
+			assert.Equal(t, "hot-wallet", spending.Policy)
+			assert.Equal(t, account, spending.Address)
+			assert.Equal(t, req.Transaction.Value, spending.Amount)
+			return nil
+		})
+
+		spendings, err := service.Evaluate(ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, spendings, 1)
+	})
+
+	t.Run("should not evaluate requests on stores without policies", func(t *testing.T) {
+		req := newRequest()
+		req.StoreName = "eth-cold"
+		req.Transaction.Value = big.NewInt(1000000)
+
+		spendings, err := service.Evaluate(ctx, req)
+		require.NoError(t, err)
+		assert.Empty(t, spendings)
+	})
+
+	t.Run("should reject transactions violating the rules", func(t *testing.T) {
+		other := common.HexToAddress("0x1")
+
+		for name, mutate := range map[string]func(tx *entities.Transaction){
+			"recipient":  func(tx *entities.Transaction) { tx.To = &other },
+			"deployment": func(tx *entities.Transaction) { tx.To = nil },
+			"selector":   func(tx *entities.Transaction) { tx.Data = common.FromHex("0x095ea7b3") },
+			"private":    func(tx *entities.Transaction) { tx.Private = true },
+			"value":      func(tx *entities.Transaction) { tx.Value = big.NewInt(1001) },
+			"gas price":  func(tx *entities.Transaction) { tx.GasPrice = big.NewInt(101) },
+			"chain ID":   func(tx *entities.Transaction) { tx.ChainID = big.NewInt(2) },
+		} {
+			req := newRequest()
+			mutate(req.Transaction)
+
+			_, err := service.Evaluate(ctx, req)
+			assert.True(t, errors.IsPolicyViolationError(err), name)
+			assert.True(t, errors.IsForbiddenError(err), name)
+		}
+	})
+
+	t.Run("should reject raw signing unless allowed", func(t *testing.T) {
+		req := newRequest()
+		req.Transaction = nil
+
+		_, err := service.Evaluate(ctx, req)
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should apply the policies bound through the account tags", func(t *testing.T) {
+		req := newRequest()
+		req.StoreName = "eth-cold"
+		req.Transaction = nil
+		req.Policies = []string{"closed"}
+
+		_, err := service.Evaluate(ctx, req)
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should reject accounts bound to an unknown policy", func(t *testing.T) {
+		req := newRequest()
+		req.Policies = []string{"unknown"}
+
+		_, err := service.Evaluate(ctx, req)
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should fail when the daily limit is exceeded", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("error")
+		db.EXPECT().Add(gomock.Any(), gomock.Any(), big.NewInt(1500)).Return(expectedErr)
+
+		_, err := service.Evaluate(ctx, newRequest())
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestTimeWindowContains(t *testing.T) {
+	monday := time.Date(2021, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should contain times within the window of the listed days", func(t *testing.T) {
+		window := &entities.TimeWindow{Days: []time.Weekday{time.Monday}, Start: 8 * time.Hour, End: 20 * time.Hour, Location: time.UTC}
+
+		assert.True(t, window.Contains(monday.Add(8*time.Hour)))
+		assert.False(t, window.Contains(monday.Add(20*time.Hour)))
+		assert.False(t, window.Contains(monday.Add(7*time.Hour)))
+		assert.False(t, window.Contains(monday.Add(24*time.Hour+10*time.Hour)))
+	})
+
+	t.Run("should contain times of windows spanning over midnight", func(t *testing.T) {
+		window := &entities.TimeWindow{Days: []time.Weekday{time.Monday}, Start: 22 * time.Hour, End: 2 * time.Hour, Location: time.UTC}
+
+		assert.True(t, window.Contains(monday.Add(23*time.Hour)))
+		assert.True(t, window.Contains(monday.Add(25*time.Hour)))
+		assert.False(t, window.Contains(monday.Add(time.Hour)))
+	})
+
+	t.Run("should evaluate the window in its timezone", func(t *testing.T) {
+		location := time.FixedZone("UTC+2", 2*60*60)
+		window := &entities.TimeWindow{Start: 8 * time.Hour, End: 9 * time.Hour, Location: location}
+
+		assert.True(t, window.Contains(monday.Add(6*time.Hour)))
+		assert.False(t, window.Contains(monday.Add(8*time.Hour)))
+	})
+}
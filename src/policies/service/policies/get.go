@@ -0,0 +1,33 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Get(ctx context.Context, name string, userInfo *authtypes.UserInfo) (*entities.Policy, error) {
+	logger := s.logger.With("name", name)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionRead, Resource: authtypes.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	policy, ok := s.policies[name]
+	if !ok {
+		errMessage := "policy not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	logger.Debug("policy found successfully")
+	return policy, nil
+}
@@ -0,0 +1,29 @@
+package policies
+
+import (
+	"context"
+	"sort"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Policies) List(ctx context.Context, userInfo *authtypes.UserInfo) ([]string, error) {
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionRead, Resource: authtypes.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	names := make([]string, 0, len(s.policies))
+	for name := range s.policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s.logger.Debug("policies listed successfully")
+	return names, nil
+}
@@ -0,0 +1,31 @@
+package policies
+
+import (
+	"sync"
+
+	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/policies"
+	"github.com/longfan78/quorum-key-manager/src/policies/database"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+type Policies struct {
+	db       database.Spendings
+	roles    auth.Roles
+	logger   log.Logger
+	mux      sync.RWMutex
+	policies map[string]*entities.Policy
+}
+
+var _ policies.Policies = &Policies{}
+
+func New(db database.Spendings, roles auth.Roles, logger log.Logger) *Policies {
+	return &Policies{
+		db:       db,
+		roles:    roles,
+		logger:   logger,
+		mux:      sync.RWMutex{},
+		policies: make(map[string]*entities.Policy),
+	}
+}
@@ -0,0 +1,22 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Refund(ctx context.Context, spendings []*entities.Spending) error {
+	for _, spending := range spendings {
+		err := s.db.Subtract(ctx, spending)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(spendings) > 0 {
+		s.logger.Debug("policy spendings refunded successfully", "count", len(spendings))
+	}
+
+	return nil
+}
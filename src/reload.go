@@ -0,0 +1,225 @@
+package src
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"reflect"
+	"sync"
+
+	rolesapi "github.com/longfan78/quorum-key-manager/src/auth/api/manifest"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authenticator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	apikey "github.com/longfan78/quorum-key-manager/src/infra/api-key"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/manifests"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	tlsinfra "github.com/longfan78/quorum-key-manager/src/infra/tls"
+	nodesapi "github.com/longfan78/quorum-key-manager/src/nodes/api/manifest"
+	policiesapi "github.com/longfan78/quorum-key-manager/src/policies/api/manifest"
+	storesapi "github.com/longfan78/quorum-key-manager/src/stores/api/manifest"
+	vaultsapi "github.com/longfan78/quorum-key-manager/src/vaults/api/manifest"
+)
+
+const (
+	manifestsSource = "manifests"
+	apiKeysSource   = "api_keys"
+	tlsSource       = "tls"
+)
+
+type manifestsHandler interface {
+	Register(ctx context.Context, mnfs []entities.Manifest) error
+	Update(ctx context.Context, mnfs []entities.Manifest) error
+}
+
+type manifestsKind struct {
+	kind    string
+	handler manifestsHandler
+}
+
+// reloader re-reads the manifests, API keys and TLS CAs and applies their differences with the running state.
+// A source that fails to reload is reported and keeps its previous state
+type reloader struct {
+	manifestReader manifests.Reader
+	apiKeyReader   apikey.Reader
+	tlsReader      tlsinfra.Reader
+	authenticator  *authenticator.Authenticator
+	logger         log.Logger
+
+	mux       sync.Mutex
+	kinds     []manifestsKind
+	manifests map[string][]entities.Manifest
+	apiKeys   map[string]*authtypes.UserClaims
+
+	// clientCAs has its own lock so that TLS handshakes are not blocked by a reload
+	casMux    sync.RWMutex
+	clientCAs *x509.CertPool
+}
+
+// serverTLSConfig verifies the client certificates of TLS handshakes against the last loaded CAs
+func (r *reloader) serverTLSConfig(cfg *tls.Config) {
+	base := cfg.Clone()
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.casMux.RLock()
+		defer r.casMux.RUnlock()
+
+		if r.clientCAs == nil {
+			return nil, nil
+		}
+
+		handshakeCfg := base.Clone()
+		handshakeCfg.ClientCAs = r.clientCAs
+		return handshakeCfg, nil
+	}
+}
+
+func (r *reloader) reload(ctx context.Context) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.manifestReader != nil {
+		err := r.reloadManifests(ctx)
+		metrics.ObserveReload(manifestsSource, err)
+	}
+
+	if r.apiKeyReader != nil && r.authenticator != nil {
+		err := r.reloadAPIKeys(ctx)
+		metrics.ObserveReload(apiKeysSource, err)
+	}
+
+	if r.tlsReader != nil && r.authenticator != nil {
+		err := r.reloadRootCAs(ctx)
+		metrics.ObserveReload(tlsSource, err)
+	}
+}
+
+func (r *reloader) reloadManifests(ctx context.Context) error {
+	mnfs, err := r.manifestReader.Load(ctx)
+	if err != nil {
+		r.logger.WithError(err).Error("failed to reload manifests")
+		return err
+	}
+
+	var reloadErr error
+	vaultsChanged := false
+	for _, k := range r.kinds {
+		logger := r.logger.With("kind", k.kind)
+		added, changed, removed := diffManifests(r.manifests[k.kind], mnfs[k.kind])
+
+		// Stores keep the clients of the vaults and stores they were created from, so they are all recreated in
+		// order when one of them or a vault changes
+		if k.kind == entities.StoreKind && (vaultsChanged || len(added) > 0 || len(changed) > 0) {
+			added, changed = nil, mnfs[k.kind]
+		}
+
+		// Resources of removed manifests keep running, so they remain part of the applied manifests
+		applied := append(append([]entities.Manifest{}, mnfs[k.kind]...), removed...)
+		for _, mnf := range removed {
+			logger.Warn("manifest removed, its resource is kept until restart", "name", mnf.Name)
+		}
+
+		if len(added) == 0 && len(changed) == 0 {
+			r.manifests[k.kind] = applied
+			continue
+		}
+
+		err = k.handler.Register(ctx, added)
+		if err == nil {
+			err = k.handler.Update(ctx, changed)
+		}
+		if err != nil {
+			// The previous manifests are kept so that the next reload applies the changes again
+			logger.WithError(err).Error("failed to reload manifests")
+			reloadErr = err
+			continue
+		}
+
+		r.manifests[k.kind] = applied
+		if k.kind == entities.VaultKind {
+			vaultsChanged = true
+		}
+
+		logger.Info("manifests reloaded", "added", len(added), "changed", len(changed))
+	}
+
+	return reloadErr
+}
+
+func (r *reloader) reloadAPIKeys(ctx context.Context) error {
+	apiKeys, err := r.apiKeyReader.Load(ctx)
+	if err != nil {
+		r.logger.WithError(err).Error("failed to reload API keys")
+		return err
+	}
+
+	revoked := 0
+	for hash := range r.apiKeys {
+		if _, ok := apiKeys[hash]; !ok {
+			revoked++
+		}
+	}
+
+	r.authenticator.SetAPIKeys(apiKeys)
+	r.apiKeys = apiKeys
+
+	r.logger.Info("API keys reloaded", "count", len(apiKeys), "revoked", revoked)
+	return nil
+}
+
+func (r *reloader) reloadRootCAs(ctx context.Context) error {
+	rootCAs, err := r.tlsReader.Load(ctx)
+	if err != nil {
+		r.logger.WithError(err).Error("failed to reload TLS CAs")
+		return err
+	}
+
+	r.authenticator.SetRootCAs(rootCAs)
+
+	r.casMux.Lock()
+	r.clientCAs = rootCAs
+	r.casMux.Unlock()
+
+	r.logger.Info("TLS CAs reloaded")
+	return nil
+}
+
+// diffManifests compares manifests by name, in the order of the new manifests
+func diffManifests(previous, current []entities.Manifest) (added, changed, removed []entities.Manifest) {
+	previousByName := make(map[string]entities.Manifest, len(previous))
+	for _, mnf := range previous {
+		previousByName[mnf.Name] = mnf
+	}
+
+	currentNames := make(map[string]bool, len(current))
+	for _, mnf := range current {
+		currentNames[mnf.Name] = true
+
+		prev, ok := previousByName[mnf.Name]
+		switch {
+		case !ok:
+			added = append(added, mnf)
+		case !reflect.DeepEqual(prev, mnf):
+			changed = append(changed, mnf)
+		}
+	}
+
+	for _, mnf := range previous {
+		if !currentNames[mnf.Name] {
+			removed = append(removed, mnf)
+		}
+	}
+
+	return added, changed, removed
+}
+
+func newManifestsKinds(rolesHandler *rolesapi.RolesHandler, policiesHandler *policiesapi.PoliciesHandler, vaultsHandler *vaultsapi.VaultsHandler, storesHandler *storesapi.StoresHandler, nodesHandler *nodesapi.NodesHandler) []manifestsKind {
+	// Order matters as stores depend on vaults and nodes on stores
+	return []manifestsKind{
+		{kind: entities.RoleKind, handler: rolesHandler},
+		{kind: entities.PolicyKind, handler: policiesHandler},
+		{kind: entities.VaultKind, handler: vaultsHandler},
+		{kind: entities.StoreKind, handler: storesHandler},
+		{kind: entities.NodeKind, handler: nodesHandler},
+	}
+}
@@ -0,0 +1,188 @@
+package src
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authenticator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	apikeymock "github.com/longfan78/quorum-key-manager/src/infra/api-key/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	manifestsmock "github.com/longfan78/quorum-key-manager/src/infra/manifests/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeManifestsHandler struct {
+	registered []entities.Manifest
+	updated    []entities.Manifest
+	err        error
+}
+
+func (h *fakeManifestsHandler) Register(_ context.Context, mnfs []entities.Manifest) error {
+	h.registered = append(h.registered, mnfs...)
+	return h.err
+}
+
+func (h *fakeManifestsHandler) Update(_ context.Context, mnfs []entities.Manifest) error {
+	h.updated = append(h.updated, mnfs...)
+	return h.err
+}
+
+func (h *fakeManifestsHandler) reset() {
+	h.registered, h.updated, h.err = nil, nil, nil
+}
+
+func fakeManifest(kind, name, specs string) entities.Manifest {
+	return entities.Manifest{Kind: kind, Name: name, Specs: map[string]interface{}{"value": specs}}
+}
+
+func TestReloadManifests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	reader := manifestsmock.NewMockReader(ctrl)
+	vaultsHandler, storesHandler, nodesHandler := &fakeManifestsHandler{}, &fakeManifestsHandler{}, &fakeManifestsHandler{}
+
+	vault := fakeManifest(entities.VaultKind, "my-vault", "address")
+	keyStore := fakeManifest(entities.StoreKind, "my-key-store", "my-vault")
+	ethStore := fakeManifest(entities.StoreKind, "my-eth-store", "my-key-store")
+	node := fakeManifest(entities.NodeKind, "my-node", "url")
+
+	r := &reloader{
+		manifestReader: reader,
+		logger:         testutils.NewMockLogger(ctrl),
+		kinds: []manifestsKind{
+			{kind: entities.VaultKind, handler: vaultsHandler},
+			{kind: entities.StoreKind, handler: storesHandler},
+			{kind: entities.NodeKind, handler: nodesHandler},
+		},
+		manifests: map[string][]entities.Manifest{
+			entities.VaultKind: {vault},
+			entities.StoreKind: {keyStore, ethStore},
+			entities.NodeKind:  {node},
+		},
+	}
+
+	t.Run("should register new manifests and update changed ones", func(t *testing.T) {
+		newNode := fakeManifest(entities.NodeKind, "my-new-node", "url")
+		changedNode := fakeManifest(entities.NodeKind, "my-node", "new-url")
+		reader.EXPECT().Load(ctx).Return(map[string][]entities.Manifest{
+			entities.VaultKind: {vault},
+			entities.StoreKind: {keyStore, ethStore},
+			entities.NodeKind:  {changedNode, newNode},
+		}, nil)
+
+		err := r.reloadManifests(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, vaultsHandler.registered)
+		assert.Empty(t, vaultsHandler.updated)
+		assert.Empty(t, storesHandler.updated)
+		assert.Equal(t, []entities.Manifest{newNode}, nodesHandler.registered)
+		assert.Equal(t, []entities.Manifest{changedNode}, nodesHandler.updated)
+		assert.Equal(t, []entities.Manifest{changedNode, newNode}, r.manifests[entities.NodeKind])
+	})
+
+	t.Run("should recreate all the stores when a vault changes", func(t *testing.T) {
+		vaultsHandler.reset()
+		storesHandler.reset()
+		nodesHandler.reset()
+		changedVault := fakeManifest(entities.VaultKind, "my-vault", "new-address")
+		reader.EXPECT().Load(ctx).Return(map[string][]entities.Manifest{
+			entities.VaultKind: {changedVault},
+			entities.StoreKind: {keyStore, ethStore},
+			entities.NodeKind:  r.manifests[entities.NodeKind],
+		}, nil)
+
+		err := r.reloadManifests(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.Manifest{changedVault}, vaultsHandler.updated)
+		assert.Equal(t, []entities.Manifest{keyStore, ethStore}, storesHandler.updated)
+		assert.Empty(t, nodesHandler.registered)
+		assert.Empty(t, nodesHandler.updated)
+	})
+
+	t.Run("should keep the resources of removed manifests", func(t *testing.T) {
+		vaultsHandler.reset()
+		storesHandler.reset()
+		reader.EXPECT().Load(ctx).Return(map[string][]entities.Manifest{
+			entities.VaultKind: r.manifests[entities.VaultKind],
+			entities.StoreKind: {keyStore},
+			entities.NodeKind:  r.manifests[entities.NodeKind],
+		}, nil)
+
+		err := r.reloadManifests(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, storesHandler.updated)
+		assert.Equal(t, []entities.Manifest{keyStore, ethStore}, r.manifests[entities.StoreKind])
+	})
+
+	t.Run("should keep the previous manifests if they fail to be applied", func(t *testing.T) {
+		nodesHandler.reset()
+		nodesHandler.err = fmt.Errorf("error")
+		previous := r.manifests[entities.NodeKind]
+		reader.EXPECT().Load(ctx).Return(map[string][]entities.Manifest{
+			entities.VaultKind: r.manifests[entities.VaultKind],
+			entities.StoreKind: r.manifests[entities.StoreKind],
+			entities.NodeKind:  {fakeManifest(entities.NodeKind, "my-node", "invalid-url")},
+		}, nil)
+
+		err := r.reloadManifests(ctx)
+
+		assert.Error(t, err)
+		assert.Equal(t, previous, r.manifests[entities.NodeKind])
+	})
+
+	t.Run("should fail and keep the running state if manifests cannot be read", func(t *testing.T) {
+		vaultsHandler.reset()
+		expectedErr := fmt.Errorf("error")
+		reader.EXPECT().Load(ctx).Return(nil, expectedErr)
+
+		err := r.reloadManifests(ctx)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Len(t, r.manifests[entities.VaultKind], 1)
+		assert.Empty(t, vaultsHandler.registered)
+	})
+}
+
+func TestReloadAPIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	logger := testutils.NewMockLogger(ctrl)
+	reader := apikeymock.NewMockReader(ctrl)
+
+	revokedKey, newKey := []byte("revoked-key"), []byte("new-key")
+	hash := func(key []byte) string { return fmt.Sprintf("%x", sha256.Sum256(key)) }
+	claims := map[string]*authtypes.UserClaims{hash(revokedKey): {Tenant: "tenantOne"}}
+	authen := authenticator.New(nil, claims, nil, nil, nil, logger)
+
+	r := &reloader{
+		apiKeyReader:  reader,
+		authenticator: authen,
+		logger:        logger,
+		apiKeys:       claims,
+	}
+
+	reader.EXPECT().Load(ctx).Return(map[string]*authtypes.UserClaims{hash(newKey): {Tenant: "tenantTwo"}}, nil)
+
+	err := r.reloadAPIKeys(ctx)
+	require.NoError(t, err)
+
+	_, err = authen.AuthenticateAPIKey(ctx, revokedKey, nil)
+	assert.Error(t, err)
+
+	userInfo, err := authen.AuthenticateAPIKey(ctx, newKey, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "tenantTwo", userInfo.Tenant)
+}
@@ -0,0 +1,48 @@
+package formatters
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// FormatDuration parses a duration validated on the request, an empty duration is 0
+func FormatDuration(duration string) time.Duration {
+	d, _ := time.ParseDuration(duration)
+	return d
+}
+
+func FormatRecovery(period string) *entities.Recovery {
+	if period == "" {
+		return nil
+	}
+
+	return &entities.Recovery{Period: FormatDuration(period)}
+}
+
+// FormatOperations parses the operations validated on the request, no operation allowing any operation
+func FormatOperations(operations []string) []entities.CryptoOperation {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	res := make([]entities.CryptoOperation, len(operations))
+	for i, op := range operations {
+		res[i] = entities.CryptoOperation(op)
+	}
+
+	return res
+}
+
+func FormatOperationsResponse(operations []entities.CryptoOperation) []string {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(operations))
+	for i, op := range operations {
+		res[i] = string(op)
+	}
+
+	return res
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/stores/api/types"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	signer "github.com/ethereum/go-ethereum/signer/core"
@@ -148,6 +149,13 @@ func FormatEthAccResponse(ethAcc *entities.ETHAccount) *types.EthAccountResponse
 		CreatedAt:           ethAcc.Metadata.CreatedAt,
 		UpdatedAt:           ethAcc.Metadata.UpdatedAt,
 		Disabled:            ethAcc.Metadata.Disabled,
+		Operations:          FormatOperationsResponse(ethAcc.Metadata.Operations),
+		WalletID:            ethAcc.WalletID,
+		DerivationPath:      ethAcc.DerivationPath,
+	}
+
+	if !ethAcc.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &ethAcc.Metadata.ExpireAt
 	}
 
 	if !ethAcc.Metadata.DeletedAt.IsZero() {
@@ -156,3 +164,24 @@ func FormatEthAccResponse(ethAcc *entities.ETHAccount) *types.EthAccountResponse
 
 	return resp
 }
+
+func FormatEthWalletResponse(wallet *entities.ETHWallet) *types.EthWalletResponse {
+	return &types.EthWalletResponse{
+		ID:        wallet.ID,
+		Mnemonic:  wallet.Mnemonic,
+		Tags:      wallet.Tags,
+		CreatedAt: wallet.Metadata.CreatedAt,
+		UpdatedAt: wallet.Metadata.UpdatedAt,
+	}
+}
+
+// FormatDerivationPath returns the derivation path of a request, the BIP-44 Ethereum path of index by default
+func FormatDerivationPath(path string, index uint32) string {
+	if path != "" {
+		return path
+	}
+
+	derivationPath := append(accounts.DerivationPath{}, accounts.DefaultBaseDerivationPath...)
+	derivationPath[len(derivationPath)-1] = index
+	return derivationPath.String()
+}
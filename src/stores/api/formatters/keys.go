@@ -15,14 +15,41 @@ func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
 		SigningAlgorithm: string(key.Algo.Type),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Version:          key.Metadata.Version,
+		RotationPolicy:   FormatRotationPolicyResponse(key.RotationPolicy),
 		Disabled:         key.Metadata.Disabled,
+		Operations:       FormatOperationsResponse(key.Metadata.Operations),
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 	}
 
+	if !key.Metadata.RotatedAt.IsZero() {
+		resp.RotatedAt = &key.Metadata.RotatedAt
+	}
+
+	if !key.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &key.Metadata.ExpireAt
+	}
+
 	if !key.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &key.Metadata.DeletedAt
 	}
 
 	return resp
 }
+
+func FormatRotationPolicy(policy *types.RotationPolicy) *entities.RotationPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &entities.RotationPolicy{Interval: FormatDuration(policy.Interval)}
+}
+
+func FormatRotationPolicyResponse(policy *entities.RotationPolicy) *types.RotationPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &types.RotationPolicy{Interval: policy.Interval.String()}
+}
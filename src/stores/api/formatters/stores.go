@@ -0,0 +1,40 @@
+package formatters
+
+import (
+	"github.com/longfan78/quorum-key-manager/src/stores/api/types"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func FormatStoreResponse(store *entities.StoreDefinition) *types.StoreResponse {
+	return &types.StoreResponse{
+		Name:           store.Name,
+		Type:           store.StoreType,
+		Vault:          store.Vault,
+		SecretStore:    store.SecretStore,
+		KeyStore:       store.KeyStore,
+		AllowedTenants: store.AllowedTenants,
+		CreatedAt:      store.CreatedAt,
+		UpdatedAt:      store.UpdatedAt,
+	}
+}
+
+func FormatSyncReportResponse(report *entities.SyncReport) *types.SyncReportResponse {
+	return &types.SyncReportResponse{
+		StoreName:   report.StoreName,
+		DryRun:      report.DryRun,
+		Added:       emptyIfNil(report.Added),
+		Deleted:     emptyIfNil(report.Deleted),
+		Updated:     emptyIfNil(report.Updated),
+		Failed:      emptyIfNil(report.Failed),
+		StartedAt:   report.StartedAt,
+		CompletedAt: report.CompletedAt,
+	}
+}
+
+func emptyIfNil(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+
+	return ids
+}
@@ -39,12 +39,21 @@ func (h *EthHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("").HandlerFunc(h.create)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodPost).Path("/import").HandlerFunc(h.importAccount)
+	r.Methods(http.MethodPost).Path("/wallets").HandlerFunc(h.createWallet)
+	r.Methods(http.MethodGet).Path("/wallets").HandlerFunc(h.listWallets)
+	r.Methods(http.MethodPost).Path("/wallets/import").HandlerFunc(h.importWallet)
+	r.Methods(http.MethodGet).Path("/wallets/{id}").HandlerFunc(h.getWallet)
+	r.Methods(http.MethodPost).Path("/wallets/{id}/derive").HandlerFunc(h.deriveAccount)
 	r.Methods(http.MethodPost).Path("/{address}/sign-transaction").HandlerFunc(h.signTransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-quorum-private-transaction").HandlerFunc(h.signPrivateTransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-eea-transaction").HandlerFunc(h.signEEATransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-typed-data").HandlerFunc(h.signTypedData)
 	r.Methods(http.MethodPost).Path("/{address}/sign-message").HandlerFunc(h.signMessage)
+	r.Methods(http.MethodPost).Path("/{address}/encrypt").HandlerFunc(h.encrypt)
+	r.Methods(http.MethodPost).Path("/{address}/decrypt").HandlerFunc(h.decrypt)
 	r.Methods(http.MethodPut).Path("/{address}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodPut).Path("/{address}/enable").HandlerFunc(h.enable)
+	r.Methods(http.MethodPut).Path("/{address}/disable").HandlerFunc(h.disable)
 	r.Methods(http.MethodPatch).Path("/{address}").HandlerFunc(h.update)
 	r.Methods(http.MethodGet).Path("/{address}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodDelete).Path("/{address}").HandlerFunc(h.delete)
@@ -88,7 +97,12 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags})
+	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{
+		Tags:       createReq.Tags,
+		TTL:        formatters.FormatDuration(createReq.TTL),
+		Recovery:   formatters.FormatRecovery(createReq.RecoveryPeriod),
+		Operations: formatters.FormatOperations(createReq.Operations),
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -138,7 +152,12 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{Tags: importReq.Tags})
+	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{
+		Tags:       importReq.Tags,
+		TTL:        formatters.FormatDuration(importReq.TTL),
+		Recovery:   formatters.FormatRecovery(importReq.RecoveryPeriod),
+		Operations: formatters.FormatOperations(importReq.Operations),
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -239,6 +258,95 @@ func (h *EthHandler) signMessage(rw http.ResponseWriter, request *http.Request)
 	}
 }
 
+// @Summary      Encrypt a payload
+// @Description  Encrypt a payload with ECIES using the public key of an existing Ethereum Account
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        address    path      string                   true  "Ethereum address"
+// @Param        request    body      types.EncryptEthRequest  true  "Encrypt request"
+// @Success      200        {string}  string                   "Encrypted payload"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/encrypt [post]
+func (h *EthHandler) encrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	encryptReq := &types.EncryptEthRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, encryptReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	encryptedData, err := ethStore.Encrypt(ctx, getAddress(request), encryptReq.Data)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(encryptedData)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Decrypt a payload
+// @Description  Decrypt a payload encrypted for an existing Ethereum Account. Only supported by accounts whose private key is held by the Quorum Key Manager
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        address    path      string                   true  "Ethereum address"
+// @Param        request    body      types.DecryptEthRequest  true  "Decrypt request"
+// @Success      200        {string}  string                   "Decrypted payload"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Decryption not supported by the vault"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/decrypt [post]
+func (h *EthHandler) decrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	decryptReq := &types.DecryptEthRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, decryptReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	data, err := ethStore.Decrypt(ctx, getAddress(request), decryptReq.Data)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(data)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Sign Typed Data (EIP-712)
 // @Description  Sign Typed Data, following EIP-712, using identified Ethereum Account
 // @Tags         Ethereum
@@ -293,6 +401,7 @@ func (h *EthHandler) signTypedData(rw http.ResponseWriter, request *http.Request
 // @Param        address    path      string                           true  "Ethereum address"
 // @Param        request    body      types.SignETHTransactionRequest  true  "Sign Ethereum transaction request"
 // @Success      200        {string}  string                           "Signed raw transaction"
+// @Success      202        {object}  infrahttp.ErrorResponse          "Operation pending approval"
 // @Failure      400        {object}  infrahttp.ErrorResponse          "Invalid request format"
 // @Failure      401        {object}  infrahttp.ErrorResponse          "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse          "Forbidden"
@@ -343,6 +452,7 @@ func (h *EthHandler) signTransaction(rw http.ResponseWriter, request *http.Reque
 // @Param        address    path      string                           true  "Ethereum address"
 // @Param        request    body      types.SignEEATransactionRequest  true  "Sign EEA transaction request"
 // @Success      200        {string}  string                           "Signed raw EEA transaction"
+// @Success      202        {object}  infrahttp.ErrorResponse          "Operation pending approval"
 // @Failure      400        {object}  infrahttp.ErrorResponse          "Invalid request format"
 // @Failure      401        {object}  infrahttp.ErrorResponse          "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse          "Forbidden"
@@ -388,6 +498,7 @@ func (h *EthHandler) signEEATransaction(rw http.ResponseWriter, request *http.Re
 // @Param        address    path      string                                     true  "Ethereum address"
 // @Param        request    body      types.SignQuorumPrivateTransactionRequest  true  "Sign Quorum transaction request"
 // @Success      200        {string}  string                                     "Signed raw Quorum private transaction"
+// @Success      202        {object}  infrahttp.ErrorResponse                    "Operation pending approval"
 // @Failure      400        {object}  infrahttp.ErrorResponse                    "Invalid request format"
 // @Failure      401        {object}  infrahttp.ErrorResponse                    "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse                    "Forbidden"
@@ -470,15 +581,25 @@ func (h *EthHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 // @Tags         Ethereum
 // @Accept       json
 // @Produce      json
-// @Param        storeName   path      string                   true   "Store ID"
-// @Param        deleted     query     bool                     false  "filter by only deleted accounts"
-// @Param        chain_uuid  query     string                   false  "Chain UUID"
-// @Param        limit       query     int                      false  "page size"
-// @Param        page        query     int                      false  "page number"
-// @Success      200         {array}   infrahttp.PageResponse   "Ethereum Account list"
-// @Failure      401         {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403         {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      500         {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        storeName       path      string                   true   "Store ID"
+// @Param        deleted         query     bool                     false  "filter by only deleted accounts"
+// @Param        expired         query     bool                     false  "filter by only expired accounts"
+// @Param        expires_within  query     string                   false  "filter by accounts expiring within a duration, including expired accounts"
+// @Param        tag.key         query     string                   false  "filter by tag, tag.<key>=<value>"
+// @Param        disabled        query     bool                     false  "filter by disabled state"
+// @Param        created_after   query     string                   false  "filter by creation date, RFC3339"
+// @Param        created_before  query     string                   false  "filter by creation date, RFC3339"
+// @Param        sort            query     string                   false  "sort by field"  Enums(created_at, id)
+// @Param        order           query     string                   false  "sort order"     Enums(asc, desc)
+// @Param        cursor          query     string                   false  "cursor of the next page, returned when filtering"
+// @Param        full            query     bool                     false  "return full objects instead of identifiers"
+// @Param        chain_uuid      query     string                   false  "Chain UUID"
+// @Param        limit           query     int                      false  "page size"
+// @Param        page            query     int                      false  "page number"
+// @Success      200             {array}   infrahttp.PageResponse   "Ethereum Account list"
+// @Failure      401             {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403             {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500             {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/ethereum [get]
 func (h *EthHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -495,12 +616,30 @@ func (h *EthHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	expiringBefore, err := getExpiringBefore(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	filter, err := getListFilter(request, false)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
 	getDeleted := request.URL.Query().Get("deleted")
 	var addresses []ethcommon.Address
-	if getDeleted == "" {
-		addresses, err = ethStore.List(ctx, limit, offset)
-	} else {
+	switch {
+	case getDeleted != "":
 		addresses, err = ethStore.ListDeleted(ctx, limit, offset)
+	case expiringBefore != nil:
+		addresses, err = ethStore.ListExpiring(ctx, *expiringBefore, limit, offset)
+	case filter != nil:
+		h.search(rw, request, ethStore, filter)
+		return
+	default:
+		addresses, err = ethStore.List(ctx, limit, offset)
 	}
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -514,6 +653,41 @@ func (h *EthHandler) list(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+func (h *EthHandler) search(rw http.ResponseWriter, request *http.Request, ethStore stores.EthStore, filter *entities.ListFilter) {
+	items, err := ethStore.Search(request.Context(), filter)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	nextCursor := ""
+	if len(items) > 0 {
+		lastItem := items[len(items)-1]
+		nextCursor = getNextCursor(filter, len(items), lastItem.Address.Hex(), lastItem.Metadata.CreatedAt)
+	}
+
+	var data interface{}
+	if getFull(request) {
+		resp := make([]*types.EthAccountResponse, 0, len(items))
+		for _, item := range items {
+			resp = append(resp, formatters.FormatEthAccResponse(item))
+		}
+		data = resp
+	} else {
+		ids := make([]ethcommon.Address, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.Address)
+		}
+		data = ids
+	}
+
+	err = infrahttp.WriteCursorPagingResponse(rw, request, data, nextCursor)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Delete Ethereum Account
 // @Description  Soft delete an Ethereum Account, can be recovered
 // @Tags         Ethereum
@@ -551,6 +725,7 @@ func (h *EthHandler) delete(rw http.ResponseWriter, request *http.Request) {
 // @Param        storeName  path  string  true  "Store ID"
 // @Param        address    path  string  true  "Ethereum address"
 // @Success      204        "Destroyed successfully"
+// @Success      202        {object}  infrahttp.ErrorResponse  "Operation pending approval"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
 // @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
@@ -604,6 +779,65 @@ func (h *EthHandler) restore(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusNoContent)
 }
 
+// @Summary      Enable Ethereum Account
+// @Description  Enable a disabled Ethereum Account, which can be used again for signing, encryption and decryption
+// @Tags         Ethereum
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store ID"
+// @Param        address    path      string                    true  "Ethereum address"
+// @Success      200        {object}  types.EthAccountResponse  "Ethereum Account data"
+// @Failure      401        {object}  infrahttp.ErrorResponse   "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Store/Account not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/enable [put]
+func (h *EthHandler) enable(rw http.ResponseWriter, request *http.Request) {
+	h.setDisabled(rw, request, false)
+}
+
+// @Summary      Disable Ethereum Account
+// @Description  Disable an Ethereum Account, which cannot sign, encrypt or decrypt until enabled again
+// @Tags         Ethereum
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store ID"
+// @Param        address    path      string                    true  "Ethereum address"
+// @Success      200        {object}  types.EthAccountResponse  "Ethereum Account data"
+// @Failure      401        {object}  infrahttp.ErrorResponse   "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Store/Account not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/disable [put]
+func (h *EthHandler) disable(rw http.ResponseWriter, request *http.Request) {
+	h.setDisabled(rw, request, true)
+}
+
+func (h *EthHandler) setDisabled(rw http.ResponseWriter, request *http.Request, disabled bool) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	var ethAcc *entities.ETHAccount
+	if disabled {
+		ethAcc, err = ethStore.Disable(ctx, getAddress(request))
+	} else {
+		ethAcc, err = ethStore.Enable(ctx, getAddress(request))
+	}
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatEthAccResponse(ethAcc))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 func getAddress(request *http.Request) ethcommon.Address {
 	return ethcommon.HexToAddress(mux.Vars(request)["address"])
 }
@@ -333,6 +333,40 @@ func (s *ethHandlerTestSuite) TestSignMessage() {
 	})
 }
 
+func (s *ethHandlerTestSuite) TestEncrypt() {
+	s.Run("should execute request successfully", func() {
+		requestBytes, _ := json.Marshal(&apiTypes.EncryptEthRequest{Data: []byte("my data")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/encrypt", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		encryptedData := []byte("encrypted data")
+		s.ethStore.EXPECT().Encrypt(gomock.Any(), ethcommon.HexToAddress(accAddress), []byte("my data")).Return(encryptedData, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(encryptedData), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *ethHandlerTestSuite) TestDecrypt() {
+	s.Run("should execute request successfully", func() {
+		requestBytes, _ := json.Marshal(&apiTypes.DecryptEthRequest{Data: []byte("encrypted data")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/decrypt", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		data := []byte("my data")
+		s.ethStore.EXPECT().Decrypt(gomock.Any(), ethcommon.HexToAddress(accAddress), []byte("encrypted data")).Return(data, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(data), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
 func (s *ethHandlerTestSuite) TestSignTransaction() {
 	s.Run("should execute request successfully with default type DYNAMIC_FEE", func() {
 		signTransactionRequest := testutils.FakeSignETHTransactionRequest("")
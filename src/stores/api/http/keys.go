@@ -32,10 +32,16 @@ func NewKeysHandler(storesConnector stores.Stores) *KeysHandler {
 func (h *KeysHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("/{id}/import").HandlerFunc(h.importKey)
 	r.Methods(http.MethodPost).Path("/{id}/sign").HandlerFunc(h.sign)
+	r.Methods(http.MethodPost).Path("/{id}/encrypt").HandlerFunc(h.encrypt)
+	r.Methods(http.MethodPost).Path("/{id}/decrypt").HandlerFunc(h.decrypt)
+	r.Methods(http.MethodPost).Path("/{id}/rotate").HandlerFunc(h.rotate)
+	r.Methods(http.MethodGet).Path("/{id}/versions").HandlerFunc(h.listVersions)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodPatch).Path("/{id}").HandlerFunc(h.update)
 	r.Methods(http.MethodPut).Path("/{id}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodPut).Path("/{id}/enable").HandlerFunc(h.enable)
+	r.Methods(http.MethodPut).Path("/{id}/disable").HandlerFunc(h.disable)
 	r.Methods(http.MethodPost).Path("/{id}").HandlerFunc(h.create)
 
 	r.Methods(http.MethodDelete).Path("/{id}").HandlerFunc(h.delete)
@@ -81,7 +87,11 @@ func (h *KeysHandler) create(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(createKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: createKeyRequest.Tags,
+			Tags:           createKeyRequest.Tags,
+			RotationPolicy: formatters.FormatRotationPolicy(createKeyRequest.RotationPolicy),
+			TTL:            formatters.FormatDuration(createKeyRequest.TTL),
+			Recovery:       formatters.FormatRecovery(createKeyRequest.RecoveryPeriod),
+			Operations:     formatters.FormatOperations(createKeyRequest.Operations),
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -135,7 +145,11 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(importKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: importKeyRequest.Tags,
+			Tags:           importKeyRequest.Tags,
+			RotationPolicy: formatters.FormatRotationPolicy(importKeyRequest.RotationPolicy),
+			TTL:            formatters.FormatDuration(importKeyRequest.TTL),
+			Recovery:       formatters.FormatRecovery(importKeyRequest.RecoveryPeriod),
+			Operations:     formatters.FormatOperations(importKeyRequest.Operations),
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -193,6 +207,172 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+// @Summary      Encrypt a payload
+// @Description  Encrypt a payload using the public key of the selected key (ECIES for ECDSA/secp256k1 keys)
+// @Tags         Keys
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                             true  "Store identifier"
+// @Param        id         path      string                             true  "Key identifier"
+// @Param        request    body      types.EncryptBase64PayloadRequest  true  "Encryption request"
+// @Success      200        {string}  string                             "encrypted payload in base64"
+// @Failure      400        {object}  infrahttp.ErrorResponse            "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse            "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse            "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse            "Store/Key not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse            "Encryption not supported by the key"
+// @Failure      500        {object}  infrahttp.ErrorResponse            "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/encrypt [post]
+func (h *KeysHandler) encrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	encryptPayloadRequest := &types.EncryptBase64PayloadRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, encryptPayloadRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	encryptedData, err := keyStore.Encrypt(ctx, getID(request), encryptPayloadRequest.Data, nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(base64.StdEncoding.EncodeToString(encryptedData)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Decrypt a payload
+// @Description  Decrypt a payload encrypted with the selected key. Only supported by keys whose private key is held by the Quorum Key Manager
+// @Tags         Keys
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                             true  "Store identifier"
+// @Param        id         path      string                             true  "Key identifier"
+// @Param        request    body      types.DecryptBase64PayloadRequest  true  "Decryption request"
+// @Success      200        {string}  string                             "decrypted payload in base64"
+// @Failure      400        {object}  infrahttp.ErrorResponse            "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse            "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse            "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse            "Store/Key not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse            "Decryption not supported by the vault"
+// @Failure      500        {object}  infrahttp.ErrorResponse            "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/decrypt [post]
+func (h *KeysHandler) decrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	decryptPayloadRequest := &types.DecryptBase64PayloadRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, decryptPayloadRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	data, err := keyStore.Decrypt(ctx, getID(request), decryptPayloadRequest.Data, nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(base64.StdEncoding.EncodeToString(data)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Rotate a key
+// @Description  Create a new version of a key under the same ID. The key signs with its latest version and the previous versions remain available
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {object}  types.KeyResponse        "Rotated key data"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Rotation not supported by the vault"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/rotate [post]
+func (h *KeysHandler) rotate(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	key, err := keyStore.Rotate(ctx, getID(request), nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatKeyResponse(key))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      List key versions
+// @Description  List the versions of a key, latest first, to verify signatures of previous versions
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {array}   types.KeyResponse        "Key versions"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/versions [get]
+func (h *KeysHandler) listVersions(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	keys, err := keyStore.ListVersions(ctx, getID(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := make([]*types.KeyResponse, 0, len(keys))
+	for _, key := range keys {
+		resp = append(resp, formatters.FormatKeyResponse(key))
+	}
+
+	err = infrahttp.WriteJSON(rw, resp)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Get key by ID
 // @Description  Retrieve a key by its ID
 // @Tags         Keys
@@ -266,7 +446,8 @@ func (h *KeysHandler) update(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	key, err := keyStore.Update(ctx, getID(request), &entities.Attributes{
-		Tags: updateRequest.Tags,
+		Tags:           updateRequest.Tags,
+		RotationPolicy: formatters.FormatRotationPolicy(updateRequest.RotationPolicy),
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -311,19 +492,90 @@ func (h *KeysHandler) restore(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusNoContent)
 }
 
-// @Summary      List Key ids
-// @Description  List key's IDs allocated on targeted Store
+// @Summary      Enable a key
+// @Description  Enable a disabled key, which can be used again for crypto operations
 // @Tags         Keys
-// @Accept       json
 // @Produce      json
-// @Param        storeName  path      string                   true   "Store identifier"
-// @Param        limit      query     int                      false  "page size"
-// @Param        page       query     int                      false  "page number"
-// @Param        deleted    query     bool                     false  "filter by only deleted keys"
-// @Success      200        {array}   infrahttp.PageResponse   "List of key ids"
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {object}  types.KeyResponse        "Key data"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/enable [put]
+func (h *KeysHandler) enable(rw http.ResponseWriter, request *http.Request) {
+	h.setDisabled(rw, request, false)
+}
+
+// @Summary      Disable a key
+// @Description  Disable a key, which cannot sign, encrypt or decrypt until enabled again
+// @Tags         Keys
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {object}  types.KeyResponse        "Key data"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
 // @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/disable [put]
+func (h *KeysHandler) disable(rw http.ResponseWriter, request *http.Request) {
+	h.setDisabled(rw, request, true)
+}
+
+func (h *KeysHandler) setDisabled(rw http.ResponseWriter, request *http.Request, disabled bool) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	var key *entities.Key
+	if disabled {
+		key, err = keyStore.Disable(ctx, getID(request))
+	} else {
+		key, err = keyStore.Enable(ctx, getID(request))
+	}
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatKeyResponse(key))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      List Key ids
+// @Description  List key's IDs allocated on targeted Store
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName          path      string                   true   "Store identifier"
+// @Param        limit              query     int                      false  "page size"
+// @Param        page               query     int                      false  "page number"
+// @Param        deleted            query     bool                     false  "filter by only deleted keys"
+// @Param        expired            query     bool                     false  "filter by only expired keys"
+// @Param        expires_within     query     string                   false  "filter by keys expiring within a duration, including expired keys"
+// @Param        signing_algorithm  query     string                   false  "filter by signing algorithm"  Enums(ecdsa, eddsa)
+// @Param        curve              query     string                   false  "filter by elliptic curve"     Enums(secp256k1, babyjubjub, curve25519)
+// @Param        tag.key            query     string                   false  "filter by tag, tag.<key>=<value>"
+// @Param        disabled           query     bool                     false  "filter by disabled state"
+// @Param        created_after      query     string                   false  "filter by creation date, RFC3339"
+// @Param        created_before     query     string                   false  "filter by creation date, RFC3339"
+// @Param        sort               query     string                   false  "sort by field"  Enums(created_at, id)
+// @Param        order              query     string                   false  "sort order"     Enums(asc, desc)
+// @Param        cursor             query     string                   false  "cursor of the next page, returned when filtering"
+// @Param        full               query     bool                     false  "return full objects instead of identifiers"
+// @Success      200                {array}   infrahttp.PageResponse   "List of key ids"
+// @Failure      401                {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403                {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500                {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/keys [get]
 func (h *KeysHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -340,12 +592,30 @@ func (h *KeysHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	expiringBefore, err := getExpiringBefore(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	filter, err := getKeyListFilter(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
 	getDeleted := request.URL.Query().Get("deleted")
 	var ids []string
-	if getDeleted == "" {
-		ids, err = keyStore.List(ctx, limit, offset)
-	} else {
+	switch {
+	case getDeleted != "":
 		ids, err = keyStore.ListDeleted(ctx, limit, offset)
+	case expiringBefore != nil:
+		ids, err = keyStore.ListExpiring(ctx, *expiringBefore, limit, offset)
+	case filter != nil:
+		h.search(rw, request, keyStore, filter)
+		return
+	default:
+		ids, err = keyStore.List(ctx, limit, offset)
 	}
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -359,6 +629,41 @@ func (h *KeysHandler) list(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+func (h *KeysHandler) search(rw http.ResponseWriter, request *http.Request, keyStore stores.KeyStore, filter *entities.ListFilter) {
+	keys, err := keyStore.Search(request.Context(), filter)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	nextCursor := ""
+	if len(keys) > 0 {
+		lastKey := keys[len(keys)-1]
+		nextCursor = getNextCursor(filter, len(keys), lastKey.ID, lastKey.Metadata.CreatedAt)
+	}
+
+	var data interface{}
+	if getFull(request) {
+		resp := make([]*types.KeyResponse, 0, len(keys))
+		for _, key := range keys {
+			resp = append(resp, formatters.FormatKeyResponse(key))
+		}
+		data = resp
+	} else {
+		ids := make([]string, 0, len(keys))
+		for _, key := range keys {
+			ids = append(ids, key.ID)
+		}
+		data = ids
+	}
+
+	err = infrahttp.WriteCursorPagingResponse(rw, request, data, nextCursor)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Soft-delete Key
 // @Description  Delete a key by its ID. Key can be recovered
 // @Tags         Keys
@@ -398,6 +703,7 @@ func (h *KeysHandler) delete(rw http.ResponseWriter, request *http.Request) {
 // @Param        storeName  path  string  true  "Store identifier"
 // @Param        id         path  string  true  "Key identifier"
 // @Success      204        "Destroyed successfully"
+// @Success      202        {object}  infrahttp.ErrorResponse  "Operation pending approval"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
 // @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
@@ -424,3 +730,26 @@ func (h *KeysHandler) destroy(rw http.ResponseWriter, request *http.Request) {
 func getID(request *http.Request) string {
 	return mux.Vars(request)["id"]
 }
+
+// getKeyListFilter returns the filter of the listed keys, which can also be filtered by algorithm
+func getKeyListFilter(request *http.Request) (*entities.ListFilter, error) {
+	signingAlgorithm := request.URL.Query().Get("signing_algorithm")
+	if signingAlgorithm != "" && signingAlgorithm != string(entities2.Ecdsa) && signingAlgorithm != string(entities2.Eddsa) {
+		return nil, errors.InvalidFormatError("invalid signing_algorithm value")
+	}
+
+	curve := request.URL.Query().Get("curve")
+	if curve != "" && curve != string(entities2.Secp256k1) && curve != string(entities2.Babyjubjub) && curve != string(entities2.Curve25519) {
+		return nil, errors.InvalidFormatError("invalid curve value")
+	}
+
+	filter, err := getListFilter(request, signingAlgorithm != "" || curve != "")
+	if err != nil || filter == nil {
+		return nil, err
+	}
+
+	filter.SigningAlgorithm = signingAlgorithm
+	filter.EllipticCurve = curve
+
+	return filter, nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 	"github.com/longfan78/quorum-key-manager/src/stores/api/formatters"
@@ -18,6 +19,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	http2 "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/stores/api/types"
 	"github.com/longfan78/quorum-key-manager/src/stores/api/types/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
@@ -261,6 +263,60 @@ func (s *keysHandlerTestSuite) TestSign() {
 	})
 }
 
+func (s *keysHandlerTestSuite) TestEncrypt() {
+	s.Run("should execute request successfully", func() {
+		requestBytes, _ := json.Marshal(&types.EncryptBase64PayloadRequest{Data: []byte("my data")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/encrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		encryptedData := []byte("encrypted data")
+		s.keyStore.EXPECT().Encrypt(gomock.Any(), keyID, []byte("my data"), nil).Return(encryptedData, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), base64.StdEncoding.EncodeToString(encryptedData), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if data is missing", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/encrypt", keyID), bytes.NewReader([]byte("{}"))).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestDecrypt() {
+	s.Run("should execute request successfully", func() {
+		requestBytes, _ := json.Marshal(&types.DecryptBase64PayloadRequest{Data: []byte("encrypted data")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/decrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		data := []byte("my data")
+		s.keyStore.EXPECT().Decrypt(gomock.Any(), keyID, []byte("encrypted data"), nil).Return(data, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), base64.StdEncoding.EncodeToString(data), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 501 if decryption is not supported", func() {
+		requestBytes, _ := json.Marshal(&types.DecryptBase64PayloadRequest{Data: []byte("encrypted data")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/decrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Decrypt(gomock.Any(), keyID, gomock.Any(), nil).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
 func (s *keysHandlerTestSuite) TestGet() {
 	s.Run("should execute request successfully", func() {
 		rw := httptest.NewRecorder()
@@ -325,6 +381,52 @@ func (s *keysHandlerTestSuite) TestList() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should execute filtered request with cursor successfully", func() {
+		key := testutils2.FakeKey()
+		after := entities.NewCursor("key0", time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet,
+			fmt.Sprintf("/stores/KeyStore/keys?limit=1&tag.env=prod&curve=secp256k1&disabled=false&order=desc&cursor=%s&full=true", after.Encode()), nil).WithContext(s.ctx)
+
+		disabled := false
+		s.keyStore.EXPECT().Search(gomock.Any(), &entities.ListFilter{
+			Tags:          map[string]string{"env": "prod"},
+			EllipticCurve: "secp256k1",
+			Disabled:      &disabled,
+			SortOrder:     entities.SortOrderDesc,
+			After:         after,
+			Limit:         1,
+		}).Return([]*entities.Key{key}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &struct {
+			Data   []*types.KeyResponse    `json:"data"`
+			Paging http2.PagePagingResponse `json:"paging"`
+		}{}
+		_ = json.Unmarshal(rw.Body.Bytes(), response)
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+		assert.Len(s.T(), response.Data, 1)
+		assert.Equal(s.T(), key.ID, response.Data[0].ID)
+		assert.Equal(s.T(), entities.NewCursor(key.ID, key.Metadata.CreatedAt).Encode(), response.Paging.Cursor)
+	})
+
+	s.Run("should fail with 400 if page is used with filters", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?tag.env=prod&page=1", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if cursor is invalid", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?cursor=invalid", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		rw := httptest.NewRecorder()
@@ -68,7 +68,9 @@ func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	secret, err := secretStore.Set(ctx, id, setSecretRequest.Value, &entities.Attributes{
-		Tags: setSecretRequest.Tags,
+		Tags:     setSecretRequest.Tags,
+		TTL:      formatters.FormatDuration(setSecretRequest.TTL),
+		Recovery: formatters.FormatRecovery(setSecretRequest.RecoveryPeriod),
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -134,15 +136,25 @@ func (h *SecretsHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 // @Tags         Secrets
 // @Accept       json
 // @Produce      json
-// @Param        deleted    query     bool                     false  "filter by deleted accounts"
-// @Param        storeName  path      string                   true   "Store ID"
-// @Param        limit      query     int                      false  "page size"
-// @Param        page       query     int                      false  "page number"
-// @Success      200        {array}   infrahttp.PageResponse   "List of Secret IDs"
-// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
-// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        deleted         query     bool                     false  "filter by deleted accounts"
+// @Param        expired         query     bool                     false  "filter by only expired secrets"
+// @Param        expires_within  query     string                   false  "filter by secrets expiring within a duration, including expired secrets"
+// @Param        tag.key         query     string                   false  "filter by tag, tag.<key>=<value>"
+// @Param        disabled        query     bool                     false  "filter by disabled state"
+// @Param        created_after   query     string                   false  "filter by creation date, RFC3339"
+// @Param        created_before  query     string                   false  "filter by creation date, RFC3339"
+// @Param        sort            query     string                   false  "sort by field"  Enums(created_at, id)
+// @Param        order           query     string                   false  "sort order"     Enums(asc, desc)
+// @Param        cursor          query     string                   false  "cursor of the next page, returned when filtering"
+// @Param        full            query     bool                     false  "return full objects instead of identifiers"
+// @Param        storeName       path      string                   true   "Store ID"
+// @Param        limit           query     int                      false  "page size"
+// @Param        page            query     int                      false  "page number"
+// @Success      200             {array}   infrahttp.PageResponse   "List of Secret IDs"
+// @Failure      401             {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403             {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404             {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500             {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/secrets [get]
 func (h *SecretsHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -159,12 +171,30 @@ func (h *SecretsHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	expiringBefore, err := getExpiringBefore(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	filter, err := getListFilter(request, false)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
 	var ids []string
 	getDeleted := request.URL.Query().Get("deleted")
-	if getDeleted == "" {
-		ids, err = secretStore.List(ctx, limit, offset)
-	} else {
+	switch {
+	case getDeleted != "":
 		ids, err = secretStore.ListDeleted(ctx, limit, offset)
+	case expiringBefore != nil:
+		ids, err = secretStore.ListExpiring(ctx, *expiringBefore, limit, offset)
+	case filter != nil:
+		h.search(rw, request, secretStore, filter)
+		return
+	default:
+		ids, err = secretStore.List(ctx, limit, offset)
 	}
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -178,6 +208,41 @@ func (h *SecretsHandler) list(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+func (h *SecretsHandler) search(rw http.ResponseWriter, request *http.Request, secretStore stores.SecretStore, filter *entities.ListFilter) {
+	items, err := secretStore.Search(request.Context(), filter)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	nextCursor := ""
+	if len(items) > 0 {
+		lastItem := items[len(items)-1]
+		nextCursor = getNextCursor(filter, len(items), lastItem.ID, lastItem.Metadata.CreatedAt)
+	}
+
+	var data interface{}
+	if getFull(request) {
+		resp := make([]*types.SecretResponse, 0, len(items))
+		for _, item := range items {
+			resp = append(resp, formatters.FormatSecretResponse(item))
+		}
+		data = resp
+	} else {
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		data = ids
+	}
+
+	err = infrahttp.WriteCursorPagingResponse(rw, request, data, nextCursor)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Delete a secret by id
 // @Description  Soft delete secret by id. It can be recovered
 // @Tags         Secrets
@@ -219,6 +284,7 @@ func (h *SecretsHandler) delete(rw http.ResponseWriter, request *http.Request) {
 // @Param        storeName  path  string  true  "Secret ID"
 // @Param        id         path  string  true  "Key ID"
 // @Success      204        "Destroyed successfully"
+// @Success      202        {object}  infrahttp.ErrorResponse  "Operation pending approval"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
 // @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret not found"
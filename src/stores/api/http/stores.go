@@ -2,15 +2,26 @@ package http
 
 import (
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
-	http2 "github.com/longfan78/quorum-key-manager/src/infra/http"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
 	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/api/formatters"
+	"github.com/longfan78/quorum-key-manager/src/stores/api/types"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	"github.com/gorilla/mux"
 )
 
+const tagParamPrefix = "tag."
+
 type StoresHandler struct {
+	stores  stores.Stores
 	secrets *SecretsHandler
 	keys    *KeysHandler
 	eth     *EthHandler
@@ -19,6 +30,7 @@ type StoresHandler struct {
 // NewStoresHandler creates a http.Handler to be served on /stores
 func NewStoresHandler(s stores.Stores) *StoresHandler {
 	return &StoresHandler{
+		stores:  s,
 		secrets: NewSecretsHandler(s),
 		keys:    NewKeysHandler(s),
 		eth:     NewEthHandler(s),
@@ -29,6 +41,15 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	// Create subrouter for /stores
 	storesSubrouter := router.PathPrefix("/stores").Subrouter()
 
+	// Register store management routes before the store subrouter which would otherwise match them
+	storesSubrouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	storesSubrouter.Methods(http.MethodPost).Path("/{storeName}").HandlerFunc(h.create)
+	storesSubrouter.Methods(http.MethodGet).Path("/{storeName}").HandlerFunc(h.get)
+	storesSubrouter.Methods(http.MethodPatch).Path("/{storeName}").HandlerFunc(h.update)
+	storesSubrouter.Methods(http.MethodDelete).Path("/{storeName}").HandlerFunc(h.delete)
+	storesSubrouter.Methods(http.MethodPost).Path("/{storeName}/sync").HandlerFunc(h.sync)
+	storesSubrouter.Methods(http.MethodGet).Path("/{storeName}/sync").HandlerFunc(h.getSyncReport)
+
 	// Create subrouter for /stores/{storeName}
 	storeSubrouter := storesSubrouter.PathPrefix("/{storeName}").Subrouter()
 	storeSubrouter.Use(storeSelector)
@@ -46,6 +67,229 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	h.eth.Register(ethSubrouter)
 }
 
+// @Summary      Creates a store
+// @Description  Creates a store and persists its definition so that it is restored on restart
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store identifier"
+// @Param        request    body      types.CreateStoreRequest  true  "Create store request"
+// @Success      200        {object}  types.StoreResponse       "Store data"
+// @Failure      400        {object}  infrahttp.ErrorResponse   "Invalid request format"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      409        {object}  infrahttp.ErrorResponse   "Store already exists"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName} [post]
+func (h *StoresHandler) create(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	createReq := &types.CreateStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	store, err := h.stores.Create(ctx, &entities.StoreDefinition{
+		Name:           mux.Vars(request)["storeName"],
+		StoreType:      createReq.Type,
+		Vault:          createReq.Vault,
+		SecretStore:    createReq.SecretStore,
+		KeyStore:       createReq.KeyStore,
+		AllowedTenants: createReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatStoreResponse(store))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets a store
+// @Description  Gets the definition of a store created through the API
+// @Tags         Stores
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Success      200        {object}  types.StoreResponse      "Store data"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName} [get]
+func (h *StoresHandler) get(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	store, err := h.stores.Inspect(ctx, mux.Vars(request)["storeName"], auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatStoreResponse(store))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists stores
+// @Description  Lists the names of all the stores, including the ones declared in manifests
+// @Tags         Stores
+// @Produce      json
+// @Param        type  query     string                   false  "Store type filter"  Enums(secret, key, ethereum)
+// @Success      200   {array}   string                   "List of store names"
+// @Failure      500   {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores [get]
+func (h *StoresHandler) list(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	storeNames, err := h.stores.List(ctx, request.URL.Query().Get("type"), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	sort.Strings(storeNames)
+	if storeNames == nil {
+		storeNames = []string{}
+	}
+
+	err = infrahttp.WriteJSON(rw, storeNames)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Updates a store
+// @Description  Replaces the definition of a store created through the API and recreates it
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store identifier"
+// @Param        request    body      types.UpdateStoreRequest  true  "Update store request"
+// @Success      200        {object}  types.StoreResponse       "Store data"
+// @Failure      400        {object}  infrahttp.ErrorResponse   "Invalid request format"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName} [patch]
+func (h *StoresHandler) update(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	updateReq := &types.UpdateStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, updateReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	store, err := h.stores.Update(ctx, &entities.StoreDefinition{
+		Name:           mux.Vars(request)["storeName"],
+		Vault:          updateReq.Vault,
+		SecretStore:    updateReq.SecretStore,
+		KeyStore:       updateReq.KeyStore,
+		AllowedTenants: updateReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatStoreResponse(store))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Deletes a store
+// @Description  Deletes a store created through the API. Items indexed for this store are kept
+// @Tags         Stores
+// @Param        storeName  path  string  true  "Store identifier"
+// @Success      204        "Deleted successfully"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName} [delete]
+func (h *StoresHandler) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := h.stores.Delete(ctx, mux.Vars(request)["storeName"], auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Synchronizes a store with its vault
+// @Description  Indexes the items added to the vault of a store, deletes the ones removed from it and updates the tags changed in it, as vaults can be written by other tools. On dry run, the drift is only reported
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store identifier"
+// @Param        request    body      types.SyncStoreRequest    true  "Sync store request"
+// @Success      200        {object}  types.SyncReportResponse  "Sync report"
+// @Failure      400        {object}  infrahttp.ErrorResponse   "Invalid request format"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName}/sync [post]
+func (h *StoresHandler) sync(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	syncReq := &types.SyncStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, syncReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	report, err := h.stores.Sync(ctx, mux.Vars(request)["storeName"], syncReq.DryRun, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatSyncReportResponse(report))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets the last sync report of a store
+// @Description  Gets the drift found by the last synchronization of a store with its vault, scheduled or requested
+// @Tags         Stores
+// @Produce      json
+// @Param        storeName  path      string                    true  "Store identifier"
+// @Success      200        {object}  types.SyncReportResponse  "Sync report"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Store or sync report not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /stores/{storeName}/sync [get]
+func (h *StoresHandler) getSyncReport(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	report, err := h.stores.GetSyncReport(ctx, mux.Vars(request)["storeName"], auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatSyncReportResponse(report))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 func storeSelector(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		h.ServeHTTP(w, r.WithContext(WithStoreName(r.Context(), mux.Vars(r)["storeName"])))
@@ -56,7 +300,7 @@ func getLimitOffset(request *http.Request) (rLimit, rOffset uint64, err error) {
 	limit := request.URL.Query().Get("limit")
 	page := request.URL.Query().Get("page")
 	if limit == "" {
-		limit = http2.DefaultPageSize
+		limit = infrahttp.DefaultPageSize
 	}
 
 	rLimit, err = strconv.ParseUint(limit, 10, 64)
@@ -77,3 +321,120 @@ func getLimitOffset(request *http.Request) (rLimit, rOffset uint64, err error) {
 
 	return rLimit, rOffset, nil
 }
+
+// getExpiringBefore returns the date before which the listed items expire, nil if no expiry filter is requested
+func getExpiringBefore(request *http.Request) (*time.Time, error) {
+	now := time.Now()
+	if request.URL.Query().Get("expired") != "" {
+		return &now, nil
+	}
+
+	expiresWithin := request.URL.Query().Get("expires_within")
+	if expiresWithin == "" {
+		return nil, nil
+	}
+
+	within, err := time.ParseDuration(expiresWithin)
+	if err != nil || within < 0 {
+		return nil, errors.InvalidFormatError("invalid expires_within value")
+	}
+
+	before := now.Add(within)
+	return &before, nil
+}
+
+// getListFilter returns the filter of the listed items, nil if the list is neither filtered, sorted nor paginated by cursor.
+// isFiltered is set when the caller filters on store specific parameters
+func getListFilter(request *http.Request, isFiltered bool) (*entities.ListFilter, error) {
+	query := request.URL.Query()
+	filter := &entities.ListFilter{
+		SortBy:    query.Get("sort"),
+		SortOrder: strings.ToUpper(query.Get("order")),
+	}
+
+	for param, values := range query {
+		if strings.HasPrefix(param, tagParamPrefix) && len(param) > len(tagParamPrefix) {
+			if filter.Tags == nil {
+				filter.Tags = make(map[string]string)
+			}
+			filter.Tags[strings.TrimPrefix(param, tagParamPrefix)] = values[0]
+			isFiltered = true
+		}
+	}
+
+	if filter.SortBy != "" && filter.SortBy != entities.SortByCreatedAt && filter.SortBy != entities.SortByID {
+		return nil, errors.InvalidFormatError("invalid sort value")
+	}
+	if filter.SortOrder != "" && filter.SortOrder != entities.SortOrderAsc && filter.SortOrder != entities.SortOrderDesc {
+		return nil, errors.InvalidFormatError("invalid order value")
+	}
+	isFiltered = isFiltered || filter.SortBy != "" || filter.SortOrder != ""
+
+	if disabled := query.Get("disabled"); disabled != "" {
+		bDisabled, err := strconv.ParseBool(disabled)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid disabled value")
+		}
+		filter.Disabled = &bDisabled
+		isFiltered = true
+	}
+
+	var err error
+	if filter.CreatedAfter, err = getTime(query.Get("created_after")); err != nil {
+		return nil, errors.InvalidFormatError("invalid created_after value")
+	}
+	if filter.CreatedBefore, err = getTime(query.Get("created_before")); err != nil {
+		return nil, errors.InvalidFormatError("invalid created_before value")
+	}
+	isFiltered = isFiltered || filter.CreatedAfter != nil || filter.CreatedBefore != nil
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		filter.After, err = entities.DecodeCursor(cursor)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid cursor value")
+		}
+		isFiltered = true
+	}
+
+	if !isFiltered && query.Get("full") == "" {
+		return nil, nil
+	}
+
+	if query.Get("page") != "" {
+		return nil, errors.InvalidFormatError("page cannot be used with filters, use cursor instead")
+	}
+
+	filter.Limit, _, err = getLimitOffset(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// getNextCursor returns the cursor of the page following the last listed item, empty if the page is the last one
+func getNextCursor(filter *entities.ListFilter, count int, lastID string, lastCreatedAt time.Time) string {
+	if filter.Limit == 0 || uint64(count) < filter.Limit {
+		return ""
+	}
+
+	return entities.NewCursor(lastID, lastCreatedAt).Encode()
+}
+
+func getTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func getFull(request *http.Request) bool {
+	full, _ := strconv.ParseBool(request.URL.Query().Get("full"))
+	return full
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/stores/api/formatters"
+	"github.com/longfan78/quorum-key-manager/src/stores/api/types"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// @Summary      Create an HD wallet
+// @Description  Create a BIP-32 hierarchical deterministic wallet from a new BIP-39 mnemonic, held in the secret store of the Ethereum store. The mnemonic is only returned in this response
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                        true  "Store ID"
+// @Param        request    body      types.CreateEthWalletRequest  true  "Create HD wallet request"
+// @Success      200        {object}  types.EthWalletResponse       "Created HD wallet"
+// @Failure      400        {object}  infrahttp.ErrorResponse       "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse       "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse       "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse       "Store not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse       "HD wallet already exists"
+// @Failure      500        {object}  infrahttp.ErrorResponse       "Internal server error"
+// @Router       /stores/{storeName}/ethereum/wallets [post]
+func (h *EthHandler) createWallet(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	createReq := &types.CreateEthWalletRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	wallet, err := ethStore.CreateWallet(ctx, createReq.ID, &entities.Attributes{Tags: createReq.Tags})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatEthWalletResponse(wallet))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Import an HD wallet
+// @Description  Import a BIP-32 hierarchical deterministic wallet from a BIP-39 mnemonic, held in the secret store of the Ethereum store
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                        true  "Store ID"
+// @Param        request    body      types.ImportEthWalletRequest  true  "Import HD wallet request"
+// @Success      200        {object}  types.EthWalletResponse       "Imported HD wallet"
+// @Failure      400        {object}  infrahttp.ErrorResponse       "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse       "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse       "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse       "Store not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse       "HD wallet already exists"
+// @Failure      500        {object}  infrahttp.ErrorResponse       "Internal server error"
+// @Router       /stores/{storeName}/ethereum/wallets/import [post]
+func (h *EthHandler) importWallet(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	importReq := &types.ImportEthWalletRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, importReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	wallet, err := ethStore.ImportWallet(ctx, importReq.ID, importReq.Mnemonic, &entities.Attributes{Tags: importReq.Tags})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatEthWalletResponse(wallet))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Get an HD wallet
+// @Description  Fetch the HD wallet data by its ID, without its mnemonic
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        id         path      string                   true  "HD wallet ID"
+// @Success      200        {object}  types.EthWalletResponse  "HD wallet found"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/HD wallet not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/wallets/{id} [get]
+func (h *EthHandler) getWallet(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	wallet, err := ethStore.GetWallet(ctx, getID(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatEthWalletResponse(wallet))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      List HD wallets
+// @Description  List the IDs of the HD wallets of the targeted Store
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true   "Store ID"
+// @Param        limit      query     int                      false  "page size"
+// @Param        page       query     int                      false  "page number"
+// @Success      200        {array}   infrahttp.PageResponse   "HD wallet IDs"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/wallets [get]
+func (h *EthHandler) listWallets(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	limit, offset, err := getLimitOffset(request)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ids, err := ethStore.ListWallets(ctx, limit, offset)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WritePagingResponse(rw, request, ids)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Derive an Ethereum Account
+// @Description  Derive the Ethereum Account of a BIP-32 path from an HD wallet, by default the BIP-44 path m/44'/60'/0'/0/{index}. Its key is imported into the key store of the Ethereum store
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                         true  "Store ID"
+// @Param        id         path      string                         true  "HD wallet ID"
+// @Param        request    body      types.DeriveEthAccountRequest  true  "Derive Ethereum Account request"
+// @Success      200        {object}  types.EthAccountResponse       "Derived Ethereum Account"
+// @Failure      400        {object}  infrahttp.ErrorResponse        "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse        "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse        "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse        "Store/HD wallet not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse        "Ethereum Account already derived"
+// @Failure      500        {object}  infrahttp.ErrorResponse        "Internal server error"
+// @Router       /stores/{storeName}/ethereum/wallets/{id}/derive [post]
+func (h *EthHandler) deriveAccount(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	deriveReq := &types.DeriveEthAccountRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, deriveReq)
+	if err != nil && err.Error() != "EOF" {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	var keyID string
+	if deriveReq.KeyID != "" {
+		keyID = deriveReq.KeyID
+	} else {
+		keyID = generateRandomKeyID()
+	}
+
+	ethAcc, err := ethStore.DeriveAccount(ctx, getID(request), formatters.FormatDerivationPath(deriveReq.Path, deriveReq.Index), keyID, &entities.Attributes{
+		Tags:       deriveReq.Tags,
+		TTL:        formatters.FormatDuration(deriveReq.TTL),
+		Recovery:   formatters.FormatRecovery(deriveReq.RecoveryPeriod),
+		Operations: formatters.FormatOperations(deriveReq.Operations),
+	})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatEthAccResponse(ethAcc))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
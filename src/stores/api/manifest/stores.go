@@ -46,6 +46,11 @@ func (h *StoresHandler) Register(ctx context.Context, mnfs []entities2.Manifest)
 	return nil
 }
 
+// Update recreates the stores of changed manifests, replacing the running ones
+func (h *StoresHandler) Update(ctx context.Context, mnfs []entities2.Manifest) error {
+	return h.Register(ctx, mnfs)
+}
+
 func (h *StoresHandler) CreateSecret(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
 	createReq := &types.CreateSecretStoreRequest{}
 	err := json.UnmarshalYAML(specs, createReq)
@@ -83,7 +88,7 @@ func (h *StoresHandler) CreateEthereum(ctx context.Context, name string, allowed
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, allowedTenants, h.userInfo)
+	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, createReq.SecretStore, allowedTenants, h.userInfo)
 	if err != nil {
 		return err
 	}
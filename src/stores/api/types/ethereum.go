@@ -17,14 +17,42 @@ const (
 )
 
 type CreateEthAccountRequest struct {
-	KeyID string            `json:"keyId,omitempty" example:"my-key-account"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	KeyID          string            `json:"keyId,omitempty" example:"my-key-account"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	TTL            string            `json:"ttl,omitempty" validate:"omitempty,isDuration" example:"24h"`
+	RecoveryPeriod string            `json:"recoveryPeriod,omitempty" validate:"omitempty,isDuration" example:"168h"`
+	Operations     []string          `json:"operations,omitempty" validate:"omitempty,unique,dive,oneof=signing encryption" example:"signing" enums:"signing,encryption"`
 }
 
 type ImportEthAccountRequest struct {
-	KeyID      string            `json:"keyId,omitempty" example:"my-imported-key-account"`
-	PrivateKey hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
-	Tags       map[string]string `json:"tags,omitempty"`
+	KeyID          string            `json:"keyId,omitempty" example:"my-imported-key-account"`
+	PrivateKey     hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	TTL            string            `json:"ttl,omitempty" validate:"omitempty,isDuration" example:"24h"`
+	RecoveryPeriod string            `json:"recoveryPeriod,omitempty" validate:"omitempty,isDuration" example:"168h"`
+	Operations     []string          `json:"operations,omitempty" validate:"omitempty,unique,dive,oneof=signing encryption" example:"signing" enums:"signing,encryption"`
+}
+
+type CreateEthWalletRequest struct {
+	ID   string            `json:"id" validate:"required" example:"my-wallet"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+type ImportEthWalletRequest struct {
+	ID       string            `json:"id" validate:"required" example:"my-wallet"`
+	Mnemonic string            `json:"mnemonic" validate:"required" example:"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"`
+	Tags     map[string]string `json:"tags,omitempty"`
+}
+
+type DeriveEthAccountRequest struct {
+	KeyID string `json:"keyId,omitempty" example:"my-derived-key-account"`
+	// Path defaults to the BIP-44 Ethereum path of Index
+	Path           string            `json:"path,omitempty" example:"m/44'/60'/0'/0/0"`
+	Index          uint32            `json:"index,omitempty" example:"0"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	TTL            string            `json:"ttl,omitempty" validate:"omitempty,isDuration" example:"24h"`
+	RecoveryPeriod string            `json:"recoveryPeriod,omitempty" validate:"omitempty,isDuration" example:"168h"`
+	Operations     []string          `json:"operations,omitempty" validate:"omitempty,unique,dive,oneof=signing encryption" example:"signing" enums:"signing,encryption"`
 }
 
 type UpdateEthAccountRequest struct {
@@ -35,6 +63,14 @@ type SignMessageRequest struct {
 	Message hexutil.Bytes `json:"message" validate:"required" example:"0xfeade..." swaggertype:"string"`
 }
 
+type EncryptEthRequest struct {
+	Data hexutil.Bytes `json:"data" validate:"required" example:"0xfeade..." swaggertype:"string"`
+}
+
+type DecryptEthRequest struct {
+	Data hexutil.Bytes `json:"data" validate:"required" example:"0x049cf4..." swaggertype:"string"`
+}
+
 type SignTypedDataRequest struct {
 	DomainSeparator DomainSeparator        `json:"domainSeparator" validate:"required"`
 	Types           map[string][]Type      `json:"types" validate:"required"`
@@ -96,9 +132,21 @@ type EthAccountResponse struct {
 	CompressedPublicKey hexutil.Bytes     `json:"compressedPublicKey" example:"0x6019a3c8..." swaggertype:"string"`
 	CreatedAt           time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt           time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+	ExpireAt            *time.Time        `json:"expireAt,omitempty" example:"2020-07-10T12:35:42.115395Z"`
 	DeletedAt           *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
 	KeyID               string            `json:"keyId" example:"my-key-id"`
 	Tags                map[string]string `json:"tags,omitempty"`
 	Address             common.Address    `json:"address" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6" swaggertype:"string"`
 	Disabled            bool              `json:"disabled" example:"false"`
+	Operations          []string          `json:"operations,omitempty" example:"signing"`
+	WalletID            string            `json:"walletId,omitempty" example:"my-wallet"`
+	DerivationPath      string            `json:"derivationPath,omitempty" example:"m/44'/60'/0'/0/0"`
+}
+
+type EthWalletResponse struct {
+	ID        string            `json:"id" example:"my-wallet"`
+	Mnemonic  string            `json:"mnemonic,omitempty" example:"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 }
@@ -10,6 +10,10 @@ type CreateKeyRequest struct {
 	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1"`
 	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	RotationPolicy   *RotationPolicy   `json:"rotationPolicy,omitempty"`
+	TTL              string            `json:"ttl,omitempty" validate:"omitempty,isDuration" example:"24h"`
+	RecoveryPeriod   string            `json:"recoveryPeriod,omitempty" validate:"omitempty,isDuration" example:"168h"`
+	Operations       []string          `json:"operations,omitempty" validate:"omitempty,unique,dive,oneof=signing encryption" example:"signing" enums:"signing,encryption"`
 }
 
 type ImportKeyRequest struct {
@@ -17,16 +21,33 @@ type ImportKeyRequest struct {
 	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
 	PrivateKey       []byte            `json:"privateKey" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	RotationPolicy   *RotationPolicy   `json:"rotationPolicy,omitempty"`
+	TTL              string            `json:"ttl,omitempty" validate:"omitempty,isDuration" example:"24h"`
+	RecoveryPeriod   string            `json:"recoveryPeriod,omitempty" validate:"omitempty,isDuration" example:"168h"`
+	Operations       []string          `json:"operations,omitempty" validate:"omitempty,unique,dive,oneof=signing encryption" example:"signing" enums:"signing,encryption"`
 }
 
 type UpdateKeyRequest struct {
-	Tags map[string]string `json:"tags,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	RotationPolicy *RotationPolicy   `json:"rotationPolicy,omitempty"`
+}
+
+type RotationPolicy struct {
+	Interval string `json:"interval" validate:"required,isDuration" example:"720h"`
 }
 
 type SignBase64PayloadRequest struct {
 	Data []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 }
 
+type EncryptBase64PayloadRequest struct {
+	Data []byte `json:"data" validate:"required" example:"bXkgc2VjcmV0IGRhdGE=" swaggertype:"string"`
+}
+
+type DecryptBase64PayloadRequest struct {
+	Data []byte `json:"data" validate:"required" example:"BJz0ak1tXzPfCp5VUjTUwB9Ov4xqYFoBIAw3...=" swaggertype:"string"`
+}
+
 type KeyResponse struct {
 	ID               string               `json:"id" example:"my-key"`
 	PublicKey        string               `json:"publicKey" example:"Cjix/fS3WdqKGKabagBNYwcClan5aImoFpnjSF0cqJs=" swaggertype:"string"`
@@ -34,8 +55,13 @@ type KeyResponse struct {
 	SigningAlgorithm string               `json:"signingAlgorithm" example:"ecdsa"`
 	Tags             map[string]string    `json:"tags,omitempty"`
 	Annotations      *entities.Annotation `json:"annotations,omitempty"`
+	Version          string               `json:"version,omitempty" example:"2"`
+	RotationPolicy   *RotationPolicy      `json:"rotationPolicy,omitempty"`
 	Disabled         bool                 `json:"disabled" example:"false"`
+	Operations       []string             `json:"operations,omitempty" example:"signing"`
 	CreatedAt        time.Time            `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt        time.Time            `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+	RotatedAt        *time.Time           `json:"rotatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	ExpireAt         *time.Time           `json:"expireAt,omitempty" example:"2020-07-10T12:35:42.115395Z"`
 	DeletedAt        *time.Time           `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
 }
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 type CreateSecretStoreRequest struct {
 	Vault string `json:"vault" validate:"required" yaml:"vault" example:"hashicorp-kv-v2"`
 }
@@ -10,5 +12,47 @@ type CreateKeyStoreRequest struct {
 }
 
 type CreateEthereumStoreRequest struct {
-	KeyStore string `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	KeyStore    string `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	SecretStore string `json:"secretStore,omitempty" yaml:"secret_store,omitempty" example:"my-secret-store"`
+}
+
+type CreateStoreRequest struct {
+	Type           string   `json:"type" validate:"required,oneof=secret key ethereum" example:"key"`
+	Vault          string   `json:"vault,omitempty" example:"hashicorp-quorum"`
+	SecretStore    string   `json:"secretStore,omitempty" example:"my-secret-store"`
+	KeyStore       string   `json:"keyStore,omitempty" example:"my-key-store"`
+	AllowedTenants []string `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+type UpdateStoreRequest struct {
+	Vault          string   `json:"vault,omitempty" example:"hashicorp-quorum"`
+	SecretStore    string   `json:"secretStore,omitempty" example:"my-secret-store"`
+	KeyStore       string   `json:"keyStore,omitempty" example:"my-key-store"`
+	AllowedTenants []string `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+type StoreResponse struct {
+	Name           string    `json:"name" example:"my-key-store"`
+	Type           string    `json:"type" example:"key"`
+	Vault          string    `json:"vault,omitempty" example:"hashicorp-quorum"`
+	SecretStore    string    `json:"secretStore,omitempty" example:"my-secret-store"`
+	KeyStore       string    `json:"keyStore,omitempty" example:"my-key-store"`
+	AllowedTenants []string  `json:"allowedTenants" example:"tenant1,tenant2"`
+	CreatedAt      time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type SyncStoreRequest struct {
+	DryRun bool `json:"dryRun,omitempty" example:"true"`
+}
+
+type SyncReportResponse struct {
+	StoreName   string    `json:"storeName" example:"my-key-store"`
+	DryRun      bool      `json:"dryRun" example:"true"`
+	Added       []string  `json:"added" example:"my-key"`
+	Deleted     []string  `json:"deleted" example:"my-deleted-key"`
+	Updated     []string  `json:"updated" example:"my-tagged-key"`
+	Failed      []string  `json:"failed" example:"my-unreadable-key"`
+	StartedAt   time.Time `json:"startedAt" example:"2020-07-09T12:35:42.115395Z"`
+	CompletedAt time.Time `json:"completedAt" example:"2020-07-09T12:35:43.115395Z"`
 }
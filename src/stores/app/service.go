@@ -1,25 +1,55 @@
 package app
 
 import (
+	"github.com/longfan78/quorum-key-manager/pkg/app"
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/audit"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/policies"
 	"github.com/longfan78/quorum-key-manager/src/stores/api/http"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/reaper"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/rotation"
 	"github.com/longfan78/quorum-key-manager/src/stores/connectors/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/synchronizer"
 	db "github.com/longfan78/quorum-key-manager/src/stores/database/postgres"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	"github.com/longfan78/quorum-key-manager/src/vaults"
-	"github.com/gorilla/mux"
 )
 
-func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, vaultsService vaults.Vaults) *stores.Connector {
+func RegisterService(a *app.App, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, vaultsService vaults.Vaults, auditor audit.Auditor, policiesService policies.Policies, approvalsService approvals.Approvals, rotationCfg *entities.RotationConfig, expiryCfg *entities.ExpiryConfig, syncCfg *entities.SyncConfig, quotaCfg *entities.QuotaConfig, notifier cluster.Notifier, elector cluster.Elector) (*stores.Connector, error) {
 	// Data layer
 	storesDB := db.New(logger, postgresClient)
 
 	// Business layer
-	storesService := stores.NewConnector(roles, storesDB, vaultsService, logger)
+	storesService := stores.NewConnector(roles, storesDB, vaultsService, auditor, policiesService, approvalsService, quotaCfg, notifier, logger)
+	notifier.Subscribe(cluster.KindStore, storesService.Refresh)
+
+	if rotationCfg != nil && rotationCfg.CheckInterval > 0 {
+		err := a.RegisterService(rotation.New(storesService, rotationCfg, elector, logger.WithComponent("rotation")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if expiryCfg != nil && expiryCfg.ReaperInterval > 0 {
+		err := a.RegisterService(reaper.New(storesService, expiryCfg, elector, logger.WithComponent("reaper")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if syncCfg != nil && syncCfg.Interval > 0 {
+		err := a.RegisterService(synchronizer.New(storesService, syncCfg, elector, logger.WithComponent("sync")))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Service layer
-	http.NewStoresHandler(storesService).Register(router)
+	http.NewStoresHandler(storesService).Register(a.Router())
 
-	return storesService
+	return storesService, nil
 }
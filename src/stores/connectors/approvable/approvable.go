@@ -0,0 +1,43 @@
+package approvable
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+type approver struct {
+	approvals approvals.Approvals
+	storeName string
+	userInfo  *authtypes.UserInfo
+	logger    log.Logger
+}
+
+// execute runs an operation only once approved, if it requires approvals, and marks its approval as executed on success
+func (a *approver) execute(ctx context.Context, req *entities.Request, operation func() error) error {
+	if isWithoutApproval(ctx) {
+		return operation()
+	}
+
+	req.StoreName = a.storeName
+
+	approved, err := a.approvals.Authorize(ctx, req, a.userInfo)
+	if err != nil {
+		return err
+	}
+
+	err = operation()
+	if err != nil || approved == nil {
+		return err
+	}
+
+	// The operation was executed so its result is returned even if the approval cannot be marked as executed
+	if err = a.approvals.Complete(ctx, approved.ID); err != nil {
+		a.logger.WithError(err).Error("failed to mark approved operation as executed", "id", approved.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package approvable
+
+import "context"
+
+type contextKey struct{}
+
+// WithoutApproval exempts the operations run with the returned context from approvals. It must only be used for
+// operations decided by the key manager itself, such as the destruction of expired items by the reaper
+func WithoutApproval(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+func isWithoutApproval(ctx context.Context) bool {
+	exempted, _ := ctx.Value(contextKey{}).(bool)
+	return exempted
+}
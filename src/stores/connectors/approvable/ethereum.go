@@ -0,0 +1,93 @@
+package approvable
+
+import (
+	"context"
+	"math/big"
+
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+)
+
+// EthStore requires approvals before destroying accounts and signing high-value transactions
+type EthStore struct {
+	stores.EthStore
+	approver
+}
+
+var _ stores.EthStore = &EthStore{}
+
+func NewEthStore(store stores.EthStore, approvalsService approvals.Approvals, storeName string, userInfo *authtypes.UserInfo, logger log.Logger) *EthStore {
+	return &EthStore{
+		EthStore: store,
+		approver: approver{approvals: approvalsService, storeName: storeName, userInfo: userInfo, logger: logger},
+	}
+}
+
+func (s *EthStore) Destroy(ctx context.Context, addr common.Address) error {
+	req := &entities.Request{Type: entities.DestroyOperation, Resource: string(authtypes.ResourceEthAccount), ResourceID: addr.Hex()}
+	return s.execute(ctx, req, func() error {
+		return s.EthStore.Destroy(ctx, addr)
+	})
+}
+
+// transactionPayload identifies a transaction to approve by the fields set by its sender, as the node proxy fills the
+// nonce, gas and fees again each time a transaction is sent
+type transactionPayload struct {
+	ChainID     *big.Int              `json:"chainId,omitempty"`
+	To          *common.Address       `json:"to"`
+	Value       *big.Int              `json:"value"`
+	Data        hexutil.Bytes         `json:"data,omitempty"`
+	PrivateArgs *ethereum.PrivateArgs `json:"privateArgs,omitempty"`
+}
+
+func (s *EthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
+	payload := &transactionPayload{ChainID: chainID, To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+	return s.sign(ctx, entities.SignTransactionOperation, addr, payload, tx.Value(), func() ([]byte, error) {
+		return s.EthStore.SignTransaction(ctx, addr, chainID, tx)
+	})
+}
+
+func (s *EthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+	payload := &transactionPayload{ChainID: chainID, To: tx.To(), Value: tx.Value(), Data: tx.Data(), PrivateArgs: args}
+	return s.sign(ctx, entities.SignEEAOperation, addr, payload, tx.Value(), func() ([]byte, error) {
+		return s.EthStore.SignEEA(ctx, addr, chainID, tx, args)
+	})
+}
+
+func (s *EthStore) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+	// The data of a private transaction is the hash of its payload, stored again in Tessera each time it is sent
+	payload := &transactionPayload{To: tx.To(), Value: tx.Value()}
+	return s.sign(ctx, entities.SignPrivateOperation, addr, payload, tx.Value(), func() ([]byte, error) {
+		return s.EthStore.SignPrivate(ctx, addr, tx)
+	})
+}
+
+func (s *EthStore) sign(ctx context.Context, operation string, addr common.Address, payload interface{}, value *big.Int, sign func() ([]byte, error)) ([]byte, error) {
+	req := &entities.Request{
+		Type:       operation,
+		Resource:   string(authtypes.ResourceEthAccount),
+		ResourceID: addr.Hex(),
+		Payload:    payload,
+		Value:      value,
+	}
+
+	var signedTx []byte
+	err := s.execute(ctx, req, func() error {
+		var err error
+		signedTx, err = sign()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
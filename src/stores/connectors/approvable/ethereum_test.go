@@ -0,0 +1,59 @@
+package approvable
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	approvalsmock "github.com/longfan78/quorum-key-manager/src/approvals/mock"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthStoreSign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockEthStore(ctrl)
+	approvals := approvalsmock.NewMockApprovals(ctrl)
+	userInfo := &authtypes.UserInfo{Username: "alice", Tenant: "tenant1"}
+	ethStore := NewEthStore(store, approvals, "my-store", userInfo, testutils.NewMockLogger(ctrl))
+
+	ctx := context.Background()
+	addr := common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+	to := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	chainID := big.NewInt(1)
+
+	t.Run("should sign raw data without approval", func(t *testing.T) {
+		store.EXPECT().SignMessage(gomock.Any(), addr, []byte("my data")).Return([]byte("signature"), nil)
+
+		signature, err := ethStore.SignMessage(ctx, addr, []byte("my data"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("signature"), signature)
+	})
+
+	t.Run("should request the same approval for a transaction sent again with another nonce and gas", func(t *testing.T) {
+		var payloads []interface{}
+		approvals.EXPECT().Authorize(gomock.Any(), gomock.Any(), userInfo).DoAndReturn(func(_ context.Context, req *entities.Request, _ *authtypes.UserInfo) (*entities.Operation, error) {
+			assert.Equal(t, entities.SignTransactionOperation, req.Type)
+			assert.Equal(t, big.NewInt(5000), req.Value)
+			payloads = append(payloads, req.Payload)
+			return nil, nil
+		}).Times(2)
+		store.EXPECT().SignTransaction(gomock.Any(), addr, chainID, gomock.Any()).Return([]byte("signed"), nil).Times(2)
+
+		_, err := ethStore.SignTransaction(ctx, addr, chainID, types.NewTransaction(1, to, big.NewInt(5000), 21000, big.NewInt(10), []byte("data")))
+		require.NoError(t, err)
+		_, err = ethStore.SignTransaction(ctx, addr, chainID, types.NewTransaction(2, to, big.NewInt(5000), 25000, big.NewInt(12), []byte("data")))
+		require.NoError(t, err)
+
+		assert.Equal(t, payloads[0], payloads[1])
+	})
+}
@@ -0,0 +1,33 @@
+package approvable
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+)
+
+// KeyStore requires approvals before destroying keys
+type KeyStore struct {
+	stores.KeyStore
+	approver
+}
+
+var _ stores.KeyStore = &KeyStore{}
+
+func NewKeyStore(store stores.KeyStore, approvalsService approvals.Approvals, storeName string, userInfo *authtypes.UserInfo, logger log.Logger) *KeyStore {
+	return &KeyStore{
+		KeyStore: store,
+		approver: approver{approvals: approvalsService, storeName: storeName, userInfo: userInfo, logger: logger},
+	}
+}
+
+func (s *KeyStore) Destroy(ctx context.Context, id string) error {
+	req := &entities.Request{Type: entities.DestroyOperation, Resource: string(authtypes.ResourceKey), ResourceID: id}
+	return s.execute(ctx, req, func() error {
+		return s.KeyStore.Destroy(ctx, id)
+	})
+}
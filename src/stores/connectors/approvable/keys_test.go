@@ -0,0 +1,72 @@
+package approvable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	approvalsmock "github.com/longfan78/quorum-key-manager/src/approvals/mock"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStoreDestroy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockKeyStore(ctrl)
+	approvals := approvalsmock.NewMockApprovals(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	userInfo := &authtypes.UserInfo{Username: "alice", Tenant: "tenant1"}
+	keyStore := NewKeyStore(store, approvals, "my-store", userInfo, logger)
+
+	ctx := context.Background()
+	id := "my-key"
+	expectedReq := &entities.Request{Type: entities.DestroyOperation, Resource: string(authtypes.ResourceKey), StoreName: "my-store", ResourceID: id}
+
+	t.Run("should not destroy the key while the operation is pending approval", func(t *testing.T) {
+		expectedErr := errors.PendingApprovalError("error")
+		approvals.EXPECT().Authorize(gomock.Any(), expectedReq, userInfo).Return(nil, expectedErr)
+
+		err := keyStore.Destroy(ctx, id)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should destroy the key and complete the approved operation", func(t *testing.T) {
+		approvals.EXPECT().Authorize(gomock.Any(), expectedReq, userInfo).Return(&entities.Operation{ID: 1, Status: entities.ApprovedStatus}, nil)
+		store.EXPECT().Destroy(gomock.Any(), id).Return(nil)
+		approvals.EXPECT().Complete(gomock.Any(), uint64(1)).Return(nil)
+
+		err := keyStore.Destroy(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("should destroy the key without approval when exempted", func(t *testing.T) {
+		store.EXPECT().Destroy(gomock.Any(), id).Return(nil)
+
+		err := keyStore.Destroy(WithoutApproval(ctx), id)
+		require.NoError(t, err)
+	})
+
+	t.Run("should destroy the key when no approval is required", func(t *testing.T) {
+		approvals.EXPECT().Authorize(gomock.Any(), expectedReq, userInfo).Return(nil, nil)
+		store.EXPECT().Destroy(gomock.Any(), id).Return(nil)
+
+		err := keyStore.Destroy(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("should not complete the operation if the destruction fails", func(t *testing.T) {
+		expectedErr := errors.HashicorpVaultError("error")
+		approvals.EXPECT().Authorize(gomock.Any(), expectedReq, userInfo).Return(&entities.Operation{ID: 1, Status: entities.ApprovedStatus}, nil)
+		store.EXPECT().Destroy(gomock.Any(), id).Return(expectedErr)
+
+		err := keyStore.Destroy(ctx, id)
+		assert.Equal(t, expectedErr, err)
+	})
+}
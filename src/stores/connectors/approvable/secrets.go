@@ -0,0 +1,33 @@
+package approvable
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/approvals/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+)
+
+// SecretStore requires approvals before destroying secrets
+type SecretStore struct {
+	stores.SecretStore
+	approver
+}
+
+var _ stores.SecretStore = &SecretStore{}
+
+func NewSecretStore(store stores.SecretStore, approvalsService approvals.Approvals, storeName string, userInfo *authtypes.UserInfo, logger log.Logger) *SecretStore {
+	return &SecretStore{
+		SecretStore: store,
+		approver:    approver{approvals: approvalsService, storeName: storeName, userInfo: userInfo, logger: logger},
+	}
+}
+
+func (s *SecretStore) Destroy(ctx context.Context, id string) error {
+	req := &entities.Request{Type: entities.DestroyOperation, Resource: string(authtypes.ResourceSecret), ResourceID: id}
+	return s.execute(ctx, req, func() error {
+		return s.SecretStore.Destroy(ctx, id)
+	})
+}
@@ -0,0 +1,65 @@
+package audited
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	"github.com/longfan78/quorum-key-manager/src/audit/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+)
+
+type recorder struct {
+	auditor   audit.Auditor
+	storeName string
+	userInfo  *authtypes.UserInfo
+}
+
+// record appends the outcome of an operation to the audit log and counts it in the store metrics. When the event cannot
+// be recorded the operation fails so that no unaudited result is returned to the caller
+func (r *recorder) record(ctx context.Context, operation string, resource authtypes.OpResource, resourceID string, payload interface{}, opErr error) error {
+	event := &entities.Event{
+		Username:    r.userInfo.Username,
+		Tenant:      r.userInfo.Tenant,
+		Operation:   operation,
+		Resource:    string(resource),
+		StoreName:   r.storeName,
+		ResourceID:  resourceID,
+		PayloadHash: hashPayload(payload),
+		Outcome:     entities.SuccessOutcome,
+	}
+
+	if opErr != nil {
+		event.Outcome = entities.FailureOutcome
+		event.Error = opErr.Error()
+	}
+
+	metrics.ObserveStoreOperation(r.storeName, event.Resource, operation, string(event.Outcome))
+
+	err := r.auditor.Record(ctx, event)
+	if opErr != nil {
+		return opErr
+	}
+
+	return err
+}
+
+func hashPayload(payload interface{}) string {
+	if payload == nil {
+		return ""
+	}
+
+	data, ok := payload.([]byte)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(payload); err != nil {
+			return ""
+		}
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,184 @@
+package audited
+
+import (
+	"context"
+	"math/big"
+
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	auditentities "github.com/longfan78/quorum-key-manager/src/audit/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// EthStore records the sensitive operations of an Ethereum store in the audit log
+type EthStore struct {
+	stores.EthStore
+	recorder
+}
+
+var _ stores.EthStore = &EthStore{}
+
+func NewEthStore(store stores.EthStore, auditor audit.Auditor, storeName string, userInfo *authtypes.UserInfo) *EthStore {
+	return &EthStore{
+		EthStore: store,
+		recorder: recorder{auditor: auditor, storeName: storeName, userInfo: userInfo},
+	}
+}
+
+func (s *EthStore) Create(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.Create(ctx, id, attr)
+	err = s.record(ctx, auditentities.CreateOperation, authtypes.ResourceEthAccount, accountID(account, id), nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) Import(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.Import(ctx, id, privKey, attr)
+	err = s.record(ctx, auditentities.ImportOperation, authtypes.ResourceEthAccount, accountID(account, id), nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) CreateWallet(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	wallet, err := s.EthStore.CreateWallet(ctx, id, attr)
+	err = s.record(ctx, auditentities.CreateOperation, authtypes.ResourceEthAccount, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func (s *EthStore) ImportWallet(ctx context.Context, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	wallet, err := s.EthStore.ImportWallet(ctx, id, mnemonic, attr)
+	err = s.record(ctx, auditentities.ImportOperation, authtypes.ResourceEthAccount, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func (s *EthStore) DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.DeriveAccount(ctx, walletID, path, id, attr)
+	err = s.record(ctx, auditentities.DeriveOperation, authtypes.ResourceEthAccount, accountID(account, id), path, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.Update(ctx, addr, attr)
+	err = s.record(ctx, auditentities.UpdateOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) Enable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.Enable(ctx, addr)
+	err = s.record(ctx, auditentities.EnableOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) Disable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	account, err := s.EthStore.Disable(ctx, addr)
+	err = s.record(ctx, auditentities.DisableOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *EthStore) Delete(ctx context.Context, addr common.Address) error {
+	err := s.EthStore.Delete(ctx, addr)
+	return s.record(ctx, auditentities.DeleteOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+}
+
+func (s *EthStore) Restore(ctx context.Context, addr common.Address) error {
+	err := s.EthStore.Restore(ctx, addr)
+	return s.record(ctx, auditentities.RestoreOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+}
+
+func (s *EthStore) Destroy(ctx context.Context, addr common.Address) error {
+	err := s.EthStore.Destroy(ctx, addr)
+	return s.record(ctx, auditentities.DestroyOperation, authtypes.ResourceEthAccount, addr.Hex(), nil, err)
+}
+
+func (s *EthStore) Sign(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	signature, err := s.EthStore.Sign(ctx, addr, data)
+	return s.recordResult(ctx, auditentities.SignOperation, addr, data, signature, err)
+}
+
+func (s *EthStore) SignMessage(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	signature, err := s.EthStore.SignMessage(ctx, addr, data)
+	return s.recordResult(ctx, auditentities.SignMessageOperation, addr, data, signature, err)
+}
+
+func (s *EthStore) SignTypedData(ctx context.Context, addr common.Address, typedData *core.TypedData) ([]byte, error) {
+	signature, err := s.EthStore.SignTypedData(ctx, addr, typedData)
+	return s.recordResult(ctx, auditentities.SignTypedDataOperation, addr, typedData, signature, err)
+}
+
+func (s *EthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
+	signedTx, err := s.EthStore.SignTransaction(ctx, addr, chainID, tx)
+	return s.recordResult(ctx, auditentities.SignTransactionOperation, addr, tx, signedTx, err)
+}
+
+func (s *EthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+	signedTx, err := s.EthStore.SignEEA(ctx, addr, chainID, tx, args)
+	return s.recordResult(ctx, auditentities.SignEEAOperation, addr, tx, signedTx, err)
+}
+
+func (s *EthStore) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+	signedTx, err := s.EthStore.SignPrivate(ctx, addr, tx)
+	return s.recordResult(ctx, auditentities.SignPrivateOperation, addr, tx, signedTx, err)
+}
+
+func (s *EthStore) Encrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	encryptedData, err := s.EthStore.Encrypt(ctx, addr, data)
+	return s.recordResult(ctx, auditentities.EncryptOperation, addr, data, encryptedData, err)
+}
+
+func (s *EthStore) Decrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	decryptedData, err := s.EthStore.Decrypt(ctx, addr, data)
+	return s.recordResult(ctx, auditentities.DecryptOperation, addr, data, decryptedData, err)
+}
+
+func (s *EthStore) recordResult(ctx context.Context, operation string, addr common.Address, payload interface{}, result []byte, err error) ([]byte, error) {
+	err = s.record(ctx, operation, authtypes.ResourceEthAccount, addr.Hex(), payload, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func accountID(account *entities.ETHAccount, id string) string {
+	if account == nil {
+		return id
+	}
+
+	return account.Address.Hex()
+}
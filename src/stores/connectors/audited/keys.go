@@ -0,0 +1,132 @@
+package audited
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	auditentities "github.com/longfan78/quorum-key-manager/src/audit/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// KeyStore records the sensitive operations of a key store in the audit log
+type KeyStore struct {
+	stores.KeyStore
+	recorder
+}
+
+var _ stores.KeyStore = &KeyStore{}
+
+func NewKeyStore(store stores.KeyStore, auditor audit.Auditor, storeName string, userInfo *authtypes.UserInfo) *KeyStore {
+	return &KeyStore{
+		KeyStore: store,
+		recorder: recorder{auditor: auditor, storeName: storeName, userInfo: userInfo},
+	}
+}
+
+func (s *KeyStore) Create(ctx context.Context, id string, alg *entities.Algorithm, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Create(ctx, id, alg, attr)
+	err = s.record(ctx, auditentities.CreateOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities.Algorithm, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Import(ctx, id, privKey, alg, attr)
+	err = s.record(ctx, auditentities.ImportOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Update(ctx context.Context, id string, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Update(ctx, id, attr)
+	err = s.record(ctx, auditentities.UpdateOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Rotate(ctx context.Context, id string, alg *entities.Algorithm) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Rotate(ctx, id, alg)
+	err = s.record(ctx, auditentities.RotateOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Enable(ctx context.Context, id string) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Enable(ctx, id)
+	err = s.record(ctx, auditentities.EnableOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Disable(ctx context.Context, id string) (*storeentities.Key, error) {
+	key, err := s.KeyStore.Disable(ctx, id)
+	err = s.record(ctx, auditentities.DisableOperation, authtypes.ResourceKey, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *KeyStore) Delete(ctx context.Context, id string) error {
+	err := s.KeyStore.Delete(ctx, id)
+	return s.record(ctx, auditentities.DeleteOperation, authtypes.ResourceKey, id, nil, err)
+}
+
+func (s *KeyStore) Restore(ctx context.Context, id string) error {
+	err := s.KeyStore.Restore(ctx, id)
+	return s.record(ctx, auditentities.RestoreOperation, authtypes.ResourceKey, id, nil, err)
+}
+
+func (s *KeyStore) Destroy(ctx context.Context, id string) error {
+	err := s.KeyStore.Destroy(ctx, id)
+	return s.record(ctx, auditentities.DestroyOperation, authtypes.ResourceKey, id, nil, err)
+}
+
+func (s *KeyStore) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	signature, err := s.KeyStore.Sign(ctx, id, data, algo)
+	err = s.record(ctx, auditentities.SignOperation, authtypes.ResourceKey, id, data, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func (s *KeyStore) Encrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	encryptedData, err := s.KeyStore.Encrypt(ctx, id, data, algo)
+	err = s.record(ctx, auditentities.EncryptOperation, authtypes.ResourceKey, id, data, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptedData, nil
+}
+
+func (s *KeyStore) Decrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	decryptedData, err := s.KeyStore.Decrypt(ctx, id, data, algo)
+	err = s.record(ctx, auditentities.DecryptOperation, authtypes.ResourceKey, id, data, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptedData, nil
+}
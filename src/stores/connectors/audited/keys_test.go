@@ -0,0 +1,74 @@
+package audited
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auditentities "github.com/longfan78/quorum-key-manager/src/audit/entities"
+	auditmock "github.com/longfan78/quorum-key-manager/src/audit/mock"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStoreSign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockKeyStore(ctrl)
+	auditor := auditmock.NewMockAuditor(ctrl)
+	userInfo := &authtypes.UserInfo{Username: "alice", Tenant: "tenant1"}
+	keyStore := NewKeyStore(store, auditor, "my-store", userInfo)
+
+	ctx := context.Background()
+	id := "my-key"
+	data := []byte("my data to sign")
+
+	t.Run("should record a successful signature", func(t *testing.T) {
+		signature := []byte("signature")
+
+		store.EXPECT().Sign(gomock.Any(), id, data, nil).Return(signature, nil)
+		auditor.EXPECT().Record(gomock.Any(), &auditentities.Event{
+			Username:    userInfo.Username,
+			Tenant:      userInfo.Tenant,
+			Operation:   auditentities.SignOperation,
+			Resource:    string(authtypes.ResourceKey),
+			StoreName:   "my-store",
+			ResourceID:  id,
+			PayloadHash: hashPayload(data),
+			Outcome:     auditentities.SuccessOutcome,
+		}).Return(nil)
+
+		result, err := keyStore.Sign(ctx, id, data, nil)
+		require.NoError(t, err)
+		assert.Equal(t, signature, result)
+	})
+
+	t.Run("should record a failed signature and return the store error", func(t *testing.T) {
+		expectedErr := errors.ForbiddenError("error")
+
+		store.EXPECT().Sign(gomock.Any(), id, data, nil).Return(nil, expectedErr)
+		auditor.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event *auditentities.Event) error {
+			assert.Equal(t, auditentities.FailureOutcome, event.Outcome)
+			assert.Equal(t, expectedErr.Error(), event.Error)
+			return nil
+		})
+
+		_, err := keyStore.Sign(ctx, id, data, nil)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should not return the signature if the event cannot be recorded", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+
+		store.EXPECT().Sign(gomock.Any(), id, data, nil).Return([]byte("signature"), nil)
+		auditor.EXPECT().Record(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		result, err := keyStore.Sign(ctx, id, data, nil)
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
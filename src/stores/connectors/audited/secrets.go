@@ -0,0 +1,51 @@
+package audited
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/audit"
+	auditentities "github.com/longfan78/quorum-key-manager/src/audit/entities"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// SecretStore records the sensitive operations of a secret store in the audit log
+type SecretStore struct {
+	stores.SecretStore
+	recorder
+}
+
+var _ stores.SecretStore = &SecretStore{}
+
+func NewSecretStore(store stores.SecretStore, auditor audit.Auditor, storeName string, userInfo *authtypes.UserInfo) *SecretStore {
+	return &SecretStore{
+		SecretStore: store,
+		recorder:    recorder{auditor: auditor, storeName: storeName, userInfo: userInfo},
+	}
+}
+
+func (s *SecretStore) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	secret, err := s.SecretStore.Set(ctx, id, value, attr)
+	err = s.record(ctx, auditentities.SetOperation, authtypes.ResourceSecret, id, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+func (s *SecretStore) Delete(ctx context.Context, id string) error {
+	err := s.SecretStore.Delete(ctx, id)
+	return s.record(ctx, auditentities.DeleteOperation, authtypes.ResourceSecret, id, nil, err)
+}
+
+func (s *SecretStore) Restore(ctx context.Context, id string) error {
+	err := s.SecretStore.Restore(ctx, id)
+	return s.record(ctx, auditentities.RestoreOperation, authtypes.ResourceSecret, id, nil, err)
+}
+
+func (s *SecretStore) Destroy(ctx context.Context, id string) error {
+	err := s.SecretStore.Destroy(ctx, id)
+	return s.record(ctx, auditentities.DestroyOperation, authtypes.ResourceSecret, id, nil, err)
+}
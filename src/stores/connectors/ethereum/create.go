@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
 
@@ -28,7 +29,9 @@ func (c Connector) Create(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 
-	acc, err := c.db.Add(ctx, models.NewETHAccountFromKey(key, attr))
+	acc := models.NewETHAccountFromKey(key, attr)
+	acc.Metadata.SetExpiry(attr, time.Now())
+	acc, err = c.db.Add(ctx, acc)
 	if err != nil {
 		return nil, err
 	}
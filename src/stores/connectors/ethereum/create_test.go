@@ -3,7 +3,9 @@ package eth
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -37,12 +40,12 @@ func TestCreate(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should create eth account successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, nil)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, nil)
 
 		rAcc, err := connector.Create(ctx, key.ID, attributes)
 
@@ -54,7 +57,7 @@ func TestCreate(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, nil)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, nil)
 
 		rAcc, err := connector.Create(ctx, key.ID, attributes)
 
@@ -84,7 +87,7 @@ func TestCreate(t *testing.T) {
 	t.Run("should fail to create ethAccount if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, expectedErr)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, expectedErr)
 
 		_, err := connector.Create(ctx, key.ID, attributes)
 
@@ -92,3 +95,36 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+// expiringAccountMatcher matches an account expiring after ttl from now
+type expiringAccountMatcher struct {
+	expected *entities2.ETHAccount
+	ttl      time.Duration
+}
+
+func newExpiringAccountMatcher(expected *entities2.ETHAccount, ttl time.Duration) gomock.Matcher {
+	return expiringAccountMatcher{expected: expected, ttl: ttl}
+}
+
+func (m expiringAccountMatcher) Matches(x interface{}) bool {
+	acc, ok := x.(*entities2.ETHAccount)
+	if !ok {
+		return false
+	}
+
+	remaining := time.Until(acc.Metadata.ExpireAt)
+	if remaining > m.ttl || remaining < m.ttl-time.Minute {
+		return false
+	}
+
+	metadata := *acc.Metadata
+	metadata.ExpireAt = m.expected.Metadata.ExpireAt
+	withoutExpiry := *acc
+	withoutExpiry.Metadata = &metadata
+
+	return reflect.DeepEqual(&withoutExpiry, m.expected)
+}
+
+func (m expiringAccountMatcher) String() string {
+	return fmt.Sprintf("is equal to %v expiring in %s", m.expected, m.ttl)
+}
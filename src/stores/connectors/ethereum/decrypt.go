@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -16,12 +17,12 @@ func (c Connector) Decrypt(ctx context.Context, addr common.Address, data []byte
 		return nil, err
 	}
 
-	acc, err := c.db.Get(ctx, addr.Hex())
+	acc, err := c.getUsable(ctx, addr, storeentities.Encryption)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := c.store.Decrypt(ctx, acc.KeyID, data)
+	result, err := c.store.Decrypt(ctx, acc.KeyID, data, ethAlgo)
 	if err != nil {
 		return nil, err
 	}
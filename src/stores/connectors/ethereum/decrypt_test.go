@@ -33,12 +33,12 @@ func TestDecryptKey(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should decrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		store.EXPECT().Decrypt(gomock.Any(), key.ID, data, ethAlgo).Return(result, nil)
 
 		rResult, err := connector.Decrypt(ctx, acc.Address, data)
 
@@ -68,7 +68,7 @@ func TestDecryptKey(t *testing.T) {
 	t.Run("should fail to decrypt data if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		store.EXPECT().Decrypt(gomock.Any(), key.ID, data, ethAlgo).Return(nil, expectedErr)
 
 		_, err := connector.Decrypt(ctx, acc.Address, data)
 
@@ -33,7 +33,7 @@ func TestDeleteKey(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
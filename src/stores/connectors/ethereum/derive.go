@@ -0,0 +1,100 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/hd"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	logger := c.logger.With("wallet_id", walletID, "path", path, "id", id)
+	logger.Debug("deriving ethereum account")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.seeds == nil {
+		errMessage := "HD wallets require the ethereum store to have a secret store"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		errMessage := "invalid derivation path"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	_, err = c.wallets.Get(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := c.seeds.Get(ctx, entities.WalletSeedPrefix+walletID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := hd.DeriveSecp256k1(seed.Value, derivationPath)
+	if err != nil {
+		errMessage := "failed to derive private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	key, err := c.store.Import(ctx, id, privKey, ethAlgo, attr)
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		key, err = c.getDerivedKey(ctx, logger, id, privKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	acc := models.NewETHAccountFromKey(key, attr)
+	acc.WalletID = walletID
+	acc.DerivationPath = derivationPath.String()
+	acc.Metadata.SetExpiry(attr, time.Now())
+	acc, err = c.db.Add(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.With("address", acc.Address, "key_id", acc.KeyID).Info("ethereum account derived successfully")
+	return acc, nil
+}
+
+// getDerivedKey gets a key already existing in the vault, failing if it is not the derived key
+func (c Connector) getDerivedKey(ctx context.Context, logger log.Logger, id string, privKey []byte) (*entities.Key, error) {
+	key, err := c.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	if err != nil {
+		errMessage := "failed to parse derived private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	if !bytes.Equal(key.PublicKey, crypto.FromECDSAPub(&ecdsaKey.PublicKey)) {
+		errMessage := "a different key already exists with the same ID"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,46 @@
+package eth
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Enable(ctx context.Context, addr ethcommon.Address) (*entities.ETHAccount, error) {
+	acc, err := c.setDisabled(ctx, addr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("ethereum account enabled successfully", "address", addr.Hex())
+	return acc, nil
+}
+
+func (c Connector) Disable(ctx context.Context, addr ethcommon.Address) (*entities.ETHAccount, error) {
+	acc, err := c.setDisabled(ctx, addr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("ethereum account disabled successfully", "address", addr.Hex())
+	return acc, nil
+}
+
+// setDisabled only updates the account in DB, so that a compromised account is frozen without involving the vault
+func (c Connector) setDisabled(ctx context.Context, addr ethcommon.Address, disabled bool) (*entities.ETHAccount, error) {
+	err := c.authorizator.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := c.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	acc.Metadata.Disabled = disabled
+	return c.db.Update(ctx, acc)
+}
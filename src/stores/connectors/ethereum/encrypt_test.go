@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -33,12 +35,12 @@ func TestEncryptKey(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, ethAlgo).Return(result, nil)
 
 		rResult, err := connector.Encrypt(ctx, acc.Address, data)
 
@@ -68,11 +70,35 @@ func TestEncryptKey(t *testing.T) {
 	t.Run("should fail to encrypt data if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, ethAlgo).Return(nil, expectedErr)
 
 		_, err := connector.Encrypt(ctx, acc.Address, data)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("should fail with DisabledError if the account is disabled", func(t *testing.T) {
+		disabledAcc := testutils2.FakeETHAccount()
+		disabledAcc.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), disabledAcc.Address.Hex()).Return(disabledAcc, nil)
+
+		_, err := connector.Encrypt(ctx, disabledAcc.Address, data)
+
+		assert.True(t, errors.IsDisabledError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if encryption was not declared for the account", func(t *testing.T) {
+		signingAcc := testutils2.FakeETHAccount()
+		signingAcc.Metadata.Operations = []storeentities.CryptoOperation{storeentities.Signing}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), signingAcc.Address.Hex()).Return(signingAcc, nil)
+
+		_, err := connector.Encrypt(ctx, signingAcc.Address, data)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
 }
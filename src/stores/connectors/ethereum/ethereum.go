@@ -9,9 +9,12 @@ import (
 )
 
 type Connector struct {
-	store        stores.KeyStore
+	store stores.KeyStore
+	// seeds holds the mnemonics of the HD wallets, nil if the store has no secret store
+	seeds        stores.SecretStore
 	logger       log.Logger
 	db           database.ETHAccounts
+	wallets      database.ETHWallets
 	authorizator auth.Authorizator
 }
 
@@ -22,11 +25,13 @@ var ethAlgo = &entities.Algorithm{
 	EllipticCurve: entities.Secp256k1,
 }
 
-func NewConnector(store stores.KeyStore, db database.ETHAccounts, authorizator auth.Authorizator, logger log.Logger) *Connector {
+func NewConnector(store stores.KeyStore, seeds stores.SecretStore, db database.ETHAccounts, wallets database.ETHWallets, authorizator auth.Authorizator, logger log.Logger) *Connector {
 	return &Connector{
 		store:        store,
+		seeds:        seeds,
 		logger:       logger,
 		db:           db,
+		wallets:      wallets,
 		authorizator: authorizator,
 	}
 }
@@ -2,7 +2,9 @@ package eth
 
 import (
 	"context"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
@@ -42,3 +44,33 @@ func (c Connector) GetDeleted(ctx context.Context, addr ethcommon.Address) (*ent
 	logger.Debug("deleted ethereum account retrieved successfully")
 	return acc, nil
 }
+
+// getUsable gets an Ethereum account that can be used for the crypto operation
+func (c Connector) getUsable(ctx context.Context, addr ethcommon.Address, operation entities.CryptoOperation) (*entities.ETHAccount, error) {
+	logger := c.logger.With("address", addr.Hex())
+
+	acc, err := c.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	if acc.Metadata.Disabled {
+		errMessage := "ethereum account is disabled"
+		logger.Error(errMessage)
+		return nil, errors.DisabledError(errMessage)
+	}
+
+	if !acc.Metadata.Allows(operation) {
+		errMessage := "operation not allowed for ethereum account"
+		logger.Error(errMessage, "operation", operation, "operations", acc.Metadata.Operations)
+		return nil, errors.ForbiddenError("ethereum account cannot be used for %s", operation)
+	}
+
+	if acc.Metadata.IsExpired(time.Now()) {
+		errMessage := "ethereum account has expired"
+		logger.Error(errMessage, "expire_at", acc.Metadata.ExpireAt)
+		return nil, errors.ExpiredError(errMessage)
+	}
+
+	return acc, nil
+}
@@ -38,12 +38,12 @@ func TestImport(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should import eth account successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, nil)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, nil)
 
 		rAcc, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -55,7 +55,7 @@ func TestImport(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, nil)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, nil)
 
 		rAcc, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -85,7 +85,7 @@ func TestImport(t *testing.T) {
 	t.Run("should fail to create ethAccount if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, expectedErr)
+		db.EXPECT().Add(gomock.Any(), newExpiringAccountMatcher(models.NewETHAccountFromKey(key, attributes), attributes.TTL)).Return(acc, expectedErr)
 
 		_, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -2,8 +2,10 @@ package eth
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -47,3 +49,53 @@ func (c Connector) ListDeleted(ctx context.Context, limit, offset uint64) ([]com
 	c.logger.Debug("deleted ethereum accounts listed successfully")
 	return addrs, nil
 }
+
+func (c Connector) ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]common.Address, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	strAddr, err := c.db.SearchExpiringAddresses(ctx, before, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []common.Address
+	for _, addr := range strAddr {
+		addrs = append(addrs, common.HexToAddress(addr))
+	}
+
+	c.logger.Debug("expiring ethereum accounts listed successfully")
+	return addrs, nil
+}
+
+func (c Connector) SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities2.ETHAccount, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.db.GetAllPurgeable(ctx, before, recoveryPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("purgeable ethereum accounts searched successfully")
+	return items, nil
+}
+
+func (c Connector) Search(ctx context.Context, filter *entities2.ListFilter) ([]*entities2.ETHAccount, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.db.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("ethereum accounts searched successfully")
+	return items, nil
+}
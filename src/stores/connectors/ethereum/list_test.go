@@ -29,7 +29,7 @@ func TestListEthAccounts(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should list ethAccounts successfully", func(t *testing.T) {
 		accOne := testutils2.FakeETHAccount()
@@ -78,7 +78,7 @@ func TestListDeletedEthAccounts(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, nil, db, nil, auth, logger)
 
 	t.Run("should list deleted ethAccounts successfully", func(t *testing.T) {
 		accOne := testutils2.FakeETHAccount()
@@ -12,6 +12,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	quorumtypes "github.com/consensys/quorum/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -208,7 +209,7 @@ func (c Connector) sign(ctx context.Context, addr common.Address, data []byte) (
 		return nil, err
 	}
 
-	acc, err := c.db.Get(ctx, addr.Hex())
+	acc, err := c.getUsable(ctx, addr, entities.Signing)
 	if err != nil {
 		return nil, err
 	}
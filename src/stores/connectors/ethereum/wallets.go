@@ -0,0 +1,119 @@
+package eth
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/hd"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) CreateWallet(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	logger := c.logger.With("wallet_id", id)
+	logger.Debug("creating ethereum wallet")
+
+	mnemonic, err := hd.NewMnemonic()
+	if err != nil {
+		errMessage := "failed to generate mnemonic"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	wallet, err := c.addWallet(ctx, logger, id, mnemonic, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet.Mnemonic = mnemonic
+
+	logger.Info("ethereum wallet created successfully")
+	return wallet, nil
+}
+
+func (c Connector) ImportWallet(ctx context.Context, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	logger := c.logger.With("wallet_id", id)
+	logger.Debug("importing ethereum wallet")
+
+	if !hd.IsMnemonicValid(mnemonic) {
+		errMessage := "invalid mnemonic"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	wallet, err := c.addWallet(ctx, logger, id, mnemonic, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("ethereum wallet imported successfully")
+	return wallet, nil
+}
+
+func (c Connector) GetWallet(ctx context.Context, id string) (*entities.ETHWallet, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := c.wallets.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("ethereum wallet retrieved successfully", "wallet_id", id)
+	return wallet, nil
+}
+
+func (c Connector) ListWallets(ctx context.Context, limit, offset uint64) ([]string, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := c.wallets.SearchIDs(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("ethereum wallets listed successfully")
+	return ids, nil
+}
+
+// addWallet stores the mnemonic of a new wallet in the secret store of the Ethereum store, under an ID reserved to HD wallets
+func (c Connector) addWallet(ctx context.Context, logger log.Logger, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.seeds == nil {
+		errMessage := "HD wallets require the ethereum store to have a secret store"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	// The mnemonic of an existing wallet must never be overwritten
+	_, err = c.wallets.Get(ctx, id)
+	if err == nil {
+		errMessage := "ethereum wallet already exists"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	}
+	if !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	_, err = c.seeds.Set(ctx, entities.WalletSeedPrefix+id, mnemonic, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.wallets.Add(ctx, &entities.ETHWallet{
+		ID:       id,
+		Tags:     attr.Tags,
+		Metadata: &entities.Metadata{},
+	})
+}
@@ -0,0 +1,209 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestCreateWallet(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attributes := testutils2.FakeAttributes()
+	wallet := &entities2.ETHWallet{ID: "my-wallet", Tags: attributes.Tags, Metadata: testutils2.FakeMetadata()}
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	seeds := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	wallets := mock2.NewMockETHWallets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, seeds, db, wallets, auth, logger)
+
+	t.Run("should create wallet successfully and return its mnemonic", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(nil, errors.NotFoundError("error"))
+		seeds.EXPECT().Set(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, gomock.Any(), attributes).Return(testutils2.FakeSecret(), nil)
+		wallets.EXPECT().Add(gomock.Any(), gomock.Any()).Return(wallet, nil)
+
+		rWallet, err := connector.CreateWallet(ctx, wallet.ID, attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, wallet.ID, rWallet.ID)
+		assert.NotEmpty(t, rWallet.Mnemonic)
+	})
+
+	t.Run("should fail with AlreadyExistsError if wallet already exists", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(wallet, nil)
+
+		_, err := connector.CreateWallet(ctx, wallet.ID, attributes)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with same error if secret store fails to store the mnemonic", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(nil, errors.NotFoundError("error"))
+		seeds.EXPECT().Set(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, gomock.Any(), attributes).Return(nil, expectedErr)
+
+		_, err := connector.CreateWallet(ctx, wallet.ID, attributes)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with NotSupportedError if the store has no secret store", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := NewConnector(store, nil, db, wallets, auth, logger).CreateWallet(ctx, wallet.ID, attributes)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+}
+
+func TestImportWallet(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attributes := testutils2.FakeAttributes()
+	wallet := &entities2.ETHWallet{ID: "my-wallet", Tags: attributes.Tags, Metadata: testutils2.FakeMetadata()}
+
+	store := mock.NewMockKeyStore(ctrl)
+	seeds := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	wallets := mock2.NewMockETHWallets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, seeds, db, wallets, auth, logger)
+
+	t.Run("should import wallet successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(nil, errors.NotFoundError("error"))
+		seeds.EXPECT().Set(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, testMnemonic, attributes).Return(testutils2.FakeSecret(), nil)
+		wallets.EXPECT().Add(gomock.Any(), gomock.Any()).Return(wallet, nil)
+
+		rWallet, err := connector.ImportWallet(ctx, wallet.ID, testMnemonic, attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, wallet, rWallet)
+		assert.Empty(t, rWallet.Mnemonic)
+	})
+
+	t.Run("should fail with InvalidParameterError if mnemonic is invalid", func(t *testing.T) {
+		_, err := connector.ImportWallet(ctx, wallet.ID, "abandon abandon", attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func TestDeriveAccount(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attributes := testutils2.FakeAttributes()
+	wallet := &entities2.ETHWallet{ID: "my-wallet", Tags: attributes.Tags, Metadata: testutils2.FakeMetadata()}
+	seed := testutils2.FakeSecret()
+	seed.Value = testMnemonic
+	key := testutils2.FakeKey()
+	acc := testutils2.FakeETHAccount()
+	expectedPrivKey := hexutil.MustDecode("0x1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727")
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	seeds := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	wallets := mock2.NewMockETHWallets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, seeds, db, wallets, auth, logger)
+
+	t.Run("should derive account successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(wallet, nil)
+		seeds.EXPECT().Get(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, "").Return(seed, nil)
+		store.EXPECT().Import(gomock.Any(), key.ID, expectedPrivKey, ethAlgo, attributes).Return(key, nil)
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rAcc *entities2.ETHAccount) (*entities2.ETHAccount, error) {
+			assert.Equal(t, wallet.ID, rAcc.WalletID)
+			assert.Equal(t, "m/44'/60'/0'/0/0", rAcc.DerivationPath)
+			assert.Equal(t, key.ID, rAcc.KeyID)
+			return acc, nil
+		})
+
+		rAcc, err := connector.DeriveAccount(ctx, wallet.ID, "m/44'/60'/0'/0/0", key.ID, attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, rAcc)
+	})
+
+	t.Run("should derive account successfully if the derived key already exists", func(t *testing.T) {
+		privKey, err := crypto.ToECDSA(expectedPrivKey)
+		require.NoError(t, err)
+		existingKey := testutils2.FakeKey()
+		existingKey.PublicKey = crypto.FromECDSAPub(&privKey.PublicKey)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(wallet, nil)
+		seeds.EXPECT().Get(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, "").Return(seed, nil)
+		store.EXPECT().Import(gomock.Any(), existingKey.ID, expectedPrivKey, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
+		store.EXPECT().Get(gomock.Any(), existingKey.ID).Return(existingKey, nil)
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).Return(acc, nil)
+
+		rAcc, err := connector.DeriveAccount(ctx, wallet.ID, "m/44'/60'/0'/0/0", existingKey.ID, attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, rAcc)
+	})
+
+	t.Run("should fail with AlreadyExistsError if a different key already exists with the same ID", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(wallet, nil)
+		seeds.EXPECT().Get(gomock.Any(), entities2.WalletSeedPrefix+wallet.ID, "").Return(seed, nil)
+		store.EXPECT().Import(gomock.Any(), key.ID, expectedPrivKey, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+
+		_, err := connector.DeriveAccount(ctx, wallet.ID, "m/44'/60'/0'/0/0", key.ID, attributes)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if path is invalid", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := connector.DeriveAccount(ctx, wallet.ID, "m/invalid", key.ID, attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if wallet is not found", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		wallets.EXPECT().Get(gomock.Any(), wallet.ID).Return(nil, expectedErr)
+
+		_, err := connector.DeriveAccount(ctx, wallet.ID, "m/44'/60'/0'/0/0", key.ID, attributes)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
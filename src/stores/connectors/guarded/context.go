@@ -0,0 +1,46 @@
+package guarded
+
+import (
+	"context"
+	"sync"
+)
+
+type contextKey struct{}
+
+// Reservations collects the daily spendings reserved by the signatures made with a context returned by
+// WithReservations, so that they can be released when the signed transactions are not sent
+type Reservations struct {
+	mu      sync.Mutex
+	refunds []func(ctx context.Context)
+}
+
+// WithReservations returns a context collecting the daily spendings reserved by the signatures made with it
+func WithReservations(ctx context.Context) (context.Context, *Reservations) {
+	reservations := &Reservations{}
+	return context.WithValue(ctx, contextKey{}, reservations), reservations
+}
+
+// Release refunds the daily spendings collected. It must only be called once the signed transactions are known not
+// to be sent, such as when the node rejects them
+func (r *Reservations) Release(ctx context.Context) {
+	r.mu.Lock()
+	refunds := r.refunds
+	r.refunds = nil
+	r.mu.Unlock()
+
+	for _, refund := range refunds {
+		refund(ctx)
+	}
+}
+
+func (r *Reservations) add(refund func(ctx context.Context)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refunds = append(r.refunds, refund)
+}
+
+func reservationsFrom(ctx context.Context) *Reservations {
+	reservations, _ := ctx.Value(contextKey{}).(*Reservations)
+	return reservations
+}
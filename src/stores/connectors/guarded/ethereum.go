@@ -0,0 +1,150 @@
+package guarded
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/policies"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+)
+
+// EthStore evaluates the signing policies before signing transactions or arbitrary data with an Ethereum store
+type EthStore struct {
+	stores.EthStore
+	policies  policies.Policies
+	db        database.ETHAccounts
+	storeName string
+	logger    log.Logger
+}
+
+var _ stores.EthStore = &EthStore{}
+
+func NewEthStore(store stores.EthStore, policiesService policies.Policies, db database.ETHAccounts, storeName string, logger log.Logger) *EthStore {
+	return &EthStore{
+		EthStore:  store,
+		policies:  policiesService,
+		db:        db,
+		storeName: storeName,
+		logger:    logger,
+	}
+}
+
+func (s *EthStore) Sign(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	return s.guard(ctx, addr, nil, func() ([]byte, error) {
+		return s.EthStore.Sign(ctx, addr, data)
+	})
+}
+
+// SignMessage is evaluated as raw signing as the content of EIP-191 messages cannot be evaluated
+func (s *EthStore) SignMessage(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	return s.guard(ctx, addr, nil, func() ([]byte, error) {
+		return s.EthStore.SignMessage(ctx, addr, data)
+	})
+}
+
+// SignTypedData is evaluated as raw signing as EIP-712 typed data, such as permits, can transfer assets that cannot be evaluated
+func (s *EthStore) SignTypedData(ctx context.Context, addr common.Address, typedData *core.TypedData) ([]byte, error) {
+	return s.guard(ctx, addr, nil, func() ([]byte, error) {
+		return s.EthStore.SignTypedData(ctx, addr, typedData)
+	})
+}
+
+func (s *EthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
+	return s.guard(ctx, addr, newTransaction(chainID, tx), func() ([]byte, error) {
+		return s.EthStore.SignTransaction(ctx, addr, chainID, tx)
+	})
+}
+
+func (s *EthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+	return s.guard(ctx, addr, newTransaction(chainID, tx), func() ([]byte, error) {
+		return s.EthStore.SignEEA(ctx, addr, chainID, tx, args)
+	})
+}
+
+func (s *EthStore) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+	// Quorum private transactions do not carry a chain ID and their data is the hash of the payload stored in Tessera
+	privateTx := &entities.Transaction{
+		To:       tx.To(),
+		Value:    tx.Value(),
+		GasPrice: tx.GasPrice(),
+		Private:  true,
+	}
+
+	return s.guard(ctx, addr, privateTx, func() ([]byte, error) {
+		return s.EthStore.SignPrivate(ctx, addr, tx)
+	})
+}
+
+// guard signs only if the policies allow it, refunding the daily spendings reserved when signing fails. The spendings
+// of a signature are collected by the reservations of the context, if any, to be refunded when it is not sent
+func (s *EthStore) guard(ctx context.Context, addr common.Address, tx *entities.Transaction, sign func() ([]byte, error)) ([]byte, error) {
+	account, err := s.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	spendings, err := s.policies.Evaluate(ctx, &entities.SigningRequest{
+		StoreName:   s.storeName,
+		Address:     addr,
+		Policies:    accountPolicies(account.Tags),
+		Transaction: tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := sign()
+	if err != nil {
+		s.refund(ctx, addr, spendings)
+		return nil, err
+	}
+
+	if reservations := reservationsFrom(ctx); reservations != nil && len(spendings) > 0 {
+		reservations.add(func(ctx context.Context) {
+			s.refund(ctx, addr, spendings)
+		})
+	}
+
+	return signature, nil
+}
+
+func (s *EthStore) refund(ctx context.Context, addr common.Address, spendings []*entities.Spending) {
+	if err := s.policies.Refund(ctx, spendings); err != nil {
+		s.logger.WithError(err).Error("failed to refund policy spendings", "address", addr.Hex())
+	}
+}
+
+func newTransaction(chainID *big.Int, tx *types.Transaction) *entities.Transaction {
+	return &entities.Transaction{
+		To:       tx.To(),
+		Data:     tx.Data(),
+		Value:    tx.Value(),
+		GasPrice: tx.GasFeeCap(),
+		ChainID:  chainID,
+	}
+}
+
+func accountPolicies(tags map[string]string) []string {
+	value, ok := tags[entities.AccountTag]
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,143 @@
+package guarded
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/policies/entities"
+	policiesmock "github.com/longfan78/quorum-key-manager/src/policies/mock"
+	dbmock "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthStoreSignTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockEthStore(ctrl)
+	policies := policiesmock.NewMockPolicies(ctrl)
+	db := dbmock.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	ethStore := NewEthStore(store, policies, db, "eth-hot", logger)
+
+	ctx := context.Background()
+	addr := common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+	to := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	chainID := big.NewInt(1)
+	tx := types.NewTransaction(0, to, big.NewInt(500), 21000, big.NewInt(50), nil)
+	account := &storesentities.ETHAccount{Address: addr, Tags: map[string]string{entities.AccountTag: "limits, hours"}}
+	spendings := []*entities.Spending{{Policy: "limits", Address: addr, Amount: big.NewInt(500)}}
+
+	t.Run("should evaluate the policies before signing", func(t *testing.T) {
+		signedTx := []byte("signed-tx")
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *entities.SigningRequest) ([]*entities.Spending, error) {
+			assert.Equal(t, "eth-hot", req.StoreName)
+			assert.Equal(t, addr, req.Address)
+			assert.Equal(t, []string{"limits", "hours"}, req.Policies)
+			assert.Equal(t, to, *req.Transaction.To)
+			assert.Equal(t, big.NewInt(500), req.Transaction.Value)
+			assert.Equal(t, big.NewInt(50), req.Transaction.GasPrice)
+			assert.Equal(t, chainID, req.Transaction.ChainID)
+			return spendings, nil
+		})
+		store.EXPECT().SignTransaction(gomock.Any(), addr, chainID, tx).Return(signedTx, nil)
+
+		result, err := ethStore.SignTransaction(ctx, addr, chainID, tx)
+		require.NoError(t, err)
+		assert.Equal(t, signedTx, result)
+	})
+
+	t.Run("should not sign transactions rejected by the policies", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("error")
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := ethStore.SignTransaction(ctx, addr, chainID, tx)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should refund the spendings when signing fails", func(t *testing.T) {
+		expectedErr := errors.HashicorpVaultError("error")
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(spendings, nil)
+		store.EXPECT().SignTransaction(gomock.Any(), addr, chainID, tx).Return(nil, expectedErr)
+		policies.EXPECT().Refund(gomock.Any(), spendings).Return(nil)
+
+		_, err := ethStore.SignTransaction(ctx, addr, chainID, tx)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should refund the spendings of a signed transaction when its reservations are released", func(t *testing.T) {
+		signedTx := []byte("signed-tx")
+		reservationsCtx, reservations := WithReservations(ctx)
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(spendings, nil)
+		store.EXPECT().SignTransaction(gomock.Any(), addr, chainID, tx).Return(signedTx, nil)
+
+		_, err := ethStore.SignTransaction(reservationsCtx, addr, chainID, tx)
+		require.NoError(t, err)
+
+		policies.EXPECT().Refund(gomock.Any(), spendings).Return(nil)
+		reservations.Release(ctx)
+
+		// Spendings are only refunded once
+		reservations.Release(ctx)
+	})
+}
+
+func TestEthStoreSignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockEthStore(ctrl)
+	policies := policiesmock.NewMockPolicies(ctrl)
+	db := dbmock.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	ethStore := NewEthStore(store, policies, db, "eth-hot", logger)
+
+	ctx := context.Background()
+	addr := common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+	typedData := &core.TypedData{PrimaryType: "Permit"}
+	account := &storesentities.ETHAccount{Address: addr, Tags: map[string]string{entities.AccountTag: "limits"}}
+
+	t.Run("should evaluate typed data as raw signing", func(t *testing.T) {
+		signature := []byte("signature")
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *entities.SigningRequest) ([]*entities.Spending, error) {
+			assert.Equal(t, []string{"limits"}, req.Policies)
+			assert.Nil(t, req.Transaction)
+			return nil, nil
+		})
+		store.EXPECT().SignTypedData(gomock.Any(), addr, typedData).Return(signature, nil)
+
+		result, err := ethStore.SignTypedData(ctx, addr, typedData)
+		require.NoError(t, err)
+		assert.Equal(t, signature, result)
+	})
+
+	t.Run("should not sign messages rejected by the policies", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("raw signing is not allowed")
+
+		db.EXPECT().Get(gomock.Any(), addr.Hex()).Return(account, nil)
+		policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := ethStore.SignMessage(ctx, addr, []byte("message"))
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"context"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 
@@ -35,6 +36,9 @@ func (c Connector) Create(ctx context.Context, id string, alg *entities2.Algorit
 		return nil, err
 	}
 
+	key.RotationPolicy = attr.RotationPolicy
+	key.Metadata.SetExpiry(attr, time.Now())
+	key.Metadata.SetUsage(attr)
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
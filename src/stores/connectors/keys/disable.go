@@ -0,0 +1,45 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Enable(ctx context.Context, id string) (*entities.Key, error) {
+	key, err := c.setDisabled(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("key enabled successfully", "id", id)
+	return key, nil
+}
+
+func (c Connector) Disable(ctx context.Context, id string) (*entities.Key, error) {
+	key, err := c.setDisabled(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("key disabled successfully", "id", id)
+	return key, nil
+}
+
+// setDisabled only updates the key in DB, as its usage is enforced by the connector whatever the underlying store
+func (c Connector) setDisabled(ctx context.Context, id string, disabled bool) (*entities.Key, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Metadata.Disabled = disabled
+	return c.db.Update(ctx, key)
+}
@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should disable key in DB only", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updated *storeentities.Key) (*storeentities.Key, error) {
+			assert.True(t, updated.Metadata.Disabled)
+			return updated, nil
+		})
+
+		rKey, err := connector.Disable(ctx, key.ID)
+
+		assert.NoError(t, err)
+		assert.True(t, rKey.Metadata.Disabled)
+	})
+
+	t.Run("should enable key in DB only", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updated *storeentities.Key) (*storeentities.Key, error) {
+			return updated, nil
+		})
+
+		rKey, err := connector.Enable(ctx, key.ID)
+
+		assert.NoError(t, err)
+		assert.False(t, rKey.Metadata.Disabled)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Disable(ctx, "my-key")
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if update fails", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), key).Return(nil, expectedErr)
+
+		_, err := connector.Disable(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
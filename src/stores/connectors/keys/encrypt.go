@@ -3,18 +3,30 @@ package keys
 import (
 	"context"
 
-	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
-func (c Connector) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (c Connector) Encrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	logger := c.logger.With("id", id)
 
-	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey})
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionEncrypt, Resource: authentities.ResourceKey})
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := c.store.Encrypt(ctx, id, data)
+	key, err := c.getUsable(ctx, id, storeentities.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
+		algo = key.Algo
+	}
+
+	result, err := c.store.Encrypt(ctx, id, data, algo)
 	if err != nil {
 		return nil, err
 	}
@@ -35,9 +35,10 @@ func TestEncrypt(t *testing.T) {
 
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, key.Algo).Return(result, nil)
 
-		rResult, err := connector.Encrypt(ctx, key.ID, data)
+		rResult, err := connector.Encrypt(ctx, key.ID, data, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, rResult, result)
@@ -46,7 +47,7 @@ func TestEncrypt(t *testing.T) {
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(expectedErr)
 
-		_, err := connector.Encrypt(ctx, key.ID, data)
+		_, err := connector.Encrypt(ctx, key.ID, data, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
@@ -54,9 +55,10 @@ func TestEncrypt(t *testing.T) {
 
 	t.Run("should fail to encrypt data if encrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, key.Algo).Return(nil, expectedErr)
 
-		_, err := connector.Encrypt(ctx, key.ID, data)
+		_, err := connector.Encrypt(ctx, key.ID, data, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
@@ -2,7 +2,9 @@ package keys
 
 import (
 	"context"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
@@ -41,3 +43,33 @@ func (c Connector) GetDeleted(ctx context.Context, id string) (*entities.Key, er
 	logger.Debug("deleted key retrieved successfully")
 	return key, nil
 }
+
+// getUsable gets a key that can be used for the crypto operation
+func (c Connector) getUsable(ctx context.Context, id string, operation entities.CryptoOperation) (*entities.Key, error) {
+	logger := c.logger.With("id", id)
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Metadata.Disabled {
+		errMessage := "key is disabled"
+		logger.Error(errMessage)
+		return nil, errors.DisabledError(errMessage)
+	}
+
+	if !key.Metadata.Allows(operation) {
+		errMessage := "operation not allowed for key"
+		logger.Error(errMessage, "operation", operation, "operations", key.Metadata.Operations)
+		return nil, errors.ForbiddenError("key cannot be used for %s", operation)
+	}
+
+	if key.Metadata.IsExpired(time.Now()) {
+		errMessage := "key has expired"
+		logger.Error(errMessage, "expire_at", key.Metadata.ExpireAt)
+		return nil, errors.ExpiredError(errMessage)
+	}
+
+	return key, nil
+}
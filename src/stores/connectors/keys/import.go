@@ -2,6 +2,7 @@ package keys
 
 import (
 	"context"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 
@@ -41,6 +42,9 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, alg *e
 		return nil, err
 	}
 
+	key.RotationPolicy = attr.RotationPolicy
+	key.Metadata.SetExpiry(attr, time.Now())
+	key.Metadata.SetUsage(attr)
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
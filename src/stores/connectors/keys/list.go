@@ -2,8 +2,10 @@ package keys
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
 func (c Connector) List(ctx context.Context, limit, offset uint64) ([]string, error) {
@@ -35,3 +37,63 @@ func (c Connector) ListDeleted(ctx context.Context, limit, offset uint64) ([]str
 	c.logger.Debug("deleted keys listed successfully")
 	return ids, nil
 }
+
+func (c Connector) ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := c.db.SearchExpiringIDs(ctx, before, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("expiring keys listed successfully")
+	return ids, nil
+}
+
+func (c Connector) SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities2.Key, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.db.GetAllPurgeable(ctx, before, recoveryPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("purgeable keys searched successfully")
+	return items, nil
+}
+
+func (c Connector) SearchDueForRotation(ctx context.Context, now time.Time) ([]*entities2.Key, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.db.GetAllDueForRotation(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("keys due for rotation searched successfully")
+	return items, nil
+}
+
+func (c Connector) Search(ctx context.Context, filter *entities2.ListFilter) ([]*entities2.Key, error) {
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.db.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("keys searched successfully")
+	return items, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -113,3 +114,49 @@ func TestListDeletedKey(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+func TestSearchKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	filter := &entities2.ListFilter{Tags: map[string]string{"env": "prod"}, Limit: 10}
+
+	t.Run("should search keys successfully", func(t *testing.T) {
+		keys := []*entities2.Key{testutils2.FakeKey(), testutils2.FakeKey()}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Search(gomock.Any(), filter).Return(keys, nil)
+
+		result, err := connector.Search(ctx, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, keys, result)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Search(ctx, filter)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail to search keys if db fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Search(gomock.Any(), filter).Return(nil, expectedErr)
+
+		_, err := connector.Search(ctx, filter)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
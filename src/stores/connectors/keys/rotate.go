@@ -0,0 +1,75 @@
+package keys
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	logger := c.logger.With("id", id)
+	logger.Debug("rotating key")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if alg == nil {
+		alg = key.Algo
+	} else if *alg != *key.Algo {
+		errMessage := "the algorithm of a key cannot change on rotation"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
+		rotated, derr := c.store.Rotate(ctx, id, alg)
+		if derr != nil {
+			return derr
+		}
+
+		key.PublicKey = rotated.PublicKey
+		key.Metadata.Version = rotated.Metadata.Version
+		key.Metadata.RotatedAt = time.Now()
+		key, derr = dbtx.Update(ctx, key)
+		if derr != nil {
+			return derr
+		}
+
+		return dbtx.AddVersion(ctx, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("key rotated successfully", "version", key.Metadata.Version)
+	return key, nil
+}
+
+func (c Connector) ListVersions(ctx context.Context, id string) ([]*entities.Key, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.db.ListVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("key versions listed successfully")
+	return keys, nil
+}
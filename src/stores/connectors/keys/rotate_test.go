@@ -0,0 +1,134 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	entities3 "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should rotate key successfully using the algorithm of the key", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.PublicKey = []byte("new-public-key")
+		rotatedKey.Metadata.Version = "2"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(rotatedKey, nil)
+		db.EXPECT().Update(gomock.Any(), key).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+			return k, nil
+		})
+		db.EXPECT().AddVersion(gomock.Any(), key).Return(nil)
+
+		rKey, err := connector.Rotate(ctx, key.ID, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, rotatedKey.PublicKey, rKey.PublicKey)
+		assert.Equal(t, "2", rKey.Metadata.Version)
+		assert.False(t, rKey.Metadata.RotatedAt.IsZero())
+	})
+
+	t.Run("should fail with InvalidParameterError if the algorithm differs from the key", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		alg := &entities2.Algorithm{Type: entities2.Eddsa, EllipticCurve: entities2.Babyjubjub}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+
+		_, err := connector.Rotate(ctx, key.ID, alg)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Rotate(ctx, "my-key", nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if the store fails to rotate", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(nil, expectedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if the version cannot be persisted", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(testutils2.FakeKey(), nil)
+		db.EXPECT().Update(gomock.Any(), key).Return(key, nil)
+		db.EXPECT().AddVersion(gomock.Any(), key).Return(expectedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestListKeyVersions(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should list key versions successfully", func(t *testing.T) {
+		versions := []*entities3.Key{testutils2.FakeKey(), testutils2.FakeKey()}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().ListVersions(gomock.Any(), "my-key").Return(versions, nil)
+
+		rVersions, err := connector.ListVersions(ctx, "my-key")
+
+		assert.NoError(t, err)
+		assert.Equal(t, versions, rVersions)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/entities"
 
 	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
 func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
@@ -16,12 +17,12 @@ func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entit
 		return nil, err
 	}
 
-	if algo == nil {
-		key, derr := c.db.Get(ctx, id)
-		if derr != nil {
-			return nil, derr
-		}
+	key, err := c.getUsable(ctx, id, storeentities.Signing)
+	if err != nil {
+		return nil, err
+	}
 
+	if algo == nil {
 		algo = key.Algo
 	}
 
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -36,6 +39,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should sign data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(result, nil)
 
 		rResult, err := connector.Sign(ctx, key.ID, data, algo)
@@ -66,6 +70,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should fail to sign data if sign fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
 
 		_, err := connector.Sign(ctx, key.ID, data, algo)
@@ -83,4 +88,40 @@ func TestSignKey(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
 	})
+
+	t.Run("should fail with ExpiredError if the key has expired", func(t *testing.T) {
+		expiredKey := testutils2.FakeKey()
+		expiredKey.Metadata.ExpireAt = time.Now().Add(-time.Minute)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiredKey.ID).Return(expiredKey, nil)
+
+		_, err := connector.Sign(ctx, expiredKey.ID, data, algo)
+
+		assert.True(t, errors.IsExpiredError(err))
+	})
+
+	t.Run("should fail with DisabledError if the key is disabled", func(t *testing.T) {
+		disabledKey := testutils2.FakeKey()
+		disabledKey.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), disabledKey.ID).Return(disabledKey, nil)
+
+		_, err := connector.Sign(ctx, disabledKey.ID, data, algo)
+
+		assert.True(t, errors.IsDisabledError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if signing was not declared for the key", func(t *testing.T) {
+		encryptionKey := testutils2.FakeKey()
+		encryptionKey.Metadata.Operations = []storeentities.CryptoOperation{storeentities.Encryption}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), encryptionKey.ID).Return(encryptionKey, nil)
+
+		_, err := connector.Sign(ctx, encryptionKey.ID, data, algo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
 }
@@ -25,6 +25,7 @@ func (c Connector) Update(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 	key.Tags = attr.Tags
+	key.RotationPolicy = attr.RotationPolicy
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
 		key, err = dbtx.Update(ctx, key)
@@ -0,0 +1,81 @@
+package quota
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// EthStore limits the number of accounts each tenant can create, import or derive in an Ethereum store
+type EthStore struct {
+	stores.EthStore
+	enforcer
+	db          database.ETHAccounts
+	newStore    func(db database.ETHAccounts) stores.EthStore
+	maxAccounts int
+}
+
+var _ stores.EthStore = &EthStore{}
+
+// NewEthStore creates an Ethereum store persisting its accounts in db with the stores built by newStore, so that
+// created accounts are persisted in the transaction attributing them to the tenant
+func NewEthStore(newStore func(db database.ETHAccounts) stores.EthStore, db database.ETHAccounts, cfg *entities.QuotaConfig, storeName string, userInfo *authtypes.UserInfo, logger log.Logger) *EthStore {
+	return &EthStore{
+		EthStore:    newStore(db),
+		enforcer:    enforcer{storeName: storeName, tenant: userInfo.Tenant, logger: logger},
+		db:          db,
+		newStore:    newStore,
+		maxAccounts: cfg.MaxAccounts,
+	}
+}
+
+func (s *EthStore) Create(ctx context.Context, id string, attr *entities.Attributes) (account *entities.ETHAccount, err error) {
+	err = s.createInTx(ctx, func(store stores.EthStore) (string, error) {
+		account, err = store.Create(ctx, id, attr)
+		if err != nil {
+			return "", err
+		}
+
+		return account.Address.Hex(), nil
+	})
+
+	return account, err
+}
+
+func (s *EthStore) Import(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (account *entities.ETHAccount, err error) {
+	err = s.createInTx(ctx, func(store stores.EthStore) (string, error) {
+		account, err = store.Import(ctx, id, privKey, attr)
+		if err != nil {
+			return "", err
+		}
+
+		return account.Address.Hex(), nil
+	})
+
+	return account, err
+}
+
+func (s *EthStore) DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (account *entities.ETHAccount, err error) {
+	err = s.createInTx(ctx, func(store stores.EthStore) (string, error) {
+		account, err = store.DeriveAccount(ctx, walletID, path, id, attr)
+		if err != nil {
+			return "", err
+		}
+
+		return account.Address.Hex(), nil
+	})
+
+	return account, err
+}
+
+func (s *EthStore) createInTx(ctx context.Context, operation func(store stores.EthStore) (string, error)) error {
+	return s.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
+		return s.create(ctx, "accounts", s.maxAccounts, dbtx, func() (string, error) {
+			return operation(s.newStore(dbtx))
+		})
+	})
+}
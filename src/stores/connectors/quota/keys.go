@@ -0,0 +1,69 @@
+package quota
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	storesentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// KeyStore limits the number of keys each tenant can create or import in a key store
+type KeyStore struct {
+	stores.KeyStore
+	enforcer
+	db       database.Keys
+	newStore func(db database.Keys) stores.KeyStore
+	maxKeys  int
+}
+
+var _ stores.KeyStore = &KeyStore{}
+
+// NewKeyStore creates a key store persisting its keys in db with the stores built by newStore, so that created keys
+// are persisted in the transaction attributing them to the tenant
+func NewKeyStore(newStore func(db database.Keys) stores.KeyStore, db database.Keys, cfg *storesentities.QuotaConfig, storeName string, userInfo *authtypes.UserInfo, logger log.Logger) *KeyStore {
+	return &KeyStore{
+		KeyStore: newStore(db),
+		enforcer: enforcer{storeName: storeName, tenant: userInfo.Tenant, logger: logger},
+		db:       db,
+		newStore: newStore,
+		maxKeys:  cfg.MaxKeys,
+	}
+}
+
+func (s *KeyStore) Create(ctx context.Context, id string, alg *entities.Algorithm, attr *storesentities.Attributes) (key *storesentities.Key, err error) {
+	err = s.createInTx(ctx, func(store stores.KeyStore) (string, error) {
+		key, err = store.Create(ctx, id, alg, attr)
+		if err != nil {
+			return "", err
+		}
+
+		return key.ID, nil
+	})
+
+	return key, err
+}
+
+func (s *KeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities.Algorithm, attr *storesentities.Attributes) (key *storesentities.Key, err error) {
+	err = s.createInTx(ctx, func(store stores.KeyStore) (string, error) {
+		key, err = store.Import(ctx, id, privKey, alg, attr)
+		if err != nil {
+			return "", err
+		}
+
+		return key.ID, nil
+	})
+
+	return key, err
+}
+
+func (s *KeyStore) createInTx(ctx context.Context, operation func(store stores.KeyStore) (string, error)) error {
+	return s.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
+		return s.create(ctx, "keys", s.maxKeys, dbtx, func() (string, error) {
+			return operation(s.newStore(dbtx))
+		})
+	})
+}
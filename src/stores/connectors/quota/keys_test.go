@@ -0,0 +1,110 @@
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	logtestutils "github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	dbmock "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStoreCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := dbmock.NewMockKeys(ctrl)
+	dbtx := dbmock.NewMockKeys(ctrl)
+	logger := logtestutils.NewMockLogger(ctrl)
+	userInfo := &authtypes.UserInfo{Username: "alice", Tenant: "tenant1"}
+
+	// The store creating the keys must persist them in the transaction of the quota
+	newStore := func(storeDB database.Keys) stores.KeyStore {
+		if storeDB == dbtx {
+			return store
+		}
+		return mock.NewMockKeyStore(ctrl)
+	}
+	keyStore := NewKeyStore(newStore, db, &entities.QuotaConfig{MaxKeys: 2}, "my-store", userInfo, logger)
+
+	ctx := context.Background()
+	key := testutils.FakeKey()
+	alg := testutils.FakeAlgorithm()
+	attr := testutils.FakeAttributes()
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+		return persist(dbtx)
+	}).AnyTimes()
+
+	t.Run("should create the key and attribute it to the tenant", func(t *testing.T) {
+		dbtx.EXPECT().LockTenant(gomock.Any(), "tenant1").Return(nil)
+		dbtx.EXPECT().CountByTenant(gomock.Any(), "tenant1").Return(1, nil)
+		store.EXPECT().Create(gomock.Any(), key.ID, alg, attr).Return(key, nil)
+		dbtx.EXPECT().SetTenant(gomock.Any(), key.ID, "tenant1").Return(nil)
+
+		createdKey, err := keyStore.Create(ctx, key.ID, alg, attr)
+
+		require.NoError(t, err)
+		assert.Equal(t, key, createdKey)
+	})
+
+	t.Run("should fail with ForbiddenError if the quota of the tenant is reached", func(t *testing.T) {
+		dbtx.EXPECT().LockTenant(gomock.Any(), "tenant1").Return(nil)
+		dbtx.EXPECT().CountByTenant(gomock.Any(), "tenant1").Return(2, nil)
+
+		_, err := keyStore.Create(ctx, key.ID, alg, attr)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with same error if the tenant cannot be locked", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+		dbtx.EXPECT().LockTenant(gomock.Any(), "tenant1").Return(expectedErr)
+
+		_, err := keyStore.Create(ctx, key.ID, alg, attr)
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+
+	t.Run("should not attribute the key if the creation fails", func(t *testing.T) {
+		expectedErr := errors.HashicorpVaultError("error")
+		dbtx.EXPECT().LockTenant(gomock.Any(), "tenant1").Return(nil)
+		dbtx.EXPECT().CountByTenant(gomock.Any(), "tenant1").Return(0, nil)
+		store.EXPECT().Create(gomock.Any(), key.ID, alg, attr).Return(nil, expectedErr)
+
+		_, err := keyStore.Create(ctx, key.ID, alg, attr)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail the creation if the key cannot be attributed to the tenant", func(t *testing.T) {
+		dbtx.EXPECT().LockTenant(gomock.Any(), "tenant1").Return(nil)
+		dbtx.EXPECT().CountByTenant(gomock.Any(), "tenant1").Return(0, nil)
+		store.EXPECT().Create(gomock.Any(), key.ID, alg, attr).Return(key, nil)
+		dbtx.EXPECT().SetTenant(gomock.Any(), key.ID, "tenant1").Return(errors.PostgresError("error"))
+
+		_, err := keyStore.Create(ctx, key.ID, alg, attr)
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+
+	t.Run("should not count keys without quota", func(t *testing.T) {
+		unlimitedStore := NewKeyStore(newStore, db, &entities.QuotaConfig{}, "my-store", userInfo, logger)
+		store.EXPECT().Import(gomock.Any(), key.ID, []byte("privKey"), alg, attr).Return(key, nil)
+		dbtx.EXPECT().SetTenant(gomock.Any(), key.ID, "tenant1").Return(nil)
+
+		_, err := unlimitedStore.Import(ctx, key.ID, []byte("privKey"), alg, attr)
+
+		require.NoError(t, err)
+	})
+}
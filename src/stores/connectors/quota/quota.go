@@ -0,0 +1,66 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+)
+
+// items are the items of a store attributed to tenants
+type items interface {
+	LockTenant(ctx context.Context, tenant string) error
+	CountByTenant(ctx context.Context, tenant string) (int, error)
+	SetTenant(ctx context.Context, id, tenant string) error
+}
+
+type enforcer struct {
+	storeName string
+	tenant    string
+	logger    log.Logger
+}
+
+// create runs a creation if the tenant has less than max items in the store, then records that the created item belongs
+// to the tenant. It runs in the transaction persisting the item, so that the item is never persisted without its tenant
+// and concurrent creations of the tenant wait for the transaction, on any replica. Deleted items count until they are
+// destroyed, as they can be restored
+func (e *enforcer) create(ctx context.Context, resource string, max int, db items, operation func() (string, error)) error {
+	logger := e.logger.With("store_name", e.storeName, "tenant", e.tenant, "resource", resource)
+
+	if max > 0 {
+		err := db.LockTenant(ctx, e.tenant)
+		if err != nil {
+			errMessage := "failed to lock items of tenant"
+			logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		n, err := db.CountByTenant(ctx, e.tenant)
+		if err != nil {
+			errMessage := "failed to count items of tenant"
+			logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		// Retrying does not help until items are destroyed, so the creation is forbidden rather than throttled
+		if n >= max {
+			errMessage := "quota exceeded, the tenant cannot create more items in this store"
+			logger.Warn(errMessage, "quota", max)
+			return errors.ForbiddenError("quota of %d %s exceeded for the tenant in store %s", max, resource, e.storeName)
+		}
+	}
+
+	id, err := operation()
+	if err != nil {
+		return err
+	}
+
+	err = db.SetTenant(ctx, id, e.tenant)
+	if err != nil {
+		errMessage := "failed to attribute created item to tenant"
+		logger.WithError(err).Error(errMessage, "id", id)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/approvable"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// Reaper periodically deletes expired keys, secrets and Ethereum accounts, and destroys them after their recovery period.
+// Destructions do not require approvals as the recovery period was decided when the items were created
+type Reaper struct {
+	stores         stores.Stores
+	interval       time.Duration
+	recoveryPeriod time.Duration
+	elector        cluster.Elector
+	logger         log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Reaper{}
+
+func New(storesConnector stores.Stores, cfg *entities.ExpiryConfig, elector cluster.Elector, logger log.Logger) *Reaper {
+	return &Reaper{
+		stores:         storesConnector,
+		interval:       cfg.ReaperInterval,
+		recoveryPeriod: cfg.RecoveryPeriod,
+		elector:        elector,
+		logger:         logger,
+		done:           make(chan struct{}),
+	}
+}
+
+func (r *Reaper) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go r.run(ctx)
+
+	r.logger.Info("expiry reaper started", "interval", r.interval.String())
+	return nil
+}
+
+func (r *Reaper) Stop(ctx context.Context) error {
+	r.cancel()
+
+	select {
+	case <-r.done:
+		r.logger.Info("expiry reaper stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Reaper) Close() error {
+	return nil
+}
+
+func (r *Reaper) Error() error {
+	return r.err
+}
+
+func (r *Reaper) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			// Only the leader reaps expired items when several replicas share the database
+			if !r.elector.IsLeader() {
+				continue
+			}
+
+			r.Reap(ctx, now)
+		}
+	}
+}
+
+// Reap deletes the items expired at now and destroys the expired items whose recovery period is over
+func (r *Reaper) Reap(ctx context.Context, now time.Time) {
+	userInfo := authtypes.NewWildcardUser()
+
+	r.reapStores(ctx, entities.KeyStoreType, userInfo, func(storeName string, logger log.Logger) error {
+		keyStore, err := r.stores.Key(ctx, storeName, userInfo)
+		if err != nil {
+			return err
+		}
+
+		r.reapKeys(ctx, keyStore, now, logger)
+		return nil
+	})
+
+	r.reapStores(ctx, entities.SecretStoreType, userInfo, func(storeName string, logger log.Logger) error {
+		secretStore, err := r.stores.Secret(ctx, storeName, userInfo)
+		if err != nil {
+			return err
+		}
+
+		r.reapSecrets(ctx, secretStore, now, logger)
+		return nil
+	})
+
+	r.reapStores(ctx, entities.EthereumStoreType, userInfo, func(storeName string, logger log.Logger) error {
+		ethStore, err := r.stores.Ethereum(ctx, storeName, userInfo)
+		if err != nil {
+			return err
+		}
+
+		r.reapEthereum(ctx, ethStore, now, logger)
+		return nil
+	})
+}
+
+func (r *Reaper) reapStores(ctx context.Context, storeType string, userInfo *authtypes.UserInfo, reapStore func(storeName string, logger log.Logger) error) {
+	storeNames, err := r.stores.List(ctx, storeType, userInfo)
+	if err != nil {
+		r.logger.WithError(err).Error("failed to list stores for expiry", "type", storeType)
+		return
+	}
+
+	for _, storeName := range storeNames {
+		logger := r.logger.With("store", storeName)
+
+		err = reapStore(storeName, logger)
+		if err != nil {
+			logger.WithError(err).Error("failed to get store for expiry")
+		}
+	}
+}
+
+func (r *Reaper) reapKeys(ctx context.Context, keyStore stores.KeyStore, now time.Time, logger log.Logger) {
+	expiredIDs, err := keyStore.ListExpiring(ctx, now, 0, 0)
+	if err != nil {
+		logger.WithError(err).Error("failed to list expired keys")
+	}
+
+	for _, id := range expiredIDs {
+		err = keyStore.Delete(ctx, id)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete expired key", "id", id)
+			continue
+		}
+
+		logger.Info("expired key deleted successfully", "id", id)
+	}
+
+	purgeableKeys, err := keyStore.SearchPurgeable(ctx, now, r.recoveryPeriod)
+	if err != nil {
+		logger.WithError(err).Error("failed to search purgeable keys")
+	}
+
+	for _, key := range purgeableKeys {
+		if !r.isPurgeable(key.Metadata, now) {
+			continue
+		}
+
+		err = keyStore.Destroy(approvable.WithoutApproval(ctx), key.ID)
+		if err != nil {
+			logger.WithError(err).Error("failed to destroy expired key", "id", key.ID)
+			continue
+		}
+
+		logger.Info("expired key destroyed successfully", "id", key.ID)
+	}
+}
+
+func (r *Reaper) reapSecrets(ctx context.Context, secretStore stores.SecretStore, now time.Time, logger log.Logger) {
+	expiredIDs, err := secretStore.ListExpiring(ctx, now, 0, 0)
+	if err != nil {
+		logger.WithError(err).Error("failed to list expired secrets")
+	}
+
+	for _, id := range expiredIDs {
+		err = secretStore.Delete(ctx, id)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete expired secret", "id", id)
+			continue
+		}
+
+		logger.Info("expired secret deleted successfully", "id", id)
+	}
+
+	purgeableSecrets, err := secretStore.SearchPurgeable(ctx, now, r.recoveryPeriod)
+	if err != nil {
+		logger.WithError(err).Error("failed to search purgeable secrets")
+	}
+
+	for _, secret := range purgeableSecrets {
+		if !r.isPurgeable(secret.Metadata, now) {
+			continue
+		}
+
+		err = secretStore.Destroy(approvable.WithoutApproval(ctx), secret.ID)
+		if err != nil {
+			logger.WithError(err).Error("failed to destroy expired secret", "id", secret.ID)
+			continue
+		}
+
+		logger.Info("expired secret destroyed successfully", "id", secret.ID)
+	}
+}
+
+func (r *Reaper) reapEthereum(ctx context.Context, ethStore stores.EthStore, now time.Time, logger log.Logger) {
+	expiredAddrs, err := ethStore.ListExpiring(ctx, now, 0, 0)
+	if err != nil {
+		logger.WithError(err).Error("failed to list expired ethereum accounts")
+	}
+
+	for _, addr := range expiredAddrs {
+		err = ethStore.Delete(ctx, addr)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete expired ethereum account", "address", addr.Hex())
+			continue
+		}
+
+		logger.Info("expired ethereum account deleted successfully", "address", addr.Hex())
+	}
+
+	purgeableAccs, err := ethStore.SearchPurgeable(ctx, now, r.recoveryPeriod)
+	if err != nil {
+		logger.WithError(err).Error("failed to search purgeable ethereum accounts")
+	}
+
+	for _, acc := range purgeableAccs {
+		if !r.isPurgeable(acc.Metadata, now) {
+			continue
+		}
+
+		err = ethStore.Destroy(approvable.WithoutApproval(ctx), acc.Address)
+		if err != nil {
+			logger.WithError(err).Error("failed to destroy expired ethereum account", "address", acc.Address.Hex())
+			continue
+		}
+
+		logger.Info("expired ethereum account destroyed successfully", "address", acc.Address.Hex())
+	}
+}
+
+// isPurgeable returns whether a deleted item expired before its deletion and its recovery period is over.
+// Items deleted before they expired were deleted by a user and are only destroyed on request
+func (r *Reaper) isPurgeable(metadata *entities.Metadata, now time.Time) bool {
+	if metadata.ExpireAt.IsZero() || metadata.DeletedAt.IsZero() || metadata.ExpireAt.After(metadata.DeletedAt) {
+		return false
+	}
+
+	recoveryPeriod := metadata.RecoveryPeriod
+	if recoveryPeriod == 0 {
+		recoveryPeriod = r.recoveryPeriod
+	}
+
+	return !metadata.DeletedAt.Add(recoveryPeriod).After(now)
+}
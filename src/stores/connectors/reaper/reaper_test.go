@@ -0,0 +1,72 @@
+package reaper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+func TestReap(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storesConnector := mock.NewMockStores(ctrl)
+	keyStore := mock.NewMockKeyStore(ctrl)
+	secretStore := mock.NewMockSecretStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	reaper := New(storesConnector, &entities.ExpiryConfig{ReaperInterval: time.Minute, RecoveryPeriod: time.Hour}, cluster.Standalone{}, logger)
+	now := time.Now()
+
+	t.Run("should delete expired items and destroy them after their recovery period", func(t *testing.T) {
+		purgeableKey := testutils2.FakeKey()
+		purgeableKey.ID = "purgeable-key"
+		purgeableKey.Metadata.ExpireAt = now.Add(-3 * time.Hour)
+		purgeableKey.Metadata.DeletedAt = now.Add(-2 * time.Hour)
+
+		recoverableKey := testutils2.FakeKey()
+		recoverableKey.ID = "recoverable-key"
+		recoverableKey.Metadata.ExpireAt = now.Add(-3 * time.Hour)
+		recoverableKey.Metadata.DeletedAt = now.Add(-2 * time.Hour)
+		recoverableKey.Metadata.RecoveryPeriod = 24 * time.Hour
+
+		deletedKey := testutils2.FakeKey()
+		deletedKey.ID = "deleted-key"
+		deletedKey.Metadata.DeletedAt = now.Add(-2 * time.Hour)
+
+		deletedBeforeExpiryKey := testutils2.FakeKey()
+		deletedBeforeExpiryKey.ID = "deleted-before-expiry-key"
+		deletedBeforeExpiryKey.Metadata.ExpireAt = now.Add(-time.Hour)
+		deletedBeforeExpiryKey.Metadata.DeletedAt = now.Add(-2 * time.Hour)
+
+		expiredSecret := testutils2.FakeSecret()
+		expiredSecret.Metadata.ExpireAt = now.Add(-time.Minute)
+		expiredSecret.Metadata.DeletedAt = now
+
+		storesConnector.EXPECT().List(gomock.Any(), entities.KeyStoreType, gomock.Any()).Return([]string{"my-key-store"}, nil)
+		storesConnector.EXPECT().Key(gomock.Any(), "my-key-store", gomock.Any()).Return(keyStore, nil)
+		keyStore.EXPECT().ListExpiring(gomock.Any(), now, uint64(0), uint64(0)).Return([]string{"expired-key"}, nil)
+		keyStore.EXPECT().Delete(gomock.Any(), "expired-key").Return(nil)
+		keyStore.EXPECT().SearchPurgeable(gomock.Any(), now, time.Hour).
+			Return([]*entities.Key{purgeableKey, recoverableKey, deletedKey, deletedBeforeExpiryKey}, nil)
+		keyStore.EXPECT().Destroy(gomock.Any(), purgeableKey.ID).Return(nil)
+
+		storesConnector.EXPECT().List(gomock.Any(), entities.SecretStoreType, gomock.Any()).Return([]string{"my-secret-store"}, nil)
+		storesConnector.EXPECT().Secret(gomock.Any(), "my-secret-store", gomock.Any()).Return(secretStore, nil)
+		secretStore.EXPECT().ListExpiring(gomock.Any(), now, uint64(0), uint64(0)).Return([]string{expiredSecret.ID}, nil)
+		secretStore.EXPECT().Delete(gomock.Any(), expiredSecret.ID).Return(nil)
+		secretStore.EXPECT().SearchPurgeable(gomock.Any(), now, time.Hour).Return([]*entities.Secret{expiredSecret}, nil)
+
+		storesConnector.EXPECT().List(gomock.Any(), entities.EthereumStoreType, gomock.Any()).Return([]string{}, nil)
+
+		reaper.Reap(ctx, now)
+	})
+}
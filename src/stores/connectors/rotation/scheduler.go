@@ -0,0 +1,125 @@
+package rotation
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// Scheduler periodically rotates the keys whose rotation policy is due
+type Scheduler struct {
+	stores   stores.Stores
+	interval time.Duration
+	elector  cluster.Elector
+	logger   log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Scheduler{}
+
+func New(storesConnector stores.Stores, cfg *entities.RotationConfig, elector cluster.Elector, logger log.Logger) *Scheduler {
+	return &Scheduler{
+		stores:   storesConnector,
+		interval: cfg.CheckInterval,
+		elector:  elector,
+		logger:   logger,
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go s.run(ctx)
+
+	s.logger.Info("key rotation scheduler started", "interval", s.interval.String())
+	return nil
+}
+
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.cancel()
+
+	select {
+	case <-s.done:
+		s.logger.Info("key rotation scheduler stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) Close() error {
+	return nil
+}
+
+func (s *Scheduler) Error() error {
+	return s.err
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			// Keys are rotated by the leader only so that a due key is not rotated by several replicas
+			if !s.elector.IsLeader() {
+				continue
+			}
+
+			s.RotateDue(ctx, now)
+		}
+	}
+}
+
+// RotateDue rotates every key of every key store whose rotation policy is due at now
+func (s *Scheduler) RotateDue(ctx context.Context, now time.Time) {
+	userInfo := authtypes.NewWildcardUser()
+
+	storeNames, err := s.stores.List(ctx, entities.KeyStoreType, userInfo)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to list key stores for rotation")
+		return
+	}
+
+	for _, storeName := range storeNames {
+		logger := s.logger.With("store", storeName)
+
+		keyStore, err := s.stores.Key(ctx, storeName, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to get key store for rotation")
+			continue
+		}
+
+		keys, err := keyStore.SearchDueForRotation(ctx, now)
+		if err != nil {
+			logger.WithError(err).Error("failed to search keys due for rotation")
+			continue
+		}
+
+		for _, key := range keys {
+			_, err = keyStore.Rotate(ctx, key.ID, key.Algo)
+			if err != nil {
+				logger.WithError(err).Error("failed to rotate key", "id", key.ID)
+				continue
+			}
+
+			logger.Info("scheduled key rotation executed successfully", "id", key.ID)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package rotation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+func TestRotateDue(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storesConnector := mock.NewMockStores(ctrl)
+	keyStore := mock.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	scheduler := New(storesConnector, &entities.RotationConfig{CheckInterval: time.Minute}, cluster.Standalone{}, logger)
+	now := time.Now()
+
+	dueKey := testutils2.FakeKey()
+	dueKey.ID = "due-key"
+	dueKey.RotationPolicy = &entities.RotationPolicy{Interval: time.Hour}
+	dueKey.Metadata.RotatedAt = now.Add(-2 * time.Hour)
+
+	t.Run("should rotate the keys whose policy is due", func(t *testing.T) {
+		storesConnector.EXPECT().List(gomock.Any(), entities.KeyStoreType, gomock.Any()).Return([]string{"my-store"}, nil)
+		storesConnector.EXPECT().Key(gomock.Any(), "my-store", gomock.Any()).Return(keyStore, nil)
+		keyStore.EXPECT().SearchDueForRotation(gomock.Any(), now).Return([]*entities.Key{dueKey}, nil)
+		keyStore.EXPECT().Rotate(gomock.Any(), dueKey.ID, dueKey.Algo).Return(dueKey, nil)
+
+		scheduler.RotateDue(ctx, now)
+	})
+
+	t.Run("should continue with the next keys if a rotation fails", func(t *testing.T) {
+		otherDueKey := testutils2.FakeKey()
+		otherDueKey.ID = "other-due-key"
+		otherDueKey.RotationPolicy = dueKey.RotationPolicy
+		otherDueKey.Metadata.RotatedAt = dueKey.Metadata.RotatedAt
+
+		storesConnector.EXPECT().List(gomock.Any(), entities.KeyStoreType, gomock.Any()).Return([]string{"my-store"}, nil)
+		storesConnector.EXPECT().Key(gomock.Any(), "my-store", gomock.Any()).Return(keyStore, nil)
+		keyStore.EXPECT().SearchDueForRotation(gomock.Any(), now).Return([]*entities.Key{dueKey, otherDueKey}, nil)
+		keyStore.EXPECT().Rotate(gomock.Any(), dueKey.ID, dueKey.Algo).Return(nil, fmt.Errorf("error"))
+		keyStore.EXPECT().Rotate(gomock.Any(), otherDueKey.ID, otherDueKey.Algo).Return(otherDueKey, nil)
+
+		scheduler.RotateDue(ctx, now)
+	})
+
+	t.Run("should continue with the next stores if the keys due for rotation cannot be searched", func(t *testing.T) {
+		otherKeyStore := mock.NewMockKeyStore(ctrl)
+
+		storesConnector.EXPECT().List(gomock.Any(), entities.KeyStoreType, gomock.Any()).Return([]string{"my-vault-store", "my-store"}, nil)
+		storesConnector.EXPECT().Key(gomock.Any(), "my-vault-store", gomock.Any()).Return(otherKeyStore, nil)
+		storesConnector.EXPECT().Key(gomock.Any(), "my-store", gomock.Any()).Return(keyStore, nil)
+		otherKeyStore.EXPECT().SearchDueForRotation(gomock.Any(), now).Return(nil, fmt.Errorf("error"))
+		keyStore.EXPECT().SearchDueForRotation(gomock.Any(), now).Return([]*entities.Key{dueKey}, nil)
+		keyStore.EXPECT().Rotate(gomock.Any(), dueKey.ID, dueKey.Algo).Return(dueKey, nil)
+
+		scheduler.RotateDue(ctx, now)
+	})
+}
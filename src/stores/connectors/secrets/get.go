@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
@@ -31,6 +32,12 @@ func (c Connector) Get(ctx context.Context, id, version string) (*entities.Secre
 		return nil, err
 	}
 
+	if secret.Metadata.IsExpired(time.Now()) {
+		errMessage := "secret has expired"
+		logger.Error(errMessage, "expire_at", secret.Metadata.ExpireAt)
+		return nil, errors.ExpiredError(errMessage)
+	}
+
 	secretVault, err := c.store.Get(ctx, id, version)
 	if err != nil {
 		return nil, err
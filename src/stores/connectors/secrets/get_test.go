@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
 
@@ -42,6 +44,18 @@ func TestGetSecret(t *testing.T) {
 		assert.Equal(t, secret, rSecret)
 	})
 
+	t.Run("should fail with ExpiredError if the secret has expired", func(t *testing.T) {
+		expiredSecret := testutils2.FakeSecret()
+		expiredSecret.Metadata.ExpireAt = time.Now().Add(-time.Minute)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiredSecret.ID, expiredSecret.Metadata.Version).Return(expiredSecret, nil)
+
+		_, err := connector.Get(ctx, expiredSecret.ID, expiredSecret.Metadata.Version)
+
+		assert.True(t, errors.IsExpiredError(err))
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(expectedErr)
 
@@ -2,6 +2,8 @@ package secrets
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 
@@ -19,6 +21,12 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	if strings.HasPrefix(id, entities.WalletSeedPrefix) {
+		errMessage := "secret ID prefix is reserved to HD wallets"
+		logger.Error(errMessage, "prefix", entities.WalletSeedPrefix)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
 	secret, err := c.store.Set(ctx, id, value, attr)
 	if err != nil && errors.IsAlreadyExistsError(err) {
 		secret, err = c.store.Get(ctx, id, "")
@@ -27,6 +35,7 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	secret.Metadata.SetExpiry(attr, time.Now())
 	_, err = c.db.Add(ctx, secret)
 	if err != nil {
 		return nil, err
@@ -12,6 +12,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/longfan78/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -57,6 +58,14 @@ func TestSetSecret(t *testing.T) {
 		assert.Equal(t, rSecret, secret)
 	})
 
+	t.Run("should fail with InvalidParameterError if the ID is reserved to HD wallets", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+
+		_, err := connector.Set(ctx, entities2.WalletSeedPrefix+"my-wallet", secret.Value, attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(expectedErr)
 
@@ -0,0 +1,44 @@
+package stores
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c *Connector) Create(ctx context.Context, store *entities.StoreDefinition, userInfo *authtypes.UserInfo) (*entities.StoreDefinition, error) {
+	logger := c.logger.With("name", store.Name, "type", store.StoreType)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.storeExists(store.Name) {
+		errMessage := "store already exists"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	}
+
+	err = c.createFromDefinition(ctx, store, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	createdStore, err := c.db.Stores().Insert(ctx, store)
+	if err != nil {
+		c.deleteStore(store.Name)
+		return nil, err
+	}
+
+	c.revisions.Set(store.Name, createdStore.UpdatedAt)
+	c.notify(ctx, cluster.OpUpsert, store.Name)
+
+	logger.Info("store persisted successfully")
+	return createdStore, nil
+}
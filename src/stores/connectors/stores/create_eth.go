@@ -5,11 +5,12 @@ import (
 
 	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/stores"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
-func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore string, allowedTenants []string, userInfo *auth.UserInfo) error {
-	logger := c.logger.With("name", name, "key_store", keyStore)
+func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error {
+	logger := c.logger.With("name", name, "key_store", keyStore, "secret_store", secretStore)
 	logger.Debug("creating ethereum store")
 
 	// TODO: Uncomment when authManager no longer a runnable
@@ -21,7 +22,18 @@ func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore string, a
 		return err
 	}
 
+	var seeds stores.SecretStore
+	if secretStore != "" {
+		seeds, err = c.getSecretStore(ctx, secretStore, resolver)
+		if err != nil {
+			return err
+		}
+	}
+
 	c.createStore(name, entities.EthereumStoreType, store, allowedTenants)
+	if seeds != nil {
+		c.setSecretStore(name, seeds)
+	}
 
 	logger.Info("ethereum store created successfully")
 	return nil
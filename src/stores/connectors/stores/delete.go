@@ -0,0 +1,41 @@
+package stores
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+func (c *Connector) Delete(ctx context.Context, name string, userInfo *authtypes.UserInfo) error {
+	logger := c.logger.With("name", name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return err
+	}
+
+	store, err := c.db.Stores().FindOne(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	err = resolver.CheckAccess(store.AllowedTenants)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.Stores().Delete(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// Items indexed for this store are kept in the database so that they are available if the store is created again
+	c.deleteStore(name)
+	c.notify(ctx, cluster.OpDelete, name)
+
+	logger.Info("store deleted successfully")
+	return nil
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/auth"
 
 	eth "github.com/longfan78/quorum-key-manager/src/stores/connectors/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/approvable"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/audited"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/quota"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/traced"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/guarded"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
@@ -20,13 +26,25 @@ func (c *Connector) Ethereum(ctx context.Context, storeName string, userInfo *au
 	permissions := c.roles.UserPermissions(ctx, userInfo)
 	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
 
-	store, err := c.getEthStore(ctx, storeName, resolver)
+	err := c.checkAllowedStore(storeName, userInfo)
 	if err != nil {
 		return nil, err
 	}
 
-	c.logger.Debug("ethereum store found successfully", "store_name", storeName)
-	return eth.NewConnector(store, c.db.ETHAccounts(storeName), resolver, c.logger), nil
+	store, seeds, err := c.getEthStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := c.logger.WithContext(ctx)
+	logger.Debug("ethereum store found successfully", "store_name", storeName)
+	newEthStore := func(db database.ETHAccounts) stores.EthStore {
+		return eth.NewConnector(store, seeds, db, c.db.ETHWallets(storeName), resolver, logger)
+	}
+	quotaStore := quota.NewEthStore(newEthStore, c.db.ETHAccounts(storeName), c.quotas, storeName, userInfo, logger)
+	approvableStore := approvable.NewEthStore(quotaStore, c.approvals, storeName, userInfo, logger)
+	guardedStore := guarded.NewEthStore(approvableStore, c.policies, c.db.ETHAccounts(storeName), storeName, logger)
+	return traced.NewEthStore(audited.NewEthStore(guardedStore, c.auditor, storeName, userInfo), storeName), nil
 }
 
 func (c *Connector) EthereumByAddr(ctx context.Context, addr common.Address, userInfo *authtypes.UserInfo) (stores.EthStore, error) {
@@ -60,17 +78,19 @@ func (c *Connector) EthereumByAddr(ctx context.Context, addr common.Address, use
 	return nil, errors.NotFoundError(errMessage)
 }
 
-func (c *Connector) getEthStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.KeyStore, error) {
+// getEthStore returns the key store of an Ethereum store, and its secret store holding HD wallets if any
+func (c *Connector) getEthStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.KeyStore, stores.SecretStore, error) {
 	storeInfo, err := c.getStore(ctx, storeName, resolver)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if storeInfo.StoreType != entities.EthereumStoreType {
 		errMessage := "not an ethereum store"
 		c.logger.Error(errMessage, "store_name", storeName)
-		return nil, errors.NotFoundError(errMessage)
+		return nil, nil, errors.NotFoundError(errMessage)
 	}
 
-	return storeInfo.Store.(stores.KeyStore), nil
+	seeds, _ := storeInfo.SecretStore.(stores.SecretStore)
+	return storeInfo.Store.(stores.KeyStore), seeds, nil
 }
@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	approvalsmock "github.com/longfan78/quorum-key-manager/src/approvals/mock"
+	auditmock "github.com/longfan78/quorum-key-manager/src/audit/mock"
 	"github.com/longfan78/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	policiesmock "github.com/longfan78/quorum-key-manager/src/policies/mock"
 	mock2 "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
 	mock4 "github.com/longfan78/quorum-key-manager/src/vaults/mock"
 	"github.com/golang/mock/gomock"
@@ -23,8 +27,11 @@ func TestGetEthereum(t *testing.T) {
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockRoles(ctrl)
 	vaults := mock4.NewMockVaults(ctrl)
+	auditor := auditmock.NewMockAuditor(ctrl)
+	policies := policiesmock.NewMockPolicies(ctrl)
+	approvals := approvalsmock.NewMockApprovals(ctrl)
 
-	connector := NewConnector(auth, db, vaults, logger)
+	connector := NewConnector(auth, db, vaults, auditor, policies, approvals, nil, cluster.Standalone{}, logger)
 
 	t.Run("should fail with not found ethereum store successfully", func(t *testing.T) {
 		storeName := "not-found-store"
@@ -7,7 +7,12 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/approvable"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/audited"
 	"github.com/longfan78/quorum-key-manager/src/stores/connectors/keys"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/quota"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/traced"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
@@ -18,13 +23,24 @@ func (c *Connector) Key(ctx context.Context, storeName string, userInfo *authtyp
 	permissions := c.roles.UserPermissions(ctx, userInfo)
 	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
 
+	err := c.checkAllowedStore(storeName, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	store, err := c.getKeyStore(ctx, storeName, resolver)
 	if err != nil {
 		return nil, err
 	}
 
-	c.logger.Debug("key store found successfully", "store_name", storeName)
-	return keys.NewConnector(store, c.db.Keys(storeName), resolver, c.logger), nil
+	logger := c.logger.WithContext(ctx)
+	logger.Debug("key store found successfully", "store_name", storeName)
+	newKeyStore := func(db database.Keys) stores.KeyStore {
+		return keys.NewConnector(store, db, resolver, logger)
+	}
+	quotaStore := quota.NewKeyStore(newKeyStore, c.db.Keys(storeName), c.quotas, storeName, userInfo, logger)
+	auditedStore := audited.NewKeyStore(approvable.NewKeyStore(quotaStore, c.approvals, storeName, userInfo, logger), c.auditor, storeName, userInfo)
+	return traced.NewKeyStore(auditedStore, storeName), nil
 }
 
 func (c *Connector) getKeyStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.KeyStore, error) {
@@ -10,20 +10,31 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/auth"
 	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/approvable"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/audited"
 	"github.com/longfan78/quorum-key-manager/src/stores/connectors/secrets"
+	"github.com/longfan78/quorum-key-manager/src/stores/connectors/traced"
 )
 
 func (c *Connector) Secret(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.SecretStore, error) {
 	permissions := c.roles.UserPermissions(ctx, userInfo)
 	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
 
+	err := c.checkAllowedStore(storeName, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	store, err := c.getSecretStore(ctx, storeName, resolver)
 	if err != nil {
 		return nil, err
 	}
 
-	c.logger.Debug("secret store found successfully", "store_name", storeName)
-	return secrets.NewConnector(store, c.db.Secrets(storeName), resolver, c.logger), nil
+	logger := c.logger.WithContext(ctx)
+	logger.Debug("secret store found successfully", "store_name", storeName)
+	secretStore := secrets.NewConnector(store, c.db.Secrets(storeName), resolver, logger)
+	auditedStore := audited.NewSecretStore(approvable.NewSecretStore(secretStore, c.approvals, storeName, userInfo, logger), c.auditor, storeName, userInfo)
+	return traced.NewSecretStore(auditedStore, storeName), nil
 }
 
 func (c *Connector) getSecretStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.SecretStore, error) {
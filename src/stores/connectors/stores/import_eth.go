@@ -19,7 +19,7 @@ func (c *Connector) ImportEthereum(ctx context.Context, storeName string, userIn
 	// permissions := c.authManager.UserPermissions(userInfo)
 	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
 
-	store, err := c.getEthStore(ctx, storeName, resolver)
+	store, _, err := c.getEthStore(ctx, storeName, resolver)
 	if err != nil {
 		return err
 	}
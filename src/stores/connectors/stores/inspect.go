@@ -0,0 +1,32 @@
+package stores
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c *Connector) Inspect(ctx context.Context, name string, userInfo *authtypes.UserInfo) (*entities.StoreDefinition, error) {
+	logger := c.logger.With("name", name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionRead, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := c.db.Stores().FindOne(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resolver.CheckAccess(store.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("store definition found successfully")
+	return store, nil
+}
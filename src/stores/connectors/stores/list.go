@@ -20,6 +20,10 @@ func (c *Connector) List(ctx context.Context, storeType string, userInfo *authty
 			continue
 		}
 
+		if !userInfo.IsStoreAllowed(k) {
+			continue
+		}
+
 		permissions := c.roles.UserPermissions(ctx, userInfo)
 		resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
 
@@ -0,0 +1,37 @@
+package stores
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+func (c *Connector) Load(ctx context.Context) error {
+	stores, err := c.db.Stores().FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	userInfo := authtypes.NewWildcardUser()
+	for _, store := range stores {
+		logger := c.logger.With("name", store.Name)
+
+		// Stores declared in manifests take precedence over persisted ones
+		if c.storeExists(store.Name) {
+			logger.Warn("store already declared in manifests, skipping persisted definition")
+			continue
+		}
+
+		// A store that cannot be created must not prevent the others from being loaded
+		err = c.createFromDefinition(ctx, store, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to load store")
+			continue
+		}
+
+		c.revisions.Set(store.Name, store.UpdatedAt)
+	}
+
+	c.logger.Info("stores loaded successfully", "count", len(stores))
+	return nil
+}
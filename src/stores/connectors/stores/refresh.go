@@ -0,0 +1,74 @@
+package stores
+
+import (
+	"context"
+	"time"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+// Refresh applies a change made to a store by another replica, reading its definition from the database
+func (c *Connector) Refresh(ctx context.Context, event *cluster.Event) error {
+	switch event.Op {
+	case cluster.OpDelete:
+		c.deleteStore(event.Name)
+		return nil
+	case cluster.OpResync:
+		return c.resync(ctx)
+	}
+
+	store, err := c.db.Stores().FindOne(ctx, event.Name)
+	if err != nil {
+		return err
+	}
+
+	err = c.createFromDefinition(ctx, store, authtypes.NewWildcardUser())
+	if err != nil {
+		return err
+	}
+
+	c.revisions.Set(store.Name, store.UpdatedAt)
+	return nil
+}
+
+// resync applies the changes made to the persisted stores that the replica missed
+func (c *Connector) resync(ctx context.Context) error {
+	readAt := time.Now()
+	stores, err := c.db.Stores().FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	userInfo := authtypes.NewWildcardUser()
+	var names []string
+	for _, store := range stores {
+		names = append(names, store.Name)
+		logger := c.logger.With("name", store.Name)
+
+		// Stores declared in manifests take precedence over persisted ones
+		if !c.revisions.Has(store.Name) && c.storeExists(store.Name) {
+			continue
+		}
+
+		if !c.revisions.Changed(store.Name, store.UpdatedAt, readAt) {
+			continue
+		}
+
+		err = c.createFromDefinition(ctx, store, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to resync store")
+			continue
+		}
+
+		c.revisions.Set(store.Name, store.UpdatedAt)
+		logger.Info("store resynced successfully")
+	}
+
+	for _, name := range c.revisions.Missing(names, readAt) {
+		c.deleteStore(name)
+		c.logger.Info("store deleted by another replica removed", "name", name)
+	}
+
+	return nil
+}
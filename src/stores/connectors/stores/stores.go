@@ -9,31 +9,52 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 
+	"github.com/longfan78/quorum-key-manager/src/approvals"
+	"github.com/longfan78/quorum-key-manager/src/audit"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/policies"
 	"github.com/longfan78/quorum-key-manager/src/stores"
 	"github.com/longfan78/quorum-key-manager/src/stores/database"
 )
 
 type Connector struct {
-	logger log.Logger
-	mux    sync.RWMutex
-	roles  auth.Roles
-	stores map[string]*entities.Store
-	vaults vaults.Vaults
-	db     database.Database
+	logger    log.Logger
+	mux       sync.RWMutex
+	roles     auth.Roles
+	stores    map[string]*entities.Store
+	vaults    vaults.Vaults
+	db        database.Database
+	auditor   audit.Auditor
+	policies  policies.Policies
+	approvals approvals.Approvals
+	notifier  cluster.Notifier
+	quotas    *entities.QuotaConfig
+	revisions *cluster.Revisions
 }
 
 var _ stores.Stores = &Connector{}
 
-func NewConnector(roles auth.Roles, db database.Database, vaultsService vaults.Vaults, logger log.Logger) *Connector {
+func NewConnector(roles auth.Roles, db database.Database, vaultsService vaults.Vaults, auditor audit.Auditor, policiesService policies.Policies, approvalsService approvals.Approvals, quotas *entities.QuotaConfig, notifier cluster.Notifier, logger log.Logger) *Connector {
+	if quotas == nil {
+		quotas = &entities.QuotaConfig{}
+	}
+
 	return &Connector{
-		logger: logger,
-		mux:    sync.RWMutex{},
-		roles:  roles,
-		stores: make(map[string]*entities.Store),
-		vaults: vaultsService,
-		db:     db,
+		logger:    logger,
+		mux:       sync.RWMutex{},
+		roles:     roles,
+		stores:    make(map[string]*entities.Store),
+		vaults:    vaultsService,
+		db:        db,
+		auditor:   auditor,
+		policies:  policiesService,
+		approvals: approvalsService,
+		notifier:  notifier,
+		quotas:    quotas,
+		revisions: cluster.NewRevisions(),
 	}
 }
 
@@ -50,6 +71,14 @@ func (c *Connector) createStore(name, storeType string, store interface{}, allow
 	}
 }
 
+// setSecretStore sets the secret store holding the HD wallets of an Ethereum store
+func (c *Connector) setSecretStore(name string, secretStore interface{}) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.stores[name].SecretStore = secretStore
+}
+
 // TODO: Move to data layer
 func (c *Connector) getStore(_ context.Context, name string, resolver auth.Authorizator) (*entities.Store, error) {
 	c.mux.RLock()
@@ -67,3 +96,53 @@ func (c *Connector) getStore(_ context.Context, name string, resolver auth.Autho
 	c.logger.Error(errMessage, "name", name)
 	return nil, errors.NotFoundError(errMessage)
 }
+
+// checkAllowedStore verifies that the user is not restricted to other stores, e.g. by a scoped API key
+func (c *Connector) checkAllowedStore(storeName string, userInfo *authtypes.UserInfo) error {
+	if !userInfo.IsStoreAllowed(storeName) {
+		errMessage := "store was not found"
+		c.logger.Error(errMessage, "name", storeName, "allowed_stores", userInfo.AllowedStores)
+		return errors.NotFoundError(errMessage)
+	}
+
+	return nil
+}
+
+// TODO: Move to data layer
+func (c *Connector) deleteStore(name string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	delete(c.stores, name)
+	c.revisions.Delete(name)
+}
+
+// TODO: Move to data layer
+func (c *Connector) storeExists(name string) bool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	_, ok := c.stores[name]
+	return ok
+}
+
+func (c *Connector) createFromDefinition(ctx context.Context, store *entities.StoreDefinition, userInfo *authtypes.UserInfo) error {
+	switch store.StoreType {
+	case entities.SecretStoreType:
+		return c.CreateSecret(ctx, store.Name, store.Vault, store.AllowedTenants, userInfo)
+	case entities.KeyStoreType:
+		return c.CreateKey(ctx, store.Name, store.Vault, store.SecretStore, store.AllowedTenants, userInfo)
+	case entities.EthereumStoreType:
+		return c.CreateEthereum(ctx, store.Name, store.KeyStore, store.SecretStore, store.AllowedTenants, userInfo)
+	default:
+		return errors.InvalidFormatError("invalid store type")
+	}
+}
+
+// notify propagates a change to the other replicas, the change being applied locally whether it succeeds or not
+func (c *Connector) notify(ctx context.Context, op, name string) {
+	err := c.notifier.Notify(ctx, &cluster.Event{Kind: cluster.KindStore, Name: name, Op: op})
+	if err != nil {
+		c.logger.WithError(err).Warn("failed to propagate store change", "name", name, "op", op)
+	}
+}
@@ -0,0 +1,115 @@
+package stores
+
+import (
+	"context"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/metrics"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// Sync reconciles the items indexed for a store with the ones of its vault, which can also be written by other tools.
+// On dry run, the drift is only reported
+func (c *Connector) Sync(ctx context.Context, storeName string, dryRun bool, userInfo *authtypes.UserInfo) (*entities.SyncReport, error) {
+	logger := c.logger.With("store_name", storeName, "dry_run", dryRun)
+
+	action := authtypes.ActionWrite
+	if dryRun {
+		action = authtypes.ActionRead
+	}
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: action, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	storeInfo, err := c.getStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.SyncReport{StoreName: storeName, DryRun: dryRun, StartedAt: time.Now()}
+	switch storeInfo.StoreType {
+	case entities.SecretStoreType:
+		err = c.syncSecrets(ctx, storeName, storeInfo.Store.(stores.SecretStore), report, logger)
+	case entities.KeyStoreType:
+		err = c.syncKeys(ctx, storeName, storeInfo.Store.(stores.KeyStore), report, logger)
+	case entities.EthereumStoreType:
+		err = c.syncEthereum(ctx, storeName, storeInfo.Store.(stores.KeyStore), report, logger)
+	default:
+		err = errors.InvalidFormatError("invalid store type")
+	}
+	if err != nil {
+		errMessage := "failed to synchronize store"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	report.CompletedAt = time.Now()
+
+	err = c.db.SyncReports().Upsert(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ObserveStoreSync(storeName, len(report.Added), len(report.Deleted), len(report.Updated), len(report.Failed))
+
+	logger.Info("store synchronized successfully",
+		"n_added", len(report.Added),
+		"n_deleted", len(report.Deleted),
+		"n_updated", len(report.Updated),
+		"n_failures", len(report.Failed),
+	)
+	return report, nil
+}
+
+// GetSyncReport gets the report of the last synchronization of a store
+func (c *Connector) GetSyncReport(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (*entities.SyncReport, error) {
+	logger := c.logger.With("store_name", storeName)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionRead, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.getStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.SyncReports().FindOne(ctx, storeName)
+}
+
+// reconcile records the drift of an item, applying the change to the database unless the synchronization is a dry run
+func reconcile(report *entities.SyncReport, drift *[]string, id string, apply func() error, logger log.Logger) {
+	if !report.DryRun {
+		err := apply()
+		if err != nil {
+			logger.WithError(err).Error("failed to reconcile item", "id", id)
+			report.Failed = append(report.Failed, id)
+			return
+		}
+	}
+
+	*drift = append(*drift, id)
+}
+
+func equalTags(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package stores
+
+import (
+	"context"
+
+	arrays "github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// syncEthereum reconciles the Ethereum accounts of a store with the keys of its vault, accounts being reported by address
+func (c *Connector) syncEthereum(ctx context.Context, storeName string, store stores.KeyStore, report *entities.SyncReport, logger log.Logger) error {
+	vaultIDs, err := store.List(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	db := c.db.ETHAccounts(storeName)
+	accounts, err := db.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	deletedAccounts, err := db.GetAllDeleted(ctx)
+	if err != nil {
+		return err
+	}
+
+	indexed := make(map[string]*entities.ETHAccount, len(accounts))
+	for _, acc := range accounts {
+		indexed[acc.KeyID] = acc
+	}
+
+	deleted := make(map[string]struct{}, len(deletedAccounts))
+	for _, acc := range deletedAccounts {
+		deleted[acc.KeyID] = struct{}{}
+	}
+
+	for _, id := range vaultIDs {
+		// Accounts deleted through the key manager can still be listed by vaults supporting their recovery
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+
+		current, isIndexed := indexed[id]
+
+		key, err := store.Get(ctx, id)
+		if err != nil {
+			// Local keys are only known through the database, their tags cannot drift
+			if isIndexed && errors.IsNotSupportedError(err) {
+				continue
+			}
+
+			logger.WithError(err).Warn("failed to get key from vault", "id", id)
+			report.Failed = append(report.Failed, id)
+			continue
+		}
+
+		if !key.IsETHAccount() {
+			continue
+		}
+
+		if !isIndexed {
+			acc := models.NewETHAccountFromKey(key, &entities.Attributes{Tags: key.Tags})
+			reconcile(report, &report.Added, acc.Address.Hex(), func() error {
+				_, err := db.Add(ctx, acc)
+				return err
+			}, logger)
+			continue
+		}
+
+		if !equalTags(current.Tags, key.Tags) {
+			current.Tags = key.Tags
+			reconcile(report, &report.Updated, current.Address.Hex(), func() error {
+				_, err := db.Update(ctx, current)
+				return err
+			}, logger)
+		}
+	}
+
+	// Accounts derived from HD wallets are not keys of the vault
+	vaultKeys := arrays.ToMap(vaultIDs)
+	for _, acc := range accounts {
+		if _, ok := vaultKeys[acc.KeyID]; ok || acc.WalletID != "" {
+			continue
+		}
+
+		addr := acc.Address.Hex()
+		reconcile(report, &report.Deleted, addr, func() error {
+			return db.Delete(ctx, addr)
+		}, logger)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package stores
+
+import (
+	"context"
+
+	arrays "github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c *Connector) syncKeys(ctx context.Context, storeName string, store stores.KeyStore, report *entities.SyncReport, logger log.Logger) error {
+	vaultIDs, err := store.List(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	db := c.db.Keys(storeName)
+	dbIDs, err := db.SearchIDs(ctx, false, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	deletedIDs, err := db.SearchIDs(ctx, true, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	indexed := arrays.ToMap(dbIDs)
+	deleted := arrays.ToMap(deletedIDs)
+	for _, id := range vaultIDs {
+		// Keys deleted through the key manager can still be listed by vaults supporting their recovery
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+
+		_, isIndexed := indexed[id]
+
+		key, err := store.Get(ctx, id)
+		if err != nil {
+			// Local keys are only known through the database, their tags cannot drift
+			if isIndexed && errors.IsNotSupportedError(err) {
+				continue
+			}
+
+			logger.WithError(err).Warn("failed to get key from vault", "id", id)
+			report.Failed = append(report.Failed, id)
+			continue
+		}
+
+		if !isIndexed {
+			reconcile(report, &report.Added, id, func() error {
+				_, err := db.Add(ctx, key)
+				return err
+			}, logger)
+			continue
+		}
+
+		current, err := db.Get(ctx, id)
+		if err != nil {
+			report.Failed = append(report.Failed, id)
+			continue
+		}
+
+		if !equalTags(current.Tags, key.Tags) {
+			current.Tags = key.Tags
+			reconcile(report, &report.Updated, id, func() error {
+				_, err := db.Update(ctx, current)
+				return err
+			}, logger)
+		}
+	}
+
+	// Keys no longer in the vault are deleted so that they remain listed among deleted keys
+	for _, id := range arrays.Diff(dbIDs, vaultIDs) {
+		reconcile(report, &report.Deleted, id, func() error {
+			return db.Delete(ctx, id)
+		}, logger)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package stores
+
+import (
+	"context"
+	"strings"
+
+	arrays "github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c *Connector) syncSecrets(ctx context.Context, storeName string, store stores.SecretStore, report *entities.SyncReport, logger log.Logger) error {
+	vaultSecrets, err := listVaultSecrets(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	db := c.db.Secrets(storeName)
+	dbIDs, err := db.SearchIDs(ctx, false, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	deletedIDs, err := db.SearchIDs(ctx, true, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	indexed := arrays.ToMap(dbIDs)
+	deleted := arrays.ToMap(deletedIDs)
+	var vaultIDs []string
+	for _, listed := range vaultSecrets {
+		id := listed.ID
+		vaultIDs = append(vaultIDs, id)
+
+		// Secrets deleted through the key manager can still be listed by vaults supporting their recovery
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+
+		// The mnemonics of the HD wallets are not secrets
+		if strings.HasPrefix(id, entities.WalletSeedPrefix) {
+			continue
+		}
+
+		// Stores not listing the metadata of their secrets only list their IDs, their latest version is then read
+		vaultSecret, withValue := listed, false
+		if listed.Metadata == nil {
+			vaultSecret, err = store.Get(ctx, id, "")
+			if err != nil {
+				logger.WithError(err).Warn("failed to get secret from vault", "id", id)
+				report.Failed = append(report.Failed, id)
+				continue
+			}
+			withValue = true
+		}
+
+		// Values are only read for the new secrets and versions
+		addSecret := func() error {
+			secret, err := vaultSecret, error(nil)
+			if !withValue {
+				secret, err = store.Get(ctx, id, vaultSecret.Metadata.Version)
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = db.Add(ctx, secret)
+			return err
+		}
+
+		if _, ok := indexed[id]; !ok {
+			reconcile(report, &report.Added, id, addSecret, logger)
+			continue
+		}
+
+		current, err := db.Get(ctx, id, vaultSecret.Metadata.Version)
+		switch {
+		case err != nil && errors.IsNotFoundError(err):
+			// A new version was set in the vault
+			reconcile(report, &report.Updated, id, addSecret, logger)
+		case err != nil:
+			report.Failed = append(report.Failed, id)
+		case vaultSecret.Tags != nil && !equalTags(current.Tags, vaultSecret.Tags):
+			// Stores keeping the tags with each version do not list them, as they only change with the version
+			current.Tags = vaultSecret.Tags
+			reconcile(report, &report.Updated, id, func() error {
+				_, err := db.Update(ctx, current)
+				return err
+			}, logger)
+		}
+	}
+
+	// Secrets no longer in the vault are deleted so that they remain listed among deleted secrets
+	for _, id := range arrays.Diff(dbIDs, vaultIDs) {
+		reconcile(report, &report.Deleted, id, func() error {
+			return db.Delete(ctx, id)
+		}, logger)
+	}
+
+	return nil
+}
+
+// listVaultSecrets lists the secrets of a vault through their metadata, so that their values are not read on every
+// synchronization. Secrets of stores not supporting it are only listed by ID
+func listVaultSecrets(ctx context.Context, store stores.SecretStore) ([]*entities.Secret, error) {
+	if lister, ok := store.(stores.SecretMetadataLister); ok {
+		return lister.ListMetadata(ctx)
+	}
+
+	ids, err := store.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*entities.Secret, len(ids))
+	for i, id := range ids {
+		secrets[i] = &entities.Secret{ID: id}
+	}
+
+	return secrets, nil
+}
@@ -0,0 +1,202 @@
+package stores
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	approvalsmock "github.com/longfan78/quorum-key-manager/src/approvals/mock"
+	auditmock "github.com/longfan78/quorum-key-manager/src/audit/mock"
+	authentities "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	authmock "github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	policiesmock "github.com/longfan78/quorum-key-manager/src/policies/mock"
+	dbmock "github.com/longfan78/quorum-key-manager/src/stores/database/mock"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+	vaultsmock "github.com/longfan78/quorum-key-manager/src/vaults/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncKeys(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbmock.NewMockDatabase(ctrl)
+	keysDB := dbmock.NewMockKeys(ctrl)
+	reportsDB := dbmock.NewMockSyncReports(ctrl)
+	keyStore := mock.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := authmock.NewMockRoles(ctrl)
+
+	connector := NewConnector(roles, db, vaultsmock.NewMockVaults(ctrl), auditmock.NewMockAuditor(ctrl), policiesmock.NewMockPolicies(ctrl), approvalsmock.NewMockApprovals(ctrl), nil, cluster.Standalone{}, logger)
+	connector.createStore("my-store", entities.KeyStoreType, keyStore, nil)
+
+	userInfo := authentities.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(authentities.ListPermissions()).AnyTimes()
+	db.EXPECT().Keys("my-store").Return(keysDB).AnyTimes()
+	db.EXPECT().SyncReports().Return(reportsDB).AnyTimes()
+
+	newKey := func(id string, tags map[string]string) *entities.Key {
+		key := testutils2.FakeKey()
+		key.ID = id
+		key.Tags = tags
+		return key
+	}
+
+	expectDrift := func() {
+		keyStore.EXPECT().List(gomock.Any(), uint64(0), uint64(0)).Return([]string{"added", "tagged", "unchanged", "recoverable"}, nil)
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{"tagged", "unchanged", "removed"}, nil)
+		keysDB.EXPECT().SearchIDs(gomock.Any(), true, uint64(0), uint64(0)).Return([]string{"recoverable"}, nil)
+		keyStore.EXPECT().Get(gomock.Any(), "added").Return(newKey("added", nil), nil)
+		keyStore.EXPECT().Get(gomock.Any(), "tagged").Return(newKey("tagged", map[string]string{"env": "prod"}), nil)
+		keyStore.EXPECT().Get(gomock.Any(), "unchanged").Return(newKey("unchanged", map[string]string{"env": "dev"}), nil)
+		keysDB.EXPECT().Get(gomock.Any(), "tagged").Return(newKey("tagged", map[string]string{"env": "dev"}), nil)
+		keysDB.EXPECT().Get(gomock.Any(), "unchanged").Return(newKey("unchanged", map[string]string{"env": "dev"}), nil)
+	}
+
+	t.Run("should only report the drift on dry run", func(t *testing.T) {
+		expectDrift()
+		reportsDB.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+
+		report, err := connector.Sync(ctx, "my-store", true, userInfo)
+
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []string{"added"}, report.Added)
+		assert.Equal(t, []string{"tagged"}, report.Updated)
+		assert.Equal(t, []string{"removed"}, report.Deleted)
+		assert.Empty(t, report.Failed)
+	})
+
+	t.Run("should reconcile the database with the vault", func(t *testing.T) {
+		expectDrift()
+		keysDB.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key *entities.Key) (*entities.Key, error) {
+			assert.Equal(t, "added", key.ID)
+			return key, nil
+		})
+		keysDB.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key *entities.Key) (*entities.Key, error) {
+			assert.Equal(t, map[string]string{"env": "prod"}, key.Tags)
+			return key, nil
+		})
+		keysDB.EXPECT().Delete(gomock.Any(), "removed").Return(errors.PostgresError("error"))
+		reportsDB.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+
+		report, err := connector.Sync(ctx, "my-store", false, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"added"}, report.Added)
+		assert.Equal(t, []string{"tagged"}, report.Updated)
+		assert.Empty(t, report.Deleted)
+		assert.Equal(t, []string{"removed"}, report.Failed)
+	})
+
+	t.Run("should fail with ForbiddenError if user cannot write stores", func(t *testing.T) {
+		user := &authentities.UserInfo{Username: "user"}
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]authentities.Permission{authentities.ReadStore})
+
+		_, err := connector.Sync(ctx, "my-store", false, user)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with same error if the vault cannot be listed", func(t *testing.T) {
+		keyStore.EXPECT().List(gomock.Any(), uint64(0), uint64(0)).Return(nil, errors.HashicorpVaultError("error"))
+
+		_, err := connector.Sync(ctx, "my-store", true, userInfo)
+
+		assert.True(t, errors.IsHashicorpVaultError(err))
+	})
+}
+
+type metadataSecretStore struct {
+	*mock.MockSecretStore
+	*mock.MockSecretMetadataLister
+}
+
+func TestSyncSecrets(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbmock.NewMockDatabase(ctrl)
+	secretsDB := dbmock.NewMockSecrets(ctrl)
+	reportsDB := dbmock.NewMockSyncReports(ctrl)
+	secretStore := mock.NewMockSecretStore(ctrl)
+	lister := mock.NewMockSecretMetadataLister(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := authmock.NewMockRoles(ctrl)
+
+	connector := NewConnector(roles, db, vaultsmock.NewMockVaults(ctrl), auditmock.NewMockAuditor(ctrl), policiesmock.NewMockPolicies(ctrl), approvalsmock.NewMockApprovals(ctrl), nil, cluster.Standalone{}, logger)
+	connector.createStore("my-store", entities.SecretStoreType, &metadataSecretStore{secretStore, lister}, nil)
+
+	userInfo := authentities.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(authentities.ListPermissions()).AnyTimes()
+	db.EXPECT().Secrets("my-store").Return(secretsDB).AnyTimes()
+	db.EXPECT().SyncReports().Return(reportsDB).AnyTimes()
+
+	newSecret := func(id, version string, tags map[string]string) *entities.Secret {
+		secret := testutils2.FakeSecret()
+		secret.ID = id
+		secret.Value = ""
+		secret.Tags = tags
+		secret.Metadata.Version = version
+		return secret
+	}
+
+	expectDrift := func() {
+		lister.EXPECT().ListMetadata(gomock.Any()).Return([]*entities.Secret{
+			newSecret("added", "1", nil),
+			newSecret("rotated", "2", nil),
+			newSecret("tagged", "1", map[string]string{"env": "prod"}),
+			newSecret("unchanged", "1", nil),
+			newSecret(entities.WalletSeedPrefix+"my-wallet", "1", nil),
+		}, nil)
+		secretsDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{"rotated", "tagged", "unchanged", "removed"}, nil)
+		secretsDB.EXPECT().SearchIDs(gomock.Any(), true, uint64(0), uint64(0)).Return([]string{}, nil)
+		secretsDB.EXPECT().Get(gomock.Any(), "rotated", "2").Return(nil, errors.NotFoundError("error"))
+		secretsDB.EXPECT().Get(gomock.Any(), "tagged", "1").Return(newSecret("tagged", "1", map[string]string{"env": "dev"}), nil)
+		secretsDB.EXPECT().Get(gomock.Any(), "unchanged", "1").Return(newSecret("unchanged", "1", map[string]string{"env": "dev"}), nil)
+	}
+
+	t.Run("should report the drift without reading the values of the secrets on dry run", func(t *testing.T) {
+		expectDrift()
+		reportsDB.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+
+		report, err := connector.Sync(ctx, "my-store", true, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"added"}, report.Added)
+		assert.Equal(t, []string{"rotated", "tagged"}, report.Updated)
+		assert.Equal(t, []string{"removed"}, report.Deleted)
+		assert.Empty(t, report.Failed)
+	})
+
+	t.Run("should only read the values of the new secrets and versions", func(t *testing.T) {
+		expectDrift()
+		secretStore.EXPECT().Get(gomock.Any(), "added", "1").Return(newSecret("added", "1", nil), nil)
+		secretStore.EXPECT().Get(gomock.Any(), "rotated", "2").Return(newSecret("rotated", "2", nil), nil)
+		secretsDB.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, secret *entities.Secret) (*entities.Secret, error) {
+			return secret, nil
+		}).Times(2)
+		secretsDB.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, secret *entities.Secret) (*entities.Secret, error) {
+			assert.Equal(t, map[string]string{"env": "prod"}, secret.Tags)
+			return secret, nil
+		})
+		secretsDB.EXPECT().Delete(gomock.Any(), "removed").Return(nil)
+		reportsDB.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+
+		report, err := connector.Sync(ctx, "my-store", false, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"added"}, report.Added)
+		assert.Equal(t, []string{"rotated", "tagged"}, report.Updated)
+		assert.Equal(t, []string{"removed"}, report.Deleted)
+		assert.Empty(t, report.Failed)
+	})
+}
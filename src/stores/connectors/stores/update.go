@@ -0,0 +1,55 @@
+package stores
+
+import (
+	"context"
+
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+func (c *Connector) Update(ctx context.Context, store *entities.StoreDefinition, userInfo *authtypes.UserInfo) (*entities.StoreDefinition, error) {
+	logger := c.logger.With("name", store.Name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.db.Stores().FindOne(ctx, store.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resolver.CheckAccess(current.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	// The store type cannot be changed, the store must be deleted and created again instead
+	store.StoreType = current.StoreType
+
+	// Stores created on top of this store keep using the previous one until they are updated
+	err = c.createFromDefinition(ctx, store, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedStore, err := c.db.Stores().Update(ctx, store)
+	if err != nil {
+		// Restore the store of the previous definition
+		if restoreErr := c.createFromDefinition(ctx, current, userInfo); restoreErr != nil {
+			logger.WithError(restoreErr).Error("failed to restore store")
+		}
+
+		return nil, err
+	}
+
+	c.revisions.Set(store.Name, updatedStore.UpdatedAt)
+	c.notify(ctx, cluster.OpUpsert, store.Name)
+
+	logger.Info("store updated successfully")
+	return updatedStore, nil
+}
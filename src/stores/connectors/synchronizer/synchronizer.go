@@ -0,0 +1,127 @@
+package synchronizer
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// Synchronizer periodically reconciles the items indexed for the stores with the ones of their vault
+type Synchronizer struct {
+	stores     stores.Stores
+	interval   time.Duration
+	dryRun     bool
+	storeNames []string
+	elector    cluster.Elector
+	logger     log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Synchronizer{}
+
+func New(storesConnector stores.Stores, cfg *entities.SyncConfig, elector cluster.Elector, logger log.Logger) *Synchronizer {
+	return &Synchronizer{
+		stores:     storesConnector,
+		interval:   cfg.Interval,
+		dryRun:     cfg.DryRun,
+		storeNames: cfg.Stores,
+		elector:    elector,
+		logger:     logger,
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *Synchronizer) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go s.run(ctx)
+
+	s.logger.Info("store synchronizer started", "interval", s.interval.String(), "dry_run", s.dryRun)
+	return nil
+}
+
+func (s *Synchronizer) Stop(ctx context.Context) error {
+	s.cancel()
+
+	select {
+	case <-s.done:
+		s.logger.Info("store synchronizer stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Synchronizer) Close() error {
+	return nil
+}
+
+func (s *Synchronizer) Error() error {
+	return s.err
+}
+
+func (s *Synchronizer) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Vaults are only read by the leader when several replicas share the database
+			if !s.elector.IsLeader() {
+				continue
+			}
+
+			s.SyncAll(ctx)
+		}
+	}
+}
+
+// SyncAll synchronizes the configured stores, or every store if none is configured
+func (s *Synchronizer) SyncAll(ctx context.Context) {
+	userInfo := authtypes.NewWildcardUser()
+
+	storeNames := s.storeNames
+	if len(storeNames) == 0 {
+		var err error
+		storeNames, err = s.stores.List(ctx, "", userInfo)
+		if err != nil {
+			s.logger.WithError(err).Error("failed to list stores for synchronization")
+			return
+		}
+		sort.Strings(storeNames)
+	}
+
+	for _, storeName := range storeNames {
+		logger := s.logger.With("store", storeName)
+
+		report, err := s.stores.Sync(ctx, storeName, s.dryRun, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to synchronize store")
+			continue
+		}
+
+		if s.dryRun && report.HasDrift() {
+			logger.Warn("store drifted from its vault",
+				"added", report.Added,
+				"deleted", report.Deleted,
+				"updated", report.Updated,
+			)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/mock"
+)
+
+func TestSyncAll(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storesConnector := mock.NewMockStores(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	t.Run("should synchronize every store if none is configured", func(t *testing.T) {
+		synchronizer := New(storesConnector, &entities.SyncConfig{Interval: time.Minute}, cluster.Standalone{}, logger)
+
+		storesConnector.EXPECT().List(gomock.Any(), "", gomock.Any()).Return([]string{"key-store", "eth-store"}, nil)
+		gomock.InOrder(
+			storesConnector.EXPECT().Sync(gomock.Any(), "eth-store", false, gomock.Any()).Return(&entities.SyncReport{}, nil),
+			storesConnector.EXPECT().Sync(gomock.Any(), "key-store", false, gomock.Any()).Return(&entities.SyncReport{}, nil),
+		)
+
+		synchronizer.SyncAll(ctx)
+	})
+
+	t.Run("should only synchronize the configured stores on dry run", func(t *testing.T) {
+		synchronizer := New(storesConnector, &entities.SyncConfig{Interval: time.Minute, DryRun: true, Stores: []string{"key-store"}}, cluster.Standalone{}, logger)
+
+		storesConnector.EXPECT().Sync(gomock.Any(), "key-store", true, gomock.Any()).Return(&entities.SyncReport{Added: []string{"my-key"}}, nil)
+
+		synchronizer.SyncAll(ctx)
+	})
+
+	t.Run("should keep synchronizing the other stores if one fails", func(t *testing.T) {
+		synchronizer := New(storesConnector, &entities.SyncConfig{Interval: time.Minute, Stores: []string{"key-store", "eth-store"}}, cluster.Standalone{}, logger)
+
+		storesConnector.EXPECT().Sync(gomock.Any(), "key-store", false, gomock.Any()).Return(nil, fmt.Errorf("error"))
+		storesConnector.EXPECT().Sync(gomock.Any(), "eth-store", false, gomock.Any()).Return(&entities.SyncReport{}, nil)
+
+		synchronizer.SyncAll(ctx)
+	})
+}
@@ -0,0 +1,170 @@
+package traced
+
+import (
+	"context"
+	"math/big"
+
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/longfan78/quorum-key-manager/pkg/ethereum"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// EthStore records a span for the operations of an Ethereum store reaching the vault
+type EthStore struct {
+	stores.EthStore
+	spanner
+}
+
+var _ stores.EthStore = &EthStore{}
+
+func NewEthStore(store stores.EthStore, storeName string) *EthStore {
+	return &EthStore{
+		EthStore: store,
+		spanner:  spanner{kind: "ethereum", storeName: storeName},
+	}
+}
+
+func (s *EthStore) Create(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Create", id)
+	res, err := s.EthStore.Create(ctx, id, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Import(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Import", id)
+	res, err := s.EthStore.Import(ctx, id, privKey, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) CreateWallet(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	ctx, span := s.startSpan(ctx, "CreateWallet", id)
+	res, err := s.EthStore.CreateWallet(ctx, id, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) ImportWallet(ctx context.Context, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	ctx, span := s.startSpan(ctx, "ImportWallet", id)
+	res, err := s.EthStore.ImportWallet(ctx, id, mnemonic, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "DeriveAccount", walletID)
+	res, err := s.EthStore.DeriveAccount(ctx, walletID, path, id, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Get", addr.Hex())
+	res, err := s.EthStore.Get(ctx, addr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Update", addr.Hex())
+	res, err := s.EthStore.Update(ctx, addr, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Enable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Enable", addr.Hex())
+	res, err := s.EthStore.Enable(ctx, addr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Disable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	ctx, span := s.startSpan(ctx, "Disable", addr.Hex())
+	res, err := s.EthStore.Disable(ctx, addr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Delete(ctx context.Context, addr common.Address) error {
+	ctx, span := s.startSpan(ctx, "Delete", addr.Hex())
+	err := s.EthStore.Delete(ctx, addr)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *EthStore) Restore(ctx context.Context, addr common.Address) error {
+	ctx, span := s.startSpan(ctx, "Restore", addr.Hex())
+	err := s.EthStore.Restore(ctx, addr)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *EthStore) Destroy(ctx context.Context, addr common.Address) error {
+	ctx, span := s.startSpan(ctx, "Destroy", addr.Hex())
+	err := s.EthStore.Destroy(ctx, addr)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *EthStore) Sign(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Sign", addr.Hex())
+	res, err := s.EthStore.Sign(ctx, addr, data)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) SignMessage(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "SignMessage", addr.Hex())
+	res, err := s.EthStore.SignMessage(ctx, addr, data)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) SignTypedData(ctx context.Context, addr common.Address, typedData *core.TypedData) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "SignTypedData", addr.Hex())
+	res, err := s.EthStore.SignTypedData(ctx, addr, typedData)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "SignTransaction", addr.Hex())
+	res, err := s.EthStore.SignTransaction(ctx, addr, chainID, tx)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "SignEEA", addr.Hex())
+	res, err := s.EthStore.SignEEA(ctx, addr, chainID, tx, args)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "SignPrivate", addr.Hex())
+	res, err := s.EthStore.SignPrivate(ctx, addr, tx)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Encrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Encrypt", addr.Hex())
+	res, err := s.EthStore.Encrypt(ctx, addr, data)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *EthStore) Decrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Decrypt", addr.Hex())
+	res, err := s.EthStore.Decrypt(ctx, addr, data)
+	tracing.EndSpan(span, err)
+	return res, err
+}
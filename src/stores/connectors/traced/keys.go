@@ -0,0 +1,116 @@
+package traced
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	storeentities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// KeyStore records a span for the operations of a key store reaching the vault
+type KeyStore struct {
+	stores.KeyStore
+	spanner
+}
+
+var _ stores.KeyStore = &KeyStore{}
+
+func NewKeyStore(store stores.KeyStore, storeName string) *KeyStore {
+	return &KeyStore{
+		KeyStore: store,
+		spanner:  spanner{kind: "keys", storeName: storeName},
+	}
+}
+
+func (s *KeyStore) Create(ctx context.Context, id string, alg *entities.Algorithm, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Create", id)
+	res, err := s.KeyStore.Create(ctx, id, alg, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities.Algorithm, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Import", id)
+	res, err := s.KeyStore.Import(ctx, id, privKey, alg, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Get(ctx context.Context, id string) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Get", id)
+	res, err := s.KeyStore.Get(ctx, id)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Update(ctx context.Context, id string, attr *storeentities.Attributes) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Update", id)
+	res, err := s.KeyStore.Update(ctx, id, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Rotate(ctx context.Context, id string, alg *entities.Algorithm) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Rotate", id)
+	res, err := s.KeyStore.Rotate(ctx, id, alg)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Enable(ctx context.Context, id string) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Enable", id)
+	res, err := s.KeyStore.Enable(ctx, id)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Disable(ctx context.Context, id string) (*storeentities.Key, error) {
+	ctx, span := s.startSpan(ctx, "Disable", id)
+	res, err := s.KeyStore.Disable(ctx, id)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Delete(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Delete", id)
+	err := s.KeyStore.Delete(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *KeyStore) Restore(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Restore", id)
+	err := s.KeyStore.Restore(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *KeyStore) Destroy(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Destroy", id)
+	err := s.KeyStore.Destroy(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *KeyStore) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Sign", id)
+	res, err := s.KeyStore.Sign(ctx, id, data, algo)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Encrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Encrypt", id)
+	res, err := s.KeyStore.Encrypt(ctx, id, data, algo)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *KeyStore) Decrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "Decrypt", id)
+	res, err := s.KeyStore.Decrypt(ctx, id, data, algo)
+	tracing.EndSpan(span, err)
+	return res, err
+}
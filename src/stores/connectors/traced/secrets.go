@@ -0,0 +1,59 @@
+package traced
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"github.com/longfan78/quorum-key-manager/src/stores"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// SecretStore records a span for the operations of a secret store reaching the vault
+type SecretStore struct {
+	stores.SecretStore
+	spanner
+}
+
+var _ stores.SecretStore = &SecretStore{}
+
+func NewSecretStore(store stores.SecretStore, storeName string) *SecretStore {
+	return &SecretStore{
+		SecretStore: store,
+		spanner:     spanner{kind: "secrets", storeName: storeName},
+	}
+}
+
+func (s *SecretStore) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	ctx, span := s.startSpan(ctx, "Set", id)
+	res, err := s.SecretStore.Set(ctx, id, value, attr)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *SecretStore) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
+	ctx, span := s.startSpan(ctx, "Get", id)
+	res, err := s.SecretStore.Get(ctx, id, version)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+func (s *SecretStore) Delete(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Delete", id)
+	err := s.SecretStore.Delete(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *SecretStore) Restore(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Restore", id)
+	err := s.SecretStore.Restore(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *SecretStore) Destroy(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "Destroy", id)
+	err := s.SecretStore.Destroy(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
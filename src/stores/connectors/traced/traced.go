@@ -0,0 +1,23 @@
+package traced
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type spanner struct {
+	kind      string
+	storeName string
+}
+
+// startSpan starts the span of an operation, named after the kind of store and the operation, e.g. "keys.Sign"
+func (s *spanner) startSpan(ctx context.Context, operation, resourceID string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, fmt.Sprintf("%s.%s", s.kind, operation),
+		attribute.String("store.name", s.storeName),
+		attribute.String("store.resource_id", resourceID),
+	)
+}
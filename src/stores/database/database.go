@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
@@ -10,9 +11,12 @@ import (
 
 type Database interface {
 	ETHAccounts(storeID string) ETHAccounts
+	ETHWallets(storeID string) ETHWallets
 	Ping(ctx context.Context) error
 	Keys(storeID string) Keys
 	Secrets(storeID string) Secrets
+	Stores() Stores
+	SyncReports() SyncReports
 }
 
 type ETHAccounts interface {
@@ -21,12 +25,26 @@ type ETHAccounts interface {
 	GetDeleted(ctx context.Context, addr string) (*entities.ETHAccount, error)
 	GetAll(ctx context.Context) ([]*entities.ETHAccount, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.ETHAccount, error)
+	GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.ETHAccount, error)
 	SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	SearchExpiringAddresses(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.ETHAccount, error)
 	Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error)
 	Update(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error)
 	Delete(ctx context.Context, addr string) error
 	Restore(ctx context.Context, addr string) error
 	Purge(ctx context.Context, addr string) error
+	// LockTenant serializes the creations of accounts of a tenant until the end of the transaction
+	LockTenant(ctx context.Context, tenant string) error
+	// CountByTenant counts the accounts of a tenant, including the deleted ones
+	CountByTenant(ctx context.Context, tenant string) (int, error)
+	SetTenant(ctx context.Context, addr, tenant string) error
+}
+
+type ETHWallets interface {
+	Get(ctx context.Context, id string) (*entities.ETHWallet, error)
+	SearchIDs(ctx context.Context, limit, offset uint64) ([]string, error)
+	Add(ctx context.Context, wallet *entities.ETHWallet) (*entities.ETHWallet, error)
 }
 
 type Keys interface {
@@ -35,12 +53,23 @@ type Keys interface {
 	GetDeleted(ctx context.Context, id string) (*entities.Key, error)
 	GetAll(ctx context.Context) ([]*entities.Key, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.Key, error)
+	GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Key, error)
+	GetAllDueForRotation(ctx context.Context, now time.Time) ([]*entities.Key, error)
 	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Key, error)
 	Add(ctx context.Context, key *entities.Key) (*entities.Key, error)
+	AddVersion(ctx context.Context, key *entities.Key) error
+	ListVersions(ctx context.Context, id string) ([]*entities.Key, error)
 	Update(ctx context.Context, key *entities.Key) (*entities.Key, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	Purge(ctx context.Context, id string) error
+	// LockTenant serializes the creations of keys of a tenant until the end of the transaction
+	LockTenant(ctx context.Context, tenant string) error
+	// CountByTenant counts the keys of a tenant, including the deleted ones
+	CountByTenant(ctx context.Context, tenant string) (int, error)
+	SetTenant(ctx context.Context, id, tenant string) error
 }
 
 type Secrets interface {
@@ -49,12 +78,28 @@ type Secrets interface {
 	GetLatestVersion(ctx context.Context, id string, isDeleted bool) (string, error)
 	ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error)
 	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Secret, error)
 	GetDeleted(ctx context.Context, id string) (*entities.Secret, error)
 	GetAll(ctx context.Context) ([]*entities.Secret, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.Secret, error)
+	GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Secret, error)
 	Add(ctx context.Context, secret *entities.Secret) (*entities.Secret, error)
 	Update(ctx context.Context, secret *entities.Secret) (*entities.Secret, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	Purge(ctx context.Context, id string) error
 }
+
+type Stores interface {
+	Insert(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error)
+	FindOne(ctx context.Context, name string) (*entities.StoreDefinition, error)
+	FindAll(ctx context.Context) ([]*entities.StoreDefinition, error)
+	Update(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error)
+	Delete(ctx context.Context, name string) error
+}
+
+type SyncReports interface {
+	Upsert(ctx context.Context, report *entities.SyncReport) error
+	FindOne(ctx context.Context, storeName string) (*entities.SyncReport, error)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	database "github.com/longfan78/quorum-key-manager/src/stores/database"
 	entities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	time "time"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -49,6 +50,20 @@ func (mr *MockDatabaseMockRecorder) ETHAccounts(storeID interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETHAccounts", reflect.TypeOf((*MockDatabase)(nil).ETHAccounts), storeID)
 }
 
+// ETHWallets mocks base method
+func (m *MockDatabase) ETHWallets(storeID string) database.ETHWallets {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ETHWallets", storeID)
+	ret0, _ := ret[0].(database.ETHWallets)
+	return ret0
+}
+
+// ETHWallets indicates an expected call of ETHWallets
+func (mr *MockDatabaseMockRecorder) ETHWallets(storeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETHWallets", reflect.TypeOf((*MockDatabase)(nil).ETHWallets), storeID)
+}
+
 // Ping mocks base method
 func (m *MockDatabase) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -91,6 +106,34 @@ func (mr *MockDatabaseMockRecorder) Secrets(storeID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockDatabase)(nil).Secrets), storeID)
 }
 
+// Stores mocks base method
+func (m *MockDatabase) Stores() database.Stores {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stores")
+	ret0, _ := ret[0].(database.Stores)
+	return ret0
+}
+
+// Stores indicates an expected call of Stores
+func (mr *MockDatabaseMockRecorder) Stores() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stores", reflect.TypeOf((*MockDatabase)(nil).Stores))
+}
+
+// SyncReports mocks base method
+func (m *MockDatabase) SyncReports() database.SyncReports {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncReports")
+	ret0, _ := ret[0].(database.SyncReports)
+	return ret0
+}
+
+// SyncReports indicates an expected call of SyncReports
+func (mr *MockDatabaseMockRecorder) SyncReports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncReports", reflect.TypeOf((*MockDatabase)(nil).SyncReports))
+}
+
 // MockETHAccounts is a mock of ETHAccounts interface
 type MockETHAccounts struct {
 	ctrl     *gomock.Controller
@@ -188,6 +231,21 @@ func (mr *MockETHAccountsMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockETHAccounts)(nil).GetAllDeleted), ctx)
 }
 
+// GetAllPurgeable mocks base method
+func (m *MockETHAccounts) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPurgeable indicates an expected call of GetAllPurgeable
+func (mr *MockETHAccountsMockRecorder) GetAllPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPurgeable", reflect.TypeOf((*MockETHAccounts)(nil).GetAllPurgeable), ctx, before, recoveryPeriod)
+}
+
 // SearchAddresses mocks base method
 func (m *MockETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +261,36 @@ func (mr *MockETHAccountsMockRecorder) SearchAddresses(ctx, isDeleted, limit, of
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAddresses", reflect.TypeOf((*MockETHAccounts)(nil).SearchAddresses), ctx, isDeleted, limit, offset)
 }
 
+// SearchExpiringAddresses mocks base method
+func (m *MockETHAccounts) SearchExpiringAddresses(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringAddresses", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringAddresses indicates an expected call of SearchExpiringAddresses
+func (mr *MockETHAccountsMockRecorder) SearchExpiringAddresses(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringAddresses", reflect.TypeOf((*MockETHAccounts)(nil).SearchExpiringAddresses), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockETHAccounts) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockETHAccountsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockETHAccounts)(nil).Search), ctx, filter)
+}
+
 // Add mocks base method
 func (m *MockETHAccounts) Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +363,117 @@ func (mr *MockETHAccountsMockRecorder) Purge(ctx, addr interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockETHAccounts)(nil).Purge), ctx, addr)
 }
 
+// LockTenant mocks base method
+func (m *MockETHAccounts) LockTenant(ctx context.Context, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTenant", ctx, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTenant indicates an expected call of LockTenant
+func (mr *MockETHAccountsMockRecorder) LockTenant(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTenant", reflect.TypeOf((*MockETHAccounts)(nil).LockTenant), ctx, tenant)
+}
+
+// CountByTenant mocks base method
+func (m *MockETHAccounts) CountByTenant(ctx context.Context, tenant string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTenant", ctx, tenant)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTenant indicates an expected call of CountByTenant
+func (mr *MockETHAccountsMockRecorder) CountByTenant(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTenant", reflect.TypeOf((*MockETHAccounts)(nil).CountByTenant), ctx, tenant)
+}
+
+// SetTenant mocks base method
+func (m *MockETHAccounts) SetTenant(ctx context.Context, addr, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenant", ctx, addr, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTenant indicates an expected call of SetTenant
+func (mr *MockETHAccountsMockRecorder) SetTenant(ctx, addr, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenant", reflect.TypeOf((*MockETHAccounts)(nil).SetTenant), ctx, addr, tenant)
+}
+
+// MockETHWallets is a mock of ETHWallets interface
+type MockETHWallets struct {
+	ctrl     *gomock.Controller
+	recorder *MockETHWalletsMockRecorder
+}
+
+// MockETHWalletsMockRecorder is the mock recorder for MockETHWallets
+type MockETHWalletsMockRecorder struct {
+	mock *MockETHWallets
+}
+
+// NewMockETHWallets creates a new mock instance
+func NewMockETHWallets(ctrl *gomock.Controller) *MockETHWallets {
+	mock := &MockETHWallets{ctrl: ctrl}
+	mock.recorder = &MockETHWalletsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockETHWallets) EXPECT() *MockETHWalletsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockETHWallets) Get(ctx context.Context, id string) (*entities.ETHWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entities.ETHWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockETHWalletsMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockETHWallets)(nil).Get), ctx, id)
+}
+
+// SearchIDs mocks base method
+func (m *MockETHWallets) SearchIDs(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIDs", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIDs indicates an expected call of SearchIDs
+func (mr *MockETHWalletsMockRecorder) SearchIDs(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockETHWallets)(nil).SearchIDs), ctx, limit, offset)
+}
+
+// Add mocks base method
+func (m *MockETHWallets) Add(ctx context.Context, wallet *entities.ETHWallet) (*entities.ETHWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, wallet)
+	ret0, _ := ret[0].(*entities.ETHWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add
+func (mr *MockETHWalletsMockRecorder) Add(ctx, wallet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockETHWallets)(nil).Add), ctx, wallet)
+}
+
 // MockKeys is a mock of Keys interface
 type MockKeys struct {
 	ctrl     *gomock.Controller
@@ -372,6 +571,36 @@ func (mr *MockKeysMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockKeys)(nil).GetAllDeleted), ctx)
 }
 
+// GetAllPurgeable mocks base method
+func (m *MockKeys) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPurgeable indicates an expected call of GetAllPurgeable
+func (mr *MockKeysMockRecorder) GetAllPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPurgeable", reflect.TypeOf((*MockKeys)(nil).GetAllPurgeable), ctx, before, recoveryPeriod)
+}
+
+// GetAllDueForRotation mocks base method
+func (m *MockKeys) GetAllDueForRotation(ctx context.Context, now time.Time) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDueForRotation", ctx, now)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDueForRotation indicates an expected call of GetAllDueForRotation
+func (mr *MockKeysMockRecorder) GetAllDueForRotation(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDueForRotation", reflect.TypeOf((*MockKeys)(nil).GetAllDueForRotation), ctx, now)
+}
+
 // SearchIDs mocks base method
 func (m *MockKeys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -387,6 +616,36 @@ func (mr *MockKeysMockRecorder) SearchIDs(ctx, isDeleted, limit, offset interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockKeys)(nil).SearchIDs), ctx, isDeleted, limit, offset)
 }
 
+// SearchExpiringIDs mocks base method
+func (m *MockKeys) SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringIDs", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringIDs indicates an expected call of SearchExpiringIDs
+func (mr *MockKeysMockRecorder) SearchExpiringIDs(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringIDs", reflect.TypeOf((*MockKeys)(nil).SearchExpiringIDs), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockKeys) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockKeysMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockKeys)(nil).Search), ctx, filter)
+}
+
 // Add mocks base method
 func (m *MockKeys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -402,6 +661,35 @@ func (mr *MockKeysMockRecorder) Add(ctx, key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockKeys)(nil).Add), ctx, key)
 }
 
+// AddVersion mocks base method
+func (m *MockKeys) AddVersion(ctx context.Context, key *entities.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVersion", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVersion indicates an expected call of AddVersion
+func (mr *MockKeysMockRecorder) AddVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVersion", reflect.TypeOf((*MockKeys)(nil).AddVersion), ctx, key)
+}
+
+// ListVersions mocks base method
+func (m *MockKeys) ListVersions(ctx context.Context, id string) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockKeysMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockKeys)(nil).ListVersions), ctx, id)
+}
+
 // Update mocks base method
 func (m *MockKeys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +747,49 @@ func (mr *MockKeysMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockKeys)(nil).Purge), ctx, id)
 }
 
+// LockTenant mocks base method
+func (m *MockKeys) LockTenant(ctx context.Context, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTenant", ctx, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTenant indicates an expected call of LockTenant
+func (mr *MockKeysMockRecorder) LockTenant(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTenant", reflect.TypeOf((*MockKeys)(nil).LockTenant), ctx, tenant)
+}
+
+// CountByTenant mocks base method
+func (m *MockKeys) CountByTenant(ctx context.Context, tenant string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTenant", ctx, tenant)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTenant indicates an expected call of CountByTenant
+func (mr *MockKeysMockRecorder) CountByTenant(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTenant", reflect.TypeOf((*MockKeys)(nil).CountByTenant), ctx, tenant)
+}
+
+// SetTenant mocks base method
+func (m *MockKeys) SetTenant(ctx context.Context, id, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenant", ctx, id, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTenant indicates an expected call of SetTenant
+func (mr *MockKeysMockRecorder) SetTenant(ctx, id, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenant", reflect.TypeOf((*MockKeys)(nil).SetTenant), ctx, id, tenant)
+}
+
 // MockSecrets is a mock of Secrets interface
 type MockSecrets struct {
 	ctrl     *gomock.Controller
@@ -556,6 +887,36 @@ func (mr *MockSecretsMockRecorder) SearchIDs(ctx, isDeleted, limit, offset inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockSecrets)(nil).SearchIDs), ctx, isDeleted, limit, offset)
 }
 
+// SearchExpiringIDs mocks base method
+func (m *MockSecrets) SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringIDs", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringIDs indicates an expected call of SearchExpiringIDs
+func (mr *MockSecretsMockRecorder) SearchExpiringIDs(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringIDs", reflect.TypeOf((*MockSecrets)(nil).SearchExpiringIDs), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockSecrets) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSecretsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSecrets)(nil).Search), ctx, filter)
+}
+
 // GetDeleted mocks base method
 func (m *MockSecrets) GetDeleted(ctx context.Context, id string) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +962,21 @@ func (mr *MockSecretsMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockSecrets)(nil).GetAllDeleted), ctx)
 }
 
+// GetAllPurgeable mocks base method
+func (m *MockSecrets) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPurgeable indicates an expected call of GetAllPurgeable
+func (mr *MockSecretsMockRecorder) GetAllPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPurgeable", reflect.TypeOf((*MockSecrets)(nil).GetAllPurgeable), ctx, before, recoveryPeriod)
+}
+
 // Add mocks base method
 func (m *MockSecrets) Add(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
@@ -672,3 +1048,152 @@ func (mr *MockSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSecrets)(nil).Purge), ctx, id)
 }
+
+// MockStores is a mock of Stores interface
+type MockStores struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoresMockRecorder
+}
+
+// MockStoresMockRecorder is the mock recorder for MockStores
+type MockStoresMockRecorder struct {
+	mock *MockStores
+}
+
+// NewMockStores creates a new mock instance
+func NewMockStores(ctrl *gomock.Controller) *MockStores {
+	mock := &MockStores{ctrl: ctrl}
+	mock.recorder = &MockStoresMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStores) EXPECT() *MockStoresMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockStores) Insert(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, store)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockStoresMockRecorder) Insert(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockStores)(nil).Insert), ctx, store)
+}
+
+// FindOne mocks base method
+func (m *MockStores) FindOne(ctx context.Context, name string) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockStoresMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockStores)(nil).FindOne), ctx, name)
+}
+
+// FindAll mocks base method
+func (m *MockStores) FindAll(ctx context.Context) ([]*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockStoresMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStores)(nil).FindAll), ctx)
+}
+
+// Update mocks base method
+func (m *MockStores) Update(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, store)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockStoresMockRecorder) Update(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStores)(nil).Update), ctx, store)
+}
+
+// Delete mocks base method
+func (m *MockStores) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockStoresMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStores)(nil).Delete), ctx, name)
+}
+
+// MockSyncReports is a mock of SyncReports interface
+type MockSyncReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncReportsMockRecorder
+}
+
+// MockSyncReportsMockRecorder is the mock recorder for MockSyncReports
+type MockSyncReportsMockRecorder struct {
+	mock *MockSyncReports
+}
+
+// NewMockSyncReports creates a new mock instance
+func NewMockSyncReports(ctrl *gomock.Controller) *MockSyncReports {
+	mock := &MockSyncReports{ctrl: ctrl}
+	mock.recorder = &MockSyncReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSyncReports) EXPECT() *MockSyncReportsMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method
+func (m *MockSyncReports) Upsert(ctx context.Context, report *entities.SyncReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert
+func (mr *MockSyncReportsMockRecorder) Upsert(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockSyncReports)(nil).Upsert), ctx, report)
+}
+
+// FindOne mocks base method
+func (m *MockSyncReports) FindOne(ctx context.Context, storeName string) (*entities.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, storeName)
+	ret0, _ := ret[0].(*entities.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockSyncReportsMockRecorder) FindOne(ctx, storeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockSyncReports)(nil).FindOne), ctx, storeName)
+}
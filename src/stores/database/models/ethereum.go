@@ -18,10 +18,15 @@ type ETHAccount struct {
 	PublicKey           []byte
 	CompressedPublicKey []byte
 	Tags                map[string]string
-	Disabled            bool
-	CreatedAt           time.Time `pg:"default:now()"`
-	UpdatedAt           time.Time `pg:"default:now()"`
-	DeletedAt           time.Time `pg:",soft_delete"`
+	WalletID            string
+	DerivationPath      string
+	Disabled            bool     `pg:",use_zero"`
+	Operations          []string `pg:",array"`
+	ExpireAt            time.Time
+	RecoveryPeriod      time.Duration `pg:",use_zero"`
+	CreatedAt           time.Time     `pg:"default:now()"`
+	UpdatedAt           time.Time     `pg:"default:now()"`
+	DeletedAt           time.Time     `pg:",soft_delete"`
 }
 
 func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
@@ -31,7 +36,12 @@ func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
 		PublicKey:           account.PublicKey,
 		CompressedPublicKey: account.CompressedPublicKey,
 		Tags:                account.Tags,
+		WalletID:            account.WalletID,
+		DerivationPath:      account.DerivationPath,
 		Disabled:            account.Metadata.Disabled,
+		Operations:          fromOperations(account.Metadata.Operations),
+		ExpireAt:            account.Metadata.ExpireAt,
+		RecoveryPeriod:      account.Metadata.RecoveryPeriod,
 		CreatedAt:           account.Metadata.CreatedAt,
 		UpdatedAt:           account.Metadata.UpdatedAt,
 		DeletedAt:           account.Metadata.DeletedAt,
@@ -47,9 +57,12 @@ func NewETHAccountFromKey(key *entities.Key, attr *entities.Attributes) *entitie
 		PublicKey:           key.PublicKey,
 		CompressedPublicKey: crypto.CompressPubkey(pubKey),
 		Metadata: &entities.Metadata{
-			Disabled:  key.Metadata.Disabled,
-			CreatedAt: key.Metadata.CreatedAt,
-			UpdatedAt: key.Metadata.UpdatedAt,
+			Disabled:       key.Metadata.Disabled || attr.Disabled,
+			Operations:     attr.Operations,
+			ExpireAt:       key.Metadata.ExpireAt,
+			RecoveryPeriod: key.Metadata.RecoveryPeriod,
+			CreatedAt:      key.Metadata.CreatedAt,
+			UpdatedAt:      key.Metadata.UpdatedAt,
 		},
 	}
 }
@@ -61,11 +74,16 @@ func (eth *ETHAccount) ToEntity() *entities.ETHAccount {
 		PublicKey:           eth.PublicKey,
 		CompressedPublicKey: eth.CompressedPublicKey,
 		Metadata: &entities.Metadata{
-			Disabled:  eth.Disabled,
-			CreatedAt: eth.CreatedAt,
-			UpdatedAt: eth.UpdatedAt,
-			DeletedAt: eth.DeletedAt,
+			Disabled:       eth.Disabled,
+			Operations:     toOperations(eth.Operations),
+			ExpireAt:       eth.ExpireAt,
+			RecoveryPeriod: eth.RecoveryPeriod,
+			CreatedAt:      eth.CreatedAt,
+			UpdatedAt:      eth.UpdatedAt,
+			DeletedAt:      eth.DeletedAt,
 		},
-		Tags: eth.Tags,
+		Tags:           eth.Tags,
+		WalletID:       eth.WalletID,
+		DerivationPath: eth.DerivationPath,
 	}
 }
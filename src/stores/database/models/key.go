@@ -18,13 +18,26 @@ type Key struct {
 	EllipticCurve    string
 	Tags             map[string]string
 	Annotations      *entities.Annotation
-	Disabled         bool
-	CreatedAt        time.Time `pg:"default:now()"`
-	UpdatedAt        time.Time `pg:"default:now()"`
+	Version          string `pg:",use_zero"`
+	RotationPolicy   *entities.RotationPolicy
+	Disabled         bool     `pg:",use_zero"`
+	Operations       []string `pg:",array"`
+	ExpireAt         time.Time
+	RecoveryPeriod   time.Duration `pg:",use_zero"`
+	CreatedAt        time.Time     `pg:"default:now()"`
+	UpdatedAt        time.Time     `pg:"default:now()"`
+	RotatedAt        time.Time     `pg:"default:now()"`
+	NextRotationAt   time.Time
 	DeletedAt        time.Time `pg:",soft_delete"`
 }
 
 func NewKey(key *entities.Key) *Key {
+	// Keys not rotated yet are rotated at creation by the database
+	rotatedAt := key.Metadata.RotatedAt
+	if rotatedAt.IsZero() {
+		rotatedAt = time.Now()
+	}
+
 	return &Key{
 		ID:               key.ID,
 		PublicKey:        key.PublicKey,
@@ -32,9 +45,16 @@ func NewKey(key *entities.Key) *Key {
 		EllipticCurve:    string(key.Algo.EllipticCurve),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Version:          key.Metadata.Version,
+		RotationPolicy:   key.RotationPolicy,
 		Disabled:         key.Metadata.Disabled,
+		Operations:       fromOperations(key.Metadata.Operations),
+		ExpireAt:         key.Metadata.ExpireAt,
+		RecoveryPeriod:   key.Metadata.RecoveryPeriod,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
+		RotatedAt:        key.Metadata.RotatedAt,
+		NextRotationAt:   key.RotationPolicy.NextRotationAt(rotatedAt),
 		DeletedAt:        key.Metadata.DeletedAt,
 	}
 }
@@ -47,13 +67,45 @@ func (k *Key) ToEntity() *entities.Key {
 			Type:          entities2.KeyType(k.SigningAlgorithm),
 			EllipticCurve: entities2.Curve(k.EllipticCurve),
 		},
-		Tags:        k.Tags,
-		Annotations: k.Annotations,
+		Tags:           k.Tags,
+		Annotations:    k.Annotations,
+		RotationPolicy: k.RotationPolicy,
 		Metadata: &entities.Metadata{
-			Disabled:  k.Disabled,
-			CreatedAt: k.CreatedAt,
-			UpdatedAt: k.UpdatedAt,
-			DeletedAt: k.DeletedAt,
+			Version:        k.Version,
+			Disabled:       k.Disabled,
+			Operations:     toOperations(k.Operations),
+			ExpireAt:       k.ExpireAt,
+			RecoveryPeriod: k.RecoveryPeriod,
+			CreatedAt:      k.CreatedAt,
+			UpdatedAt:      k.UpdatedAt,
+			RotatedAt:      k.RotatedAt,
+			DeletedAt:      k.DeletedAt,
 		},
 	}
 }
+
+func fromOperations(operations []entities.CryptoOperation) []string {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(operations))
+	for i, op := range operations {
+		res[i] = string(op)
+	}
+
+	return res
+}
+
+func toOperations(operations []string) []entities.CryptoOperation {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	res := make([]entities.CryptoOperation, len(operations))
+	for i, op := range operations {
+		res[i] = entities.CryptoOperation(op)
+	}
+
+	return res
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type KeyVersion struct {
+	tableName struct{} `pg:"key_versions"` // nolint:unused,structcheck // reason
+
+	ID        string `pg:",pk"`
+	StoreID   string `pg:",pk"`
+	Version   string `pg:",pk,use_zero"`
+	PublicKey []byte
+	CreatedAt time.Time `pg:"default:now()"`
+}
+
+func NewKeyVersion(key *entities.Key) *KeyVersion {
+	return &KeyVersion{
+		ID:        key.ID,
+		Version:   key.Metadata.Version,
+		PublicKey: key.PublicKey,
+		CreatedAt: key.Metadata.RotatedAt,
+	}
+}
+
+// ToEntity returns the version of the given key
+func (v *KeyVersion) ToEntity(key *Key) *entities.Key {
+	versionKey := key.ToEntity()
+	versionKey.PublicKey = v.PublicKey
+	versionKey.Metadata.Version = v.Version
+	versionKey.Metadata.RotatedAt = v.CreatedAt
+
+	return versionKey
+}
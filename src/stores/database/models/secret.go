@@ -9,25 +9,29 @@ import (
 type Secret struct {
 	tableName struct{} `pg:"secrets"` // nolint:unused,structcheck // reason
 
-	ID        string `pg:",pk"`
-	Version   string `pg:",pk"`
-	StoreID   string `pg:",pk"`
-	Tags      map[string]string
-	Disabled  bool
-	CreatedAt time.Time `pg:"default:now()"`
-	UpdatedAt time.Time `pg:"default:now()"`
-	DeletedAt time.Time `pg:",soft_delete"`
+	ID             string `pg:",pk"`
+	Version        string `pg:",pk"`
+	StoreID        string `pg:",pk"`
+	Tags           map[string]string
+	Disabled       bool
+	ExpireAt       time.Time
+	RecoveryPeriod time.Duration `pg:",use_zero"`
+	CreatedAt      time.Time     `pg:"default:now()"`
+	UpdatedAt      time.Time     `pg:"default:now()"`
+	DeletedAt      time.Time     `pg:",soft_delete"`
 }
 
 func NewSecret(secret *entities.Secret) *Secret {
 	return &Secret{
-		ID:        secret.ID,
-		Version:   secret.Metadata.Version,
-		Tags:      secret.Tags,
-		Disabled:  secret.Metadata.Disabled,
-		CreatedAt: secret.Metadata.CreatedAt,
-		UpdatedAt: secret.Metadata.UpdatedAt,
-		DeletedAt: secret.Metadata.DeletedAt,
+		ID:             secret.ID,
+		Version:        secret.Metadata.Version,
+		Tags:           secret.Tags,
+		Disabled:       secret.Metadata.Disabled,
+		ExpireAt:       secret.Metadata.ExpireAt,
+		RecoveryPeriod: secret.Metadata.RecoveryPeriod,
+		CreatedAt:      secret.Metadata.CreatedAt,
+		UpdatedAt:      secret.Metadata.UpdatedAt,
+		DeletedAt:      secret.Metadata.DeletedAt,
 	}
 }
 
@@ -36,11 +40,13 @@ func (s *Secret) ToEntity() *entities.Secret {
 		ID:   s.ID,
 		Tags: s.Tags,
 		Metadata: &entities.Metadata{
-			Version:   s.Version,
-			Disabled:  s.Disabled,
-			CreatedAt: s.CreatedAt,
-			UpdatedAt: s.UpdatedAt,
-			DeletedAt: s.DeletedAt,
+			Version:        s.Version,
+			Disabled:       s.Disabled,
+			ExpireAt:       s.ExpireAt,
+			RecoveryPeriod: s.RecoveryPeriod,
+			CreatedAt:      s.CreatedAt,
+			UpdatedAt:      s.UpdatedAt,
+			DeletedAt:      s.DeletedAt,
 		},
 	}
 }
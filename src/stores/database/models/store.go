@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type Store struct {
+	tableName struct{} `pg:"stores"` // nolint:unused,structcheck // reason
+
+	Name           string `pg:",pk"`
+	StoreType      string
+	Vault          string    `pg:",use_zero"`
+	SecretStore    string    `pg:",use_zero"`
+	KeyStore       string    `pg:",use_zero"`
+	AllowedTenants []string  `pg:",array,use_zero"`
+	CreatedAt      time.Time `pg:"default:now()"`
+	UpdatedAt      time.Time `pg:"default:now()"`
+}
+
+func NewStore(store *entities.StoreDefinition) *Store {
+	return &Store{
+		Name:           store.Name,
+		StoreType:      store.StoreType,
+		Vault:          store.Vault,
+		SecretStore:    store.SecretStore,
+		KeyStore:       store.KeyStore,
+		AllowedTenants: store.AllowedTenants,
+		CreatedAt:      store.CreatedAt,
+		UpdatedAt:      store.UpdatedAt,
+	}
+}
+
+func (s *Store) ToEntity() *entities.StoreDefinition {
+	return &entities.StoreDefinition{
+		Name:           s.Name,
+		StoreType:      s.StoreType,
+		Vault:          s.Vault,
+		SecretStore:    s.SecretStore,
+		KeyStore:       s.KeyStore,
+		AllowedTenants: s.AllowedTenants,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
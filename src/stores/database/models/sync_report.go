@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type SyncReport struct {
+	tableName struct{} `pg:"store_syncs"` // nolint:unused,structcheck // reason
+
+	StoreName   string    `pg:",pk"`
+	DryRun      bool      `pg:",use_zero"`
+	Added       []string  `pg:",array,use_zero"`
+	Deleted     []string  `pg:",array,use_zero"`
+	Updated     []string  `pg:",array,use_zero"`
+	Failed      []string  `pg:",array,use_zero"`
+	StartedAt   time.Time `pg:",use_zero"`
+	CompletedAt time.Time `pg:",use_zero"`
+}
+
+func NewSyncReport(report *entities.SyncReport) *SyncReport {
+	return &SyncReport{
+		StoreName:   report.StoreName,
+		DryRun:      report.DryRun,
+		Added:       report.Added,
+		Deleted:     report.Deleted,
+		Updated:     report.Updated,
+		Failed:      report.Failed,
+		StartedAt:   report.StartedAt,
+		CompletedAt: report.CompletedAt,
+	}
+}
+
+func (r *SyncReport) ToEntity() *entities.SyncReport {
+	return &entities.SyncReport{
+		StoreName:   r.StoreName,
+		DryRun:      r.DryRun,
+		Added:       r.Added,
+		Deleted:     r.Deleted,
+		Updated:     r.Updated,
+		Failed:      r.Failed,
+		StartedAt:   r.StartedAt,
+		CompletedAt: r.CompletedAt,
+	}
+}
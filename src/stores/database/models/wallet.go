@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type ETHWallet struct {
+	tableName struct{} `pg:"eth_wallets"` // nolint:unused,structcheck // reason
+
+	ID        string `pg:",pk"`
+	StoreID   string `pg:",pk"`
+	Tags      map[string]string
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+	DeletedAt time.Time `pg:",soft_delete"`
+}
+
+func NewETHWallet(wallet *entities.ETHWallet) *ETHWallet {
+	return &ETHWallet{
+		ID:        wallet.ID,
+		Tags:      wallet.Tags,
+		CreatedAt: wallet.Metadata.CreatedAt,
+		UpdatedAt: wallet.Metadata.UpdatedAt,
+		DeletedAt: wallet.Metadata.DeletedAt,
+	}
+}
+
+func (w *ETHWallet) ToEntity() *entities.ETHWallet {
+	return &entities.ETHWallet{
+		ID:   w.ID,
+		Tags: w.Tags,
+		Metadata: &entities.Metadata{
+			CreatedAt: w.CreatedAt,
+			UpdatedAt: w.UpdatedAt,
+			DeletedAt: w.DeletedAt,
+		},
+	}
+}
@@ -28,6 +28,10 @@ func (db *Database) ETHAccounts(storeID string) database.ETHAccounts {
 	return NewETHAccounts(storeID, db.client, db.logger.With("store_id", storeID))
 }
 
+func (db *Database) ETHWallets(storeID string) database.ETHWallets {
+	return NewETHWallets(storeID, db.client, db.logger.With("store_id", storeID))
+}
+
 func (db *Database) Ping(ctx context.Context) error {
 	err := db.client.Ping(ctx)
 	if err != nil {
@@ -46,3 +50,11 @@ func (db *Database) Keys(storeID string) database.Keys {
 func (db *Database) Secrets(storeID string) database.Secrets {
 	return NewSecrets(storeID, db.client, db.logger.With("store_id", storeID))
 }
+
+func (db *Database) Stores() database.Stores {
+	return NewStores(db.client, db.logger)
+}
+
+func (db *Database) SyncReports() database.SyncReports {
+	return NewSyncReports(db.client, db.logger)
+}
@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
 	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
@@ -15,6 +18,9 @@ import (
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 )
 
+// accountsTenantLockClass is the class of the advisory locks serializing the creations of accounts of each tenant in each store
+const accountsTenantLockClass = 2
+
 type ETHAccounts struct {
 	storeID string
 	logger  log.Logger
@@ -100,6 +106,24 @@ func (ea *ETHAccounts) GetAllDeleted(ctx context.Context) ([]*entities.ETHAccoun
 	return accounts, nil
 }
 
+func (ea *ETHAccounts) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.ETHAccount, error) {
+	var ethAccs []*models.ETHAccount
+
+	err := ea.client.SelectDeletedWhere(ctx, &ethAccs, "store_id = ? AND "+purgeableCondition, ea.storeID, int64(recoveryPeriod), before)
+	if err != nil {
+		errMessage := "failed to get all purgeable accounts"
+		ea.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var accounts []*entities.ETHAccount
+	for _, acc := range ethAccs {
+		accounts = append(accounts, acc.ToEntity())
+	}
+
+	return accounts, nil
+}
+
 func (ea *ETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	ids, err := client.QuerySearchIDs(ctx, ea.client, "eth_accounts", "address", "store_id = ?", []interface{}{ea.storeID}, isDeleted, limit, offset)
 	if err != nil {
@@ -111,6 +135,50 @@ func (ea *ETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limi
 	return ids, nil
 }
 
+func (ea *ETHAccounts) SearchExpiringAddresses(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	ids, err := client.QuerySearchIDs(ctx, ea.client, "eth_accounts", "address", "store_id = ? AND expire_at <= ?", []interface{}{ea.storeID, before}, false, limit, offset)
+	if err != nil {
+		errMessage := "failed to list of expiring ethereum addresses"
+		ea.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (ea *ETHAccounts) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.ETHAccount, error) {
+	addresses, err := searchIDs(ctx, ea.client, "eth_accounts", "address", nil, ea.storeID, filter)
+	if err != nil {
+		errMessage := "failed to search ethereum accounts"
+		ea.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(addresses) == 0 {
+		return []*entities.ETHAccount{}, nil
+	}
+
+	var accModels []*models.ETHAccount
+	err = ea.client.SelectWhere(ctx, &accModels, "store_id = ? AND address = ANY(?)", []string{}, ea.storeID, pq.Array(addresses))
+	if err != nil {
+		errMessage := "failed to get searched ethereum accounts"
+		ea.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	pos := positions(addresses)
+	sort.Slice(accModels, func(i, j int) bool {
+		return pos[accModels[i].Address] < pos[accModels[j].Address]
+	})
+
+	accounts := make([]*entities.ETHAccount, 0, len(accModels))
+	for _, accModel := range accModels {
+		accounts = append(accounts, accModel.ToEntity())
+	}
+
+	return accounts, nil
+}
+
 func (ea *ETHAccounts) Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
 	accModel := models.NewETHAccount(account)
 	accModel.StoreID = ea.storeID
@@ -178,3 +246,40 @@ func (ea *ETHAccounts) Purge(ctx context.Context, addr string) error {
 
 	return nil
 }
+
+func (ea *ETHAccounts) LockTenant(ctx context.Context, tenant string) error {
+	var locked bool
+	err := ea.client.QueryOne(ctx, &locked, "SELECT true FROM pg_advisory_xact_lock(?, hashtext(? || '|' || ?))", accountsTenantLockClass, ea.storeID, tenant)
+	if err != nil {
+		errMessage := "failed to lock accounts of tenant"
+		ea.logger.With("tenant", tenant).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (ea *ETHAccounts) CountByTenant(ctx context.Context, tenant string) (int, error) {
+	var count int
+	err := ea.client.QueryOne(ctx, &count, "SELECT count(*) FROM eth_accounts WHERE store_id = ? AND tenant = ?", ea.storeID, tenant)
+	if err != nil {
+		errMessage := "failed to count accounts of tenant"
+		ea.logger.With("tenant", tenant).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return count, nil
+}
+
+func (ea *ETHAccounts) SetTenant(ctx context.Context, addr, tenant string) error {
+	// Only the tenant is updated so that a concurrent update of the account is not overwritten
+	var updatedAddr string
+	err := ea.client.QueryOne(ctx, &updatedAddr, "UPDATE eth_accounts SET tenant = ? WHERE address = ? AND store_id = ? RETURNING address", tenant, addr, ea.storeID)
+	if err != nil {
+		errMessage := "failed to set tenant of account"
+		ea.logger.With("address", addr).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
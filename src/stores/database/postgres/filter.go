@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+// searchIDs returns the ids of the non-deleted items of table matching filter, in the order of the filter.
+// Pagination relies on the (created_at, id) keyset so that pages stay stable under concurrent inserts
+func searchIDs(ctx context.Context, client postgres.Client, table, idCol string, tableArgs []interface{}, storeID string, filter *entities.ListFilter) ([]string, error) {
+	args := append([]interface{}{}, tableArgs...)
+	conditions := []string{}
+	addCondition := func(condition string, arg ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, arg...)
+	}
+
+	addCondition("store_id = ?", storeID)
+	addCondition("deleted_at IS NULL")
+
+	if len(filter.Tags) > 0 {
+		tags, err := json.Marshal(filter.Tags)
+		if err != nil {
+			return nil, err
+		}
+		addCondition("tags @> ?::jsonb", string(tags))
+	}
+	if filter.SigningAlgorithm != "" {
+		addCondition("signing_algorithm = ?", filter.SigningAlgorithm)
+	}
+	if filter.EllipticCurve != "" {
+		addCondition("elliptic_curve = ?", filter.EllipticCurve)
+	}
+	if filter.Disabled != nil {
+		addCondition("disabled = ?", *filter.Disabled)
+	}
+	if filter.CreatedAfter != nil {
+		addCondition("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCondition("created_at < ?", *filter.CreatedBefore)
+	}
+
+	direction, comparator := entities.SortOrderAsc, ">"
+	if filter.SortOrder == entities.SortOrderDesc {
+		direction, comparator = entities.SortOrderDesc, "<"
+	}
+
+	var order string
+	switch filter.SortBy {
+	case entities.SortByID:
+		order = fmt.Sprintf("%s %s", idCol, direction)
+		if filter.After != nil {
+			addCondition(fmt.Sprintf("%s %s ?", idCol, comparator), filter.After.ID)
+		}
+	default:
+		order = fmt.Sprintf("created_at %s, %s %s", direction, idCol, direction)
+		if filter.After != nil {
+			addCondition(fmt.Sprintf("(created_at, %s) %s (?, ?)", idCol, comparator), filter.After.CreatedAt, filter.After.ID)
+		}
+	}
+
+	page := fmt.Sprintf("SELECT %s, created_at FROM %s WHERE %s ORDER BY %s", idCol, table, strings.Join(conditions, " AND "), order)
+	if filter.Limit != 0 {
+		page = fmt.Sprintf("%s LIMIT %d", page, filter.Limit)
+	}
+
+	var ids []string
+	err := client.Query(ctx, &ids, fmt.Sprintf("SELECT array_agg(%s ORDER BY %s) FROM (%s) AS page", idCol, order, page), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// purgeableCondition matches the deleted items that expired before their deletion and whose recovery period is over at a date.
+// Items without a recovery period use the default one. Recovery periods are stored in nanoseconds
+const purgeableCondition = "expire_at IS NOT NULL AND expire_at <= deleted_at AND " +
+	"deleted_at + (CASE WHEN recovery_period = 0 THEN ? ELSE recovery_period END) / 1000 * INTERVAL '1 microsecond' <= ?"
+
+// positions indexes ids by their position, to restore the order of searchIDs on the selected items
+func positions(ids []string) map[string]int {
+	pos := make(map[string]int, len(ids))
+	for i, id := range ids {
+		pos[id] = i
+	}
+
+	return pos
+}
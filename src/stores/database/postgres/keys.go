@@ -2,6 +2,10 @@ package postgres
 
 import (
 	"context"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
 	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
@@ -15,6 +19,9 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/stores/database"
 )
 
+// keysTenantLockClass is the class of the advisory locks serializing the creations of keys of each tenant in each store
+const keysTenantLockClass = 1
+
 type Keys struct {
 	storeID string
 	logger  log.Logger
@@ -100,6 +107,42 @@ func (k *Keys) GetAllDeleted(ctx context.Context) ([]*entities.Key, error) {
 	return keys, nil
 }
 
+func (k *Keys) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Key, error) {
+	var keyModels []*models.Key
+
+	err := k.client.SelectDeletedWhere(ctx, &keyModels, "store_id = ? AND "+purgeableCondition, k.storeID, int64(recoveryPeriod), before)
+	if err != nil {
+		errMessage := "failed to get all purgeable keys"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var keys []*entities.Key
+	for _, key := range keyModels {
+		keys = append(keys, key.ToEntity())
+	}
+
+	return keys, nil
+}
+
+func (k *Keys) GetAllDueForRotation(ctx context.Context, now time.Time) ([]*entities.Key, error) {
+	var keyModels []*models.Key
+
+	err := k.client.SelectWhere(ctx, &keyModels, "store_id = ? AND next_rotation_at <= ?", []string{}, k.storeID, now)
+	if err != nil {
+		errMessage := "failed to get all keys due for rotation"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var keys []*entities.Key
+	for _, key := range keyModels {
+		keys = append(keys, key.ToEntity())
+	}
+
+	return keys, nil
+}
+
 func (k *Keys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	ids, err := client.QuerySearchIDs(ctx, k.client, "keys", "id", "store_id = ?", []interface{}{k.storeID}, isDeleted, limit, offset)
 	if err != nil {
@@ -111,11 +154,65 @@ func (k *Keys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint
 	return ids, nil
 }
 
+func (k *Keys) SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	ids, err := client.QuerySearchIDs(ctx, k.client, "keys", "id", "store_id = ? AND expire_at <= ?", []interface{}{k.storeID, before}, false, limit, offset)
+	if err != nil {
+		errMessage := "failed to list expiring keys ids"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (k *Keys) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Key, error) {
+	ids, err := searchIDs(ctx, k.client, "keys", "id", nil, k.storeID, filter)
+	if err != nil {
+		errMessage := "failed to search keys"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(ids) == 0 {
+		return []*entities.Key{}, nil
+	}
+
+	var keyModels []*models.Key
+	err = k.client.SelectWhere(ctx, &keyModels, "store_id = ? AND id = ANY(?)", []string{}, k.storeID, pq.Array(ids))
+	if err != nil {
+		errMessage := "failed to get searched keys"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	pos := positions(ids)
+	sort.Slice(keyModels, func(i, j int) bool {
+		return pos[keyModels[i].ID] < pos[keyModels[j].ID]
+	})
+
+	keys := make([]*entities.Key, 0, len(keyModels))
+	for _, keyModel := range keyModels {
+		keys = append(keys, keyModel.ToEntity())
+	}
+
+	return keys, nil
+}
+
 func (k *Keys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	keyModel := models.NewKey(key)
 	keyModel.StoreID = k.storeID
 
-	err := k.client.Insert(ctx, keyModel)
+	// The first version of the key is recorded along with the key
+	err := k.client.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		derr := dbtx.Insert(ctx, keyModel)
+		if derr != nil {
+			return derr
+		}
+
+		versionModel := models.NewKeyVersion(keyModel.ToEntity())
+		versionModel.StoreID = k.storeID
+		return dbtx.Insert(ctx, versionModel)
+	})
 	if err != nil {
 		errMessage := "failed to add key"
 		k.logger.With("id", key.ID).WithError(err).Error(errMessage)
@@ -125,6 +222,50 @@ func (k *Keys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error
 	return keyModel.ToEntity(), nil
 }
 
+func (k *Keys) AddVersion(ctx context.Context, key *entities.Key) error {
+	versionModel := models.NewKeyVersion(key)
+	versionModel.StoreID = k.storeID
+
+	err := k.client.Insert(ctx, versionModel)
+	if err != nil {
+		errMessage := "failed to add key version"
+		k.logger.With("id", key.ID, "version", key.Metadata.Version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (k *Keys) ListVersions(ctx context.Context, id string) ([]*entities.Key, error) {
+	keyModel := &models.Key{ID: id, StoreID: k.storeID}
+	err := k.client.SelectPK(ctx, keyModel)
+	if err != nil {
+		errMessage := "failed to get key"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var versionModels []*models.KeyVersion
+	err = k.client.SelectWhere(ctx, &versionModels, "id = ? AND store_id = ?", []string{}, id, k.storeID)
+	if err != nil {
+		errMessage := "failed to list key versions"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Latest version first
+	sort.Slice(versionModels, func(i, j int) bool {
+		return versionModels[i].CreatedAt.After(versionModels[j].CreatedAt)
+	})
+
+	keys := make([]*entities.Key, 0, len(versionModels))
+	for _, versionModel := range versionModels {
+		keys = append(keys, versionModel.ToEntity(keyModel))
+	}
+
+	return keys, nil
+}
+
 func (k *Keys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	keyModel := models.NewKey(key)
 	keyModel.StoreID = k.storeID
@@ -169,5 +310,49 @@ func (k *Keys) Purge(ctx context.Context, id string) error {
 		return errors.FromError(err).SetMessage(errMessage)
 	}
 
+	err = k.client.ForceDeleteWhere(ctx, &models.KeyVersion{}, "id = ? AND store_id = ?", id, k.storeID)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to permanently delete key versions"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (k *Keys) LockTenant(ctx context.Context, tenant string) error {
+	var locked bool
+	err := k.client.QueryOne(ctx, &locked, "SELECT true FROM pg_advisory_xact_lock(?, hashtext(? || '|' || ?))", keysTenantLockClass, k.storeID, tenant)
+	if err != nil {
+		errMessage := "failed to lock keys of tenant"
+		k.logger.With("tenant", tenant).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (k *Keys) CountByTenant(ctx context.Context, tenant string) (int, error) {
+	var count int
+	err := k.client.QueryOne(ctx, &count, "SELECT count(*) FROM keys WHERE store_id = ? AND tenant = ?", k.storeID, tenant)
+	if err != nil {
+		errMessage := "failed to count keys of tenant"
+		k.logger.With("tenant", tenant).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return count, nil
+}
+
+func (k *Keys) SetTenant(ctx context.Context, id, tenant string) error {
+	// Only the tenant is updated so that a concurrent update of the key is not overwritten
+	var updatedID string
+	err := k.client.QueryOne(ctx, &updatedID, "UPDATE keys SET tenant = ? WHERE id = ? AND store_id = ? RETURNING id", tenant, id, k.storeID)
+	if err != nil {
+		errMessage := "failed to set tenant of key"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
 	return nil
 }
@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
 	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
@@ -107,6 +110,56 @@ func (s *Secrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset u
 	return ids, nil
 }
 
+func (s *Secrets) SearchExpiringIDs(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	// A secret expires with its latest version
+	latestVersions := "(SELECT DISTINCT ON (id) id, created_at, expire_at, deleted_at FROM secrets WHERE store_id = ? ORDER BY id, created_at DESC) AS latest"
+	ids, err := client.QuerySearchIDs(ctx, s.client, latestVersions, "id", "expire_at <= ?", []interface{}{s.storeID, before}, false, limit, offset)
+	if err != nil {
+		errMessage := "failed to list expiring secret ids"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (s *Secrets) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Secret, error) {
+	// A secret is searched through its latest version
+	latestVersions := "(SELECT DISTINCT ON (id) * FROM secrets WHERE store_id = ? ORDER BY id, created_at DESC) AS latest"
+	ids, err := searchIDs(ctx, s.client, latestVersions, "id", []interface{}{s.storeID}, s.storeID, filter)
+	if err != nil {
+		errMessage := "failed to search secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(ids) == 0 {
+		return []*entities.Secret{}, nil
+	}
+
+	var secretModels []*models.Secret
+	err = s.client.SelectWhere(ctx, &secretModels,
+		"(id, version) IN (SELECT DISTINCT ON (id) id, version FROM secrets WHERE store_id = ? AND id = ANY(?) ORDER BY id, created_at DESC) AND store_id = ?",
+		[]string{}, s.storeID, pq.Array(ids), s.storeID)
+	if err != nil {
+		errMessage := "failed to get searched secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	pos := positions(ids)
+	sort.Slice(secretModels, func(i, j int) bool {
+		return pos[secretModels[i].ID] < pos[secretModels[j].ID]
+	})
+
+	secrets := make([]*entities.Secret, 0, len(secretModels))
+	for _, secretModel := range secretModels {
+		secrets = append(secrets, secretModel.ToEntity())
+	}
+
+	return secrets, nil
+}
+
 func (s *Secrets) ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error) {
 	var versions []string
 	var err error
@@ -163,6 +216,26 @@ func (s *Secrets) GetAllDeleted(ctx context.Context) ([]*entities.Secret, error)
 	return items, nil
 }
 
+func (s *Secrets) GetAllPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Secret, error) {
+	var itemModels []*models.Secret
+
+	// A secret is purgeable through its latest version
+	latestVersions := "(id, version) IN (SELECT DISTINCT ON (id) id, version FROM secrets WHERE store_id = ? AND deleted_at IS NOT NULL ORDER BY id, created_at DESC)"
+	err := s.client.SelectDeletedWhere(ctx, &itemModels, "store_id = ? AND "+latestVersions+" AND "+purgeableCondition, s.storeID, s.storeID, int64(recoveryPeriod), before)
+	if err != nil {
+		errMessage := "failed to get all purgeable secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var items []*entities.Secret
+	for _, item := range itemModels {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
 func (s *Secrets) Add(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
 	itemModel := models.NewSecret(secret)
 	itemModel.StoreID = s.storeID
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type Stores struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Stores = &Stores{}
+
+func NewStores(db postgres.Client, logger log.Logger) *Stores {
+	return &Stores{
+		logger: logger,
+		client: db,
+	}
+}
+
+func (s *Stores) Insert(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error) {
+	storeModel := models.NewStore(store)
+
+	err := s.client.Insert(ctx, storeModel)
+	if err != nil {
+		errMessage := "failed to insert store"
+		s.logger.With("name", store.Name).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return storeModel.ToEntity(), nil
+}
+
+func (s *Stores) FindOne(ctx context.Context, name string) (*entities.StoreDefinition, error) {
+	storeModel := &models.Store{Name: name}
+
+	err := s.client.SelectPK(ctx, storeModel)
+	if err != nil {
+		errMessage := "failed to get store"
+		s.logger.With("name", name).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return storeModel.ToEntity(), nil
+}
+
+func (s *Stores) FindAll(ctx context.Context) ([]*entities.StoreDefinition, error) {
+	var storeModels []*models.Store
+
+	err := s.client.Select(ctx, &storeModels)
+	if err != nil {
+		errMessage := "failed to get all stores"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Stores can depend on each other, they are returned in creation order so that dependencies come first
+	sort.Slice(storeModels, func(i, j int) bool {
+		return storeModels[i].CreatedAt.Before(storeModels[j].CreatedAt)
+	})
+
+	var stores []*entities.StoreDefinition
+	for _, store := range storeModels {
+		stores = append(stores, store.ToEntity())
+	}
+
+	return stores, nil
+}
+
+func (s *Stores) Update(ctx context.Context, store *entities.StoreDefinition) (*entities.StoreDefinition, error) {
+	storeModel := models.NewStore(store)
+	storeModel.UpdatedAt = time.Now()
+
+	err := s.client.UpdatePK(ctx, storeModel)
+	if err != nil {
+		errMessage := "failed to update store"
+		s.logger.With("name", store.Name).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Update does not update the model, we must update and then get
+	return s.FindOne(ctx, store.Name)
+}
+
+func (s *Stores) Delete(ctx context.Context, name string) error {
+	err := s.client.DeletePK(ctx, &models.Store{Name: name})
+	if err != nil {
+		errMessage := "failed to delete store"
+		s.logger.With("name", name).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
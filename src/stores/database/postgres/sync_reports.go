@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type SyncReports struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.SyncReports = &SyncReports{}
+
+func NewSyncReports(db postgres.Client, logger log.Logger) *SyncReports {
+	return &SyncReports{
+		logger: logger,
+		client: db,
+	}
+}
+
+// Upsert replaces the last report of the store
+func (s *SyncReports) Upsert(ctx context.Context, report *entities.SyncReport) error {
+	reportModel := models.NewSyncReport(report)
+
+	err := s.client.UpdatePK(ctx, reportModel)
+	if err != nil && errors.IsNotFoundError(err) {
+		err = s.client.Insert(ctx, reportModel)
+	}
+	if err != nil {
+		errMessage := "failed to persist sync report"
+		s.logger.With("store_name", report.StoreName).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *SyncReports) FindOne(ctx context.Context, storeName string) (*entities.SyncReport, error) {
+	reportModel := &models.SyncReport{StoreName: storeName}
+
+	err := s.client.SelectPK(ctx, reportModel)
+	if err != nil {
+		errMessage := "failed to get sync report"
+		s.logger.With("store_name", storeName).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return reportModel.ToEntity(), nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres/client"
+	"github.com/longfan78/quorum-key-manager/src/stores/database"
+	"github.com/longfan78/quorum-key-manager/src/stores/database/models"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
+)
+
+type ETHWallets struct {
+	storeID string
+	logger  log.Logger
+	client  postgres.Client
+}
+
+var _ database.ETHWallets = &ETHWallets{}
+
+func NewETHWallets(storeID string, db postgres.Client, logger log.Logger) *ETHWallets {
+	return &ETHWallets{
+		storeID: storeID,
+		logger:  logger,
+		client:  db,
+	}
+}
+
+func (w *ETHWallets) Get(ctx context.Context, id string) (*entities.ETHWallet, error) {
+	wallet := &models.ETHWallet{ID: id, StoreID: w.storeID}
+
+	err := w.client.SelectPK(ctx, wallet)
+	if err != nil {
+		errMessage := "failed to get ethereum wallet"
+		w.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return wallet.ToEntity(), nil
+}
+
+func (w *ETHWallets) SearchIDs(ctx context.Context, limit, offset uint64) ([]string, error) {
+	ids, err := client.QuerySearchIDs(ctx, w.client, "eth_wallets", "id", "store_id = ?", []interface{}{w.storeID}, false, limit, offset)
+	if err != nil {
+		errMessage := "failed to list ethereum wallet ids"
+		w.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (w *ETHWallets) Add(ctx context.Context, wallet *entities.ETHWallet) (*entities.ETHWallet, error) {
+	walletModel := models.NewETHWallet(wallet)
+	walletModel.StoreID = w.storeID
+
+	err := w.client.Insert(ctx, walletModel)
+	if err != nil {
+		errMessage := "failed to add ethereum wallet"
+		w.logger.With("id", wallet.ID).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return walletModel.ToEntity(), nil
+}
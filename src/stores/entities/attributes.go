@@ -6,8 +6,8 @@ import "time"
 type CryptoOperation string
 
 const (
-	Signing    = "signing"
-	Encryption = "encryption"
+	Signing    CryptoOperation = "signing"
+	Encryption CryptoOperation = "encryption"
 )
 
 // RecoveryPolicy policies for recovering a deleted item
@@ -21,7 +21,7 @@ type Attributes struct {
 	// Disabled whether item is disabled
 	Disabled bool
 
-	// TTL
+	// TTL after which the item cannot be used anymore and is deleted
 	TTL time.Duration
 
 	// Recovery policy about a key after being deleted before being destroyed
@@ -29,12 +29,15 @@ type Attributes struct {
 
 	// Tags attached to a stored item
 	Tags map[string]string
+
+	// RotationPolicy schedules the rotation of a key
+	RotationPolicy *RotationPolicy
 }
 
 type Recovery struct {
 	// Policy for recovery
 	Policy RecoveryPolicy
 
-	// Period for recovery
-	Period time.Time
+	// Period during which an expired item can be restored before being destroyed
+	Period time.Duration
 }
@@ -9,4 +9,7 @@ type ETHAccount struct {
 	CompressedPublicKey []byte
 	Metadata            *Metadata
 	Tags                map[string]string
+	// WalletID and DerivationPath are set when the account is derived from an HD wallet
+	WalletID       string
+	DerivationPath string
 }
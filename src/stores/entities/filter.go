@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByID        = "id"
+
+	SortOrderAsc  = "ASC"
+	SortOrderDesc = "DESC"
+)
+
+// ListFilter filters, sorts and paginates the items listed from a store
+type ListFilter struct {
+	// Tags items must have all the tags
+	Tags             map[string]string
+	SigningAlgorithm string
+	EllipticCurve    string
+	Disabled         *bool
+	CreatedAfter     *time.Time
+	CreatedBefore    *time.Time
+	SortBy           string
+	SortOrder        string
+	// After lists the items following a cursor, keeping pages stable under concurrent inserts
+	After *Cursor
+	Limit uint64
+}
+
+// Cursor identifies the last item of a page
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func NewCursor(id string, createdAt time.Time) *Cursor {
+	return &Cursor{ID: id, CreatedAt: createdAt}
+}
+
+// Encode returns the opaque representation of the cursor
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor returned by Encode
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &Cursor{}
+	err = json.Unmarshal(b, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
@@ -10,6 +10,8 @@ type Key struct {
 	Metadata    *Metadata
 	Tags        map[string]string
 	Annotations *Annotation
+	// RotationPolicy is nil when the key is not rotated automatically
+	RotationPolicy *RotationPolicy
 }
 
 func (k *Key) IsETHAccount() bool {
@@ -3,10 +3,58 @@ package entities
 import "time"
 
 type Metadata struct {
-	Version   string
-	Disabled  bool
-	ExpireAt  time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt time.Time
+	Version        string
+	Disabled       bool
+	Operations     []CryptoOperation
+	ExpireAt       time.Time
+	RecoveryPeriod time.Duration
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      time.Time
+	RotatedAt      time.Time
+}
+
+// SetExpiry sets the expiry of an item created at now with attr
+func (m *Metadata) SetExpiry(attr *Attributes, now time.Time) {
+	if attr.TTL > 0 {
+		m.ExpireAt = now.Add(attr.TTL)
+	}
+
+	if attr.Recovery != nil {
+		m.RecoveryPeriod = attr.Recovery.Period
+	}
+}
+
+// SetUsage restricts the usage of an item created with attr to the declared operations
+func (m *Metadata) SetUsage(attr *Attributes) {
+	m.Disabled = m.Disabled || attr.Disabled
+	m.Operations = attr.Operations
+}
+
+// Allows returns whether the item can be used for an operation, any operation being allowed if none was declared
+func (m *Metadata) Allows(operation CryptoOperation) bool {
+	if len(m.Operations) == 0 {
+		return true
+	}
+
+	for _, op := range m.Operations {
+		if op == operation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExpired returns whether the item has expired at now
+func (m *Metadata) IsExpired(now time.Time) bool {
+	return !m.ExpireAt.IsZero() && !m.ExpireAt.After(now)
+}
+
+type ExpiryConfig struct {
+	// ReaperInterval is the period at which expired items are deleted and purged, 0 disables the reaper
+	ReaperInterval time.Duration
+
+	// RecoveryPeriod is the period during which an expired item without recovery period can be restored before being destroyed
+	RecoveryPeriod time.Duration
 }
@@ -0,0 +1,7 @@
+package entities
+
+// QuotaConfig limits the items each tenant can create in a store, 0 meaning unlimited
+type QuotaConfig struct {
+	MaxKeys     int
+	MaxAccounts int
+}
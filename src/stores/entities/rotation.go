@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+// RotationPolicy schedules the rotation of a key at a fixed interval
+type RotationPolicy struct {
+	Interval time.Duration
+}
+
+// IsDue returns whether a key rotated at rotatedAt must be rotated again
+func (p *RotationPolicy) IsDue(rotatedAt, now time.Time) bool {
+	return p != nil && p.Interval > 0 && !rotatedAt.Add(p.Interval).After(now)
+}
+
+// NextRotationAt returns when a key rotated at rotatedAt must be rotated again, or the zero time if it is not rotated
+// automatically
+func (p *RotationPolicy) NextRotationAt(rotatedAt time.Time) time.Time {
+	if p == nil || p.Interval <= 0 {
+		return time.Time{}
+	}
+
+	return rotatedAt.Add(p.Interval)
+}
+
+type RotationConfig struct {
+	// CheckInterval is the period at which the rotation policies are evaluated, 0 disables scheduled rotation
+	CheckInterval time.Duration
+}
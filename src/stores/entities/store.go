@@ -1,5 +1,7 @@
 package entities
 
+import "time"
+
 const (
 	EthereumStoreType = "ethereum"
 	KeyStoreType      = "key"
@@ -10,5 +12,19 @@ type Store struct {
 	Name           string
 	AllowedTenants []string
 	Store          interface{}
+	// SecretStore holds the HD wallet mnemonics of an Ethereum store, nil if not configured
+	SecretStore interface{}
+	StoreType   string
+}
+
+// StoreDefinition is the persisted definition of a store managed at runtime
+type StoreDefinition struct {
+	Name           string
 	StoreType      string
+	Vault          string
+	SecretStore    string
+	KeyStore       string
+	AllowedTenants []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
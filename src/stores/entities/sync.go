@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+// SyncReport is the drift between the items indexed for a store and the ones of its vault
+type SyncReport struct {
+	StoreName string
+	// DryRun is set when the drift was only reported, the database being left unchanged
+	DryRun bool
+	// Added are the items of the vault which are not indexed
+	Added []string
+	// Deleted are the indexed items which are no longer in the vault
+	Deleted []string
+	// Updated are the indexed items whose tags or version changed in the vault
+	Updated []string
+	// Failed are the items which could not be read from the vault or reconciled
+	Failed      []string
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+func (r *SyncReport) HasDrift() bool {
+	return len(r.Added) > 0 || len(r.Deleted) > 0 || len(r.Updated) > 0
+}
+
+type SyncConfig struct {
+	// Interval is the period at which the stores are synchronized with their vault, 0 disables scheduled synchronization
+	Interval time.Duration
+	// DryRun only reports the drift of the stores
+	DryRun bool
+	// Stores are the synchronized stores, every secret, key and Ethereum store being synchronized if empty
+	Stores []string
+}
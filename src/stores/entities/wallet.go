@@ -0,0 +1,14 @@
+package entities
+
+// WalletSeedPrefix prefixes the IDs of the mnemonics of the HD wallets in secret stores, these IDs being reserved so that
+// the mnemonics can neither be read nor overwritten as secrets
+const WalletSeedPrefix = "hd-wallet-"
+
+// ETHWallet is a BIP-32 hierarchical deterministic wallet from which Ethereum accounts are derived
+type ETHWallet struct {
+	ID string
+	// Mnemonic is only set when the wallet is created
+	Mnemonic string
+	Tags     map[string]string
+	Metadata *Metadata
+}
@@ -3,6 +3,7 @@ package stores
 import (
 	"context"
 	"math/big"
+	"time"
 
 	quorumtypes "github.com/consensys/quorum/core/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -23,15 +24,42 @@ type EthStore interface {
 	// Import imports an externally created Ethereum account
 	Import(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (*entities.ETHAccount, error)
 
+	// CreateWallet creates an HD wallet from a new BIP-39 mnemonic, only returned by this call
+	CreateWallet(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHWallet, error)
+
+	// ImportWallet imports an HD wallet from a BIP-39 mnemonic
+	ImportWallet(ctx context.Context, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error)
+
+	// GetWallet gets an HD wallet
+	GetWallet(ctx context.Context, id string) (*entities.ETHWallet, error)
+
+	// ListWallets lists HD wallet ids
+	ListWallets(ctx context.Context, limit, offset uint64) ([]string, error)
+
+	// DeriveAccount derives the Ethereum account of a BIP-32 path from an HD wallet and stores its key under id
+	DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (*entities.ETHAccount, error)
+
 	// Get gets an Ethereum account
 	Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error)
 
 	// List lists all Ethereum account addresses
 	List(ctx context.Context, limit, offset uint64) ([]common.Address, error)
 
+	// ListExpiring lists the Ethereum account addresses expiring before a date, including expired accounts
+	ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]common.Address, error)
+
+	// Search searches Ethereum accounts matching a filter
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.ETHAccount, error)
+
 	// Update updates Ethereum account attributes
 	Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error)
 
+	// Enable enables a disabled Ethereum account
+	Enable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error)
+
+	// Disable disables an Ethereum account, which cannot sign, encrypt or decrypt until enabled
+	Disable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error)
+
 	// Delete deletes an account temporarily, by using Restore the account can be restored
 	Delete(ctx context.Context, addr common.Address) error
 
@@ -41,6 +69,9 @@ type EthStore interface {
 	// ListDeleted lists all deleted Ethereum accounts
 	ListDeleted(ctx context.Context, limit, offset uint64) ([]common.Address, error)
 
+	// SearchPurgeable searches deleted Ethereum accounts expired before their deletion whose recovery period, or recoveryPeriod if unset, is over before a date
+	SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.ETHAccount, error)
+
 	// Restore restores a previously deleted Ethereum account
 	Restore(ctx context.Context, addr common.Address) error
 
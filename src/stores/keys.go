@@ -2,6 +2,7 @@ package stores
 
 import (
 	"context"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 
@@ -23,9 +24,27 @@ type KeyStore interface {
 	// List lists keys
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// ListExpiring lists keys expiring before a date, including expired keys
+	ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error)
+
+	// Search searches keys matching a filter
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Key, error)
+
+	// Rotate creates a new version of a key under the same ID, with the algorithm of the key if not specified
+	Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error)
+
+	// ListVersions lists the versions of a key, latest first
+	ListVersions(ctx context.Context, id string) ([]*entities.Key, error)
+
 	// Update updates key tags
 	Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error)
 
+	// Enable enables a disabled key
+	Enable(ctx context.Context, id string) (*entities.Key, error)
+
+	// Disable disables a key, which cannot be used for crypto operations until enabled
+	Disable(ctx context.Context, id string) (*entities.Key, error)
+
 	// Delete soft-deletes a key
 	Delete(ctx context.Context, id string) error
 
@@ -35,6 +54,12 @@ type KeyStore interface {
 	// ListDeleted lists deleted keys
 	ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// SearchPurgeable searches deleted keys expired before their deletion whose recovery period, or recoveryPeriod if unset, is over before a date
+	SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Key, error)
+
+	// SearchDueForRotation searches keys whose rotation policy is due at a date
+	SearchDueForRotation(ctx context.Context, now time.Time) ([]*entities.Key, error)
+
 	// Restore restores a previously deleted secret
 	Restore(ctx context.Context, id string) error
 
@@ -45,8 +70,8 @@ type KeyStore interface {
 	Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
 
 	// Encrypt encrypts any arbitrary data using a specified key
-	Encrypt(ctx context.Context, id string, data []byte) ([]byte, error)
+	Encrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
 
 	// Decrypt decrypts a single block of encrypted data.
-	Decrypt(ctx context.Context, id string, data []byte) ([]byte, error)
+	Decrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
 }
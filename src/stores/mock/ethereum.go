@@ -8,9 +8,10 @@ import (
 	context "context"
 	ethereum "github.com/longfan78/quorum-key-manager/pkg/ethereum"
 	entities "github.com/longfan78/quorum-key-manager/src/stores/entities"
-	types "github.com/consensys/quorum/core/types"
+	quorumtypes "github.com/consensys/quorum/core/types"
+	types "github.com/ethereum/go-ethereum/core/types"
+	time "time"
 	common "github.com/ethereum/go-ethereum/common"
-	types0 "github.com/ethereum/go-ethereum/core/types"
 	core "github.com/ethereum/go-ethereum/signer/core"
 	gomock "github.com/golang/mock/gomock"
 	big "math/big"
@@ -70,6 +71,81 @@ func (mr *MockEthStoreMockRecorder) Import(ctx, id, privKey, attr interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockEthStore)(nil).Import), ctx, id, privKey, attr)
 }
 
+// CreateWallet mocks base method
+func (m *MockEthStore) CreateWallet(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, id, attr)
+	ret0, _ := ret[0].(*entities.ETHWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet
+func (mr *MockEthStoreMockRecorder) CreateWallet(ctx, id, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockEthStore)(nil).CreateWallet), ctx, id, attr)
+}
+
+// ImportWallet mocks base method
+func (m *MockEthStore) ImportWallet(ctx context.Context, id, mnemonic string, attr *entities.Attributes) (*entities.ETHWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWallet", ctx, id, mnemonic, attr)
+	ret0, _ := ret[0].(*entities.ETHWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWallet indicates an expected call of ImportWallet
+func (mr *MockEthStoreMockRecorder) ImportWallet(ctx, id, mnemonic, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWallet", reflect.TypeOf((*MockEthStore)(nil).ImportWallet), ctx, id, mnemonic, attr)
+}
+
+// GetWallet mocks base method
+func (m *MockEthStore) GetWallet(ctx context.Context, id string) (*entities.ETHWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, id)
+	ret0, _ := ret[0].(*entities.ETHWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet
+func (mr *MockEthStoreMockRecorder) GetWallet(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockEthStore)(nil).GetWallet), ctx, id)
+}
+
+// ListWallets mocks base method
+func (m *MockEthStore) ListWallets(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWallets", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWallets indicates an expected call of ListWallets
+func (mr *MockEthStoreMockRecorder) ListWallets(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWallets", reflect.TypeOf((*MockEthStore)(nil).ListWallets), ctx, limit, offset)
+}
+
+// DeriveAccount mocks base method
+func (m *MockEthStore) DeriveAccount(ctx context.Context, walletID, path, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveAccount", ctx, walletID, path, id, attr)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAccount indicates an expected call of DeriveAccount
+func (mr *MockEthStoreMockRecorder) DeriveAccount(ctx, walletID, path, id, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAccount", reflect.TypeOf((*MockEthStore)(nil).DeriveAccount), ctx, walletID, path, id, attr)
+}
+
 // Get mocks base method
 func (m *MockEthStore) Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +176,36 @@ func (mr *MockEthStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEthStore)(nil).List), ctx, limit, offset)
 }
 
+// ListExpiring mocks base method
+func (m *MockEthStore) ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiring", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiring indicates an expected call of ListExpiring
+func (mr *MockEthStoreMockRecorder) ListExpiring(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiring", reflect.TypeOf((*MockEthStore)(nil).ListExpiring), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockEthStore) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockEthStoreMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockEthStore)(nil).Search), ctx, filter)
+}
+
 // Update mocks base method
 func (m *MockEthStore) Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +221,36 @@ func (mr *MockEthStoreMockRecorder) Update(ctx, addr, attr interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEthStore)(nil).Update), ctx, addr, attr)
 }
 
+// Enable mocks base method
+func (m *MockEthStore) Enable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, addr)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockEthStoreMockRecorder) Enable(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEthStore)(nil).Enable), ctx, addr)
+}
+
+// Disable mocks base method
+func (m *MockEthStore) Disable(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, addr)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockEthStoreMockRecorder) Disable(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockEthStore)(nil).Disable), ctx, addr)
+}
+
 // Delete mocks base method
 func (m *MockEthStore) Delete(ctx context.Context, addr common.Address) error {
 	m.ctrl.T.Helper()
@@ -159,6 +295,21 @@ func (mr *MockEthStoreMockRecorder) ListDeleted(ctx, limit, offset interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockEthStore)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// SearchPurgeable mocks base method
+func (m *MockEthStore) SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPurgeable indicates an expected call of SearchPurgeable
+func (mr *MockEthStoreMockRecorder) SearchPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPurgeable", reflect.TypeOf((*MockEthStore)(nil).SearchPurgeable), ctx, before, recoveryPeriod)
+}
+
 // Restore mocks base method
 func (m *MockEthStore) Restore(ctx context.Context, addr common.Address) error {
 	m.ctrl.T.Helper()
@@ -233,7 +384,7 @@ func (mr *MockEthStoreMockRecorder) SignTypedData(ctx, addr, typedData interface
 }
 
 // SignTransaction mocks base method
-func (m *MockEthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types0.Transaction) ([]byte, error) {
+func (m *MockEthStore) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SignTransaction", ctx, addr, chainID, tx)
 	ret0, _ := ret[0].([]byte)
@@ -248,7 +399,7 @@ func (mr *MockEthStoreMockRecorder) SignTransaction(ctx, addr, chainID, tx inter
 }
 
 // SignEEA mocks base method
-func (m *MockEthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types0.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+func (m *MockEthStore) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SignEEA", ctx, addr, chainID, tx, args)
 	ret0, _ := ret[0].([]byte)
@@ -263,7 +414,7 @@ func (mr *MockEthStoreMockRecorder) SignEEA(ctx, addr, chainID, tx, args interfa
 }
 
 // SignPrivate mocks base method
-func (m *MockEthStore) SignPrivate(ctx context.Context, addr common.Address, tx *types.Transaction) ([]byte, error) {
+func (m *MockEthStore) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SignPrivate", ctx, addr, tx)
 	ret0, _ := ret[0].([]byte)
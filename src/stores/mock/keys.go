@@ -8,6 +8,7 @@ import (
 	context "context"
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 	entities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	time "time"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -95,6 +96,66 @@ func (mr *MockKeyStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeyStore)(nil).List), ctx, limit, offset)
 }
 
+// ListExpiring mocks base method
+func (m *MockKeyStore) ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiring", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiring indicates an expected call of ListExpiring
+func (mr *MockKeyStoreMockRecorder) ListExpiring(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiring", reflect.TypeOf((*MockKeyStore)(nil).ListExpiring), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockKeyStore) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockKeyStoreMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockKeyStore)(nil).Search), ctx, filter)
+}
+
+// Rotate mocks base method
+func (m *MockKeyStore) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, alg)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockKeyStoreMockRecorder) Rotate(ctx, id, alg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockKeyStore)(nil).Rotate), ctx, id, alg)
+}
+
+// ListVersions mocks base method
+func (m *MockKeyStore) ListVersions(ctx context.Context, id string) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockKeyStoreMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockKeyStore)(nil).ListVersions), ctx, id)
+}
+
 // Update mocks base method
 func (m *MockKeyStore) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +171,36 @@ func (mr *MockKeyStoreMockRecorder) Update(ctx, id, attr interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeyStore)(nil).Update), ctx, id, attr)
 }
 
+// Enable mocks base method
+func (m *MockKeyStore) Enable(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockKeyStoreMockRecorder) Enable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockKeyStore)(nil).Enable), ctx, id)
+}
+
+// Disable mocks base method
+func (m *MockKeyStore) Disable(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockKeyStoreMockRecorder) Disable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockKeyStore)(nil).Disable), ctx, id)
+}
+
 // Delete mocks base method
 func (m *MockKeyStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -154,6 +245,36 @@ func (mr *MockKeyStoreMockRecorder) ListDeleted(ctx, limit, offset interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockKeyStore)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// SearchPurgeable mocks base method
+func (m *MockKeyStore) SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPurgeable indicates an expected call of SearchPurgeable
+func (mr *MockKeyStoreMockRecorder) SearchPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPurgeable", reflect.TypeOf((*MockKeyStore)(nil).SearchPurgeable), ctx, before, recoveryPeriod)
+}
+
+// SearchDueForRotation mocks base method
+func (m *MockKeyStore) SearchDueForRotation(ctx context.Context, now time.Time) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDueForRotation", ctx, now)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchDueForRotation indicates an expected call of SearchDueForRotation
+func (mr *MockKeyStoreMockRecorder) SearchDueForRotation(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDueForRotation", reflect.TypeOf((*MockKeyStore)(nil).SearchDueForRotation), ctx, now)
+}
+
 // Restore mocks base method
 func (m *MockKeyStore) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -198,31 +319,31 @@ func (mr *MockKeyStoreMockRecorder) Sign(ctx, id, data, algo interface{}) *gomoc
 }
 
 // Encrypt mocks base method
-func (m *MockKeyStore) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (m *MockKeyStore) Encrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data)
+	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data, algo)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Encrypt indicates an expected call of Encrypt
-func (mr *MockKeyStoreMockRecorder) Encrypt(ctx, id, data interface{}) *gomock.Call {
+func (mr *MockKeyStoreMockRecorder) Encrypt(ctx, id, data, algo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKeyStore)(nil).Encrypt), ctx, id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKeyStore)(nil).Encrypt), ctx, id, data, algo)
 }
 
 // Decrypt mocks base method
-func (m *MockKeyStore) Decrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (m *MockKeyStore) Decrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data)
+	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data, algo)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Decrypt indicates an expected call of Decrypt
-func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.Call {
+func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data, algo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data, algo)
 }
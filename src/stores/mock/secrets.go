@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	entities "github.com/longfan78/quorum-key-manager/src/stores/entities"
+	time "time"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -79,6 +80,36 @@ func (mr *MockSecretStoreMockRecorder) List(ctx, limit, offset interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSecretStore)(nil).List), ctx, limit, offset)
 }
 
+// ListExpiring mocks base method
+func (m *MockSecretStore) ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiring", ctx, before, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiring indicates an expected call of ListExpiring
+func (mr *MockSecretStoreMockRecorder) ListExpiring(ctx, before, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiring", reflect.TypeOf((*MockSecretStore)(nil).ListExpiring), ctx, before, limit, offset)
+}
+
+// Search mocks base method
+func (m *MockSecretStore) Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSecretStoreMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSecretStore)(nil).Search), ctx, filter)
+}
+
 // Delete mocks base method
 func (m *MockSecretStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -123,6 +154,21 @@ func (mr *MockSecretStoreMockRecorder) ListDeleted(ctx, limit, offset interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockSecretStore)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// SearchPurgeable mocks base method
+func (m *MockSecretStore) SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPurgeable", ctx, before, recoveryPeriod)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPurgeable indicates an expected call of SearchPurgeable
+func (mr *MockSecretStoreMockRecorder) SearchPurgeable(ctx, before, recoveryPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPurgeable", reflect.TypeOf((*MockSecretStore)(nil).SearchPurgeable), ctx, before, recoveryPeriod)
+}
+
 // Restore mocks base method
 func (m *MockSecretStore) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -150,3 +196,41 @@ func (mr *MockSecretStoreMockRecorder) Destroy(ctx, id interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockSecretStore)(nil).Destroy), ctx, id)
 }
+
+// MockSecretMetadataLister is a mock of SecretMetadataLister interface
+type MockSecretMetadataLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretMetadataListerMockRecorder
+}
+
+// MockSecretMetadataListerMockRecorder is the mock recorder for MockSecretMetadataLister
+type MockSecretMetadataListerMockRecorder struct {
+	mock *MockSecretMetadataLister
+}
+
+// NewMockSecretMetadataLister creates a new mock instance
+func NewMockSecretMetadataLister(ctrl *gomock.Controller) *MockSecretMetadataLister {
+	mock := &MockSecretMetadataLister{ctrl: ctrl}
+	mock.recorder = &MockSecretMetadataListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSecretMetadataLister) EXPECT() *MockSecretMetadataListerMockRecorder {
+	return m.recorder
+}
+
+// ListMetadata mocks base method
+func (m *MockSecretMetadataLister) ListMetadata(ctx context.Context) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMetadata", ctx)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMetadata indicates an expected call of ListMetadata
+func (mr *MockSecretMetadataListerMockRecorder) ListMetadata(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadata", reflect.TypeOf((*MockSecretMetadataLister)(nil).ListMetadata), ctx)
+}
@@ -6,8 +6,9 @@ package mock
 
 import (
 	context "context"
-	entities "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	stores "github.com/longfan78/quorum-key-manager/src/stores"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	entities "github.com/longfan78/quorum-key-manager/src/stores/entities"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -37,21 +38,21 @@ func (m *MockStores) EXPECT() *MockStoresMockRecorder {
 }
 
 // CreateEthereum mocks base method
-func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, secretStore, allowedTenants, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateEthereum indicates an expected call of CreateEthereum
-func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, secretStore, allowedTenants, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, secretStore, allowedTenants, userInfo)
 }
 
 // CreateKey mocks base method
-func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateKey", arg0, name, vault, secretStore, allowedTenants, userInfo)
 	ret0, _ := ret[0].(error)
@@ -65,7 +66,7 @@ func (mr *MockStoresMockRecorder) CreateKey(arg0, name, vault, secretStore, allo
 }
 
 // CreateSecret mocks base method
-func (m *MockStores) CreateSecret(arg0 context.Context, name, vault string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateSecret(arg0 context.Context, name, vault string, allowedTenants []string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecret", arg0, name, vault, allowedTenants, userInfo)
 	ret0, _ := ret[0].(error)
@@ -79,7 +80,7 @@ func (mr *MockStoresMockRecorder) CreateSecret(arg0, name, vault, allowedTenants
 }
 
 // ImportEthereum mocks base method
-func (m *MockStores) ImportEthereum(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+func (m *MockStores) ImportEthereum(ctx context.Context, name string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ImportEthereum", ctx, name, userInfo)
 	ret0, _ := ret[0].(error)
@@ -93,7 +94,7 @@ func (mr *MockStoresMockRecorder) ImportEthereum(ctx, name, userInfo interface{}
 }
 
 // ImportKeys mocks base method
-func (m *MockStores) ImportKeys(ctx context.Context, storeName string, userInfo *entities.UserInfo) error {
+func (m *MockStores) ImportKeys(ctx context.Context, storeName string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ImportKeys", ctx, storeName, userInfo)
 	ret0, _ := ret[0].(error)
@@ -107,7 +108,7 @@ func (mr *MockStoresMockRecorder) ImportKeys(ctx, storeName, userInfo interface{
 }
 
 // ImportSecrets mocks base method
-func (m *MockStores) ImportSecrets(ctx context.Context, storeName string, userInfo *entities.UserInfo) error {
+func (m *MockStores) ImportSecrets(ctx context.Context, storeName string, userInfo *auth.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ImportSecrets", ctx, storeName, userInfo)
 	ret0, _ := ret[0].(error)
@@ -120,8 +121,38 @@ func (mr *MockStoresMockRecorder) ImportSecrets(ctx, storeName, userInfo interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecrets", reflect.TypeOf((*MockStores)(nil).ImportSecrets), ctx, storeName, userInfo)
 }
 
+// Sync mocks base method
+func (m *MockStores) Sync(ctx context.Context, storeName string, dryRun bool, userInfo *auth.UserInfo) (*entities.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, storeName, dryRun, userInfo)
+	ret0, _ := ret[0].(*entities.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync
+func (mr *MockStoresMockRecorder) Sync(ctx, storeName, dryRun, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockStores)(nil).Sync), ctx, storeName, dryRun, userInfo)
+}
+
+// GetSyncReport mocks base method
+func (m *MockStores) GetSyncReport(ctx context.Context, storeName string, userInfo *auth.UserInfo) (*entities.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncReport", ctx, storeName, userInfo)
+	ret0, _ := ret[0].(*entities.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncReport indicates an expected call of GetSyncReport
+func (mr *MockStoresMockRecorder) GetSyncReport(ctx, storeName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncReport", reflect.TypeOf((*MockStores)(nil).GetSyncReport), ctx, storeName, userInfo)
+}
+
 // Secret mocks base method
-func (m *MockStores) Secret(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.SecretStore, error) {
+func (m *MockStores) Secret(ctx context.Context, storeName string, userInfo *auth.UserInfo) (stores.SecretStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Secret", ctx, storeName, userInfo)
 	ret0, _ := ret[0].(stores.SecretStore)
@@ -136,7 +167,7 @@ func (mr *MockStoresMockRecorder) Secret(ctx, storeName, userInfo interface{}) *
 }
 
 // Key mocks base method
-func (m *MockStores) Key(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.KeyStore, error) {
+func (m *MockStores) Key(ctx context.Context, storeName string, userInfo *auth.UserInfo) (stores.KeyStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Key", ctx, storeName, userInfo)
 	ret0, _ := ret[0].(stores.KeyStore)
@@ -151,7 +182,7 @@ func (mr *MockStoresMockRecorder) Key(ctx, storeName, userInfo interface{}) *gom
 }
 
 // Ethereum mocks base method
-func (m *MockStores) Ethereum(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.EthStore, error) {
+func (m *MockStores) Ethereum(ctx context.Context, storeName string, userInfo *auth.UserInfo) (stores.EthStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Ethereum", ctx, storeName, userInfo)
 	ret0, _ := ret[0].(stores.EthStore)
@@ -166,7 +197,7 @@ func (mr *MockStoresMockRecorder) Ethereum(ctx, storeName, userInfo interface{})
 }
 
 // EthereumByAddr mocks base method
-func (m *MockStores) EthereumByAddr(ctx context.Context, addr common.Address, userInfo *entities.UserInfo) (stores.EthStore, error) {
+func (m *MockStores) EthereumByAddr(ctx context.Context, addr common.Address, userInfo *auth.UserInfo) (stores.EthStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "EthereumByAddr", ctx, addr, userInfo)
 	ret0, _ := ret[0].(stores.EthStore)
@@ -181,7 +212,7 @@ func (mr *MockStoresMockRecorder) EthereumByAddr(ctx, addr, userInfo interface{}
 }
 
 // List mocks base method
-func (m *MockStores) List(ctx context.Context, storeType string, userInfo *entities.UserInfo) ([]string, error) {
+func (m *MockStores) List(ctx context.Context, storeType string, userInfo *auth.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, storeType, userInfo)
 	ret0, _ := ret[0].([]string)
@@ -196,7 +227,7 @@ func (mr *MockStoresMockRecorder) List(ctx, storeType, userInfo interface{}) *go
 }
 
 // ListAllAccounts mocks base method
-func (m *MockStores) ListAllAccounts(ctx context.Context, userInfo *entities.UserInfo) ([]common.Address, error) {
+func (m *MockStores) ListAllAccounts(ctx context.Context, userInfo *auth.UserInfo) ([]common.Address, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListAllAccounts", ctx, userInfo)
 	ret0, _ := ret[0].([]common.Address)
@@ -209,3 +240,76 @@ func (mr *MockStoresMockRecorder) ListAllAccounts(ctx, userInfo interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllAccounts", reflect.TypeOf((*MockStores)(nil).ListAllAccounts), ctx, userInfo)
 }
+
+// Create mocks base method
+func (m *MockStores) Create(ctx context.Context, store *entities.StoreDefinition, userInfo *auth.UserInfo) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, store, userInfo)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockStoresMockRecorder) Create(ctx, store, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStores)(nil).Create), ctx, store, userInfo)
+}
+
+// Inspect mocks base method
+func (m *MockStores) Inspect(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect", ctx, name, userInfo)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inspect indicates an expected call of Inspect
+func (mr *MockStoresMockRecorder) Inspect(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockStores)(nil).Inspect), ctx, name, userInfo)
+}
+
+// Update mocks base method
+func (m *MockStores) Update(ctx context.Context, store *entities.StoreDefinition, userInfo *auth.UserInfo) (*entities.StoreDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, store, userInfo)
+	ret0, _ := ret[0].(*entities.StoreDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockStoresMockRecorder) Update(ctx, store, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStores)(nil).Update), ctx, store, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockStores) Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockStoresMockRecorder) Delete(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStores)(nil).Delete), ctx, name, userInfo)
+}
+
+// Load mocks base method
+func (m *MockStores) Load(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load
+func (mr *MockStoresMockRecorder) Load(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockStores)(nil).Load), ctx)
+}
@@ -2,6 +2,7 @@ package stores
 
 import (
 	"context"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
@@ -18,6 +19,12 @@ type SecretStore interface {
 	// List secrets
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// ListExpiring secrets expiring before a date, including expired secrets
+	ListExpiring(ctx context.Context, before time.Time, limit, offset uint64) ([]string, error)
+
+	// Search secrets matching a filter, through their latest version
+	Search(ctx context.Context, filter *entities.ListFilter) ([]*entities.Secret, error)
+
 	// Delete secret not permanently, it can be restored
 	Delete(ctx context.Context, id string) error
 
@@ -27,9 +34,20 @@ type SecretStore interface {
 	// ListDeleted secrets
 	ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// SearchPurgeable deleted secrets expired before their deletion whose recovery period, or recoveryPeriod if unset, is over before a date
+	SearchPurgeable(ctx context.Context, before time.Time, recoveryPeriod time.Duration) ([]*entities.Secret, error)
+
 	// Restore a previously deleted secret
 	Restore(ctx context.Context, id string) error
 
 	// Destroy secret permanently
 	Destroy(ctx context.Context, id string) error
 }
+
+// SecretMetadataLister is implemented by the secret stores able to list their secrets without reading their values, so
+// that a synchronization only reads the values of the new secrets and versions
+type SecretMetadataLister interface {
+	// ListMetadata lists the latest version of the secrets, without their value. Tags are nil for the stores keeping
+	// them with each version, the tags of a version then never changing
+	ListMetadata(ctx context.Context) ([]*entities.Secret, error)
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/longfan78/quorum-key-manager/pkg/common"
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/akv"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
@@ -114,6 +116,37 @@ func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return parseKeyBundleRes(&res), nil
 }
 
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	if alg.Type != entities2.Ecdsa || alg.EllipticCurve != entities2.Secp256k1 {
+		errMessage := "not supported elliptic curve and signing algorithm in AKV for rotation"
+		logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	current, err := s.client.GetKey(ctx, id, "")
+	if err != nil {
+		errMessage := "failed to get AKV key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Creating a key with an existing name creates a new version of the key
+	res, err := s.client.CreateKey(ctx, id, keyvault.EC, keyvault.P256K, &keyvault.KeyAttributes{}, nil, common.Tomapstr(current.Tags))
+	if err != nil {
+		errMessage := "failed to rotate AKV key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return parseKeyBundleRes(&res), nil
+}
+
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	res, err := s.client.GetKeys(ctx, 0)
 	if err != nil {
@@ -167,6 +200,30 @@ func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Key, error
 	return parseKeyDeleteBundleRes(&res), nil
 }
 
+func (s *Store) Enable(_ context.Context, _ string) (*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Disable(_ context.Context, _ string) (*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchDueForRotation(_ context.Context, _ time.Time) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
 func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
 	res, err := s.client.GetDeletedKeys(ctx, 0)
 	if err != nil {
@@ -242,10 +299,34 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return err
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Encrypt encrypts data with ECIES using the public key of the key, as Azure Key Vault has no encryption for secp256k1 keys
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	if alg.Type != entities2.Ecdsa || alg.EllipticCurve != entities2.Secp256k1 {
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for AKV encryption"
+		logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedData, err := ecdsa.EncryptSecp256k1(key.PublicKey, data)
+	if err != nil {
+		errMessage := "failed to encrypt using AKV key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return encryptedData, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Decrypt is not supported as the private key never leaves Azure Key Vault, which cannot perform ECDH on secp256k1 keys
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decryption is not supported by AKV keys")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
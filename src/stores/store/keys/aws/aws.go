@@ -3,10 +3,12 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/aws"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
@@ -144,6 +146,18 @@ func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	return ids, nil
 }
 
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm) (*entities.Key, error) {
+	err := errors.NotSupportedError("rotate key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Key, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
 	logger := s.logger.With("id", id)
 	key, err := s.Get(ctx, id)
@@ -201,6 +215,38 @@ func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, err
 }
 
+func (s *Store) Enable(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list expiring keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchDueForRotation(_ context.Context, _ time.Time) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Key, error) {
+	err := errors.NotSupportedError("search keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
 	err := errors.NotSupportedError("list deleted keys is not supported")
 	s.logger.Warn(err.Error())
@@ -264,12 +310,36 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return err
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Encrypt encrypts data with ECIES using the public key of the key, as AWS KMS has no encryption for secp256k1 keys
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	if alg.Type != entities2.Ecdsa || alg.EllipticCurve != entities2.Secp256k1 {
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for AWS encryption"
+		logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedData, err := ecdsa.EncryptSecp256k1(key.PublicKey, data)
+	if err != nil {
+		errMessage := "failed to encrypt using AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return encryptedData, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Decrypt is not supported as the private key never leaves AWS KMS, which cannot perform ECDH on secp256k1 keys
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decryption is not supported by AWS keys")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
 func (s *Store) getAWSKeyID(ctx context.Context, id string) (string, error) {
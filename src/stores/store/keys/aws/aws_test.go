@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
 	"fmt"
 	"testing"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/longfan78/quorum-key-manager/src/entities"
 
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/stores"
 
@@ -19,8 +22,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -310,18 +315,50 @@ func (s *awsKeyStoreTestSuite) TestDestroy() {
 func (s *awsKeyStoreTestSuite) TestEncrypt() {
 	ctx := context.Background()
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Encrypt(ctx, "my-id", []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should encrypt with the public key data decrypted by the private key", func() {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(s.T(), err)
+		asn1PubKey, err := asn1.Marshal(publicKeyInfo{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}},
+			PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(&privKey.PublicKey), BitLength: 8 * len(crypto.FromECDSAPub(&privKey.PublicKey))},
+		})
+		require.NoError(s.T(), err)
+		pubKey := fakeGetPubKey(keyID)
+		pubKey.PublicKey = asn1PubKey
+
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(pubKey, nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		encryptedData, err := s.keyStore.Encrypt(ctx, id, []byte("my data"), &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		require.NoError(s.T(), err)
+
+		data, err := ecdsa.DecryptSecp256k1(crypto.FromECDSA(privKey), encryptedData)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []byte("my data"), data)
+	})
+
+	s.Run("should return NotSupportedError if the algorithm does not support encryption", func() {
+		_, err := s.keyStore.Encrypt(ctx, "my-id", []byte(""), &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Babyjubjub,
+		})
+		assert.True(s.T(), errors.IsNotSupportedError(err))
 	})
 }
 
 func (s *awsKeyStoreTestSuite) TestDecrypt() {
 	ctx := context.Background()
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Decrypt(ctx, "my-id", []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should return NotSupportedError", func() {
+		_, err := s.keyStore.Decrypt(ctx, "my-id", []byte(""), &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		assert.True(s.T(), errors.IsNotSupportedError(err))
 	})
 }
 
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"path"
+	"time"
 
 	entities2 "github.com/longfan78/quorum-key-manager/src/entities"
 
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/hashicorp"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
@@ -40,14 +42,14 @@ func New(client hashicorp.PluginClient, logger log.Logger) *Store {
 	}
 }
 
-func (s *Store) Create(_ context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
 	if !s.isSupportedAlgo(alg) {
 		errMessage := "invalid or not supported elliptic curve and signing algorithm for Hashicorp key creation"
 		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
 		return nil, errors.NotSupportedError(errMessage)
 	}
 
-	res, err := s.client.CreateKey(map[string]interface{}{
+	res, err := s.client.CreateKey(ctx, map[string]interface{}{
 		idLabel:        id,
 		curveLabel:     alg.EllipticCurve,
 		algorithmLabel: alg.Type,
@@ -61,14 +63,14 @@ func (s *Store) Create(_ context.Context, id string, alg *entities2.Algorithm, a
 	return parseAPISecretToKey(res)
 }
 
-func (s *Store) Import(_ context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
 	if !s.isSupportedAlgo(alg) {
 		errMessage := "invalid or not supported elliptic curve and signing algorithm for Hashicorp key import"
 		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
 		return nil, errors.NotSupportedError(errMessage)
 	}
 
-	res, err := s.client.ImportKey(map[string]interface{}{
+	res, err := s.client.ImportKey(ctx, map[string]interface{}{
 		idLabel:         id,
 		curveLabel:      alg.EllipticCurve,
 		algorithmLabel:  alg.Type,
@@ -84,10 +86,10 @@ func (s *Store) Import(_ context.Context, id string, privKey []byte, alg *entiti
 	return parseAPISecretToKey(res)
 }
 
-func (s *Store) Get(_ context.Context, id string) (*entities.Key, error) {
+func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	logger := s.logger.With("id", id)
 
-	res, err := s.client.GetKey(id)
+	res, err := s.client.GetKey(ctx, id)
 	if err != nil {
 		errMessage := "failed to get Hashicorp key"
 		logger.WithError(err).Error(errMessage)
@@ -103,8 +105,8 @@ func (s *Store) Get(_ context.Context, id string) (*entities.Key, error) {
 	return parseAPISecretToKey(res)
 }
 
-func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
-	res, err := s.client.ListKeys()
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	res, err := s.client.ListKeys(ctx)
 	if err != nil {
 		errMessage := "failed to list Hashicorp keys"
 		s.logger.WithError(err).Error(errMessage)
@@ -128,8 +130,20 @@ func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
 	return ids, nil
 }
 
-func (s *Store) Update(_ context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
-	res, err := s.client.UpdateKey(id, map[string]interface{}{
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm) (*entities.Key, error) {
+	err := errors.NotSupportedError("rotate key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Key, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
+	res, err := s.client.UpdateKey(ctx, id, map[string]interface{}{
 		tagsLabel: attr.Tags,
 	})
 	if err != nil {
@@ -153,6 +167,38 @@ func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, err
 }
 
+func (s *Store) Enable(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list expiring keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchDueForRotation(_ context.Context, _ time.Time) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Key, error) {
+	err := errors.NotSupportedError("search keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
 	err := errors.NotSupportedError("list deleted keys is not supported")
 	s.logger.Warn(err.Error())
@@ -165,8 +211,8 @@ func (s *Store) Restore(_ context.Context, _ string) error {
 	return err
 }
 
-func (s *Store) Destroy(_ context.Context, id string) error {
-	err := s.client.DestroyKey(path.Join(id))
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	err := s.client.DestroyKey(ctx, path.Join(id))
 	if err != nil {
 		errMessage := "failed to permanently delete Hashicorp key"
 		s.logger.WithError(err).Error(errMessage)
@@ -176,7 +222,7 @@ func (s *Store) Destroy(_ context.Context, id string) error {
 	return nil
 }
 
-func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
+func (s *Store) Sign(ctx context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
 	if !s.isSupportedAlgo(alg) {
 		errMessage := "invalid or not supported elliptic curve and signing algorithm for Hashicorp signing"
 		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
@@ -185,7 +231,7 @@ func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.A
 
 	logger := s.logger.With("id", id)
 
-	res, err := s.client.Sign(id, data)
+	res, err := s.client.Sign(ctx, id, data)
 	if err != nil {
 		errMessage := "failed to sign using Hashicorp key"
 		logger.WithError(err).Error(errMessage)
@@ -202,12 +248,36 @@ func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.A
 	return signature, nil
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Encrypt encrypts data with ECIES using the public key of the key, as the Hashicorp Vault plugin has no encryption for secp256k1 keys
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	if alg.Type != entities2.Ecdsa || alg.EllipticCurve != entities2.Secp256k1 {
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for Hashicorp encryption"
+		logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedData, err := ecdsa.EncryptSecp256k1(key.PublicKey, data)
+	if err != nil {
+		errMessage := "failed to encrypt using Hashicorp key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return encryptedData, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Decrypt is not supported as the private key never leaves the Hashicorp Vault plugin, which cannot perform ECDH on secp256k1 keys
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decryption is not supported by Hashicorp keys")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
 func (s *Store) isSupportedAlgo(alg *entities2.Algorithm) bool {
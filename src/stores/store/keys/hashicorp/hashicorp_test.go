@@ -75,7 +75,7 @@ func (s *hashicorpKeyStoreTestSuite) TestCreate() {
 	}
 
 	s.Run("should create a new key successfully", func() {
-		s.mockVault.EXPECT().CreateKey(expectedData).Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), expectedData).Return(hashicorpSecret, nil)
 
 		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
 
@@ -91,7 +91,7 @@ func (s *hashicorpKeyStoreTestSuite) TestCreate() {
 	})
 
 	s.Run("should fail with NotSupported error", func() {
-		s.mockVault.EXPECT().CreateKey(expectedData).Return(nil, expectedErr)
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), expectedData).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
 			Type:          entities.Eddsa,
@@ -103,7 +103,7 @@ func (s *hashicorpKeyStoreTestSuite) TestCreate() {
 	})
 
 	s.Run("should fail with same error if CreateKey fails", func() {
-		s.mockVault.EXPECT().CreateKey(expectedData).Return(nil, expectedErr)
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), expectedData).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
 
@@ -141,7 +141,7 @@ func (s *hashicorpKeyStoreTestSuite) TestImport() {
 	}
 
 	s.Run("should import a new key successfully", func() {
-		s.mockVault.EXPECT().ImportKey(expectedData).Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().ImportKey(gomock.Any(), expectedData).Return(hashicorpSecret, nil)
 
 		key, err := s.keyStore.Import(ctx, id, privKeyB, algorithm, attributes)
 
@@ -157,7 +157,7 @@ func (s *hashicorpKeyStoreTestSuite) TestImport() {
 	})
 
 	s.Run("should fail with NotSupported error", func() {
-		s.mockVault.EXPECT().CreateKey(expectedData).Return(nil, expectedErr)
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), expectedData).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Import(ctx, id, privKeyB, &entities.Algorithm{
 			Type:          entities.Eddsa,
@@ -169,7 +169,7 @@ func (s *hashicorpKeyStoreTestSuite) TestImport() {
 	})
 
 	s.Run("should fail with same error if ImportKey fails", func() {
-		s.mockVault.EXPECT().ImportKey(expectedData).Return(nil, expectedErr)
+		s.mockVault.EXPECT().ImportKey(gomock.Any(), expectedData).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Import(ctx, id, privKeyB, algorithm, attributes)
 
@@ -197,7 +197,7 @@ func (s *hashicorpKeyStoreTestSuite) TestGet() {
 	}
 
 	s.Run("should get a key successfully without version", func() {
-		s.mockVault.EXPECT().GetKey(id).Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().GetKey(gomock.Any(), id).Return(hashicorpSecret, nil)
 
 		key, err := s.keyStore.Get(ctx, id)
 
@@ -213,7 +213,7 @@ func (s *hashicorpKeyStoreTestSuite) TestGet() {
 	})
 
 	s.Run("should fail with same error if GetKey fails", func() {
-		s.mockVault.EXPECT().GetKey(id).Return(nil, expectedErr)
+		s.mockVault.EXPECT().GetKey(gomock.Any(), id).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Get(ctx, id)
 
@@ -233,7 +233,7 @@ func (s *hashicorpKeyStoreTestSuite) TestList() {
 			},
 		}
 
-		s.mockVault.EXPECT().ListKeys().Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().ListKeys(gomock.Any()).Return(hashicorpSecret, nil)
 
 		ids, err := s.keyStore.List(ctx, 0, 0)
 
@@ -242,7 +242,7 @@ func (s *hashicorpKeyStoreTestSuite) TestList() {
 	})
 
 	s.Run("should fail with same error if List fails", func() {
-		s.mockVault.EXPECT().ListKeys().Return(nil, expectedErr)
+		s.mockVault.EXPECT().ListKeys(gomock.Any()).Return(nil, expectedErr)
 
 		key, err := s.keyStore.List(ctx, 0, 0)
 
@@ -262,7 +262,7 @@ func (s *hashicorpKeyStoreTestSuite) TestSign() {
 	}
 
 	s.Run("should sign a payload successfully", func() {
-		s.mockVault.EXPECT().Sign(id, data).Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().Sign(gomock.Any(), id, data).Return(hashicorpSecret, nil)
 
 		signature, err := s.keyStore.Sign(ctx, id, data, &entities.Algorithm{
 			Type:          entities.Ecdsa,
@@ -284,7 +284,7 @@ func (s *hashicorpKeyStoreTestSuite) TestSign() {
 	})
 
 	s.Run("should fail with same error if Sign fails", func() {
-		s.mockVault.EXPECT().Sign(id, data).Return(nil, expectedErr)
+		s.mockVault.EXPECT().Sign(gomock.Any(), id, data).Return(nil, expectedErr)
 
 		signature, err := s.keyStore.Sign(ctx, id, data, &entities.Algorithm{
 			Type:          entities.Ecdsa,
@@ -3,6 +3,7 @@ package local
 import (
 	"context"
 	"encoding/base64"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/longfan78/quorum-key-manager/pkg/crypto/eddsa"
@@ -52,6 +53,30 @@ func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, errors.ErrNotSupported
 }
 
+func (s *Store) Enable(_ context.Context, _ string) (*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Disable(_ context.Context, _ string) (*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchDueForRotation(_ context.Context, _ time.Time) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
 func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
 	var ids []string
 	items, err := s.db.GetAllDeleted(ctx)
@@ -77,35 +102,10 @@ func (s *Store) Import(ctx context.Context, id string, importedPrivKey []byte, a
 func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
 	logger := s.logger.With("id", id).With("signing_algorithm", alg.Type).With("curve", alg.EllipticCurve)
 
-	var privKey []byte
-	var pubKey []byte
-	var err error
-	switch {
-	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Babyjubjub:
-		privKey, pubKey, err = eddsa.CreateBabyjubjub(importedPrivKey)
-		if err != nil {
-			errMessage := "failed to generate EDDSA/Babyjujub key pair"
-			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
-		}
-	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
-		privKey, pubKey, err = ecdsa.CreateSecp256k1(importedPrivKey)
-		if err != nil {
-			errMessage := "failed to generate Secp256k1/ECDSA key pair"
-			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
-		}
-	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Curve25519:
-		privKey, pubKey, err = eddsa.CreateED25519(importedPrivKey)
-		if err != nil {
-			errMessage := "failed to generate EDDSA/Curve25519 key pair"
-			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
-		}
-	default:
-		errMessage := "invalid signing algorithm/elliptic curve combination"
-		logger.Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage)
+	privKey, pubKey, err := generateKeyPair(importedPrivKey, alg)
+	if err != nil {
+		logger.WithError(err).Error(err.Error())
+		return nil, err
 	}
 
 	secret, err := s.secretStore.Set(ctx, id, base64.StdEncoding.EncodeToString(privKey), attr)
@@ -121,20 +121,39 @@ func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, a
 		return nil, err
 	}
 
-	return &entities.Key{
-		ID:        id,
-		PublicKey: pubKey,
-		Algo: &entities2.Algorithm{
-			Type:          alg.Type,
-			EllipticCurve: alg.EllipticCurve,
-		},
-		Metadata: &entities.Metadata{
-			Disabled:  false,
-			CreatedAt: secret.Metadata.CreatedAt,
-			UpdatedAt: secret.Metadata.UpdatedAt,
-		},
-		Tags: secret.Tags,
-	}, nil
+	return newKey(id, pubKey, alg, secret), nil
+}
+
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	logger := s.logger.With("id", id).With("signing_algorithm", alg.Type).With("curve", alg.EllipticCurve)
+
+	current, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, pubKey, err := generateKeyPair(nil, alg)
+	if err != nil {
+		logger.WithError(err).Error(err.Error())
+		return nil, err
+	}
+
+	// Setting an existing secret creates a new version, the previous versions remain available
+	secret, err := s.secretStore.Set(ctx, id, base64.StdEncoding.EncodeToString(privKey), &entities.Attributes{Tags: current.Tags})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Add(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(id, pubKey, alg, secret), nil
+}
+
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Key, error) {
+	return nil, errors.ErrNotSupported
 }
 
 func (s *Store) Update(_ context.Context, _ string, _ *entities.Attributes) (*entities.Key, error) {
@@ -202,18 +221,11 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
 	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
 
-	secret, err := s.secretStore.Get(ctx, id, "")
+	privkey, err := s.getPrivKey(ctx, logger, id)
 	if err != nil {
 		return nil, err
 	}
 
-	privkey, err := base64.StdEncoding.DecodeString(secret.Value)
-	if err != nil {
-		errMessage := "failed to decode private key secret"
-		logger.Error(errMessage)
-		return nil, errors.DependencyFailureError(errMessage)
-	}
-
 	var signature []byte
 	switch {
 	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Babyjubjub:
@@ -241,10 +253,158 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return errors.ErrNotSupported
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	if !isEncryptionSupported(algo) {
+		errMessage := "signing algorithm and curve combination not supported for encryption"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	privKey, err := s.getPrivKey(ctx, logger, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pubKey, err := ecdsa.CreateSecp256k1(privKey)
+	if err != nil {
+		errMessage := "failed to parse private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	encryptedData, err := ecdsa.EncryptSecp256k1(pubKey, data)
+	if err != nil {
+		errMessage := "failed to encrypt"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return encryptedData, nil
+}
+
+func (s *Store) Decrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	if !isEncryptionSupported(algo) {
+		errMessage := "signing algorithm and curve combination not supported for decryption"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	privKey, err := s.getPrivKey(ctx, logger, id)
+	if err != nil {
+		return nil, err
+	}
+
+	decryptedData, err := ecdsa.DecryptSecp256k1(privKey, data)
+	if err == nil {
+		return decryptedData, nil
+	}
+
+	// Data encrypted before the key was rotated is decrypted with the version it was encrypted for
+	decryptedData, err = s.decryptWithPreviousVersions(ctx, logger, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if decryptedData == nil {
+		errMessage := "failed to decrypt"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	return decryptedData, nil
+}
+
+// decryptWithPreviousVersions tries the previous versions of a key, latest first, returning nil if none decrypts the data
+func (s *Store) decryptWithPreviousVersions(ctx context.Context, logger log.Logger, id string, data []byte) ([]byte, error) {
+	versions, err := s.db.ListVersions(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The latest version was already tried
+	for i := len(versions) - 2; i >= 0; i-- {
+		privKey, err := s.getPrivKeyVersion(ctx, logger, id, versions[i])
+		if err != nil {
+			return nil, err
+		}
+
+		decryptedData, err := ecdsa.DecryptSecp256k1(privKey, data)
+		if err == nil {
+			logger.Debug("data decrypted with a previous key version", "version", versions[i])
+			return decryptedData, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) getPrivKey(ctx context.Context, logger log.Logger, id string) ([]byte, error) {
+	return s.getPrivKeyVersion(ctx, logger, id, "")
+}
+
+func (s *Store) getPrivKeyVersion(ctx context.Context, logger log.Logger, id, version string) ([]byte, error) {
+	secret, err := s.secretStore.Get(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := base64.StdEncoding.DecodeString(secret.Value)
+	if err != nil {
+		errMessage := "failed to decode private key secret"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	return privKey, nil
+}
+
+func generateKeyPair(importedPrivKey []byte, alg *entities2.Algorithm) (privKey, pubKey []byte, err error) {
+	switch {
+	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Babyjubjub:
+		privKey, pubKey, err = eddsa.CreateBabyjubjub(importedPrivKey)
+		if err != nil {
+			return nil, nil, errors.InvalidParameterError("failed to generate EDDSA/Babyjujub key pair")
+		}
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		privKey, pubKey, err = ecdsa.CreateSecp256k1(importedPrivKey)
+		if err != nil {
+			return nil, nil, errors.InvalidParameterError("failed to generate Secp256k1/ECDSA key pair")
+		}
+	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Curve25519:
+		privKey, pubKey, err = eddsa.CreateED25519(importedPrivKey)
+		if err != nil {
+			return nil, nil, errors.InvalidParameterError("failed to generate EDDSA/Curve25519 key pair")
+		}
+	default:
+		return nil, nil, errors.InvalidParameterError("invalid signing algorithm/elliptic curve combination")
+	}
+
+	return privKey, pubKey, nil
+}
+
+func newKey(id string, pubKey []byte, alg *entities2.Algorithm, secret *entities.Secret) *entities.Key {
+	return &entities.Key{
+		ID:        id,
+		PublicKey: pubKey,
+		Algo: &entities2.Algorithm{
+			Type:          alg.Type,
+			EllipticCurve: alg.EllipticCurve,
+		},
+		Metadata: &entities.Metadata{
+			Version:   secret.Metadata.Version,
+			Disabled:  false,
+			CreatedAt: secret.Metadata.CreatedAt,
+			UpdatedAt: secret.Metadata.UpdatedAt,
+		},
+		Tags: secret.Tags,
+	}
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// isEncryptionSupported indicates whether data can be encrypted with ECIES for the algorithm
+func isEncryptionSupported(alg *entities2.Algorithm) bool {
+	return alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1
 }
@@ -453,18 +453,72 @@ func (s *localKeyStoreTestSuite) TestDestroy() {
 
 func (s *localKeyStoreTestSuite) TestEncrypt() {
 	ctx := context.Background()
+	algo := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+	secret := testutils.FakeSecret()
+	secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Encrypt(ctx, id, []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should encrypt with an ECDSA/Secp256k1 key and decrypt successfully", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil).Times(2)
+
+		encryptedData, err := s.keyStore.Encrypt(ctx, id, []byte("my data"), algo)
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), []byte("my data"), encryptedData)
+
+		data, err := s.keyStore.Decrypt(ctx, id, encryptedData, algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []byte("my data"), data)
+	})
+
+	s.Run("should fail with InvalidParameterError if the algorithm does not support encryption", func() {
+		_, err := s.keyStore.Encrypt(ctx, id, []byte("my data"), &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Babyjubjub,
+		})
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with same error if Get Secret fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Encrypt(ctx, id, []byte("my data"), algo)
+		assert.Equal(s.T(), expectedErr, err)
 	})
 }
 
 func (s *localKeyStoreTestSuite) TestDecrypt() {
 	ctx := context.Background()
+	algo := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+
+	s.Run("should decrypt data encrypted with a previous version of a rotated key", func() {
+		previousSecret := testutils.FakeSecret()
+		previousSecret.Metadata.Version = "1"
+		previousSecret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+		privKey, err := crypto.GenerateKey()
+		require.NoError(s.T(), err)
+		latestSecret := testutils.FakeSecret()
+		latestSecret.Metadata.Version = "2"
+		latestSecret.Value = base64.StdEncoding.EncodeToString(crypto.FromECDSA(privKey))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(previousSecret, nil)
+		encryptedData, err := s.keyStore.Encrypt(ctx, id, []byte("my data"), algo)
+		require.NoError(s.T(), err)
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(latestSecret, nil)
+		s.mockSecretDB.EXPECT().ListVersions(ctx, id, false).Return([]string{"1", "2"}, nil)
+		s.mockSecretStore.EXPECT().Get(ctx, id, "1").Return(previousSecret, nil)
+
+		data, err := s.keyStore.Decrypt(ctx, id, encryptedData, algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []byte("my data"), data)
+	})
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Decrypt(ctx, id, []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should fail with InvalidParameterError if data was not encrypted for the key", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+		s.mockSecretDB.EXPECT().ListVersions(ctx, id, false).Return([]string{secret.Metadata.Version}, nil)
+
+		_, err := s.keyStore.Decrypt(ctx, id, []byte("my data"), algo)
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
 	})
 }
@@ -3,6 +3,7 @@ package akv
 import (
 	"context"
 	"path"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/akv"
@@ -91,6 +92,18 @@ func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Secret, er
 	return parseDeletedSecretBundle(&res), nil
 }
 
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Secret, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Secret, error) {
+	return nil, errors.ErrNotSupported
+}
+
 func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
 	items, err := s.client.ListDeletedSecrets(ctx, 0)
 	if err != nil {
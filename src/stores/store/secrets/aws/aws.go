@@ -3,7 +3,9 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/aws"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
@@ -21,6 +23,7 @@ type Store struct {
 }
 
 var _ stores.SecretStore = &Store{}
+var _ stores.SecretMetadataLister = &Store{}
 
 func New(client aws.SecretsManagerClient, logger log.Logger) *Store {
 	return &Store{
@@ -98,22 +101,30 @@ func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret,
 }
 
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	entries, err := s.listAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// return only a list of secret names (IDs)
 	var result []string
-	nextToken := ""
+	for _, entry := range entries {
+		result = append(result, *entry.Name)
+	}
 
-	// Loop until the entire list is constituted
-	for {
-		ret, retToken, err := s.listPaginated(ctx, 0, nextToken)
-		if err != nil {
-			return nil, err
-		}
+	return result, nil
+}
 
-		result = append(result, ret...)
-		if retToken == nil {
-			break
-		}
+// ListMetadata lists the current version and tags of the secrets, returned by the listing without their values
+func (s *Store) ListMetadata(ctx context.Context) ([]*entities.Secret, error) {
+	entries, err := s.listAll(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		nextToken = *retToken
+	var result []*entities.Secret
+	for _, entry := range entries {
+		result = append(result, parseSecretListEntry(entry))
 	}
 
 	return result, nil
@@ -136,6 +147,22 @@ func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Secret, error
 	return nil, err
 }
 
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list expiring secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Secret, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("search secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
 	err := errors.NotSupportedError("list deleted secret is not supported")
 	s.logger.Warn(err.Error())
@@ -164,7 +191,29 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *Store) listPaginated(ctx context.Context, maxResults int64, nextToken string) (resList []string, resNextToken *string, err error) {
+func (s *Store) listAll(ctx context.Context) ([]*secretsmanager.SecretListEntry, error) {
+	var result []*secretsmanager.SecretListEntry
+	nextToken := ""
+
+	// Loop until the entire list is constituted
+	for {
+		ret, retToken, err := s.listPaginated(ctx, 0, nextToken)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, ret...)
+		if retToken == nil {
+			break
+		}
+
+		nextToken = *retToken
+	}
+
+	return result, nil
+}
+
+func (s *Store) listPaginated(ctx context.Context, maxResults int64, nextToken string) (resList []*secretsmanager.SecretListEntry, resNextToken *string, err error) {
 	listOutput, err := s.client.ListSecrets(ctx, maxResults, nextToken)
 	if err != nil {
 		errMessage := "failed to list AWS secrets"
@@ -172,11 +221,5 @@ func (s *Store) listPaginated(ctx context.Context, maxResults int64, nextToken s
 		return nil, nil, errors.FromError(err).SetMessage(errMessage)
 	}
 
-	// return only a list of secret names (IDs)
-	var secretNamesList []string
-	for _, secret := range listOutput.SecretList {
-		secretNamesList = append(secretNamesList, *secret.Name)
-	}
-
-	return secretNamesList, listOutput.NextToken, nil
+	return listOutput.SecretList, listOutput.NextToken, nil
 }
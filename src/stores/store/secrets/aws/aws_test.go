@@ -14,6 +14,7 @@ import (
 	"github.com/longfan78/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -287,6 +288,44 @@ func (s *awsSecretStoreTestSuite) TestList() {
 	})
 }
 
+func (s *awsSecretStoreTestSuite) TestListMetadata() {
+	ctx := context.Background()
+	name, version, previousVersion := "my-secret", "version-2", "version-1"
+	tagKey, tagValue := "tag1", "tagValue1"
+	currentStage, previousStage := "AWSCURRENT", "AWSPREVIOUS"
+
+	s.Run("should list the current version and tags of the secrets successfully", func() {
+		listOutput := &secretsmanager.ListSecretsOutput{
+			SecretList: []*secretsmanager.SecretListEntry{{
+				Name: &name,
+				SecretVersionsToStages: map[string][]*string{
+					previousVersion: {&previousStage},
+					version:         {&currentStage},
+				},
+				Tags: []*secretsmanager.Tag{{Key: &tagKey, Value: &tagValue}},
+			}},
+		}
+
+		s.mockVault.EXPECT().ListSecrets(gomock.Any(), int64(0), "").Return(listOutput, nil)
+		secrets, err := s.secretStore.(stores.SecretMetadataLister).ListMetadata(ctx)
+
+		require.NoError(s.T(), err)
+		require.Len(s.T(), secrets, 1)
+		assert.Equal(s.T(), name, secrets[0].ID)
+		assert.Empty(s.T(), secrets[0].Value)
+		assert.Equal(s.T(), version, secrets[0].Metadata.Version)
+		assert.Equal(s.T(), map[string]string{tagKey: tagValue}, secrets[0].Tags)
+	})
+
+	s.Run("should fail if list fails", func() {
+		s.mockVault.EXPECT().ListSecrets(gomock.Any(), int64(0), "").Return(nil, expectedErr)
+		secrets, err := s.secretStore.(stores.SecretMetadataLister).ListMetadata(ctx)
+
+		assert.Nil(s.T(), secrets)
+		assert.True(s.T(), errors.IsAWSError(err))
+	})
+}
+
 func (s *awsSecretStoreTestSuite) TestListDeleted() {
 	s.Run("should fail with not implemented error", func() {
 		ctx := context.Background()
@@ -1,9 +1,14 @@
 package aws
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 )
 
+// Staging label of the current version of the AWS secrets
+const currentVersionStage = "AWSCURRENT"
+
 func formatAwsSecret(id, value string, tags map[string]string, metadata *entities.Metadata) *entities.Secret {
 	return &entities.Secret{
 		ID:       id,
@@ -12,3 +17,26 @@ func formatAwsSecret(id, value string, tags map[string]string, metadata *entitie
 		Metadata: metadata,
 	}
 }
+
+func parseSecretListEntry(entry *secretsmanager.SecretListEntry) *entities.Secret {
+	currentVersion := ""
+	for version, stages := range entry.SecretVersionsToStages {
+		for _, stage := range stages {
+			if aws.StringValue(stage) == currentVersionStage {
+				currentVersion = version
+			}
+		}
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range entry.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return formatAwsSecret(aws.StringValue(entry.Name), "", tags, &entities.Metadata{
+		Version:   currentVersion,
+		CreatedAt: aws.TimeValue(entry.CreatedDate),
+		UpdatedAt: aws.TimeValue(entry.LastChangedDate),
+		DeletedAt: aws.TimeValue(entry.DeletedDate),
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
 	"github.com/longfan78/quorum-key-manager/src/infra/hashicorp"
@@ -26,6 +27,7 @@ type Store struct {
 }
 
 var _ stores.SecretStore = &Store{}
+var _ stores.SecretMetadataLister = &Store{}
 
 func New(client hashicorp.Kvv2Client, db database.Secrets, logger log.Logger) *Store {
 	return &Store{
@@ -38,7 +40,7 @@ func New(client hashicorp.Kvv2Client, db database.Secrets, logger log.Logger) *S
 func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
 	logger := s.logger.With("id", id)
 
-	secretItem, err := s.client.SetSecret(id, map[string]interface{}{
+	secretItem, err := s.client.SetSecret(ctx, id, map[string]interface{}{
 		valueLabel: value,
 		tagsLabel:  attr.Tags,
 	})
@@ -51,7 +53,7 @@ func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attrib
 	return s.Get(ctx, id, string(secretItem.Data[versionLabel].(json.Number)))
 }
 
-func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, error) {
+func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
 	logger := s.logger.With("id", id, "version", version)
 
 	var callData map[string][]string
@@ -68,7 +70,7 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 		}
 	}
 
-	hashicorpSecretData, err := s.client.ReadData(id, callData)
+	hashicorpSecretData, err := s.client.ReadData(ctx, id, callData)
 	if err != nil {
 		errMessage := "failed to get Hashicorp secret data"
 		logger.WithError(err).Error(errMessage)
@@ -83,7 +85,7 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 	value := data[valueLabel].(string)
 
 	// We need to do a second call to get the metadata
-	hashicorpSecretMetadata, err := s.client.ReadMetadata(id)
+	hashicorpSecretMetadata, err := s.client.ReadMetadata(ctx, id)
 	if err != nil {
 		errMessage := "failed to get Hashicorp secret metadata"
 		logger.WithError(err).Error(errMessage)
@@ -105,8 +107,8 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 	return formatHashicorpSecret(id, value, tags, metadata), nil
 }
 
-func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
-	res, err := s.client.ListSecrets()
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	res, err := s.client.ListSecrets(ctx)
 	if err != nil {
 		errMessage := "failed to list Hashicorp secrets"
 		s.logger.WithError(err).Error(errMessage)
@@ -126,6 +128,41 @@ func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
 	return keysStr, nil
 }
 
+// ListMetadata lists the current version of the secrets from their metadata. Tags being stored with the data of each
+// version, they are not returned
+func (s *Store) ListMetadata(ctx context.Context) ([]*entities.Secret, error) {
+	ids, err := s.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*entities.Secret
+	for _, id := range ids {
+		logger := s.logger.With("id", id)
+
+		hashicorpSecretMetadata, err := s.client.ReadMetadata(ctx, id)
+		if err != nil {
+			errMessage := "failed to get Hashicorp secret metadata"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		} else if hashicorpSecretMetadata == nil {
+			// The secret was deleted after being listed
+			continue
+		}
+
+		metadata, err := formatHashicorpSecretMetadata(hashicorpSecretMetadata, "")
+		if err != nil {
+			errMessage := "failed to parse Hashicorp secret"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.HashicorpVaultError(errMessage)
+		}
+
+		result = append(result, formatHashicorpSecret(id, "", nil, metadata))
+	}
+
+	return result, nil
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	logger := s.logger.With("id", id)
 
@@ -133,7 +170,7 @@ func (s *Store) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	hashicorpSecretData, err := s.client.ReadData(id, map[string][]string{
+	hashicorpSecretData, err := s.client.ReadData(ctx, id, map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -147,7 +184,7 @@ func (s *Store) Delete(ctx context.Context, id string) error {
 		return errors.NotFoundError(errMessage)
 	}
 
-	err = s.client.DeleteSecret(id, map[string][]string{
+	err = s.client.DeleteSecret(ctx, id, map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -165,6 +202,22 @@ func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Secret, error
 	return nil, err
 }
 
+func (s *Store) ListExpiring(_ context.Context, _ time.Time, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list expiring secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) SearchPurgeable(_ context.Context, _ time.Time, _ time.Duration) ([]*entities.Secret, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) Search(_ context.Context, _ *entities.ListFilter) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("search secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
 	err := errors.NotSupportedError("list deleted secret is not supported")
 	s.logger.Warn(err.Error())
@@ -179,7 +232,7 @@ func (s *Store) Restore(ctx context.Context, id string) error {
 		return err
 	}
 
-	err = s.client.RestoreSecret(id, map[string][]string{
+	err = s.client.RestoreSecret(ctx, id, map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -199,7 +252,7 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 		return err
 	}
 
-	err = s.client.DestroySecret(id, map[string][]string{
+	err = s.client.DestroySecret(ctx, id, map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -106,9 +106,9 @@ func (s *hashicorpSecretStoreTestSuite) TestSet() {
 	s.Run("should set a new secret successfully", func() {
 		expectedCreatedAt, _ := time.Parse(time.RFC3339, "2018-03-22T02:36:43.986212308Z")
 
-		s.mockVault.EXPECT().SetSecret(id, expectedWriteData).Return(hashicorpSecret, nil)
-		s.mockVault.EXPECT().ReadData(id, gomock.Any()).Return(hashicorpSecretData, nil)
-		s.mockVault.EXPECT().ReadMetadata(id).Return(hashicorpSecretMetadata, nil)
+		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, expectedWriteData).Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, gomock.Any()).Return(hashicorpSecretData, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(hashicorpSecretMetadata, nil)
 
 		secret, err := s.secretStore.Set(ctx, id, value, attributes)
 
@@ -123,7 +123,7 @@ func (s *hashicorpSecretStoreTestSuite) TestSet() {
 	})
 
 	s.Run("should fail with same error if write fails", func() {
-		s.mockVault.EXPECT().SetSecret(id, expectedWriteData).Return(nil, expectedErr)
+		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, expectedWriteData).Return(nil, expectedErr)
 
 		secret, err := s.secretStore.Set(ctx, id, value, attributes)
 
@@ -183,8 +183,8 @@ func (s *hashicorpSecretStoreTestSuite) TestGet() {
 	s.Run("should get a secret successfully with empty version", func() {
 		expectedCreatedAt, _ := time.Parse(time.RFC3339, "2018-03-22T02:36:43.986212308Z")
 
-		s.mockVault.EXPECT().ReadData(id, nil).Return(hashicorpSecretData, nil)
-		s.mockVault.EXPECT().ReadMetadata(id).Return(hashicorpSecretMetadata, nil)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, nil).Return(hashicorpSecretData, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(hashicorpSecretMetadata, nil)
 
 		secret, err := s.secretStore.Get(ctx, id, "")
 
@@ -201,8 +201,8 @@ func (s *hashicorpSecretStoreTestSuite) TestGet() {
 	s.Run("should get a secret successfully with version", func() {
 		version := "2"
 
-		s.mockVault.EXPECT().ReadData(id, map[string][]string{versionLabel: {version}}).Return(hashicorpSecretData, nil)
-		s.mockVault.EXPECT().ReadMetadata(id).Return(hashicorpSecretMetadata, nil)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, map[string][]string{versionLabel: {version}}).Return(hashicorpSecretData, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(hashicorpSecretMetadata, nil)
 
 		secret, err := s.secretStore.Get(ctx, id, version)
 
@@ -213,10 +213,10 @@ func (s *hashicorpSecretStoreTestSuite) TestGet() {
 	s.Run("should get a secret successfully with deletion time and destroyed", func() {
 		version := "1"
 
-		s.mockVault.EXPECT().ReadData(id, map[string][]string{
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, map[string][]string{
 			versionLabel: {version},
 		}).Return(hashicorpSecretData, nil)
-		s.mockVault.EXPECT().ReadMetadata(id).Return(hashicorpSecretMetadata, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(hashicorpSecretMetadata, nil)
 
 		secret, err := s.secretStore.Get(ctx, id, version)
 
@@ -226,7 +226,7 @@ func (s *hashicorpSecretStoreTestSuite) TestGet() {
 	})
 
 	s.Run("should fail with same error if read data fails", func() {
-		s.mockVault.EXPECT().ReadData(id, nil).Return(nil, expectedErr)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, nil).Return(nil, expectedErr)
 
 		secret, err := s.secretStore.Get(ctx, id, "")
 
@@ -235,8 +235,8 @@ func (s *hashicorpSecretStoreTestSuite) TestGet() {
 	})
 
 	s.Run("should fail with same error if read metadata fails", func() {
-		s.mockVault.EXPECT().ReadData(id, nil).Return(hashicorpSecretData, nil)
-		s.mockVault.EXPECT().ReadMetadata(id).Return(nil, expectedErr)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, nil).Return(hashicorpSecretData, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(nil, expectedErr)
 
 		secret, err := s.secretStore.Get(ctx, id, "")
 
@@ -257,7 +257,7 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 			},
 		}
 
-		s.mockVault.EXPECT().ListSecrets().Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().ListSecrets(gomock.Any()).Return(hashicorpSecret, nil)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -266,7 +266,7 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 	})
 
 	s.Run("should return empty list if result is nil", func() {
-		s.mockVault.EXPECT().ListSecrets().Return(nil, nil)
+		s.mockVault.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -275,7 +275,7 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 	})
 
 	s.Run("should fail with same error if ListSecrets fails", func() {
-		s.mockVault.EXPECT().ListSecrets().Return(nil, expectedErr)
+		s.mockVault.EXPECT().ListSecrets(gomock.Any()).Return(nil, expectedErr)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -284,6 +284,48 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 	})
 }
 
+func (s *hashicorpSecretStoreTestSuite) TestListMetadata() {
+	ctx := context.Background()
+	id := "my-secret1"
+	hashicorpSecretMetadata := &hashicorp.Secret{
+		Data: map[string]interface{}{
+			"current_version":      json.Number("2"),
+			"delete_version_after": "0s",
+			"versions": map[string]interface{}{
+				"2": map[string]interface{}{
+					"created_time":  "2018-03-22T02:36:43.986212308Z",
+					"deletion_time": "",
+					"destroyed":     false,
+				},
+			},
+		},
+	}
+
+	s.Run("should list the current version of the secrets without reading their data", func() {
+		s.mockVault.EXPECT().ListSecrets(gomock.Any()).Return(&hashicorp.Secret{Data: map[string]interface{}{"keys": []interface{}{id}}}, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(hashicorpSecretMetadata, nil)
+
+		secrets, err := s.secretStore.(stores.SecretMetadataLister).ListMetadata(ctx)
+
+		assert.NoError(s.T(), err)
+		assert.Len(s.T(), secrets, 1)
+		assert.Equal(s.T(), id, secrets[0].ID)
+		assert.Empty(s.T(), secrets[0].Value)
+		assert.Nil(s.T(), secrets[0].Tags)
+		assert.Equal(s.T(), "2", secrets[0].Metadata.Version)
+	})
+
+	s.Run("should fail with same error if ReadMetadata fails", func() {
+		s.mockVault.EXPECT().ListSecrets(gomock.Any()).Return(&hashicorp.Secret{Data: map[string]interface{}{"keys": []interface{}{id}}}, nil)
+		s.mockVault.EXPECT().ReadMetadata(gomock.Any(), id).Return(nil, expectedErr)
+
+		secrets, err := s.secretStore.(stores.SecretMetadataLister).ListMetadata(ctx)
+
+		assert.Nil(s.T(), secrets)
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
 func (s *hashicorpSecretStoreTestSuite) TestDelete() {
 	ctx := context.Background()
 	id := "my-deleted-secret"
@@ -291,8 +333,8 @@ func (s *hashicorpSecretStoreTestSuite) TestDelete() {
 
 	s.Run("should delete secret by id successfully", func() {
 		data := map[string][]string{"versions": {"1", "2", "3"}}
-		s.mockVault.EXPECT().ReadData(id, data).Return(&hashicorp.Secret{}, nil)
-		s.mockVault.EXPECT().DeleteSecret(id, data).Return(nil)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, data).Return(&hashicorp.Secret{}, nil)
+		s.mockVault.EXPECT().DeleteSecret(gomock.Any(), id, data).Return(nil)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, false).Return(versions, nil)
 
 		err := s.secretStore.Delete(ctx, id)
@@ -301,7 +343,7 @@ func (s *hashicorpSecretStoreTestSuite) TestDelete() {
 	})
 
 	s.Run("should fail with same NotFound if secret is not found by id ", func() {
-		s.mockVault.EXPECT().ReadData(id, gomock.Any()).Return(nil, nil)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, gomock.Any()).Return(nil, nil)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, false).Return(versions, nil)
 
 		err := s.secretStore.Delete(ctx, id)
@@ -309,8 +351,8 @@ func (s *hashicorpSecretStoreTestSuite) TestDelete() {
 	})
 
 	s.Run("should fail with same error if delete secret by id fails", func() {
-		s.mockVault.EXPECT().ReadData(id, gomock.Any()).Return(&hashicorp.Secret{}, nil)
-		s.mockVault.EXPECT().DeleteSecret(id, gomock.Any()).Return(expectedErr)
+		s.mockVault.EXPECT().ReadData(gomock.Any(), id, gomock.Any()).Return(&hashicorp.Secret{}, nil)
+		s.mockVault.EXPECT().DeleteSecret(gomock.Any(), id, gomock.Any()).Return(expectedErr)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, false).Return(versions, nil)
 
 		err := s.secretStore.Delete(ctx, id)
@@ -325,14 +367,14 @@ func (s *hashicorpSecretStoreTestSuite) TestRestore() {
 
 	s.Run("should restore secret by id successfully", func() {
 		data := map[string][]string{"versions": {"1", "2", "3"}}
-		s.mockVault.EXPECT().RestoreSecret(id, data).Return(nil)
+		s.mockVault.EXPECT().RestoreSecret(gomock.Any(), id, data).Return(nil)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, true).Return(versions, nil)
 		err := s.secretStore.Restore(ctx, id)
 		assert.NoError(s.T(), err)
 	})
 
 	s.Run("should fail with same error if restore secret by id fails", func() {
-		s.mockVault.EXPECT().RestoreSecret(id, gomock.Any()).Return(expectedErr)
+		s.mockVault.EXPECT().RestoreSecret(gomock.Any(), id, gomock.Any()).Return(expectedErr)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, true).Return(versions, nil)
 		err := s.secretStore.Restore(ctx, id)
 		assert.True(s.T(), errors.IsHashicorpVaultError(err))
@@ -346,14 +388,14 @@ func (s *hashicorpSecretStoreTestSuite) TestDestroy() {
 
 	s.Run("should destroy secret by id successfully", func() {
 		data := map[string][]string{"versions": {"1", "2", "3"}}
-		s.mockVault.EXPECT().DestroySecret(id, data).Return(nil)
+		s.mockVault.EXPECT().DestroySecret(gomock.Any(), id, data).Return(nil)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, true).Return(versions, nil)
 		err := s.secretStore.Destroy(ctx, id)
 		assert.NoError(s.T(), err)
 	})
 
 	s.Run("should fail with same error if destroy secret by id fails", func() {
-		s.mockVault.EXPECT().DestroySecret(id, gomock.Any()).Return(expectedErr)
+		s.mockVault.EXPECT().DestroySecret(gomock.Any(), id, gomock.Any()).Return(expectedErr)
 		s.mockDB.EXPECT().ListVersions(gomock.Any(), id, true).Return(versions, nil)
 		err := s.secretStore.Destroy(ctx, id)
 		assert.True(s.T(), errors.IsHashicorpVaultError(err))
@@ -4,14 +4,15 @@ import (
 	"context"
 
 	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/stores/entities"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 //go:generate mockgen -source=stores.go -destination=mock/stores.go -package=mock
 
 type Stores interface {
-	// CreateEthereum creates an ethereum store
-	CreateEthereum(_ context.Context, name, keyStore string, allowedTenants []string, userInfo *auth.UserInfo) error
+	// CreateEthereum creates an ethereum store, with an optional secret store holding its HD wallets
+	CreateEthereum(_ context.Context, name, keyStore, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error
 
 	// CreateKey creates a key store
 	CreateKey(_ context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error
@@ -28,6 +29,12 @@ type Stores interface {
 	// ImportSecrets import secrets from the vault into a secret store
 	ImportSecrets(ctx context.Context, storeName string, userInfo *auth.UserInfo) error
 
+	// Sync reconciles the items indexed for a store with the ones of its vault, only reporting the drift on dry run
+	Sync(ctx context.Context, storeName string, dryRun bool, userInfo *auth.UserInfo) (*entities.SyncReport, error)
+
+	// GetSyncReport gets the report of the last synchronization of a store
+	GetSyncReport(ctx context.Context, storeName string, userInfo *auth.UserInfo) (*entities.SyncReport, error)
+
 	// Secret get secret store by name
 	Secret(ctx context.Context, storeName string, userInfo *auth.UserInfo) (SecretStore, error)
 
@@ -45,4 +52,19 @@ type Stores interface {
 
 	// ListAllAccounts list all accounts from all stores
 	ListAllAccounts(ctx context.Context, userInfo *auth.UserInfo) ([]common.Address, error)
+
+	// Create creates a store from a definition and persists it
+	Create(ctx context.Context, store *entities.StoreDefinition, userInfo *auth.UserInfo) (*entities.StoreDefinition, error)
+
+	// Inspect gets the definition of a persisted store
+	Inspect(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.StoreDefinition, error)
+
+	// Update updates the definition of a persisted store and recreates it
+	Update(ctx context.Context, store *entities.StoreDefinition, userInfo *auth.UserInfo) (*entities.StoreDefinition, error)
+
+	// Delete deletes a persisted store
+	Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error
+
+	// Load creates all the persisted stores
+	Load(ctx context.Context) error
 }
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/longfan78/quorum-key-manager/pkg/json"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/api/http"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	infrahttp "github.com/longfan78/quorum-key-manager/src/infra/http"
+	"github.com/longfan78/quorum-key-manager/src/vaults"
+	"github.com/longfan78/quorum-key-manager/src/vaults/api/types"
+)
+
+type VaultsHandler struct {
+	vaults vaults.Vaults
+}
+
+func NewVaultsHandler(vaultsService vaults.Vaults) *VaultsHandler {
+	return &VaultsHandler{vaults: vaultsService}
+}
+
+func (h *VaultsHandler) Register(router *mux.Router) {
+	vaultsRouter := router.PathPrefix("/vaults").Subrouter()
+
+	vaultsRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	vaultsRouter.Methods(http.MethodPost).Path("/{vaultName}").HandlerFunc(h.create)
+	vaultsRouter.Methods(http.MethodGet).Path("/{vaultName}").HandlerFunc(h.get)
+	vaultsRouter.Methods(http.MethodPatch).Path("/{vaultName}").HandlerFunc(h.update)
+	vaultsRouter.Methods(http.MethodDelete).Path("/{vaultName}").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates a vault
+// @Description  Creates a vault client and persists its definition so that it is restored on restart
+// @Tags         Vaults
+// @Accept       json
+// @Produce      json
+// @Param        vaultName  path      string                    true  "vault identifier"
+// @Param        request    body      types.CreateVaultRequest  true  "Create vault request"
+// @Success      200        {object}  types.VaultResponse       "Vault data"
+// @Failure      400        {object}  infrahttp.ErrorResponse   "Invalid request format"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      409        {object}  infrahttp.ErrorResponse   "Vault already exists"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /vaults/{vaultName} [post]
+func (h *VaultsHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	createReq := &types.CreateVaultRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	vault, err := h.vaults.Create(ctx, &entities.VaultDefinition{
+		Name:           getVaultName(r),
+		VaultType:      createReq.Type,
+		Config:         createReq.Specs,
+		AllowedTenants: createReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewVaultResponse(vault))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets a vault
+// @Description  Gets the definition of a vault created through the API
+// @Tags         Vaults
+// @Produce      json
+// @Param        vaultName  path      string                   true  "vault identifier"
+// @Success      200        {object}  types.VaultResponse      "Vault data"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Vault not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /vaults/{vaultName} [get]
+func (h *VaultsHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vault, err := h.vaults.Inspect(ctx, getVaultName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewVaultResponse(vault))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists vaults
+// @Description  Lists the names of all the vaults, including the ones declared in manifests
+// @Tags         Vaults
+// @Produce      json
+// @Success      200  {array}   string                   "List of vault names"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /vaults [get]
+func (h *VaultsHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vaultNames, err := h.vaults.List(ctx, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, vaultNames)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Updates a vault
+// @Description  Replaces the specs and allowed tenants of a vault created through the API and recreates its client
+// @Tags         Vaults
+// @Accept       json
+// @Produce      json
+// @Param        vaultName  path      string                    true  "vault identifier"
+// @Param        request    body      types.UpdateVaultRequest  true  "Update vault request"
+// @Success      200        {object}  types.VaultResponse       "Vault data"
+// @Failure      400        {object}  infrahttp.ErrorResponse   "Invalid request format"
+// @Failure      403        {object}  infrahttp.ErrorResponse   "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse   "Vault not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse   "Internal server error"
+// @Router       /vaults/{vaultName} [patch]
+func (h *VaultsHandler) update(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	updateReq := &types.UpdateVaultRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, updateReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	vault, err := h.vaults.Update(ctx, &entities.VaultDefinition{
+		Name:           getVaultName(r),
+		Config:         updateReq.Specs,
+		AllowedTenants: updateReq.AllowedTenants,
+	}, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewVaultResponse(vault))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Deletes a vault
+// @Description  Deletes a vault created through the API. Stores created from this vault keep working until they are deleted
+// @Tags         Vaults
+// @Param        vaultName  path  string  true  "vault identifier"
+// @Success      204        "Deleted successfully"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Vault not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /vaults/{vaultName} [delete]
+func (h *VaultsHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.vaults.Delete(ctx, getVaultName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func getVaultName(r *http.Request) string {
+	return mux.Vars(r)["vaultName"]
+}
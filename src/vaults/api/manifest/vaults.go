@@ -44,6 +44,11 @@ func (h *VaultsHandler) Register(ctx context.Context, mnfs []entities.Manifest)
 	return nil
 }
 
+// Update recreates the vault clients of changed manifests, replacing the running ones
+func (h *VaultsHandler) Update(ctx context.Context, mnfs []entities.Manifest) error {
+	return h.Register(ctx, mnfs)
+}
+
 func (h *VaultsHandler) CreateHashicorp(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
 	config := &entities.HashicorpConfig{}
 	err := json.UnmarshalYAML(specs, config)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/entities"
+)
+
+type CreateVaultRequest struct {
+	Type           string      `json:"type" validate:"required,oneof=hashicorp azure aws" example:"hashicorp"`
+	Specs          interface{} `json:"specs" validate:"required"`
+	AllowedTenants []string    `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+type UpdateVaultRequest struct {
+	Specs          interface{} `json:"specs" validate:"required"`
+	AllowedTenants []string    `json:"allowedTenants,omitempty" example:"tenant1,tenant2"`
+}
+
+// VaultResponse does not contain the vault specs as they hold credentials
+type VaultResponse struct {
+	Name           string    `json:"name" example:"my-hashicorp-vault"`
+	Type           string    `json:"type" example:"hashicorp"`
+	AllowedTenants []string  `json:"allowedTenants" example:"tenant1,tenant2"`
+	CreatedAt      time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewVaultResponse(vault *entities.VaultDefinition) *VaultResponse {
+	return &VaultResponse{
+		Name:           vault.Name,
+		Type:           vault.VaultType,
+		AllowedTenants: vault.AllowedTenants,
+		CreatedAt:      vault.CreatedAt,
+		UpdatedAt:      vault.UpdatedAt,
+	}
+}
@@ -1,12 +1,27 @@
 package app
 
 import (
+	"github.com/gorilla/mux"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/vaults/api/http"
+	db "github.com/longfan78/quorum-key-manager/src/vaults/database/postgres"
 	"github.com/longfan78/quorum-key-manager/src/vaults/service/vaults"
 )
 
-func RegisterService(logger log.Logger, roles auth.Roles) *vaults.Vaults {
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, cfg *entities.VaultsConfig, notifier cluster.Notifier) *vaults.Vaults {
+	// Data layer
+	vaultsRepository := db.NewVaults(postgresClient, cfg.EncryptionKey)
+
 	// Business layer
-	return vaults.New(roles, logger)
+	vaultsService := vaults.New(vaultsRepository, roles, notifier, logger)
+	notifier.Subscribe(cluster.KindVault, vaultsService.Refresh)
+
+	// Service layer
+	http.NewVaultsHandler(vaultsService).Register(router)
+
+	return vaultsService
 }
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/src/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Vaults interface {
+	// Insert inserts a new vault definition
+	Insert(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error)
+	// FindOne gets a vault definition
+	FindOne(ctx context.Context, name string) (*entities.VaultDefinition, error)
+	// FindAll gets all the vault definitions
+	FindAll(ctx context.Context) ([]*entities.VaultDefinition, error)
+	// Update updates a vault definition
+	Update(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error)
+	// Delete deletes a vault definition
+	Delete(ctx context.Context, name string) error
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/longfan78/quorum-key-manager/src/entities"
+	reflect "reflect"
+)
+
+// MockVaults is a mock of Vaults interface
+type MockVaults struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultsMockRecorder
+}
+
+// MockVaultsMockRecorder is the mock recorder for MockVaults
+type MockVaultsMockRecorder struct {
+	mock *MockVaults
+}
+
+// NewMockVaults creates a new mock instance
+func NewMockVaults(ctrl *gomock.Controller) *MockVaults {
+	mock := &MockVaults{ctrl: ctrl}
+	mock.recorder = &MockVaultsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVaults) EXPECT() *MockVaultsMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockVaults) Insert(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, vault)
+	ret0, _ := ret[0].(*entities.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockVaultsMockRecorder) Insert(ctx, vault interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockVaults)(nil).Insert), ctx, vault)
+}
+
+// FindOne mocks base method
+func (m *MockVaults) FindOne(ctx context.Context, name string) (*entities.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret0, _ := ret[0].(*entities.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne
+func (mr *MockVaultsMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockVaults)(nil).FindOne), ctx, name)
+}
+
+// FindAll mocks base method
+func (m *MockVaults) FindAll(ctx context.Context) ([]*entities.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entities.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockVaultsMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockVaults)(nil).FindAll), ctx)
+}
+
+// Update mocks base method
+func (m *MockVaults) Update(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, vault)
+	ret0, _ := ret[0].(*entities.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockVaultsMockRecorder) Update(ctx, vault interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVaults)(nil).Update), ctx, vault)
+}
+
+// Delete mocks base method
+func (m *MockVaults) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockVaultsMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVaults)(nil).Delete), ctx, name)
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/longfan78/quorum-key-manager/src/entities"
+)
+
+type Vault struct {
+	tableName struct{} `pg:"vaults"` // nolint:unused,structcheck // reason
+
+	Name      string `pg:",pk"`
+	VaultType string
+	// Config is only set on vaults persisted before their configuration was encrypted
+	Config interface{}
+	// EncryptedConfig is the JSON configuration of the vault encrypted with AES-256-GCM
+	EncryptedConfig []byte
+	AllowedTenants  []string  `pg:",array,use_zero"`
+	CreatedAt       time.Time `pg:"default:now()"`
+	UpdatedAt       time.Time `pg:"default:now()"`
+}
+
+func NewVault(vault *entities.VaultDefinition, encryptedConfig []byte) *Vault {
+	return &Vault{
+		Name:            vault.Name,
+		VaultType:       vault.VaultType,
+		EncryptedConfig: encryptedConfig,
+		AllowedTenants:  vault.AllowedTenants,
+		CreatedAt:       vault.CreatedAt,
+		UpdatedAt:       vault.UpdatedAt,
+	}
+}
+
+func (v *Vault) ToEntity(config interface{}) *entities.VaultDefinition {
+	return &entities.VaultDefinition{
+		Name:           v.Name,
+		VaultType:      v.VaultType,
+		Config:         config,
+		AllowedTenants: v.AllowedTenants,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
+	}
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lib/pq"
+	"github.com/longfan78/quorum-key-manager/pkg/crypto/aes"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/postgres"
+	"github.com/longfan78/quorum-key-manager/src/vaults/database"
+	"github.com/longfan78/quorum-key-manager/src/vaults/database/models"
+)
+
+// The client only updates non zero fields, the plaintext configuration of previously persisted vaults being cleared
+const updateVaultQuery = `
+UPDATE vaults SET config = NULL, encrypted_config = ?, allowed_tenants = ?, updated_at = now()
+WHERE name = ?
+RETURNING name`
+
+type Vaults struct {
+	pgClient      postgres.Client
+	encryptionKey []byte
+}
+
+var _ database.Vaults = &Vaults{}
+
+// NewVaults creates a vaults repository encrypting their configuration, which holds credentials, with encryptionKey.
+// Vaults cannot be persisted without encryption key
+func NewVaults(pgClient postgres.Client, encryptionKey []byte) *Vaults {
+	return &Vaults{pgClient: pgClient, encryptionKey: encryptionKey}
+}
+
+func (v *Vaults) Insert(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error) {
+	encryptedConfig, err := v.encrypt(vault.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultModel := models.NewVault(vault, encryptedConfig)
+	err = v.pgClient.Insert(ctx, vaultModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return vaultModel.ToEntity(vault.Config), nil
+}
+
+func (v *Vaults) FindOne(ctx context.Context, name string) (*entities.VaultDefinition, error) {
+	vaultModel := &models.Vault{Name: name}
+
+	err := v.pgClient.SelectPK(ctx, vaultModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.toEntity(vaultModel)
+}
+
+func (v *Vaults) FindAll(ctx context.Context) ([]*entities.VaultDefinition, error) {
+	var vaultModels []*models.Vault
+
+	err := v.pgClient.Select(ctx, &vaultModels)
+	if err != nil {
+		return nil, err
+	}
+
+	var vaults []*entities.VaultDefinition
+	for _, vaultModel := range vaultModels {
+		vault, err := v.toEntity(vaultModel)
+		if err != nil {
+			return nil, err
+		}
+
+		vaults = append(vaults, vault)
+	}
+
+	return vaults, nil
+}
+
+func (v *Vaults) Update(ctx context.Context, vault *entities.VaultDefinition) (*entities.VaultDefinition, error) {
+	encryptedConfig, err := v.encrypt(vault.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	err = v.pgClient.QueryOne(ctx, &name, updateVaultQuery, encryptedConfig, pq.Array(vault.AllowedTenants), vault.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update does not update the model, we must update and then get
+	return v.FindOne(ctx, vault.Name)
+}
+
+func (v *Vaults) Delete(ctx context.Context, name string) error {
+	err := v.pgClient.DeletePK(ctx, &models.Vault{Name: name})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *Vaults) encrypt(config interface{}) ([]byte, error) {
+	if v.encryptionKey == nil {
+		return nil, errors.ConfigError("vaults encryption key is required to persist vault credentials")
+	}
+
+	bConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, errors.EncodingError("failed to marshal vault config")
+	}
+
+	encryptedConfig, err := aes.Encrypt(v.encryptionKey, bConfig)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to encrypt vault config")
+	}
+
+	return encryptedConfig, nil
+}
+
+func (v *Vaults) toEntity(vaultModel *models.Vault) (*entities.VaultDefinition, error) {
+	if vaultModel.EncryptedConfig == nil {
+		return vaultModel.ToEntity(vaultModel.Config), nil
+	}
+
+	if v.encryptionKey == nil {
+		return nil, errors.ConfigError("vaults encryption key is required to load vault %s", vaultModel.Name)
+	}
+
+	bConfig, err := aes.Decrypt(v.encryptionKey, vaultModel.EncryptedConfig)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to decrypt config of vault %s", vaultModel.Name)
+	}
+
+	var config interface{}
+	err = json.Unmarshal(bConfig, &config)
+	if err != nil {
+		return nil, errors.EncodingError("failed to unmarshal config of vault %s", vaultModel.Name)
+	}
+
+	return vaultModel.ToEntity(config), nil
+}
@@ -91,3 +91,91 @@ func (mr *MockVaultsMockRecorder) Get(ctx, name, userInfo interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVaults)(nil).Get), ctx, name, userInfo)
 }
+
+// Create mocks base method
+func (m *MockVaults) Create(ctx context.Context, vault *entities0.VaultDefinition, userInfo *entities.UserInfo) (*entities0.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, vault, userInfo)
+	ret0, _ := ret[0].(*entities0.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockVaultsMockRecorder) Create(ctx, vault, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVaults)(nil).Create), ctx, vault, userInfo)
+}
+
+// Inspect mocks base method
+func (m *MockVaults) Inspect(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities0.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect", ctx, name, userInfo)
+	ret0, _ := ret[0].(*entities0.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inspect indicates an expected call of Inspect
+func (mr *MockVaultsMockRecorder) Inspect(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockVaults)(nil).Inspect), ctx, name, userInfo)
+}
+
+// List mocks base method
+func (m *MockVaults) List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockVaultsMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVaults)(nil).List), ctx, userInfo)
+}
+
+// Update mocks base method
+func (m *MockVaults) Update(ctx context.Context, vault *entities0.VaultDefinition, userInfo *entities.UserInfo) (*entities0.VaultDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, vault, userInfo)
+	ret0, _ := ret[0].(*entities0.VaultDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockVaultsMockRecorder) Update(ctx, vault, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVaults)(nil).Update), ctx, vault, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockVaults) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockVaultsMockRecorder) Delete(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVaults)(nil).Delete), ctx, name, userInfo)
+}
+
+// Load mocks base method
+func (m *MockVaults) Load(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load
+func (mr *MockVaultsMockRecorder) Load(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockVaults)(nil).Load), ctx)
+}
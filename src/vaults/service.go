@@ -21,4 +21,22 @@ type Vaults interface {
 
 	// Get gets a valut by name
 	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Vault, error)
+
+	// Create creates a vault client from a definition and persists it
+	Create(ctx context.Context, vault *entities.VaultDefinition, userInfo *auth.UserInfo) (*entities.VaultDefinition, error)
+
+	// Inspect gets the definition of a persisted vault
+	Inspect(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.VaultDefinition, error)
+
+	// List lists the names of all the vaults
+	List(ctx context.Context, userInfo *auth.UserInfo) ([]string, error)
+
+	// Update updates the definition of a persisted vault and recreates its client
+	Update(ctx context.Context, vault *entities.VaultDefinition, userInfo *auth.UserInfo) (*entities.VaultDefinition, error)
+
+	// Delete deletes a persisted vault and its client
+	Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error
+
+	// Load creates the clients of all the persisted vaults
+	Load(ctx context.Context) error
 }
@@ -0,0 +1,47 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+func (c *Vaults) Create(ctx context.Context, vault *entities.VaultDefinition, userInfo *auth.UserInfo) (*entities.VaultDefinition, error) {
+	logger := c.logger.With("name", vault.Name, "type", vault.VaultType)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourceVault})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.vaultExists(vault.Name) {
+		errMessage := "vault already exists"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	}
+
+	err = c.createFromDefinition(ctx, vault, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	createdVault, err := c.db.Insert(ctx, vault)
+	if err != nil {
+		c.deleteVault(vault.Name)
+
+		errMessage := "failed to persist vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	c.revisions.Set(vault.Name, createdVault.UpdatedAt)
+	c.notify(ctx, cluster.OpUpsert, vault.Name)
+
+	logger.Info("vault persisted successfully")
+	return createdVault, nil
+}
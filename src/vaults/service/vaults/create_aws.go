@@ -13,7 +13,9 @@ func (c *Vaults) CreateAWS(_ context.Context, name string, config *entities.AWSC
 	logger := c.logger.With("name", name)
 	logger.Debug("creating aws vault client")
 
-	cli, err := client.New(client.NewConfig(config), logger)
+	clientCfg := client.NewConfig(config)
+	clientCfg.Name = name
+	cli, err := client.New(clientCfg, logger)
 	if err != nil {
 		errMessage := "failed to instantiate AWS client"
 		logger.WithError(err).Error(errMessage)
@@ -7,7 +7,9 @@ import (
 	entities2 "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/auth/mock"
 	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/vaults/database/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,7 +20,7 @@ func TestCreateAWS(t *testing.T) {
 
 	logger := testutils.NewMockLogger(ctrl)
 	roles := mock.NewMockRoles(ctrl)
-	vault := New(roles, logger)
+	vault := New(dbmock.NewMockVaults(ctrl), roles, cluster.Standalone{}, logger)
 
 	ctx := context.Background()
 	vaultName := "aws-vault"
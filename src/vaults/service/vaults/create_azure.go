@@ -13,7 +13,9 @@ func (c *Vaults) CreateAzure(_ context.Context, name string, config *entities.Az
 	logger := c.logger.With("name", name)
 	logger.Debug("creating akv client")
 
-	cli, err := client.NewClient(client.NewConfig(config))
+	clientCfg := client.NewConfig(config)
+	clientCfg.Name = name
+	cli, err := client.NewClient(clientCfg)
 	if err != nil {
 		errMessage := "failed to instantiate AKV client"
 		logger.WithError(err).Error(errMessage)
@@ -16,7 +16,9 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 	logger := c.logger.With("name", name)
 	logger.Debug("creating hashicorp vault client")
 
-	cli, err := client.NewClient(client.NewConfig(config))
+	clientCfg := client.NewConfig(config)
+	clientCfg.Name = name
+	cli, err := client.NewClient(clientCfg)
 	if err != nil {
 		errMessage := "failed to instantiate Hashicorp client"
 		logger.WithError(err).Error(errMessage)
@@ -27,6 +29,7 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 		logger.Warn("skipping certs verification will make your connection insecure and is not recommended in production")
 	}
 
+	var stopTokenWatcher context.CancelFunc
 	if config.Token != "" {
 		cli.SetToken(config.Token)
 	} else if config.TokenPath != "" {
@@ -35,8 +38,10 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 			return err
 		}
 
+		var watcherCtx context.Context
+		watcherCtx, stopTokenWatcher = context.WithCancel(context.Background())
 		go func() {
-			err = tokenWatcher.Start(context.Background())
+			err := tokenWatcher.Start(watcherCtx)
 			if err != nil {
 				logger.WithError(err).Error("token watcher has exited with errors")
 			} else {
@@ -58,6 +63,7 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 			retries++
 
 			if retries == maxRetries {
+				stopTokenWatcher()
 				errMessage := "failed to reach hashicorp vault. Please verify that the server is reachable"
 				logger.WithError(err).Error(errMessage)
 				return errors.InvalidFormatError(errMessage)
@@ -66,6 +72,9 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 	}
 
 	c.createVault(name, entities.HashicorpVaultType, allowedTenants, cli)
+	if stopTokenWatcher != nil {
+		c.setTokenWatcher(name, stopTokenWatcher)
+	}
 
 	logger.Info("hashicorp vault created successfully")
 	return nil
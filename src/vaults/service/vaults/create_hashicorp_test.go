@@ -7,7 +7,9 @@ import (
 	entities2 "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/auth/mock"
 	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/vaults/database/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,7 +20,7 @@ func TestCreateHashicorp(t *testing.T) {
 
 	logger := testutils.NewMockLogger(ctrl)
 	roles := mock.NewMockRoles(ctrl)
-	vault := New(roles, logger)
+	vault := New(dbmock.NewMockVaults(ctrl), roles, cluster.Standalone{}, logger)
 
 	ctx := context.Background()
 	vaultName := "hashicorp-vault"
@@ -32,4 +34,13 @@ func TestCreateHashicorp(t *testing.T) {
 		err := vault.CreateHashicorp(ctx, vaultName, cfg, allowedTenants, userInfo)
 		assert.NoError(t, err)
 	})
+
+	t.Run("should stop the token watcher of the replaced vault", func(t *testing.T) {
+		watcherCtx, stopTokenWatcher := context.WithCancel(ctx)
+		vault.setTokenWatcher(vaultName, stopTokenWatcher)
+
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{Token: "my-token"}, allowedTenants, entities2.NewWildcardUser())
+		assert.NoError(t, err)
+		assert.Error(t, watcherCtx.Err())
+	})
 }
@@ -0,0 +1,228 @@
+package vaults
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	entities2 "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/mock"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+	clustermock "github.com/longfan78/quorum-key-manager/src/infra/cluster/mock"
+	"github.com/longfan78/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/longfan78/quorum-key-manager/src/vaults/database/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	db := dbmock.NewMockVaults(ctrl)
+	notifier := clustermock.NewMockNotifier(ctrl)
+	vault := New(db, roles, notifier, logger)
+
+	ctx := context.Background()
+	userInfo := entities2.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(entities2.ListPermissions()).AnyTimes()
+
+	t.Run("should create and persist vault successfully", func(t *testing.T) {
+		vaultDef := &entities.VaultDefinition{
+			Name:      "aws-vault",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+		}
+
+		db.EXPECT().Insert(gomock.Any(), vaultDef).Return(vaultDef, nil)
+		notifier.EXPECT().Notify(gomock.Any(), &cluster.Event{Kind: cluster.KindVault, Name: vaultDef.Name, Op: cluster.OpUpsert}).Return(nil)
+
+		createdVault, err := vault.Create(ctx, vaultDef, userInfo)
+		require.NoError(t, err)
+		assert.Equal(t, vaultDef, createdVault)
+
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with AlreadyExistsError if vault already exists", func(t *testing.T) {
+		vaultDef := &entities.VaultDefinition{
+			Name:      "aws-vault",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+		}
+
+		_, err := vault.Create(ctx, vaultDef, userInfo)
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if specs are invalid", func(t *testing.T) {
+		vaultDef := &entities.VaultDefinition{
+			Name:      "invalid-vault",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3"},
+		}
+
+		_, err := vault.Create(ctx, vaultDef, userInfo)
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if Hashicorp specs contain filesystem paths", func(t *testing.T) {
+		vaultDef := &entities.VaultDefinition{
+			Name:      "hashicorp-vault",
+			VaultType: entities.HashicorpVaultType,
+			Config:    map[string]interface{}{"mountPoint": "secret", "address": "https://attacker:8200", "tokenPath": "/vault/token/.my_token"},
+		}
+
+		_, err := vault.Create(ctx, vaultDef, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should remove vault client if persistence fails", func(t *testing.T) {
+		vaultDef := &entities.VaultDefinition{
+			Name:      "aws-vault-2",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+		}
+		expectedErr := errors.PostgresError("error")
+
+		db.EXPECT().Insert(gomock.Any(), vaultDef).Return(nil, expectedErr)
+
+		_, err := vault.Create(ctx, vaultDef, userInfo)
+		assert.True(t, errors.IsPostgresError(err))
+
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if user does not have permission", func(t *testing.T) {
+		user := &entities2.UserInfo{Username: "user"}
+		roles.EXPECT().UserPermissions(gomock.Any(), user).Return([]entities2.Permission{entities2.ReadVault})
+
+		_, err := vault.Create(ctx, &entities.VaultDefinition{Name: "aws-vault-3"}, user)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
+
+func TestLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	db := dbmock.NewMockVaults(ctrl)
+	vault := New(db, roles, cluster.Standalone{}, logger)
+
+	ctx := context.Background()
+	userInfo := entities2.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(entities2.ListPermissions()).AnyTimes()
+
+	t.Run("should load persisted vaults and skip invalid ones", func(t *testing.T) {
+		validVault := &entities.VaultDefinition{
+			Name:      "aws-vault",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+		}
+		invalidVault := &entities.VaultDefinition{
+			Name:      "invalid-vault",
+			VaultType: "invalid",
+		}
+
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.VaultDefinition{validVault, invalidVault}, nil)
+
+		err := vault.Load(ctx)
+		require.NoError(t, err)
+
+		_, err = vault.Get(ctx, validVault.Name, userInfo)
+		assert.NoError(t, err)
+		_, err = vault.Get(ctx, invalidVault.Name, userInfo)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with same error if FindAll fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		db.EXPECT().FindAll(gomock.Any()).Return(nil, expectedErr)
+
+		err := vault.Load(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	db := dbmock.NewMockVaults(ctrl)
+	vault := New(db, roles, cluster.Standalone{}, logger)
+
+	ctx := context.Background()
+	userInfo := entities2.NewWildcardUser()
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(entities2.ListPermissions()).AnyTimes()
+
+	vaultDef := &entities.VaultDefinition{
+		Name:      "aws-vault",
+		VaultType: entities.AWSVaultType,
+		Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+	}
+
+	t.Run("should create the vault changed by another replica", func(t *testing.T) {
+		db.EXPECT().FindOne(gomock.Any(), vaultDef.Name).Return(vaultDef, nil)
+
+		err := vault.Refresh(ctx, &cluster.Event{Kind: cluster.KindVault, Name: vaultDef.Name, Op: cluster.OpUpsert})
+		require.NoError(t, err)
+
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete the vault deleted by another replica", func(t *testing.T) {
+		err := vault.Refresh(ctx, &cluster.Event{Kind: cluster.KindVault, Name: vaultDef.Name, Op: cluster.OpDelete})
+		require.NoError(t, err)
+
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should resync the vaults changed while notifications were missed", func(t *testing.T) {
+		updatedVault := &entities.VaultDefinition{
+			Name:      "aws-vault-2",
+			VaultType: entities.AWSVaultType,
+			Config:    map[string]interface{}{"region": "eu-west-3", "accessID": "access-id", "secretKey": "secret-key"},
+			UpdatedAt: time.Now(),
+		}
+		db.EXPECT().FindOne(gomock.Any(), vaultDef.Name).Return(vaultDef, nil)
+		err := vault.Refresh(ctx, &cluster.Event{Kind: cluster.KindVault, Name: vaultDef.Name, Op: cluster.OpUpsert})
+		require.NoError(t, err)
+
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.VaultDefinition{updatedVault}, nil)
+
+		err = vault.Refresh(ctx, &cluster.Event{Kind: cluster.KindVault, Op: cluster.OpResync})
+		require.NoError(t, err)
+
+		_, err = vault.Get(ctx, updatedVault.Name, userInfo)
+		assert.NoError(t, err)
+		_, err = vault.Get(ctx, vaultDef.Name, userInfo)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with same error if FindOne fails", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+
+		db.EXPECT().FindOne(gomock.Any(), vaultDef.Name).Return(nil, expectedErr)
+
+		err := vault.Refresh(ctx, &cluster.Event{Kind: cluster.KindVault, Name: vaultDef.Name, Op: cluster.OpUpsert})
+		assert.Equal(t, expectedErr, err)
+	})
+}
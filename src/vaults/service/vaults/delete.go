@@ -0,0 +1,46 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+func (c *Vaults) Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error {
+	logger := c.logger.With("name", name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourceVault})
+	if err != nil {
+		return err
+	}
+
+	vault, err := c.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get vault"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(vault.AllowedTenants)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.Delete(ctx, name)
+	if err != nil {
+		errMessage := "failed to delete vault"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Stores already created from this vault keep their client until they are deleted
+	c.deleteVault(name)
+	c.notify(ctx, cluster.OpDelete, name)
+
+	logger.Info("vault deleted successfully")
+	return nil
+}
@@ -0,0 +1,35 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+)
+
+func (c *Vaults) Inspect(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.VaultDefinition, error) {
+	logger := c.logger.With("name", name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceVault})
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := c.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(vault.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("vault definition found successfully")
+	return vault, nil
+}
@@ -0,0 +1,23 @@
+package vaults
+
+import (
+	"context"
+	"sort"
+
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (c *Vaults) List(ctx context.Context, userInfo *auth.UserInfo) ([]string, error) {
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, c.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceVault})
+	if err != nil {
+		return nil, err
+	}
+
+	vaultNames := c.listVaults(resolver)
+	sort.Strings(vaultNames)
+
+	c.logger.Debug("vaults listed successfully")
+	return vaultNames, nil
+}
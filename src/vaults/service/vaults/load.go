@@ -0,0 +1,40 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+)
+
+func (c *Vaults) Load(ctx context.Context) error {
+	vaults, err := c.db.FindAll(ctx)
+	if err != nil {
+		errMessage := "failed to load vaults"
+		c.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	userInfo := auth.NewWildcardUser()
+	for _, vault := range vaults {
+		logger := c.logger.With("name", vault.Name)
+
+		// Vaults declared in manifests take precedence over persisted ones
+		if c.vaultExists(vault.Name) {
+			logger.Warn("vault already declared in manifests, skipping persisted definition")
+			continue
+		}
+
+		// A vault that cannot be reached must not prevent the others from being loaded
+		err = c.createFromDefinition(ctx, vault, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to load vault")
+			continue
+		}
+
+		c.revisions.Set(vault.Name, vault.UpdatedAt)
+	}
+
+	c.logger.Info("vaults loaded successfully", "count", len(vaults))
+	return nil
+}
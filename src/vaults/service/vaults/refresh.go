@@ -0,0 +1,74 @@
+package vaults
+
+import (
+	"context"
+	"time"
+
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+// Refresh applies a change made to a vault by another replica, reading its definition from the database
+func (c *Vaults) Refresh(ctx context.Context, event *cluster.Event) error {
+	switch event.Op {
+	case cluster.OpDelete:
+		c.deleteVault(event.Name)
+		return nil
+	case cluster.OpResync:
+		return c.resync(ctx)
+	}
+
+	vault, err := c.db.FindOne(ctx, event.Name)
+	if err != nil {
+		return err
+	}
+
+	err = c.createFromDefinition(ctx, vault, auth.NewWildcardUser())
+	if err != nil {
+		return err
+	}
+
+	c.revisions.Set(vault.Name, vault.UpdatedAt)
+	return nil
+}
+
+// resync applies the changes made to the persisted vaults that the replica missed
+func (c *Vaults) resync(ctx context.Context) error {
+	readAt := time.Now()
+	vaults, err := c.db.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	userInfo := auth.NewWildcardUser()
+	var names []string
+	for _, vault := range vaults {
+		names = append(names, vault.Name)
+		logger := c.logger.With("name", vault.Name)
+
+		// Vaults declared in manifests take precedence over persisted ones
+		if !c.revisions.Has(vault.Name) && c.vaultExists(vault.Name) {
+			continue
+		}
+
+		if !c.revisions.Changed(vault.Name, vault.UpdatedAt, readAt) {
+			continue
+		}
+
+		err = c.createFromDefinition(ctx, vault, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to resync vault")
+			continue
+		}
+
+		c.revisions.Set(vault.Name, vault.UpdatedAt)
+		logger.Info("vault resynced successfully")
+	}
+
+	for _, name := range c.revisions.Missing(names, readAt) {
+		c.deleteVault(name)
+		c.logger.Info("vault deleted by another replica removed", "name", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	auth "github.com/longfan78/quorum-key-manager/src/auth/entities"
+	"github.com/longfan78/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
+)
+
+func (c *Vaults) Update(ctx context.Context, vault *entities.VaultDefinition, userInfo *auth.UserInfo) (*entities.VaultDefinition, error) {
+	logger := c.logger.With("name", vault.Name)
+
+	resolver := authorizator.New(c.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourceVault})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.db.FindOne(ctx, vault.Name)
+	if err != nil {
+		errMessage := "failed to get vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = resolver.CheckAccess(current.AllowedTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	// The vault type cannot be changed, the vault must be deleted and created again instead
+	vault.VaultType = current.VaultType
+
+	err = c.createFromDefinition(ctx, vault, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedVault, err := c.db.Update(ctx, vault)
+	if err != nil {
+		errMessage := "failed to update vault"
+		logger.WithError(err).Error(errMessage)
+
+		// Restore the client of the previous definition
+		if restoreErr := c.createFromDefinition(ctx, current, userInfo); restoreErr != nil {
+			logger.WithError(restoreErr).Error("failed to restore vault client")
+		}
+
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	c.revisions.Set(vault.Name, updatedVault.UpdatedAt)
+	c.notify(ctx, cluster.OpUpsert, vault.Name)
+
+	logger.Info("vault updated successfully")
+	return updatedVault, nil
+}
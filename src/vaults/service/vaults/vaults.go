@@ -5,27 +5,40 @@ import (
 	"sync"
 
 	"github.com/longfan78/quorum-key-manager/pkg/errors"
+	"github.com/longfan78/quorum-key-manager/pkg/json"
 	"github.com/longfan78/quorum-key-manager/src/auth"
+	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
 	"github.com/longfan78/quorum-key-manager/src/entities"
+	"github.com/longfan78/quorum-key-manager/src/infra/cluster"
 	"github.com/longfan78/quorum-key-manager/src/infra/log"
 	"github.com/longfan78/quorum-key-manager/src/vaults"
+	"github.com/longfan78/quorum-key-manager/src/vaults/database"
 )
 
 type Vaults struct {
-	logger log.Logger
-	mux    sync.RWMutex
-	vaults map[string]*entities.Vault
-	roles  auth.Roles
+	db       database.Vaults
+	logger   log.Logger
+	mux      sync.RWMutex
+	vaults   map[string]*entities.Vault
+	roles    auth.Roles
+	notifier cluster.Notifier
+	// tokenWatchers stop the watchers of the token files of the Hashicorp vaults, by vault name
+	tokenWatchers map[string]context.CancelFunc
+	revisions     *cluster.Revisions
 }
 
 var _ vaults.Vaults = &Vaults{}
 
-func New(roles auth.Roles, logger log.Logger) *Vaults {
+func New(db database.Vaults, roles auth.Roles, notifier cluster.Notifier, logger log.Logger) *Vaults {
 	return &Vaults{
-		logger: logger,
-		mux:    sync.RWMutex{},
-		vaults: make(map[string]*entities.Vault),
-		roles:  roles,
+		db:            db,
+		logger:        logger,
+		mux:           sync.RWMutex{},
+		vaults:        make(map[string]*entities.Vault),
+		roles:         roles,
+		notifier:      notifier,
+		tokenWatchers: make(map[string]context.CancelFunc),
+		revisions:     cluster.NewRevisions(),
 	}
 }
 
@@ -34,6 +47,8 @@ func (c *Vaults) createVault(name, vaultType string, allowedTenants []string, cl
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
+	// The token watcher of a replaced vault would otherwise keep running
+	c.stopTokenWatcher(name)
 	c.vaults[name] = &entities.Vault{
 		Name:           name,
 		Client:         cli,
@@ -59,3 +74,96 @@ func (c *Vaults) getVault(_ context.Context, name string, resolver auth.Authoriz
 	c.logger.Error(errMessage, "name", name)
 	return nil, errors.NotFoundError(errMessage)
 }
+
+// TODO: Move to in-memory data layer
+func (c *Vaults) deleteVault(name string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.stopTokenWatcher(name)
+	delete(c.vaults, name)
+	c.revisions.Delete(name)
+}
+
+// TODO: Move to in-memory data layer
+func (c *Vaults) setTokenWatcher(name string, cancel context.CancelFunc) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.tokenWatchers[name] = cancel
+}
+
+// stopTokenWatcher must be called with the lock held
+func (c *Vaults) stopTokenWatcher(name string) {
+	if cancel, ok := c.tokenWatchers[name]; ok {
+		cancel()
+		delete(c.tokenWatchers, name)
+	}
+}
+
+// TODO: Move to in-memory data layer
+func (c *Vaults) vaultExists(name string) bool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	_, ok := c.vaults[name]
+	return ok
+}
+
+// TODO: Move to in-memory data layer
+func (c *Vaults) listVaults(resolver auth.Authorizator) []string {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	var vaultNames []string
+	for name, vault := range c.vaults {
+		if err := resolver.CheckAccess(vault.AllowedTenants); err != nil {
+			continue
+		}
+
+		vaultNames = append(vaultNames, name)
+	}
+
+	return vaultNames
+}
+
+func (c *Vaults) createFromDefinition(ctx context.Context, vault *entities.VaultDefinition, userInfo *authtypes.UserInfo) error {
+	switch vault.VaultType {
+	case entities.HashicorpVaultType:
+		config := &entities.HashicorpConfig{}
+		if err := json.UnmarshalJSON(vault.Config, config); err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		// Files of the key manager must not be read, and sent to any address, on behalf of API users
+		if config.TokenPath != "" || config.CACert != "" || config.CAPath != "" || config.ClientCert != "" || config.ClientKey != "" {
+			return errors.InvalidParameterError("filesystem paths are only supported by vaults declared in manifests")
+		}
+
+		return c.CreateHashicorp(ctx, vault.Name, config, vault.AllowedTenants, userInfo)
+	case entities.AzureVaultType:
+		config := &entities.AzureConfig{}
+		if err := json.UnmarshalJSON(vault.Config, config); err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		return c.CreateAzure(ctx, vault.Name, config, vault.AllowedTenants, userInfo)
+	case entities.AWSVaultType:
+		config := &entities.AWSConfig{}
+		if err := json.UnmarshalJSON(vault.Config, config); err != nil {
+			return errors.InvalidFormatError(err.Error())
+		}
+
+		return c.CreateAWS(ctx, vault.Name, config, vault.AllowedTenants, userInfo)
+	default:
+		return errors.InvalidFormatError("invalid vault type")
+	}
+}
+
+// notify propagates a change to the other replicas, the change being applied locally whether it succeeds or not
+func (c *Vaults) notify(ctx context.Context, op, name string) {
+	err := c.notifier.Notify(ctx, &cluster.Event{Kind: cluster.KindVault, Name: name, Op: op})
+	if err != nil {
+		c.logger.WithError(err).Warn("failed to propagate vault change", "name", name, "op", op)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	aliaspg "github.com/longfan78/quorum-key-manager/src/aliases/database/postgres"
+	authpg "github.com/longfan78/quorum-key-manager/src/auth/database/postgres"
 	"github.com/longfan78/quorum-key-manager/src/aliases/service/aliases"
 	"github.com/longfan78/quorum-key-manager/src/aliases/service/registries"
 	authtypes "github.com/longfan78/quorum-key-manager/src/auth/entities"
@@ -135,7 +136,7 @@ func (s *acceptanceTestSuite) TestEthereum() {
 	testSuite := new(ethTestSuite)
 	testSuite.env = s.env
 	testSuite.db = db
-	testSuite.store = eth.NewConnector(hashicorpkey.New(s.hasicorpPluginClient, logger), db, s.auth, logger)
+	testSuite.store = eth.NewConnector(hashicorpkey.New(s.hasicorpPluginClient, logger), nil, db, nil, s.auth, logger)
 	testSuite.utils = s.utils
 
 	suite.Run(s.T(), testSuite)
@@ -150,7 +151,7 @@ func (s *acceptanceTestSuite) TestEthereum() {
 	testSuite.env = s.env
 	testSuite.db = db
 	testSuite.utils = s.utils
-	testSuite.store = eth.NewConnector(local.New(hashicorp.New(s.hashicorpKvv2Client, secretsDB, logger), secretsDB, logger), db, s.auth, logger)
+	testSuite.store = eth.NewConnector(local.New(hashicorp.New(s.hashicorpKvv2Client, secretsDB, logger), secretsDB, logger), nil, db, nil, s.auth, logger)
 
 	suite.Run(s.T(), testSuite)
 }
@@ -159,7 +160,7 @@ func (s *acceptanceTestSuite) TestAliases() {
 	aliasRepository := aliaspg.NewAlias(s.env.postgresClient)
 	registryRepository := aliaspg.NewRegistry(s.env.postgresClient)
 
-	rolesService := roles.New(s.env.logger)
+	rolesService := roles.New(authpg.NewRoles(s.env.postgresClient), s.env.logger)
 
 	testSuite := new(aliasStoreTestSuite)
 	testSuite.env = s.env
@@ -158,6 +158,36 @@ func (s *ethTestSuite) TestGet() {
 	})
 }
 
+func (s *ethTestSuite) TestDisableEnable() {
+	ctx := context.Background()
+	id := s.newID("my-account-disable")
+
+	account, err := s.store.Create(ctx, id, &entities.Attributes{
+		Tags: testutils.FakeTags(),
+	})
+	require.NoError(s.T(), err)
+
+	s.Run("should disable an Ethereum Account successfully", func() {
+		disabledAccount, err := s.store.Disable(ctx, account.Address)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), disabledAccount.Metadata.Disabled)
+
+		retrievedAccount, err := s.db.Get(ctx, account.Address.Hex())
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedAccount.Metadata.Disabled)
+	})
+
+	s.Run("should enable a disabled Ethereum Account successfully", func() {
+		enabledAccount, err := s.store.Enable(ctx, account.Address)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), enabledAccount.Metadata.Disabled)
+
+		retrievedAccount, err := s.db.Get(ctx, account.Address.Hex())
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrievedAccount.Metadata.Disabled)
+	})
+}
+
 func (s *ethTestSuite) TestList() {
 	ctx := context.Background()
 	tags := testutils.FakeTags()
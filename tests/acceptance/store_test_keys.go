@@ -331,6 +331,38 @@ func (s *keysTestSuite) TestUpdate() {
 	})
 }
 
+func (s *keysTestSuite) TestDisableEnable() {
+	ctx := context.Background()
+	id := s.newID("my-key-disable")
+	_, err := s.store.Create(ctx, id, &entities2.Algorithm{
+		Type:          entities2.Ecdsa,
+		EllipticCurve: entities2.Secp256k1,
+	}, &entities.Attributes{
+		Tags: testutils.FakeTags(),
+	})
+	require.NoError(s.T(), err)
+
+	s.Run("should disable a key successfully", func() {
+		disabledKey, err := s.store.Disable(ctx, id)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), disabledKey.Metadata.Disabled)
+
+		key, err := s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), key.Metadata.Disabled)
+	})
+
+	s.Run("should enable a disabled key successfully", func() {
+		enabledKey, err := s.store.Enable(ctx, id)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), enabledKey.Metadata.Disabled)
+
+		key, err := s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), key.Metadata.Disabled)
+	})
+}
+
 func (s *keysTestSuite) TestSignVerify() {
 	ctx := context.Background()
 	tags := testutils.FakeTags()